@@ -2,6 +2,8 @@ package app
 
 import (
 	"fairyring/blockbuster"
+	keyshareante "fairyring/x/keyshare/ante"
+	keysharekeeper "fairyring/x/keyshare/keeper"
 	pepante "fairyring/x/pep/ante"
 	pepkeeper "fairyring/x/pep/keeper"
 
@@ -10,31 +12,37 @@ import (
 )
 
 type FairyringHandlerOptions struct {
-	BaseOptions  ante.HandlerOptions
-	Mempool      blockbuster.Mempool
-	KeyShareLane pepante.KeyShareLane
-	TxDecoder    sdk.TxDecoder
-	TxEncoder    sdk.TxEncoder
-	PepKeeper    pepkeeper.Keeper
+	BaseOptions    ante.HandlerOptions
+	Mempool        blockbuster.Mempool
+	KeyShareLane   pepante.KeyShareLane
+	TxDecoder      sdk.TxDecoder
+	TxEncoder      sdk.TxEncoder
+	PepKeeper      pepkeeper.Keeper
+	KeyshareKeeper keysharekeeper.Keeper
 }
 
-// NewFairyringAnteHandler wraps all of the default Cosmos SDK AnteDecorators with the Fairyring AnteHandler.
-func NewFairyringAnteHandler(options FairyringHandlerOptions) sdk.AnteHandler {
-	if options.BaseOptions.AccountKeeper == nil {
+// validateBaseOptions panics if one of the keepers required by the SDK AnteDecorators is missing
+func validateBaseOptions(options ante.HandlerOptions) {
+	if options.AccountKeeper == nil {
 		panic("account keeper is required for ante builder")
 	}
 
-	if options.BaseOptions.BankKeeper == nil {
+	if options.BankKeeper == nil {
 		panic("bank keeper is required for ante builder")
 	}
 
-	if options.BaseOptions.SignModeHandler == nil {
+	if options.SignModeHandler == nil {
 		panic("sign mode handler is required for ante builder")
 	}
 
-	if options.BaseOptions.FeegrantKeeper == nil {
+	if options.FeegrantKeeper == nil {
 		panic("fee grant keeper is required for ante builder")
 	}
+}
+
+// NewFairyringAnteHandler wraps all of the default Cosmos SDK AnteDecorators with the Fairyring AnteHandler.
+func NewFairyringAnteHandler(options FairyringHandlerOptions) sdk.AnteHandler {
+	validateBaseOptions(options.BaseOptions)
 
 	anteDecorators := []sdk.AnteDecorator{
 		ante.NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
@@ -55,6 +63,38 @@ func NewFairyringAnteHandler(options FairyringHandlerOptions) sdk.AnteHandler {
 		ante.NewSigVerificationDecorator(options.BaseOptions.AccountKeeper, options.BaseOptions.SignModeHandler),
 		ante.NewIncrementSequenceDecorator(options.BaseOptions.AccountKeeper),
 		pepante.NewPepDecorator(options.PepKeeper, options.TxEncoder, options.KeyShareLane, options.Mempool),
+		keyshareante.NewKeyshareRevealDecorator(options.KeyshareKeeper),
+	}
+
+	return sdk.ChainAnteDecorators(anteDecorators...)
+}
+
+// NewEncryptedTxAnteHandler returns the AnteHandler run by the pep module on the decrypted transactions,
+// so they obey the same rules as the plaintext ones. It uses the decorators of NewFairyringAnteHandler,
+// except that the signatures are verified against the pep nonces and the account sequences are not increased.
+// The fee of the decrypted transactions is deducted from their fee payer, or fee granter, to pay for their execution.
+// The mempool and keyshare lane checks of the PEPDecorator do not apply to decrypted transactions.
+func NewEncryptedTxAnteHandler(options FairyringHandlerOptions) sdk.AnteHandler {
+	validateBaseOptions(options.BaseOptions)
+
+	anteDecorators := []sdk.AnteDecorator{
+		ante.NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
+		ante.NewExtensionOptionsDecorator(options.BaseOptions.ExtensionOptionChecker),
+		ante.NewValidateBasicDecorator(),
+		ante.NewTxTimeoutHeightDecorator(),
+		ante.NewValidateMemoDecorator(options.BaseOptions.AccountKeeper),
+		ante.NewConsumeGasForTxSizeDecorator(options.BaseOptions.AccountKeeper),
+		ante.NewDeductFeeDecorator(
+			options.BaseOptions.AccountKeeper,
+			options.BaseOptions.BankKeeper,
+			options.BaseOptions.FeegrantKeeper,
+			options.BaseOptions.TxFeeChecker,
+		),
+		ante.NewSetPubKeyDecorator(options.BaseOptions.AccountKeeper), // SetPubKeyDecorator must be called before all signature verification decorators
+		ante.NewValidateSigCountDecorator(options.BaseOptions.AccountKeeper),
+		ante.NewSigGasConsumeDecorator(options.BaseOptions.AccountKeeper, options.BaseOptions.SigGasConsumer),
+		pepante.NewPepNonceVerificationDecorator(options.PepKeeper, options.BaseOptions.AccountKeeper, options.BaseOptions.SignModeHandler),
+		keyshareante.NewKeyshareRevealDecorator(options.KeyshareKeeper),
 	}
 
 	return sdk.ChainAnteDecorators(anteDecorators...)
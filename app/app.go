@@ -196,6 +196,7 @@ var (
 		govtypes.ModuleName:            {authtypes.Burner},
 		ibctransfertypes.ModuleName:    {authtypes.Minter, authtypes.Burner},
 		pepmoduletypes.ModuleName:      {authtypes.Minter, authtypes.Burner, authtypes.Staking},
+		keysharemoduletypes.ModuleName: nil,
 		// this line is used by starport scaffolding # stargate/app/maccPerms
 	}
 )
@@ -550,17 +551,6 @@ func New(
 		app.IBCKeeper.ConnectionKeeper,
 		app.BankKeeper,
 	)
-	pepModule := pepmodule.NewAppModule(
-		appCodec,
-		app.PepKeeper,
-		app.AccountKeeper,
-		app.BankKeeper,
-		app.MsgServiceRouter(),
-		encodingConfig.TxConfig,
-		app.SimCheck,
-	)
-
-	pepIBCModule := pepmodule.NewIBCModule(app.PepKeeper)
 
 	scopedKeyshareKeeper := app.CapabilityKeeper.ScopeToModule(keysharemoduletypes.ModuleName)
 	app.KeyshareKeeper = *keysharemodulekeeper.NewKeeper(
@@ -574,6 +564,8 @@ func New(
 		app.IBCKeeper.ConnectionKeeper,
 		app.PepKeeper,
 		app.StakingKeeper,
+		app.SlashingKeeper,
+		app.BankKeeper,
 	)
 
 	keyshareModule := keysharemodule.NewAppModule(
@@ -586,6 +578,37 @@ func New(
 
 	keyshareIBCModule := keysharemodule.NewIBCModule(app.KeyshareKeeper)
 
+	handlerOptions := ante.HandlerOptions{
+		AccountKeeper:   app.AccountKeeper,
+		BankKeeper:      app.BankKeeper,
+		FeegrantKeeper:  app.FeeGrantKeeper,
+		SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
+		SignModeHandler: app.txConfig.SignModeHandler(),
+	}
+
+	// The decrypted transactions go through the same ante decorators as the plaintext ones
+	encryptedTxAnteHandler := NewEncryptedTxAnteHandler(FairyringHandlerOptions{
+		BaseOptions:    handlerOptions,
+		PepKeeper:      app.PepKeeper,
+		KeyshareKeeper: app.KeyshareKeeper,
+		TxDecoder:      app.txConfig.TxDecoder(),
+		TxEncoder:      app.txConfig.TxEncoder(),
+	})
+
+	// The pep module funds the keyshare reward pool with encrypted tx fees
+	pepModule := pepmodule.NewAppModule(
+		appCodec,
+		app.PepKeeper,
+		app.AccountKeeper,
+		app.BankKeeper,
+		app.KeyshareKeeper,
+		app.MsgServiceRouter(),
+		encodingConfig.TxConfig,
+		encryptedTxAnteHandler,
+	)
+
+	pepIBCModule := pepmodule.NewIBCModule(app.PepKeeper)
+
 	// ---------------------------------------------------------------------------- //
 	// ------------------------- Begin Custom Code -------------------------------- //
 	// ---------------------------------------------------------------------------- //
@@ -608,6 +631,7 @@ func New(
 		config,
 		0,
 		keyshare.NewDefaultKeyshareFactory(app.txConfig.TxDecoder()),
+		app.KeyshareKeeper,
 	)
 
 	// Default lane accepts all other transactions.
@@ -632,20 +656,14 @@ func New(
 
 	// Create a global ante handler that will be called on each transaction when
 	// proposals are being built and verified.
-	handlerOptions := ante.HandlerOptions{
-		AccountKeeper:   app.AccountKeeper,
-		BankKeeper:      app.BankKeeper,
-		FeegrantKeeper:  app.FeeGrantKeeper,
-		SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
-		SignModeHandler: app.txConfig.SignModeHandler(),
-	}
 	options := FairyringHandlerOptions{
-		BaseOptions:  handlerOptions,
-		PepKeeper:    app.PepKeeper,
-		TxDecoder:    app.txConfig.TxDecoder(),
-		TxEncoder:    app.txConfig.TxEncoder(),
-		KeyShareLane: keyshareLane,
-		Mempool:      mempool,
+		BaseOptions:    handlerOptions,
+		PepKeeper:      app.PepKeeper,
+		KeyshareKeeper: app.KeyshareKeeper,
+		TxDecoder:      app.txConfig.TxDecoder(),
+		TxEncoder:      app.txConfig.TxEncoder(),
+		KeyShareLane:   keyshareLane,
+		Mempool:        mempool,
 	}
 	anteHandler := NewFairyringAnteHandler(options)
 
@@ -140,11 +140,17 @@ func (l *KeyShareLane) ProcessLaneBasic(txs []sdk.Tx) error {
 // VerifyTx will verify that the keyshare transaction is valid.
 // It will return an error if the transaction is invalid.
 func (l *KeyShareLane) VerifyTx(ctx sdk.Context, keyshareTx sdk.Tx) error {
-	_, err := l.GetKeyShareInfo(keyshareTx)
+	keyshareInfo, err := l.GetKeyShareInfo(keyshareTx)
 	if err != nil {
 		return fmt.Errorf("failed to get keyshare info: %w", err)
 	}
 
+	// In commit-reveal mode the aggregated key of a height must not be included before that height,
+	// otherwise the encrypted transactions targeting it could still be front-run
+	if l.keyshareKeeper.KeyshareCommitReveal(ctx) && keyshareInfo.Height > uint64(ctx.BlockHeight()) {
+		return fmt.Errorf("aggregated keyshare of height %d can not be included at height %d", keyshareInfo.Height, ctx.BlockHeight())
+	}
+
 	// verify the keyshare transaction
 	_, err = l.verifyTx(ctx, keyshareTx)
 	if err != nil {
@@ -29,6 +29,14 @@ type KeyShareLane struct {
 	// if a transaction is a aggregateKeyshare transaction and how to extract relevant
 	// information from the transaction (creator Address).
 	Factory
+
+	// keyshareKeeper tells whether the keyshares are submitted with commit-reveal.
+	keyshareKeeper KeyshareKeeper
+}
+
+// KeyshareKeeper defines the keyshare module params the lane depends on.
+type KeyshareKeeper interface {
+	KeyshareCommitReveal(ctx sdk.Context) bool
 }
 
 // NewKeyShareLane returns a new KeyShare lane.
@@ -36,15 +44,17 @@ func NewKeyShareLane(
 	cfg blockbuster.BaseLaneConfig,
 	maxTx int,
 	af Factory,
+	kk KeyshareKeeper,
 ) *KeyShareLane {
 	if err := cfg.ValidateBasic(); err != nil {
 		panic(err)
 	}
 
 	return &KeyShareLane{
-		Mempool:     NewMempool(cfg.TxEncoder, maxTx, af),
-		DefaultLane: base.NewDefaultLane(cfg),
-		Factory:     af,
+		Mempool:        NewMempool(cfg.TxEncoder, maxTx, af),
+		DefaultLane:    base.NewDefaultLane(cfg),
+		Factory:        af,
+		keyshareKeeper: kk,
 	}
 }
 
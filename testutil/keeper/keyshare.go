@@ -12,17 +12,25 @@ import (
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	slashingkeeper "github.com/cosmos/cosmos-sdk/x/slashing/keeper"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"fairyring/x/keyshare/keeper"
 	"fairyring/x/keyshare/types"
+	pepkeeper "fairyring/x/pep/keeper"
+	peptypes "fairyring/x/pep/types"
 
 	dbm "github.com/cometbft/cometbft-db"
 	"github.com/cometbft/cometbft/libs/log"
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	"github.com/cosmos/cosmos-sdk/store"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -70,16 +78,44 @@ func (keyshareconnectionKeeper) GetConnection(ctx sdk.Context, connectionID stri
 }
 
 func KeyshareKeeper(t testing.TB) (*keeper.Keeper, sdk.Context) {
+	k, ctx, _ := KeyshareKeeperWithDeps(t)
+	return k, ctx
+}
+
+// KeyshareDeps holds the keepers the keyshare keeper of the tests depends on
+type KeyshareDeps struct {
+	StakingKeeper *stakingkeeper.Keeper
+	PepKeeper     *pepkeeper.Keeper
+	BankKeeper    bankkeeper.BaseKeeper
+}
+
+// KeyshareKeeperWithDeps returns a keyshare keeper along with the keepers it depends on, so that
+// tests can set up the staking validators and the pep state the keyshare module relies on
+func KeyshareKeeperWithDeps(t testing.TB) (*keeper.Keeper, sdk.Context, KeyshareDeps) {
 	storeKey := sdk.NewKVStoreKey(types.StoreKey)
 	memStoreKey := storetypes.NewMemoryStoreKey(types.MemStoreKey)
+	accStoreKey := sdk.NewKVStoreKey(authtypes.StoreKey)
+	bankStoreKey := sdk.NewKVStoreKey(banktypes.StoreKey)
+	stakingStoreKey := sdk.NewKVStoreKey(stakingtypes.StoreKey)
+	slashingStoreKey := sdk.NewKVStoreKey(slashingtypes.StoreKey)
+	pepStoreKey := sdk.NewKVStoreKey(peptypes.StoreKey)
+	pepMemStoreKey := storetypes.NewMemoryStoreKey(peptypes.MemStoreKey)
 
 	db := dbm.NewMemDB()
 	stateStore := store.NewCommitMultiStore(db)
 	stateStore.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
 	stateStore.MountStoreWithDB(memStoreKey, storetypes.StoreTypeMemory, nil)
+	stateStore.MountStoreWithDB(accStoreKey, storetypes.StoreTypeIAVL, db)
+	stateStore.MountStoreWithDB(bankStoreKey, storetypes.StoreTypeIAVL, db)
+	stateStore.MountStoreWithDB(stakingStoreKey, storetypes.StoreTypeIAVL, db)
+	stateStore.MountStoreWithDB(slashingStoreKey, storetypes.StoreTypeIAVL, db)
+	stateStore.MountStoreWithDB(pepStoreKey, storetypes.StoreTypeIAVL, db)
+	stateStore.MountStoreWithDB(pepMemStoreKey, storetypes.StoreTypeMemory, nil)
 	require.NoError(t, stateStore.LoadLatestVersion())
 
 	registry := codectypes.NewInterfaceRegistry()
+	authtypes.RegisterInterfaces(registry)
+	cryptocodec.RegisterInterfaces(registry)
 	cdc := codec.NewProtoCodec(registry)
 
 	capabilityKeeper := capabilitykeeper.NewKeeper(cdc, storeKey, memStoreKey)
@@ -93,16 +129,21 @@ func KeyshareKeeper(t testing.TB) (*keeper.Keeper, sdk.Context) {
 
 	accountKeeper := authkeeper.NewAccountKeeper(
 		cdc,
-		sdk.NewKVStoreKey("acc"),
+		accStoreKey,
 		authtypes.ProtoBaseAccount,
-		map[string][]string{},
+		map[string][]string{
+			stakingtypes.BondedPoolName:    {authtypes.Burner, authtypes.Staking},
+			stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
+			types.ModuleName:               nil,
+			minttypes.ModuleName:           {authtypes.Minter},
+		},
 		sdk.Bech32PrefixAccAddr,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
 	bankKeeper := bankkeeper.NewBaseKeeper(
 		cdc,
-		sdk.NewKVStoreKey("bank"),
+		bankStoreKey,
 		accountKeeper,
 		map[string]bool{},
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
@@ -110,13 +151,21 @@ func KeyshareKeeper(t testing.TB) (*keeper.Keeper, sdk.Context) {
 
 	stakingKeeper := stakingkeeper.NewKeeper(
 		cdc,
-		sdk.NewKVStoreKey("staking"),
+		stakingStoreKey,
 		accountKeeper,
 		bankKeeper,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
-	pepKeeper, _ := PepKeeper(t)
+	slashingKeeper := slashingkeeper.NewKeeper(
+		cdc,
+		types.Amino,
+		slashingStoreKey,
+		stakingKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	pepKeeper := newPepKeeper(pepStoreKey, pepMemStoreKey)
 
 	k := keeper.NewKeeper(
 		cdc,
@@ -130,12 +179,19 @@ func KeyshareKeeper(t testing.TB) (*keeper.Keeper, sdk.Context) {
 		keyshareconnectionKeeper{},
 		*pepKeeper,
 		stakingKeeper,
+		slashingKeeper,
+		bankKeeper,
 	)
 
 	ctx := sdk.NewContext(stateStore, tmproto.Header{}, false, log.NewNopLogger())
 
 	// Initialize params
 	k.SetParams(ctx, types.DefaultParams())
+	pepKeeper.SetParams(ctx, peptypes.DefaultParams())
 
-	return k, ctx
+	return k, ctx, KeyshareDeps{
+		StakingKeeper: stakingKeeper,
+		PepKeeper:     pepKeeper,
+		BankKeeper:    bankKeeper,
+	}
 }
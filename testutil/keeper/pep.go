@@ -32,7 +32,7 @@ import (
 type pepChannelKeeper struct{}
 
 func (pepChannelKeeper) GetChannel(ctx sdk.Context, srcPort, srcChan string) (channel channeltypes.Channel, found bool) {
-	return channeltypes.Channel{}, false
+	return channeltypes.Channel{State: channeltypes.OPEN, ConnectionHops: []string{"connection-0"}}, true
 }
 func (pepChannelKeeper) GetNextSequenceSend(ctx sdk.Context, portID, channelID string) (uint64, bool) {
 	return 0, false
@@ -79,6 +79,19 @@ func PepKeeper(t testing.TB) (*keeper.Keeper, sdk.Context) {
 	stateStore.MountStoreWithDB(memStoreKey, storetypes.StoreTypeMemory, nil)
 	require.NoError(t, stateStore.LoadLatestVersion())
 
+	k := newPepKeeper(storeKey, memStoreKey)
+
+	ctx := sdk.NewContext(stateStore, tmproto.Header{}, false, logger)
+
+	// Initialize params
+	k.SetParams(ctx, types.DefaultParams())
+
+	return k, ctx
+}
+
+// newPepKeeper builds a pep keeper on the given store keys, so that it can share
+// the multistore of the keeper depending on it
+func newPepKeeper(storeKey *storetypes.KVStoreKey, memStoreKey *storetypes.MemoryStoreKey) *keeper.Keeper {
 	registry := codectypes.NewInterfaceRegistry()
 	appCodec := codec.NewProtoCodec(registry)
 	capabilityKeeper := capabilitykeeper.NewKeeper(appCodec, storeKey, memStoreKey)
@@ -107,7 +120,7 @@ func PepKeeper(t testing.TB) (*keeper.Keeper, sdk.Context) {
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
-	k := keeper.NewKeeper(
+	return keeper.NewKeeper(
 		appCodec,
 		storeKey,
 		memStoreKey,
@@ -118,11 +131,4 @@ func PepKeeper(t testing.TB) (*keeper.Keeper, sdk.Context) {
 		pepconnectionKeeper{},
 		bankKeeper,
 	)
-
-	ctx := sdk.NewContext(stateStore, tmproto.Header{}, false, logger)
-
-	// Initialize params
-	k.SetParams(ctx, types.DefaultParams())
-
-	return k, ctx
 }
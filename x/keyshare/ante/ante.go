@@ -0,0 +1,49 @@
+package ante
+
+import (
+	"fairyring/x/keyshare/keeper"
+	"fairyring/x/keyshare/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ sdk.AnteDecorator = KeyshareRevealDecorator{}
+
+// KeyshareRevealDecorator is an AnteDecorator that rejects the key shares revealed before their
+// block height in commit-reveal mode. As CheckTx runs on the last committed height, the key shares
+// of a height only enter the mempool once that height is committed.
+type KeyshareRevealDecorator struct {
+	keyshareKeeper keeper.Keeper
+}
+
+func NewKeyshareRevealDecorator(kk keeper.Keeper) KeyshareRevealDecorator {
+	return KeyshareRevealDecorator{
+		keyshareKeeper: kk,
+	}
+}
+
+// AnteHandle checks the block height of every key share revealed in the transaction
+func (kd KeyshareRevealDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if !kd.keyshareKeeper.KeyshareCommitReveal(ctx) {
+		return next(ctx, tx, simulate)
+	}
+
+	height := uint64(ctx.BlockHeight())
+
+	for _, msg := range tx.GetMsgs() {
+		switch m := msg.(type) {
+		case *types.MsgSendKeyshare:
+			if m.BlockHeight > height {
+				return ctx, types.ErrKeyshareRevealTooEarly.Wrapf("current height: %d, got: %d", height, m.BlockHeight)
+			}
+		case *types.MsgSendKeyshareBatch:
+			for _, item := range m.Keyshares {
+				if item.BlockHeight > height {
+					return ctx, types.ErrKeyshareRevealTooEarly.Wrapf("current height: %d, got: %d", height, item.BlockHeight)
+				}
+			}
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
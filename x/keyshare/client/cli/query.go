@@ -27,6 +27,7 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdQueryParams())
 	cmd.AddCommand(CmdListValidatorSet())
 	cmd.AddCommand(CmdShowValidatorSet())
+	cmd.AddCommand(CmdShowValidatorSetByShareIndex())
 	cmd.AddCommand(CmdListKeyShare())
 	cmd.AddCommand(CmdShowKeyShare())
 	cmd.AddCommand(CmdListAggregatedKeyShare())
@@ -37,6 +38,16 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdListGeneralKeyShare())
 	cmd.AddCommand(CmdShowGeneralKeyShare())
 	cmd.AddCommand(CmdShowCommitments())
+	cmd.AddCommand(CmdShowDkgRound())
+	cmd.AddCommand(CmdListKeyshareLiveness())
+	cmd.AddCommand(CmdShowKeyshareLiveness())
+	cmd.AddCommand(CmdListGeneralKeyRequest())
+	cmd.AddCommand(CmdShowGeneralKeyRequest())
+	cmd.AddCommand(CmdShowRewardPool())
+	cmd.AddCommand(CmdShowPendingRewards())
+	cmd.AddCommand(CmdListKeyShareRequest())
+	cmd.AddCommand(CmdShowKeyShareRequest())
+	cmd.AddCommand(CmdListKeyShareRequestByStatus())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
@@ -0,0 +1,48 @@
+package cli
+
+import (
+	"context"
+
+	"fairyring/x/keyshare/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+func CmdShowDkgRound() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-dkg-round [id]",
+		Short: "shows a dkg round with its deals and complaints",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			argID, err := cast.ToUint64E(args[0])
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryGetDkgRoundRequest{
+				Id: argID,
+			}
+
+			res, err := queryClient.DkgRound(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
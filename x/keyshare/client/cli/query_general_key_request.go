@@ -0,0 +1,73 @@
+package cli
+
+import (
+	"context"
+
+	"fairyring/x/keyshare/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+)
+
+func CmdListGeneralKeyRequest() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-general-key-request",
+		Short: "list all the general key requests made on fairyring",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryAllGeneralKeyRequestRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.GeneralKeyRequestAll(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdShowGeneralKeyRequest() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-general-key-request [identity]",
+		Short: "shows a general key request and its aggregated key by identity",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			argIdentity := args[0]
+
+			params := &types.QueryGetGeneralKeyRequestRequest{
+				Identity: argIdentity,
+			}
+
+			res, err := queryClient.GeneralKeyRequest(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
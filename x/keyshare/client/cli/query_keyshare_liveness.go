@@ -0,0 +1,73 @@
+package cli
+
+import (
+	"context"
+
+	"fairyring/x/keyshare/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+)
+
+func CmdListKeyshareLiveness() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-keyshare-liveness",
+		Short: "list the keyshare liveness of all validators",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryAllKeyshareLivenessRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.KeyshareLivenessAll(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdShowKeyshareLiveness() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-keyshare-liveness [validator]",
+		Short: "shows the keyshare liveness of a validator, including its missed counter",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			argValidator := args[0]
+
+			params := &types.QueryGetKeyshareLivenessRequest{
+				Validator: argValidator,
+			}
+
+			res, err := queryClient.KeyshareLiveness(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
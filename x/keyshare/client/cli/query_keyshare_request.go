@@ -0,0 +1,158 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"fairyring/x/keyshare/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+)
+
+const (
+	FlagCounterpartyChannel = "counterparty-channel"
+	FlagProposalID          = "proposal-id"
+	FlagSent                = "sent"
+)
+
+func CmdListKeyShareRequest() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-keyshare-request",
+		Short: "list the keyshare requests received over IBC",
+		Long:  "list the keyshare requests received over IBC, optionally filtered by counterparty channel, proposal id and sent status",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			counterpartyChannel, err := cmd.Flags().GetString(FlagCounterpartyChannel)
+			if err != nil {
+				return err
+			}
+
+			proposalID, err := cmd.Flags().GetString(FlagProposalID)
+			if err != nil {
+				return err
+			}
+
+			sent, err := cmd.Flags().GetBool(FlagSent)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryAllKeyShareRequestRequest{
+				Pagination:          pageReq,
+				CounterpartyChannel: counterpartyChannel,
+				ProposalId:          proposalID,
+				FilterBySent:        cmd.Flags().Changed(FlagSent),
+				Sent:                sent,
+			}
+
+			res, err := queryClient.KeyShareRequestAll(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagCounterpartyChannel, "", "Only list the requests received from this counterparty channel")
+	cmd.Flags().String(FlagProposalID, "", "Only list the requests of this proposal id")
+	cmd.Flags().Bool(FlagSent, false, "Only list the requests whose aggregated key was delivered (--sent) or not (--sent=false)")
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdShowKeyShareRequest() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-keyshare-request [identity]",
+		Short: "shows a keyshare request received over IBC and its delivery status by identity",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			argIdentity := args[0]
+
+			params := &types.QueryGetKeyShareRequestRequest{
+				Identity: argIdentity,
+			}
+
+			res, err := queryClient.KeyShareRequest(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdListKeyShareRequestByStatus() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-keyshare-request-by-status [pending|aggregated|sent|failed]",
+		Short: "list the keyshare requests received over IBC with the given delivery status",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			argStatus, err := parseKeyShareRequestStatus(args[0])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryKeyShareRequestsByStatusRequest{
+				Status:     argStatus,
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.KeyShareRequestsByStatus(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// parseKeyShareRequestStatus parses a status given either by its short name or by its enum name
+func parseKeyShareRequestStatus(arg string) (types.KeyShareRequestStatus, error) {
+	name := strings.ToUpper(arg)
+	if !strings.HasPrefix(name, "KEY_SHARE_REQUEST_STATUS_") {
+		name = "KEY_SHARE_REQUEST_STATUS_" + name
+	}
+
+	val, ok := types.KeyShareRequestStatus_value[name]
+	if !ok {
+		return 0, fmt.Errorf("invalid keyshare request status: %s", arg)
+	}
+	return types.KeyShareRequestStatus(val), nil
+}
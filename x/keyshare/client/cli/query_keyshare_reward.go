@@ -0,0 +1,71 @@
+package cli
+
+import (
+	"context"
+
+	"fairyring/x/keyshare/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+)
+
+func CmdShowRewardPool() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-reward-pool",
+		Short: "Show the keyshare reward pool and the height it is next distributed at",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryRewardPoolRequest{}
+
+			res, err := queryClient.RewardPool(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdShowPendingRewards() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-pending-rewards [validator]",
+		Short: "Show the key shares of a validator accepted during the current reward epoch and its pending rewards",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryPendingRewardsRequest{
+				Validator: args[0],
+			}
+
+			res, err := queryClient.PendingRewards(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cast"
 	"github.com/spf13/cobra"
 )
 
@@ -78,3 +79,39 @@ func CmdShowValidatorSet() *cobra.Command {
 
 	return cmd
 }
+
+func CmdShowValidatorSetByShareIndex() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-validator-set-by-share-index [share-index]",
+		Short: "shows the validatorSet a key share index is assigned to",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			argShareIndex, err := cast.ToUint64E(args[0])
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryGetValidatorSetByShareIndexRequest{
+				ShareIndex: argShareIndex,
+			}
+
+			res, err := queryClient.ValidatorSetByShareIndex(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
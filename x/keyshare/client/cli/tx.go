@@ -33,6 +33,17 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdDeleteAuthorizedAddress())
 
 	cmd.AddCommand(CmdCreateGeneralKeyShare())
+	cmd.AddCommand(CmdStartDkgRound())
+	cmd.AddCommand(CmdSubmitDkgDeal())
+	cmd.AddCommand(CmdSubmitDkgComplaint())
+	cmd.AddCommand(CmdSubmitDkgJustification())
+	cmd.AddCommand(CmdSendKeyshareBatch())
+	cmd.AddCommand(CmdUnjailKeyshareValidator())
+	cmd.AddCommand(CmdDeRegisterValidator())
+	cmd.AddCommand(CmdPauseKeyshare())
+	cmd.AddCommand(CmdCommitKeyshare())
+	cmd.AddCommand(CmdRequestGeneralKeyshare())
+	cmd.AddCommand(CmdResendAggrKeyshare())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
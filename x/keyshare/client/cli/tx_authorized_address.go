@@ -25,9 +25,16 @@ func CmdCreateAuthorizedAddress() *cobra.Command {
 				return err
 			}
 
+			expiryHeight, scope, err := parseAuthorizedAddressFlags(cmd)
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgCreateAuthorizedAddress(
 				clientCtx.GetFromAddress().String(),
 				indexTarget,
+				expiryHeight,
+				scope,
 			)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -36,6 +43,7 @@ func CmdCreateAuthorizedAddress() *cobra.Command {
 		},
 	}
 
+	addAuthorizedAddressFlags(cmd)
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -59,10 +67,17 @@ func CmdUpdateAuthorizedAddress() *cobra.Command {
 				return err
 			}
 
+			expiryHeight, scope, err := parseAuthorizedAddressFlags(cmd)
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgUpdateAuthorizedAddress(
 				clientCtx.GetFromAddress().String(),
 				indexTarget,
 				isAuthorized,
+				expiryHeight,
+				scope,
 			)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -71,6 +86,7 @@ func CmdUpdateAuthorizedAddress() *cobra.Command {
 		},
 	}
 
+	addAuthorizedAddressFlags(cmd)
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -104,3 +120,39 @@ func CmdDeleteAuthorizedAddress() *cobra.Command {
 
 	return cmd
 }
+
+const (
+	FlagExpiryHeight   = "expiry-height"
+	FlagBlockKeyshares = "block-keyshares"
+	FlagGeneralIDTypes = "general-id-types"
+)
+
+// addAuthorizedAddressFlags adds the expiry & scope flags of an authorized address,
+// without scope flags the address can submit every key share
+func addAuthorizedAddressFlags(cmd *cobra.Command) {
+	cmd.Flags().Uint64(FlagExpiryHeight, 0, "Height at which the authorization expires, 0 never expires")
+	cmd.Flags().Bool(FlagBlockKeyshares, false, "Allow the address to submit block keyshares")
+	cmd.Flags().StringSlice(FlagGeneralIDTypes, nil, "Id types of the general keyshares the address is allowed to submit")
+}
+
+func parseAuthorizedAddressFlags(cmd *cobra.Command) (uint64, types.AuthorizedScope, error) {
+	expiryHeight, err := cmd.Flags().GetUint64(FlagExpiryHeight)
+	if err != nil {
+		return 0, types.AuthorizedScope{}, err
+	}
+
+	blockKeyshares, err := cmd.Flags().GetBool(FlagBlockKeyshares)
+	if err != nil {
+		return 0, types.AuthorizedScope{}, err
+	}
+
+	generalIDTypes, err := cmd.Flags().GetStringSlice(FlagGeneralIDTypes)
+	if err != nil {
+		return 0, types.AuthorizedScope{}, err
+	}
+
+	return expiryHeight, types.AuthorizedScope{
+		BlockKeyshares: blockKeyshares,
+		GeneralIdTypes: generalIDTypes,
+	}, nil
+}
@@ -0,0 +1,64 @@
+package cli
+
+import (
+	"fairyring/x/keyshare/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+const FlagValidator = "validator"
+
+func CmdCommitKeyshare() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "commit-keyshare [keyshare] [keyshare-index] [block-height]",
+		Short: "Commit to the keyshare of a future block height, only its hash is broadcast",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argKeyshare := args[0]
+
+			keyshareIndex, err := cast.ToUint64E(args[1])
+			if err != nil {
+				return err
+			}
+
+			argBlockHeight, err := cast.ToUint64E(args[2])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// Authorized addresses commit on behalf of the validator that authorized them
+			validator, err := cmd.Flags().GetString(FlagValidator)
+			if err != nil {
+				return err
+			}
+			if validator == "" {
+				validator = clientCtx.GetFromAddress().String()
+			}
+
+			msg := types.NewMsgCommitKeyshare(
+				clientCtx.GetFromAddress().String(),
+				argBlockHeight,
+				keyshareIndex,
+				types.KeyshareCommitmentHash(validator, argBlockHeight, keyshareIndex, argKeyshare),
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagValidator, "", "Validator the keyshare is committed for, defaults to the sender")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,148 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"fairyring/x/keyshare/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+func CmdStartDkgRound() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "start-dkg-round",
+		Short: "Start a new distributed key generation round among the registered validators",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgStartDkgRound(
+				clientCtx.GetFromAddress().String(),
+			)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdSubmitDkgDeal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-dkg-deal [round-id] [commitments] [encrypted-shares]",
+		Short: "Submit the coefficient commitments and the encrypted shares of a dkg deal",
+		Long:  "Commitments are comma separated hex encoded points, encrypted shares are comma separated index:data pairs",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argRoundID, err := cast.ToUint64E(args[0])
+			if err != nil {
+				return err
+			}
+
+			commitments := strings.Split(args[1], ",")
+
+			var encryptedShares []types.DkgEncryptedShare
+			for _, eachShare := range strings.Split(args[2], ",") {
+				indexData := strings.SplitN(eachShare, ":", 2)
+				if len(indexData) != 2 {
+					return fmt.Errorf("invalid encrypted share: %s, expected index:data", eachShare)
+				}
+				index, err := cast.ToUint64E(indexData[0])
+				if err != nil {
+					return err
+				}
+				encryptedShares = append(encryptedShares, types.DkgEncryptedShare{
+					Index: index,
+					Data:  indexData[1],
+				})
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSubmitDkgDeal(
+				clientCtx.GetFromAddress().String(),
+				argRoundID,
+				commitments,
+				encryptedShares,
+			)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdSubmitDkgComplaint() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-dkg-complaint [round-id] [dealer]",
+		Short: "Complain about an invalid share received from a dealer",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argRoundID, err := cast.ToUint64E(args[0])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSubmitDkgComplaint(
+				clientCtx.GetFromAddress().String(),
+				argRoundID,
+				args[1],
+			)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdSubmitDkgJustification() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-dkg-justification [round-id] [complainer] [shares]",
+		Short: "Answer a complaint by revealing the comma separated shares dealt to the share indices of the complainer",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argRoundID, err := cast.ToUint64E(args[0])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSubmitDkgJustification(
+				clientCtx.GetFromAddress().String(),
+				argRoundID,
+				args[1],
+				strings.Split(args[2], ","),
+			)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
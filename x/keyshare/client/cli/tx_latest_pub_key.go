@@ -12,9 +12,10 @@ import (
 
 func CmdCreateLatestPubKey() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "create-latest-pub-key [public-key] [commitments]",
+		Use:   "create-latest-pub-key [public-key] [commitments] [index-owners]",
 		Short: "Create a latest public key",
-		Args:  cobra.ExactArgs(2),
+		Long:  "Create a latest public key, index-owners is the comma separated list of the validators the key shares were dealt to, the i-th validator holding the share index i",
+		Args:  cobra.ExactArgs(3),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 
 			// Get value arguments
@@ -23,6 +24,8 @@ func CmdCreateLatestPubKey() *cobra.Command {
 			commitmentStr := args[1]
 			commitments := strings.Split(commitmentStr, ",")
 
+			owners := strings.Split(args[2], ",")
+
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
@@ -32,6 +35,7 @@ func CmdCreateLatestPubKey() *cobra.Command {
 				clientCtx.GetFromAddress().String(),
 				argPublicKey,
 				commitments,
+				types.NewKeyShareAssignments(owners),
 			)
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
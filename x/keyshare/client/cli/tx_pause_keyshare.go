@@ -0,0 +1,44 @@
+package cli
+
+import (
+	"time"
+
+	"fairyring/x/keyshare/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+)
+
+func CmdPauseKeyshare() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pause-keyshare [duration]",
+		Short: "Pause the keyshare duties of the validator for the given duration, e.g. 2h",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argDuration, err := time.ParseDuration(args[0])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgPauseKeyshare(
+				clientCtx.GetFromAddress().String(),
+				argDuration,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
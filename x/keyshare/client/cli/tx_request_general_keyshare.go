@@ -0,0 +1,47 @@
+package cli
+
+import (
+	"strconv"
+
+	"fairyring/x/keyshare/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+)
+
+func CmdRequestGeneralKeyshare() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "request-general-keyshare [id] [release-height]",
+		Short: "Request the decryption key of an id, released at the given height. The general key request fee is escrowed",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argId := args[0]
+
+			argReleaseHeight, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRequestGeneralKeyshare(
+				clientCtx.GetFromAddress().String(),
+				argId,
+				argReleaseHeight,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,88 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"fairyring/x/keyshare/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+func CmdSendKeyshareBatch() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send-keyshare-batch [keyshares] [general-keyshares]",
+		Short: "Broadcast keyshares of several heights & general key shares of several identities in a single message",
+		Long: "Keyshares are comma separated block-height:keyshare-index:keyshare entries, " +
+			"general keyshares are comma separated id-type:id-value:keyshare-index:keyshare entries, either of them can be empty",
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			var keyshares []types.BatchKeyshare
+			for _, eachKeyshare := range splitBatchArg(args[0]) {
+				fields := strings.Split(eachKeyshare, ":")
+				if len(fields) != 3 {
+					return fmt.Errorf("invalid keyshare: %s, expected block-height:keyshare-index:keyshare", eachKeyshare)
+				}
+				blockHeight, err := cast.ToUint64E(fields[0])
+				if err != nil {
+					return err
+				}
+				keyshareIndex, err := cast.ToUint64E(fields[1])
+				if err != nil {
+					return err
+				}
+				keyshares = append(keyshares, types.BatchKeyshare{
+					Message:       fields[2],
+					KeyShareIndex: keyshareIndex,
+					BlockHeight:   blockHeight,
+				})
+			}
+
+			var generalKeyshares []types.BatchGeneralKeyshare
+			for _, eachKeyshare := range splitBatchArg(args[1]) {
+				fields := strings.Split(eachKeyshare, ":")
+				if len(fields) != 4 {
+					return fmt.Errorf("invalid general keyshare: %s, expected id-type:id-value:keyshare-index:keyshare", eachKeyshare)
+				}
+				keyshareIndex, err := cast.ToUint64E(fields[2])
+				if err != nil {
+					return err
+				}
+				generalKeyshares = append(generalKeyshares, types.BatchGeneralKeyshare{
+					IdType:        fields[0],
+					IdValue:       fields[1],
+					KeyShare:      fields[3],
+					KeyShareIndex: keyshareIndex,
+				})
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSendKeyshareBatch(
+				clientCtx.GetFromAddress().String(),
+				keyshares,
+				generalKeyshares,
+			)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// splitBatchArg splits a comma separated list, an empty argument results in an empty list
+func splitBatchArg(arg string) []string {
+	if arg == "" {
+		return nil
+	}
+	return strings.Split(arg, ",")
+}
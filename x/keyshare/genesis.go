@@ -24,17 +24,57 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	}
 	// Set actuve public key
 	k.SetActivePubKey(ctx, genState.ActivePubKey)
+	k.SetKeyShareAssignments(ctx, genState.ActivePubKey.Assignments)
 	// Set queued public key
 	k.SetQueuedPubKey(ctx, genState.QueuedPubKey)
 
 	// Set all the authorizedAddress
 	for _, elem := range genState.AuthorizedAddressList {
 		k.SetAuthorizedAddress(ctx, elem)
+		k.IncreaseAuthorizedCount(ctx, elem.AuthorizedBy)
 	}
 	// Set all the generalKeyShare
 	for _, elem := range genState.GeneralKeyShareList {
 		k.SetGeneralKeyShare(ctx, elem)
 	}
+	// Set all the dkgRound
+	for _, elem := range genState.DkgRoundList {
+		k.SetDkgRound(ctx, elem)
+	}
+	// Set all the dkgDeal
+	for _, elem := range genState.DkgDealList {
+		k.SetDkgDeal(ctx, elem)
+	}
+	// Set all the dkgComplaint
+	for _, elem := range genState.DkgComplaintList {
+		k.SetDkgComplaint(ctx, elem)
+	}
+	k.SetDkgRoundCount(ctx, genState.DkgRoundCount)
+	// Set all the keyShareMisbehavior
+	for _, elem := range genState.KeyShareMisbehaviorList {
+		k.SetKeyShareMisbehavior(ctx, elem)
+	}
+	// Set all the keyshareLivenessInfo
+	for _, elem := range genState.KeyshareLivenessInfoList {
+		k.SetKeyshareLivenessInfo(ctx, elem)
+	}
+	// Set all the keyshareCommitment
+	for _, elem := range genState.KeyshareCommitmentList {
+		k.SetKeyshareCommitment(ctx, elem)
+	}
+	// Set all the generalKeyRequest, the pending ones are released & queued for expiry again
+	for _, elem := range genState.GeneralKeyRequestList {
+		k.SetGeneralKeyRequest(ctx, elem)
+		if elem.AggrKeyshare == "" {
+			k.SetGeneralKeyReleaseQueue(ctx, elem.ReleaseHeight, elem.Identity)
+			k.SetGeneralKeyExpiryQueue(ctx, elem.ExpiryHeight, elem.Identity)
+		}
+	}
+	k.SetRewardPool(ctx, genState.RewardPool)
+	// Set all the validatorRewardShares
+	for _, elem := range genState.ValidatorRewardSharesList {
+		k.SetValidatorRewardShares(ctx, elem)
+	}
 	// this line is used by starport scaffolding # genesis/module/init
 
 	var portID string
@@ -79,6 +119,16 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 
 	genesis.AuthorizedAddressList = k.GetAllAuthorizedAddress(ctx)
 	genesis.GeneralKeyShareList = k.GetAllGeneralKeyShare(ctx)
+	genesis.DkgRoundList = k.GetAllDkgRound(ctx)
+	genesis.DkgDealList = k.GetAllDkgDeal(ctx)
+	genesis.DkgComplaintList = k.GetAllDkgComplaint(ctx)
+	genesis.DkgRoundCount = k.GetDkgRoundCount(ctx)
+	genesis.KeyShareMisbehaviorList = k.GetAllKeyShareMisbehavior(ctx)
+	genesis.KeyshareLivenessInfoList = k.GetAllKeyshareLivenessInfo(ctx)
+	genesis.KeyshareCommitmentList = k.GetAllKeyshareCommitment(ctx)
+	genesis.GeneralKeyRequestList = k.GetAllGeneralKeyRequest(ctx)
+	genesis.RewardPool = k.GetRewardPool(ctx)
+	genesis.ValidatorRewardSharesList = k.GetAllValidatorRewardShares(ctx)
 	// this line is used by starport scaffolding # genesis/module/export
 
 	genesis.PortId = k.GetPort(ctx)
@@ -8,6 +8,7 @@ import (
 	"fairyring/x/keyshare"
 	"fairyring/x/keyshare/types"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -61,6 +62,55 @@ func TestGenesis(t *testing.T) {
 				IdValue:   "1",
 			},
 		},
+		KeyShareMisbehaviorList: []types.KeyShareMisbehavior{
+			{
+				Validator: "0",
+			},
+			{
+				Validator: "1",
+			},
+		},
+		KeyshareLivenessInfoList: []types.KeyshareLivenessInfo{
+			{
+				Validator: "0",
+			},
+			{
+				Validator: "1",
+			},
+		},
+		KeyshareCommitmentList: []types.KeyshareCommitment{
+			{
+				Validator:     "0",
+				BlockHeight:   1,
+				KeyShareIndex: 1,
+			},
+			{
+				Validator:     "1",
+				BlockHeight:   1,
+				KeyShareIndex: 2,
+			},
+		},
+		GeneralKeyRequestList: []types.GeneralKeyRequest{
+			{
+				Identity: "0",
+				Fee:      sdk.NewCoin("ufairy", sdk.NewInt(1)),
+			},
+			{
+				Identity: "1",
+				Fee:      sdk.NewCoin("ufairy", sdk.NewInt(1)),
+			},
+		},
+		RewardPool: types.RewardPool{Coins: sdk.NewCoins(sdk.NewInt64Coin("ufairy", 10))},
+		ValidatorRewardSharesList: []types.ValidatorRewardShares{
+			{
+				Validator:      "0",
+				AcceptedShares: 1,
+			},
+			{
+				Validator:      "1",
+				AcceptedShares: 2,
+			},
+		},
 		// this line is used by starport scaffolding # genesis/test/state
 	}
 
@@ -77,5 +127,11 @@ func TestGenesis(t *testing.T) {
 	require.ElementsMatch(t, genesisState.AggregatedKeyShareList, got.AggregatedKeyShareList)
 	require.ElementsMatch(t, genesisState.AuthorizedAddressList, got.AuthorizedAddressList)
 	require.ElementsMatch(t, genesisState.GeneralKeyShareList, got.GeneralKeyShareList)
+	require.ElementsMatch(t, genesisState.KeyShareMisbehaviorList, got.KeyShareMisbehaviorList)
+	require.ElementsMatch(t, genesisState.KeyshareLivenessInfoList, got.KeyshareLivenessInfoList)
+	require.ElementsMatch(t, genesisState.KeyshareCommitmentList, got.KeyshareCommitmentList)
+	require.ElementsMatch(t, genesisState.GeneralKeyRequestList, got.GeneralKeyRequestList)
+	require.Equal(t, genesisState.RewardPool, got.RewardPool)
+	require.ElementsMatch(t, genesisState.ValidatorRewardSharesList, got.ValidatorRewardSharesList)
 	// this line is used by starport scaffolding # genesis/test/assert
 }
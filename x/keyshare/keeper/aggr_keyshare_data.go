@@ -2,7 +2,8 @@ package keeper
 
 import (
 	"errors"
-	"time"
+	"fmt"
+	"strconv"
 
 	"fairyring/x/keyshare/types"
 
@@ -13,8 +14,6 @@ import (
 	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
 )
 
-const MAX_RETRIES = 5
-
 // TransmitAggrKeyshareDataPacket transmits the packet over IBC with the specified source port and source channel
 func (k Keeper) TransmitAggrKeyshareDataPacket(
 	ctx sdk.Context,
@@ -48,31 +47,55 @@ func (k Keeper) TransmitAggrKeyshareDataPacket(
 	return k.ChannelKeeper.SendPacket(ctx, channelCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, packetBytes)
 }
 
+// SendAggrKeyshare transmits the aggregated key packet over the channel with a timeout of
+// AggrKeysharePacketTimeout, and emits an event recording the attempt
+func (k Keeper) SendAggrKeyshare(
+	ctx sdk.Context,
+	packetData types.AggrKeyshareDataPacketData,
+	sourcePort,
+	sourceChannel string,
+) (uint64, error) {
+	timeoutTimestamp := uint64(ctx.BlockTime().Add(k.AggrKeysharePacketTimeout(ctx)).UnixNano())
+
+	sequence, err := k.TransmitAggrKeyshareDataPacket(
+		ctx,
+		packetData,
+		sourcePort,
+		sourceChannel,
+		clienttypes.ZeroHeight(),
+		timeoutTimestamp,
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	// Track the packet in flight, only its acknowledgement or timeout can schedule the next retry
+	if keyShareReq, found := k.GetKeyShareRequest(ctx, packetData.Identity); found {
+		keyShareReq.InflightSequence = sequence
+		keyShareReq.RetryHeight = 0
+		k.SetKeyShareRequest(ctx, keyShareReq)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.AggrKeyshareSendAttemptEventType,
+			sdk.NewAttribute(types.AggrKeyshareSendAttemptEventIdentity, packetData.Identity),
+			sdk.NewAttribute(types.AggrKeyshareSendAttemptEventPort, sourcePort),
+			sdk.NewAttribute(types.AggrKeyshareSendAttemptEventChannel, sourceChannel),
+			sdk.NewAttribute(types.AggrKeyshareSendAttemptEventSequence, strconv.FormatUint(sequence, 10)),
+			sdk.NewAttribute(types.AggrKeyshareSendAttemptEventRetry, strconv.FormatUint(packetData.Retries, 10)),
+			sdk.NewAttribute(types.AggrKeyshareSendAttemptEventTimeoutTimestamp, strconv.FormatUint(timeoutTimestamp, 10)),
+		),
+	)
+
+	return sequence, nil
+}
+
 // OnAcknowledgementAggrKeyshareDataPacket responds to the the success or failure of a packet
 // acknowledgement written on the receiving chain.
 func (k Keeper) OnAcknowledgementAggrKeyshareDataPacket(ctx sdk.Context, packet channeltypes.Packet, data types.AggrKeyshareDataPacketData, ack channeltypes.Acknowledgement) error {
 	switch dispatchedAck := ack.Response.(type) {
 	case *channeltypes.Acknowledgement_Error:
-
-		// retry sending the packet
-		if data.Retries < MAX_RETRIES {
-			timeoutTimestamp := ctx.BlockTime().Add(time.Second * 20).UnixNano()
-
-			data.Retries = data.Retries + 1
-
-			_, err := k.TransmitAggrKeyshareDataPacket(
-				ctx,
-				data,
-				packet.SourcePort,
-				packet.SourceChannel,
-				clienttypes.ZeroHeight(),
-				uint64(timeoutTimestamp),
-			)
-			if err != nil {
-				return err
-			}
-		}
-		return nil
+		return k.RetryAggrKeyshare(ctx, packet, data, types.AggrKeyshareRetryReasonErrorAck)
 	case *channeltypes.Acknowledgement_Result:
 		// Decode the packet acknowledgment
 		var packetAck types.AggrKeyshareDataPacketAck
@@ -88,8 +111,19 @@ func (k Keeper) OnAcknowledgementAggrKeyshareDataPacket(ctx sdk.Context, packet
 		}
 
 		keyshareReq.Sent = true
+		keyshareReq.Failed = false
+		keyshareReq.InflightSequence = 0
+		keyshareReq.RetryHeight = 0
+		keyshareReq.ClosedHeight = uint64(ctx.BlockHeight())
 		k.SetKeyShareRequest(ctx, keyshareReq)
 
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(types.AggrKeyshareDeliveredEventType,
+				sdk.NewAttribute(types.AggrKeyshareDeliveredEventIdentity, data.Identity),
+				sdk.NewAttribute(types.AggrKeyshareDeliveredEventRetry, strconv.FormatUint(data.Retries, 10)),
+			),
+		)
+
 		return nil
 	default:
 		// The counter-party module doesn't implement the correct acknowledgment format
@@ -99,25 +133,62 @@ func (k Keeper) OnAcknowledgementAggrKeyshareDataPacket(ctx sdk.Context, packet
 
 // OnTimeoutAggrKeyshareDataPacket responds to the case where a packet has not been transmitted because of a timeout
 func (k Keeper) OnTimeoutAggrKeyshareDataPacket(ctx sdk.Context, packet channeltypes.Packet, data types.AggrKeyshareDataPacketData) error {
+	return k.RetryAggrKeyshare(ctx, packet, data, types.AggrKeyshareRetryReasonTimeout)
+}
+
+// RetryAggrKeyshare sends an undelivered aggregated key packet again after the backoff of its retry,
+// the key share request is marked failed once AggrKeyshareMaxRetries retries are used or when the packet can't be sent.
+// Packets that are no longer in flight for their request, e.g. superseded by a MsgResendAggrKeyshare, are not retried
+func (k Keeper) RetryAggrKeyshare(ctx sdk.Context, packet channeltypes.Packet, data types.AggrKeyshareDataPacketData, reason string) error {
+	keyShareReq, found := k.GetKeyShareRequest(ctx, data.Identity)
+	if found {
+		if keyShareReq.InflightSequence != packet.Sequence {
+			return nil
+		}
 
-	// retry sending the packet
-	if data.Retries < MAX_RETRIES {
-		timeoutTimestamp := ctx.BlockTime().Add(time.Second * 20).UnixNano()
+		keyShareReq.InflightSequence = 0
+		k.SetKeyShareRequest(ctx, keyShareReq)
+	}
 
-		data.Retries = data.Retries + 1
+	params := k.GetParams(ctx)
+	if data.Retries >= params.AggrKeyshareMaxRetries {
+		k.FailKeyShareRequest(ctx, data.Identity, types.KeyShareRequestFailureRetries)
+		return nil
+	}
 
-		_, err := k.TransmitAggrKeyshareDataPacket(
-			ctx,
-			data,
-			packet.SourcePort,
-			packet.SourceChannel,
-			clienttypes.ZeroHeight(),
-			uint64(timeoutTimestamp),
-		)
-		if err != nil {
-			return err
+	data.Retries = data.Retries + 1
+
+	delay := params.AggrKeyshareRetryDelay(data.Retries)
+	if delay == 0 {
+		// A send error is not returned so the acknowledgement or timeout of the previous packet is still processed
+		if _, err := k.SendAggrKeyshare(ctx, data, packet.SourcePort, packet.SourceChannel); err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("Error while sending aggregated keyshare of identity: %s, %s", data.Identity, err.Error()))
+			k.FailKeyShareRequest(ctx, data.Identity, types.KeyShareRequestFailureSend)
 		}
+		return nil
+	}
+
+	retry := types.AggrKeyshareRetry{
+		Height:    uint64(ctx.BlockHeight()) + delay,
+		PortId:    packet.SourcePort,
+		ChannelId: packet.SourceChannel,
+		Packet:    data,
 	}
+	k.SetAggrKeyshareRetry(ctx, retry)
+
+	if found {
+		keyShareReq.RetryHeight = retry.Height
+		k.SetKeyShareRequest(ctx, keyShareReq)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.AggrKeyshareRetryScheduledEventType,
+			sdk.NewAttribute(types.AggrKeyshareRetryScheduledEventIdentity, data.Identity),
+			sdk.NewAttribute(types.AggrKeyshareRetryScheduledEventRetry, strconv.FormatUint(data.Retries, 10)),
+			sdk.NewAttribute(types.AggrKeyshareRetryScheduledEventHeight, strconv.FormatUint(retry.Height, 10)),
+			sdk.NewAttribute(types.AggrKeyshareRetryScheduledEventReason, reason),
+		),
+	)
 
 	return nil
 }
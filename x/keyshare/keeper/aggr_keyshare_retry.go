@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"fairyring/x/keyshare/types"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetAggrKeyshareRetry queues an aggregated key packet to be sent again at the retry height
+func (k Keeper) SetAggrKeyshareRetry(ctx sdk.Context, retry types.AggrKeyshareRetry) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AggrKeyshareRetryQueueKeyPrefix))
+	b := k.cdc.MustMarshal(&retry)
+	store.Set(types.AggrKeyshareRetryQueueKey(
+		retry.Height,
+		retry.Packet.Identity,
+	), b)
+}
+
+// GetAllAggrKeyshareRetry returns all the queued aggregated key packets
+func (k Keeper) GetAllAggrKeyshareRetry(ctx sdk.Context) (list []types.AggrKeyshareRetry) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AggrKeyshareRetryQueueKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.AggrKeyshareRetry
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// ProcessAggrKeyshareRetries sends the aggregated key packets queued up to the current height again,
+// the key share request is marked failed if its packet can't be sent anymore
+func (k Keeper) ProcessAggrKeyshareRetries(ctx sdk.Context) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AggrKeyshareRetryQueueKeyPrefix))
+
+	end := make([]byte, 8)
+	binary.BigEndian.PutUint64(end, uint64(ctx.BlockHeight())+1)
+	iterator := store.Iterator(nil, end)
+
+	var keys [][]byte
+	var retries []types.AggrKeyshareRetry
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.AggrKeyshareRetry
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+
+		keys = append(keys, iterator.Key())
+		retries = append(retries, val)
+	}
+	iterator.Close()
+
+	for i, key := range keys {
+		store.Delete(key)
+
+		retry := retries[i]
+
+		// The key may have been delivered or resent by a MsgResendAggrKeyshare in the meantime
+		keyShareReq, found := k.GetKeyShareRequest(ctx, retry.Packet.Identity)
+		if !found || keyShareReq.Sent || keyShareReq.RetryHeight != retry.Height {
+			continue
+		}
+
+		if _, err := k.SendAggrKeyshare(ctx, retry.Packet, retry.PortId, retry.ChannelId); err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("Error while sending aggregated keyshare of identity: %s, %s", retry.Packet.Identity, err.Error()))
+			k.FailKeyShareRequest(ctx, retry.Packet.Identity, types.KeyShareRequestFailureSend)
+		}
+	}
+}
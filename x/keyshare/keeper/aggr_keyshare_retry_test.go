@@ -0,0 +1,119 @@
+package keeper_test
+
+import (
+	"testing"
+
+	keepertest "fairyring/testutil/keeper"
+	"fairyring/x/keyshare/types"
+
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetryAggrKeyshare(t *testing.T) {
+	keeper, ctx := keepertest.KeyshareKeeper(t)
+	params := keeper.GetParams(ctx)
+	params.AggrKeyshareMaxRetries = 2
+	params.AggrKeyshareRetryBackoffBlocks = 5
+	keeper.SetParams(ctx, params)
+
+	ctx = ctx.WithBlockHeight(100)
+	packet := channeltypes.Packet{SourcePort: "keyshare", SourceChannel: "channel-0"}
+
+	keeper.SetKeyShareRequest(ctx, types.KeyShareRequest{Identity: "1/rq", AggrKeyshare: "key"})
+	require.NoError(t, keeper.RetryAggrKeyshare(ctx, packet, types.AggrKeyshareDataPacketData{Identity: "1/rq", Retries: 1}, types.AggrKeyshareRetryReasonTimeout))
+
+	// The second retry is queued after twice the backoff
+	retries := keeper.GetAllAggrKeyshareRetry(ctx)
+	require.Len(t, retries, 1)
+	require.Equal(t, uint64(110), retries[0].Height)
+	require.Equal(t, uint64(2), retries[0].Packet.Retries)
+	require.Equal(t, "channel-0", retries[0].ChannelId)
+
+	// The request is failed once the retries are used
+	require.NoError(t, keeper.RetryAggrKeyshare(ctx, packet, retries[0].Packet, types.AggrKeyshareRetryReasonErrorAck))
+	req, found := keeper.GetKeyShareRequest(ctx, "1/rq")
+	require.True(t, found)
+	require.Equal(t, types.KeyShareRequestStatus_KEY_SHARE_REQUEST_STATUS_FAILED, req.Status())
+}
+
+func TestRetryAggrKeyshareSendError(t *testing.T) {
+	keeper, ctx := keepertest.KeyshareKeeper(t)
+	params := keeper.GetParams(ctx)
+	params.AggrKeyshareRetryBackoffBlocks = 0
+	keeper.SetParams(ctx, params)
+
+	ctx = ctx.WithBlockHeight(100)
+	packet := channeltypes.Packet{SourcePort: "keyshare", SourceChannel: "channel-0"}
+
+	// The module owns no channel capability, so the packet sent right away fails the request
+	// without returning an error to the acknowledgement or timeout callback
+	keeper.SetKeyShareRequest(ctx, types.KeyShareRequest{Identity: "1/rq", AggrKeyshare: "key"})
+	require.NoError(t, keeper.RetryAggrKeyshare(ctx, packet, types.AggrKeyshareDataPacketData{Identity: "1/rq"}, types.AggrKeyshareRetryReasonTimeout))
+	require.Empty(t, keeper.GetAllAggrKeyshareRetry(ctx))
+
+	req, found := keeper.GetKeyShareRequest(ctx, "1/rq")
+	require.True(t, found)
+	require.Equal(t, types.KeyShareRequestStatus_KEY_SHARE_REQUEST_STATUS_FAILED, req.Status())
+}
+
+func TestProcessAggrKeyshareRetries(t *testing.T) {
+	keeper, ctx := keepertest.KeyshareKeeper(t)
+	ctx = ctx.WithBlockHeight(100)
+
+	keeper.SetKeyShareRequest(ctx, types.KeyShareRequest{Identity: "1/rq", AggrKeyshare: "key", RetryHeight: 100})
+	keeper.SetKeyShareRequest(ctx, types.KeyShareRequest{Identity: "2/rq", AggrKeyshare: "key", Sent: true})
+	keeper.SetKeyShareRequest(ctx, types.KeyShareRequest{Identity: "4/rq", AggrKeyshare: "key", RetryHeight: 105})
+	keeper.SetAggrKeyshareRetry(ctx, types.AggrKeyshareRetry{Height: 100, PortId: "keyshare", ChannelId: "channel-0", Packet: types.AggrKeyshareDataPacketData{Identity: "1/rq", Retries: 1}})
+	keeper.SetAggrKeyshareRetry(ctx, types.AggrKeyshareRetry{Height: 99, PortId: "keyshare", ChannelId: "channel-0", Packet: types.AggrKeyshareDataPacketData{Identity: "2/rq", Retries: 1}})
+	keeper.SetAggrKeyshareRetry(ctx, types.AggrKeyshareRetry{Height: 98, PortId: "keyshare", ChannelId: "channel-0", Packet: types.AggrKeyshareDataPacketData{Identity: "4/rq", Retries: 1}})
+	keeper.SetAggrKeyshareRetry(ctx, types.AggrKeyshareRetry{Height: 101, PortId: "keyshare", ChannelId: "channel-0", Packet: types.AggrKeyshareDataPacketData{Identity: "3/rq", Retries: 1}})
+
+	keeper.ProcessAggrKeyshareRetries(ctx)
+
+	// Only the retry of a later height is left in the queue
+	retries := keeper.GetAllAggrKeyshareRetry(ctx)
+	require.Len(t, retries, 1)
+	require.Equal(t, "3/rq", retries[0].Packet.Identity)
+
+	// The module owns no channel capability, so the retry can't be sent
+	req, found := keeper.GetKeyShareRequest(ctx, "1/rq")
+	require.True(t, found)
+	require.Equal(t, types.KeyShareRequestStatus_KEY_SHARE_REQUEST_STATUS_FAILED, req.Status())
+
+	// Delivered requests are left untouched
+	req, found = keeper.GetKeyShareRequest(ctx, "2/rq")
+	require.True(t, found)
+	require.Equal(t, types.KeyShareRequestStatus_KEY_SHARE_REQUEST_STATUS_SENT, req.Status())
+
+	// Retries superseded by a later one are dropped
+	req, found = keeper.GetKeyShareRequest(ctx, "4/rq")
+	require.True(t, found)
+	require.Equal(t, types.KeyShareRequestStatus_KEY_SHARE_REQUEST_STATUS_AGGREGATED, req.Status())
+}
+
+func TestRetryAggrKeyshareStalePacket(t *testing.T) {
+	keeper, ctx := keepertest.KeyshareKeeper(t)
+	ctx = ctx.WithBlockHeight(100)
+
+	keeper.SetKeyShareRequest(ctx, types.KeyShareRequest{Identity: "1/rq", AggrKeyshare: "key", InflightSequence: 7})
+
+	// The timeout of a packet that is no longer in flight is ignored
+	stale := channeltypes.Packet{Sequence: 6, SourcePort: "keyshare", SourceChannel: "channel-0"}
+	require.NoError(t, keeper.RetryAggrKeyshare(ctx, stale, types.AggrKeyshareDataPacketData{Identity: "1/rq"}, types.AggrKeyshareRetryReasonTimeout))
+	require.Empty(t, keeper.GetAllAggrKeyshareRetry(ctx))
+
+	req, found := keeper.GetKeyShareRequest(ctx, "1/rq")
+	require.True(t, found)
+	require.Equal(t, uint64(7), req.InflightSequence)
+
+	// The timeout of the packet in flight queues the retry
+	inflight := channeltypes.Packet{Sequence: 7, SourcePort: "keyshare", SourceChannel: "channel-0"}
+	require.NoError(t, keeper.RetryAggrKeyshare(ctx, inflight, types.AggrKeyshareDataPacketData{Identity: "1/rq"}, types.AggrKeyshareRetryReasonTimeout))
+	require.Len(t, keeper.GetAllAggrKeyshareRetry(ctx), 1)
+
+	req, found = keeper.GetKeyShareRequest(ctx, "1/rq")
+	require.True(t, found)
+	require.Equal(t, uint64(0), req.InflightSequence)
+	require.Equal(t, uint64(105), req.RetryHeight)
+}
@@ -57,3 +57,60 @@ func (k Keeper) GetAllKeyShareRequests(ctx sdk.Context) (list []types.KeyShareRe
 
 	return
 }
+
+// FailKeyShareRequest marks the keyShare request of the identity as failed, it is deleted
+// once the KeyShareRequestGraceBlocks param is over
+func (k Keeper) FailKeyShareRequest(ctx sdk.Context, identity string, reason string) {
+	keyShareReq, found := k.GetKeyShareRequest(ctx, identity)
+	if !found || keyShareReq.IsClosed() {
+		return
+	}
+
+	keyShareReq.Failed = true
+	keyShareReq.ClosedHeight = uint64(ctx.BlockHeight())
+	k.SetKeyShareRequest(ctx, keyShareReq)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.KeyShareRequestFailedEventType,
+			sdk.NewAttribute(types.KeyShareRequestFailedEventIdentity, identity),
+			sdk.NewAttribute(types.KeyShareRequestFailedEventReason, reason),
+		),
+	)
+}
+
+// SweepKeyShareRequests marks the keyShare requests not delivered by their deadline as failed and deletes
+// the delivered & failed ones closed more than KeyShareRequestGraceBlocks ago.
+// At most MaxPrunedEntriesPerBlock requests are scanned in a block, from where the previous block stopped
+func (k Keeper) SweepKeyShareRequests(ctx sdk.Context) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.KeyShareRequestKeyPrefix))
+
+	height := uint64(ctx.BlockHeight())
+	grace := k.KeyShareRequestGraceBlocks(ctx)
+
+	var expired []string
+	var closed [][]byte
+
+	k.scanFromCursor(ctx, store, types.KeyShareRequestKeyPrefix, func(key, value []byte) {
+		var val types.KeyShareRequest
+		k.cdc.MustUnmarshal(value, &val)
+
+		if val.IsClosed() {
+			if val.ClosedHeight+grace <= height {
+				closed = append(closed, key)
+			}
+			return
+		}
+
+		if val.Deadline != 0 && val.Deadline <= height {
+			expired = append(expired, val.Identity)
+		}
+	})
+
+	for _, key := range closed {
+		store.Delete(key)
+	}
+
+	for _, identity := range expired {
+		k.FailKeyShareRequest(ctx, identity, types.KeyShareRequestFailureDeadline)
+	}
+}
@@ -0,0 +1,98 @@
+package keeper_test
+
+import (
+	"testing"
+
+	keepertest "fairyring/testutil/keeper"
+	"fairyring/x/keyshare/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSweepKeyShareRequests(t *testing.T) {
+	keeper, ctx := keepertest.KeyshareKeeper(t)
+	params := keeper.GetParams(ctx)
+	params.KeyShareRequestGraceBlocks = 10
+	keeper.SetParams(ctx, params)
+
+	ctx = ctx.WithBlockHeight(100)
+
+	keeper.SetKeyShareRequest(ctx, types.KeyShareRequest{Identity: "1/rq", Deadline: 100})
+	keeper.SetKeyShareRequest(ctx, types.KeyShareRequest{Identity: "2/rq", Deadline: 101})
+	keeper.SetKeyShareRequest(ctx, types.KeyShareRequest{Identity: "3/rq"})
+	keeper.SetKeyShareRequest(ctx, types.KeyShareRequest{Identity: "4/rq", AggrKeyshare: "key", Sent: true, ClosedHeight: 90})
+	keeper.SetKeyShareRequest(ctx, types.KeyShareRequest{Identity: "5/rq", AggrKeyshare: "key", Sent: true, ClosedHeight: 91})
+	keeper.SetKeyShareRequest(ctx, types.KeyShareRequest{Identity: "6/rq", Failed: true, ClosedHeight: 90})
+
+	keeper.SweepKeyShareRequests(ctx)
+
+	// Requests past their deadline are marked failed
+	req, found := keeper.GetKeyShareRequest(ctx, "1/rq")
+	require.True(t, found)
+	require.Equal(t, types.KeyShareRequestStatus_KEY_SHARE_REQUEST_STATUS_FAILED, req.Status())
+	require.Equal(t, uint64(100), req.ClosedHeight)
+
+	// Requests within their deadline or without any are kept pending
+	for _, identity := range []string{"2/rq", "3/rq"} {
+		req, found = keeper.GetKeyShareRequest(ctx, identity)
+		require.True(t, found)
+		require.Equal(t, types.KeyShareRequestStatus_KEY_SHARE_REQUEST_STATUS_PENDING, req.Status())
+	}
+
+	// Closed requests are deleted once the grace period is over
+	_, found = keeper.GetKeyShareRequest(ctx, "4/rq")
+	require.False(t, found)
+	_, found = keeper.GetKeyShareRequest(ctx, "6/rq")
+	require.False(t, found)
+	_, found = keeper.GetKeyShareRequest(ctx, "5/rq")
+	require.True(t, found)
+
+	// The failed request is deleted once its own grace period is over
+	keeper.SweepKeyShareRequests(ctx.WithBlockHeight(110))
+	_, found = keeper.GetKeyShareRequest(ctx, "1/rq")
+	require.False(t, found)
+	req, found = keeper.GetKeyShareRequest(ctx, "2/rq")
+	require.True(t, found)
+	require.Equal(t, types.KeyShareRequestStatus_KEY_SHARE_REQUEST_STATUS_FAILED, req.Status())
+}
+
+func TestKeyShareRequestsByStatus(t *testing.T) {
+	keeper, ctx := keepertest.KeyshareKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	keeper.SetKeyShareRequest(ctx, types.KeyShareRequest{Identity: "1/rq"})
+	keeper.SetKeyShareRequest(ctx, types.KeyShareRequest{Identity: "2/rq", AggrKeyshare: "key"})
+	keeper.SetKeyShareRequest(ctx, types.KeyShareRequest{Identity: "3/rq", AggrKeyshare: "key", Sent: true})
+	keeper.SetKeyShareRequest(ctx, types.KeyShareRequest{Identity: "4/rq", AggrKeyshare: "key", Failed: true})
+	keeper.SetKeyShareRequest(ctx, types.KeyShareRequest{Identity: "5/rq", AggrKeyshare: "key"})
+
+	for _, tc := range []struct {
+		status     types.KeyShareRequestStatus
+		identities []string
+	}{
+		{status: types.KeyShareRequestStatus_KEY_SHARE_REQUEST_STATUS_PENDING, identities: []string{"1/rq"}},
+		{status: types.KeyShareRequestStatus_KEY_SHARE_REQUEST_STATUS_AGGREGATED, identities: []string{"2/rq", "5/rq"}},
+		{status: types.KeyShareRequestStatus_KEY_SHARE_REQUEST_STATUS_SENT, identities: []string{"3/rq"}},
+		{status: types.KeyShareRequestStatus_KEY_SHARE_REQUEST_STATUS_FAILED, identities: []string{"4/rq"}},
+	} {
+		t.Run(tc.status.String(), func(t *testing.T) {
+			resp, err := keeper.KeyShareRequestsByStatus(wctx, &types.QueryKeyShareRequestsByStatusRequest{
+				Status:     tc.status,
+				Pagination: &query.PageRequest{CountTotal: true},
+			})
+			require.NoError(t, err)
+			require.Equal(t, uint64(len(tc.identities)), resp.Pagination.Total)
+
+			var identities []string
+			for _, req := range resp.KeyShareRequests {
+				identities = append(identities, req.Identity)
+			}
+			require.ElementsMatch(t, tc.identities, identities)
+		})
+	}
+
+	_, err := keeper.KeyShareRequestsByStatus(wctx, nil)
+	require.Error(t, err)
+}
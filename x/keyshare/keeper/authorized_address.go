@@ -114,3 +114,73 @@ func (k Keeper) GetAllAuthorizedAddress(ctx sdk.Context) (list []types.Authorize
 
 	return
 }
+
+// getKeyshareValidator returns the registered validator the sender submits key shares for, which is
+// either the sender itself or the validator that authorized it. An authorized sender also has to be
+// allowed to submit the key share by the scope of its authorization
+func (k Keeper) getKeyshareValidator(
+	ctx sdk.Context,
+	sender string,
+	allowed func(types.AuthorizedAddress) bool,
+) (types.ValidatorSet, error) {
+	validatorInfo, found := k.GetValidatorSet(ctx, sender)
+	if found {
+		// If the sender is in the validator set & authorized another address to submit key share
+		if count := k.GetAuthorizedCount(ctx, sender); count != 0 {
+			return validatorInfo, types.ErrAuthorizedAnotherAddress
+		}
+		return validatorInfo, nil
+	}
+
+	authorizedAddrInfo, found := k.GetAuthorizedAddress(ctx, sender)
+	if !found || !authorizedAddrInfo.IsAuthorized {
+		return validatorInfo, types.ErrAddrIsNotValidatorOrAuthorized.Wrap(sender)
+	}
+
+	if authorizedAddrInfo.IsExpired(uint64(ctx.BlockHeight())) {
+		return validatorInfo, types.ErrAuthorizedAddrExpired.Wrapf("expiry height: %d", authorizedAddrInfo.ExpiryHeight)
+	}
+
+	if !allowed(authorizedAddrInfo) {
+		return validatorInfo, types.ErrAuthorizedAddrOutOfScope.Wrap(sender)
+	}
+
+	validatorInfo, found = k.GetValidatorSet(ctx, authorizedAddrInfo.AuthorizedBy)
+	if !found {
+		return validatorInfo, types.ErrAuthorizerIsNotValidator.Wrap(authorizedAddrInfo.AuthorizedBy)
+	}
+
+	return validatorInfo, nil
+}
+
+// keyshareValidatorAddress returns the address of the validator the sender submits key shares for
+func (k Keeper) keyshareValidatorAddress(ctx sdk.Context, sender string) string {
+	if _, found := k.GetValidatorSet(ctx, sender); found {
+		return sender
+	}
+	if authorizedAddrInfo, found := k.GetAuthorizedAddress(ctx, sender); found {
+		return authorizedAddrInfo.AuthorizedBy
+	}
+	return sender
+}
+
+// RemoveExpiredAuthorizedAddresses removes the authorized addresses expired at the current height
+func (k Keeper) RemoveExpiredAuthorizedAddresses(ctx sdk.Context) {
+	height := uint64(ctx.BlockHeight())
+
+	for _, eachAddress := range k.GetAllAuthorizedAddress(ctx) {
+		if !eachAddress.IsExpired(height) {
+			continue
+		}
+
+		k.RemoveAuthorizedAddress(ctx, eachAddress.Target)
+		k.DecreaseAuthorizedCount(ctx, eachAddress.AuthorizedBy)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(types.AuthorizedAddressExpiredEventType,
+				sdk.NewAttribute(types.AuthorizedAddressExpiredEventTarget, eachAddress.Target),
+				sdk.NewAttribute(types.AuthorizedAddressExpiredEventAuthorizedBy, eachAddress.AuthorizedBy),
+			),
+		)
+	}
+}
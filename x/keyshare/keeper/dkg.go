@@ -0,0 +1,207 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"fairyring/x/keyshare/types"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetDkgRound set a specific dkgRound in the store from its index
+func (k Keeper) SetDkgRound(ctx sdk.Context, dkgRound types.DkgRound) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DkgRoundKeyPrefix))
+	b := k.cdc.MustMarshal(&dkgRound)
+	store.Set(types.DkgRoundKey(
+		dkgRound.Id,
+	), b)
+}
+
+// GetDkgRound returns a dkgRound from its index
+func (k Keeper) GetDkgRound(
+	ctx sdk.Context,
+	id uint64,
+) (val types.DkgRound, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DkgRoundKeyPrefix))
+
+	b := store.Get(types.DkgRoundKey(
+		id,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllDkgRound returns all dkgRound
+func (k Keeper) GetAllDkgRound(ctx sdk.Context) (list []types.DkgRound) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DkgRoundKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.DkgRound
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// SetDkgRoundCount set the id of the latest dkg round
+func (k Keeper) SetDkgRoundCount(ctx sdk.Context, count uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DkgRoundCountKeyPrefix))
+	countBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(countBytes, count)
+	store.Set([]byte(types.DkgRoundCountKeyPrefix), countBytes)
+}
+
+// GetDkgRoundCount returns the id of the latest dkg round
+func (k Keeper) GetDkgRoundCount(ctx sdk.Context) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DkgRoundCountKeyPrefix))
+	b := store.Get([]byte(types.DkgRoundCountKeyPrefix))
+	if len(b) == 0 {
+		return 0
+	}
+	return binary.BigEndian.Uint64(b)
+}
+
+// GetLatestDkgRound returns the most recently started dkg round
+func (k Keeper) GetLatestDkgRound(ctx sdk.Context) (val types.DkgRound, found bool) {
+	count := k.GetDkgRoundCount(ctx)
+	if count == 0 {
+		return val, false
+	}
+	return k.GetDkgRound(ctx, count)
+}
+
+// SetDkgDeal set a specific dkgDeal in the store from its index
+func (k Keeper) SetDkgDeal(ctx sdk.Context, dkgDeal types.DkgDeal) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DkgDealKeyPrefix))
+	b := k.cdc.MustMarshal(&dkgDeal)
+	store.Set(types.DkgDealKey(
+		dkgDeal.RoundId,
+		dkgDeal.Dealer,
+	), b)
+}
+
+// GetDkgDeal returns a dkgDeal from its index
+func (k Keeper) GetDkgDeal(
+	ctx sdk.Context,
+	roundId uint64,
+	dealer string,
+) (val types.DkgDeal, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DkgDealKeyPrefix))
+
+	b := store.Get(types.DkgDealKey(
+		roundId,
+		dealer,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllDkgDealByRound returns all dkgDeal of a dkg round
+func (k Keeper) GetAllDkgDealByRound(ctx sdk.Context, roundId uint64) (list []types.DkgDeal) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DkgDealKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, types.DkgRoundKey(roundId))
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.DkgDeal
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetAllDkgDeal returns all dkgDeal
+func (k Keeper) GetAllDkgDeal(ctx sdk.Context) (list []types.DkgDeal) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DkgDealKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.DkgDeal
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// SetDkgComplaint set a specific dkgComplaint in the store from its index
+func (k Keeper) SetDkgComplaint(ctx sdk.Context, dkgComplaint types.DkgComplaint) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DkgComplaintKeyPrefix))
+	b := k.cdc.MustMarshal(&dkgComplaint)
+	store.Set(types.DkgComplaintKey(
+		dkgComplaint.RoundId,
+		dkgComplaint.Dealer,
+		dkgComplaint.Complainer,
+	), b)
+}
+
+// GetDkgComplaint returns a dkgComplaint from its index
+func (k Keeper) GetDkgComplaint(
+	ctx sdk.Context,
+	roundId uint64,
+	dealer string,
+	complainer string,
+) (val types.DkgComplaint, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DkgComplaintKeyPrefix))
+
+	b := store.Get(types.DkgComplaintKey(
+		roundId,
+		dealer,
+		complainer,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllDkgComplaintByRound returns all dkgComplaint of a dkg round
+func (k Keeper) GetAllDkgComplaintByRound(ctx sdk.Context, roundId uint64) (list []types.DkgComplaint) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DkgComplaintKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, types.DkgRoundKey(roundId))
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.DkgComplaint
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetAllDkgComplaint returns all dkgComplaint
+func (k Keeper) GetAllDkgComplaint(ctx sdk.Context) (list []types.DkgComplaint) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DkgComplaintKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.DkgComplaint
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
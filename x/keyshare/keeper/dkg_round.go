@@ -0,0 +1,146 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strconv"
+
+	"fairyring/x/keyshare/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/drand/kyber"
+)
+
+// ProcessDkgRound moves the latest dkg round to its next phase once the current phase is over.
+// When the justification phase ends, the round is finalized and the resulting public key is queued.
+func (k Keeper) ProcessDkgRound(ctx sdk.Context) {
+	round, found := k.GetLatestDkgRound(ctx)
+	if !found || !round.IsInProgress() {
+		return
+	}
+
+	height := uint64(ctx.BlockHeight())
+
+	switch round.Phase {
+	case types.DKG_PHASE_DEAL:
+		if height <= round.DealEndHeight {
+			return
+		}
+		round.Phase = types.DKG_PHASE_COMPLAINT
+	case types.DKG_PHASE_COMPLAINT:
+		if height <= round.ComplaintEndHeight {
+			return
+		}
+		round.Phase = types.DKG_PHASE_JUSTIFICATION
+	case types.DKG_PHASE_JUSTIFICATION:
+		if height <= round.JustificationEndHeight {
+			return
+		}
+		round = k.finalizeDkgRound(ctx, round)
+	}
+
+	k.SetDkgRound(ctx, round)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.DkgRoundPhaseChangedEventType,
+			sdk.NewAttribute(types.DkgRoundPhaseChangedEventRoundID, strconv.FormatUint(round.Id, 10)),
+			sdk.NewAttribute(types.DkgRoundPhaseChangedEventPhase, round.Phase.String()),
+			sdk.NewAttribute(types.DkgRoundPhaseChangedEventPubKey, round.PublicKey),
+		),
+	)
+}
+
+// finalizeDkgRound builds the qualified set of dealers, whose every complaint has been justified,
+// and combines their commitments into the queued public key and commitments
+func (k Keeper) finalizeDkgRound(ctx sdk.Context, round types.DkgRound) types.DkgRound {
+	disqualified := make(map[string]struct{})
+	for _, eachComplaint := range k.GetAllDkgComplaintByRound(ctx, round.Id) {
+		if len(eachComplaint.Justifications) == 0 {
+			disqualified[eachComplaint.Dealer] = struct{}{}
+		}
+	}
+
+	var qualifiedDealers []string
+	var qualifiedCommitments [][]kyber.Point
+
+	for _, eachParticipant := range round.Participants {
+		if _, found := disqualified[eachParticipant.Validator]; found {
+			continue
+		}
+		deal, found := k.GetDkgDeal(ctx, round.Id, eachParticipant.Validator)
+		if !found {
+			continue
+		}
+		commitments, err := types.ParseDkgCommitments(deal.Commitments)
+		if err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("Error while parsing dkg deal commitments of %s: %s", deal.Dealer, err.Error()))
+			continue
+		}
+		qualifiedDealers = append(qualifiedDealers, deal.Dealer)
+		qualifiedCommitments = append(qualifiedCommitments, commitments)
+	}
+
+	round.QualifiedDealers = qualifiedDealers
+
+	if uint64(len(qualifiedDealers)) < round.Threshold {
+		k.Logger(ctx).Error(fmt.Sprintf("DKG round %d failed, expected at least %d qualified dealers, got: %d", round.Id, round.Threshold, len(qualifiedDealers)))
+		round.Phase = types.DKG_PHASE_FAILED
+		return round
+	}
+
+	if _, found := k.GetQueuedPubKey(ctx); found {
+		k.Logger(ctx).Error(fmt.Sprintf("DKG round %d failed, a queued public key already exists", round.Id))
+		round.Phase = types.DKG_PHASE_FAILED
+		return round
+	}
+
+	pubKey, shareCommitments := types.AggregateDkgDeals(qualifiedCommitments, round.TotalShares())
+
+	pubKeyByte, err := pubKey.MarshalBinary()
+	if err != nil {
+		k.Logger(ctx).Error(fmt.Sprintf("DKG round %d failed, unable to marshal public key: %s", round.Id, err.Error()))
+		round.Phase = types.DKG_PHASE_FAILED
+		return round
+	}
+
+	commitments := make([]string, 0, len(shareCommitments))
+	for _, c := range shareCommitments {
+		cByte, err := c.MarshalBinary()
+		if err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("DKG round %d failed, unable to marshal commitment: %s", round.Id, err.Error()))
+			round.Phase = types.DKG_PHASE_FAILED
+			return round
+		}
+		commitments = append(commitments, hex.EncodeToString(cByte))
+	}
+
+	// Every participant holds the shares dealt to its own indices, with the weight given when the round started
+	assignments := make([]types.KeyShareAssignment, 0, len(round.Participants))
+	for _, eachParticipant := range round.Participants {
+		assignments = append(assignments, types.KeyShareAssignment{
+			Validator:    eachParticipant.Validator,
+			ShareIndices: eachParticipant.ShareIndices,
+			Weight:       eachParticipant.Weight,
+		})
+	}
+
+	if _, err = k.QueuePubKey(
+		ctx,
+		authtypes.NewModuleAddress(types.ModuleName).String(),
+		hex.EncodeToString(pubKeyByte),
+		commitments,
+		round.Threshold,
+		assignments,
+	); err != nil {
+		k.Logger(ctx).Error(fmt.Sprintf("DKG round %d failed, unable to queue public key: %s", round.Id, err.Error()))
+		round.Phase = types.DKG_PHASE_FAILED
+		return round
+	}
+
+	round.PublicKey = hex.EncodeToString(pubKeyByte)
+	round.Commitments = commitments
+	round.Phase = types.DKG_PHASE_FINALIZED
+
+	return round
+}
@@ -0,0 +1,225 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"strconv"
+
+	"fairyring/x/keyshare/types"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetGeneralKeyRequest set a specific generalKeyRequest in the store from its index
+func (k Keeper) SetGeneralKeyRequest(ctx sdk.Context, generalKeyRequest types.GeneralKeyRequest) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.GeneralKeyRequestKeyPrefix))
+	b := k.cdc.MustMarshal(&generalKeyRequest)
+	store.Set(types.GeneralKeyRequestKey(
+		generalKeyRequest.Identity,
+	), b)
+}
+
+// GetGeneralKeyRequest returns a generalKeyRequest from its index
+func (k Keeper) GetGeneralKeyRequest(
+	ctx sdk.Context,
+	identity string,
+) (val types.GeneralKeyRequest, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.GeneralKeyRequestKeyPrefix))
+
+	b := store.Get(types.GeneralKeyRequestKey(
+		identity,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveGeneralKeyRequest removes a generalKeyRequest from the store
+func (k Keeper) RemoveGeneralKeyRequest(
+	ctx sdk.Context,
+	identity string,
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.GeneralKeyRequestKeyPrefix))
+	store.Delete(types.GeneralKeyRequestKey(
+		identity,
+	))
+}
+
+// GetAllGeneralKeyRequest returns all generalKeyRequest
+func (k Keeper) GetAllGeneralKeyRequest(ctx sdk.Context) (list []types.GeneralKeyRequest) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.GeneralKeyRequestKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.GeneralKeyRequest
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// SetGeneralKeyReleaseQueue queues the identity of a general key request to be released at releaseHeight
+func (k Keeper) SetGeneralKeyReleaseQueue(ctx sdk.Context, releaseHeight uint64, identity string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.GeneralKeyReleaseQueueKeyPrefix))
+	store.Set(types.GeneralKeyReleaseQueueKey(releaseHeight, identity), []byte(identity))
+}
+
+// SetGeneralKeyExpiryQueue queues the identity of a general key request to expire at expiryHeight
+func (k Keeper) SetGeneralKeyExpiryQueue(ctx sdk.Context, expiryHeight uint64, identity string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.GeneralKeyExpiryQueueKeyPrefix))
+	store.Set(types.GeneralKeyExpiryQueueKey(expiryHeight, identity), []byte(identity))
+}
+
+// RequestGeneralKey escrows the general key request fee from the creator and requests the decryption key
+// of the id for the creator, key shares are submitted for it from the release height until the active key
+// expires. The release height must be before the expiry of the active key, as the key shares are verified
+// against it. The fee of a request whose key is not aggregated by then is refunded.
+// It is used by MsgRequestGeneralKeyshare and can be called by other modules to request keys on their own
+func (k Keeper) RequestGeneralKey(
+	ctx sdk.Context,
+	creator sdk.AccAddress,
+	id string,
+	releaseHeight uint64,
+) (types.GeneralKeyRequest, error) {
+	if err := types.ValidateGeneralKeyRequestID(id); err != nil {
+		return types.GeneralKeyRequest{}, err
+	}
+
+	if releaseHeight <= uint64(ctx.BlockHeight()) {
+		return types.GeneralKeyRequest{}, types.ErrInvalidReleaseHeight.Wrapf("expected a height above: %d, got: %d", ctx.BlockHeight(), releaseHeight)
+	}
+
+	identity := types.GeneralKeyRequestIdentity(creator.String(), id)
+	if _, found := k.GetGeneralKeyRequest(ctx, identity); found {
+		return types.GeneralKeyRequest{}, types.ErrGeneralKeyRequestExists.Wrapf("identity: %s", identity)
+	}
+
+	activePubKey, found := k.GetActivePubKey(ctx)
+	if !found {
+		return types.GeneralKeyRequest{}, types.ErrPubKeyNotFound
+	}
+
+	if releaseHeight >= activePubKey.Expiry {
+		return types.GeneralKeyRequest{}, types.ErrInvalidReleaseHeight.Wrapf("expected a height below the active key expiry: %d, got: %d", activePubKey.Expiry, releaseHeight)
+	}
+
+	fee := k.GeneralKeyRequestFee(ctx)
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, creator, types.ModuleName, sdk.NewCoins(fee)); err != nil {
+		return types.GeneralKeyRequest{}, err
+	}
+
+	generalKeyRequest := types.GeneralKeyRequest{
+		Identity:        identity,
+		Creator:         creator.String(),
+		Pubkey:          activePubKey.PublicKey,
+		RequestedHeight: uint64(ctx.BlockHeight()),
+		ReleaseHeight:   releaseHeight,
+		Fee:             fee,
+		ExpiryHeight:    activePubKey.Expiry,
+	}
+
+	k.SetGeneralKeyRequest(ctx, generalKeyRequest)
+	k.SetGeneralKeyReleaseQueue(ctx, releaseHeight, identity)
+	k.SetGeneralKeyExpiryQueue(ctx, activePubKey.Expiry, identity)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.GeneralKeyRequestedEventType,
+			sdk.NewAttribute(types.GeneralKeyRequestedEventIdentity, identity),
+			sdk.NewAttribute(types.GeneralKeyRequestedEventCreator, creator.String()),
+			sdk.NewAttribute(types.GeneralKeyRequestedEventReleaseHeight, strconv.FormatUint(releaseHeight, 10)),
+			sdk.NewAttribute(types.GeneralKeyRequestedEventFee, fee.String()),
+		),
+	)
+
+	return generalKeyRequest, nil
+}
+
+// ReleaseGeneralKeyRequests asks the validators to submit the general key shares of the requests
+// released up to the current height. At most MaxPrunedEntriesPerBlock requests are released in a block,
+// the rest stays at the head of the release queue and is released in the next blocks
+func (k Keeper) ReleaseGeneralKeyRequests(ctx sdk.Context) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.GeneralKeyReleaseQueueKeyPrefix))
+
+	end := make([]byte, 8)
+	binary.BigEndian.PutUint64(end, uint64(ctx.BlockHeight())+1)
+	iterator := store.Iterator(nil, end)
+
+	var keys [][]byte
+	var identities []string
+
+	for ; iterator.Valid() && len(keys) < types.MaxPrunedEntriesPerBlock; iterator.Next() {
+		keys = append(keys, iterator.Key())
+		identities = append(identities, string(iterator.Value()))
+	}
+	iterator.Close()
+
+	for i, key := range keys {
+		store.Delete(key)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(types.StartSendGeneralKeyShareEventType,
+				sdk.NewAttribute(types.StartSendGeneralKeyShareEventIdentity, identities[i]),
+				sdk.NewAttribute(types.StartSendGeneralKeyShareEventIDType, GeneralKeyRequestIdentity),
+			),
+		)
+	}
+}
+
+// ExpireGeneralKeyRequests removes the general key requests whose key was not aggregated before
+// their expiry height and refunds their escrowed fee to the requester. At most MaxPrunedEntriesPerBlock
+// requests are expired in a block, the rest is expired in the next blocks. A request whose refund fails
+// is kept in the expiry queue and retried in the next block
+func (k Keeper) ExpireGeneralKeyRequests(ctx sdk.Context) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.GeneralKeyExpiryQueueKeyPrefix))
+
+	end := make([]byte, 8)
+	binary.BigEndian.PutUint64(end, uint64(ctx.BlockHeight())+1)
+	iterator := store.Iterator(nil, end)
+
+	var keys [][]byte
+	var identities []string
+
+	for ; iterator.Valid() && len(keys) < types.MaxPrunedEntriesPerBlock; iterator.Next() {
+		keys = append(keys, iterator.Key())
+		identities = append(identities, string(iterator.Value()))
+	}
+	iterator.Close()
+
+	for i, key := range keys {
+		generalKeyRequest, found := k.GetGeneralKeyRequest(ctx, identities[i])
+		if !found || generalKeyRequest.AggrKeyshare != "" {
+			store.Delete(key)
+			continue
+		}
+
+		creator, err := sdk.AccAddressFromBech32(generalKeyRequest.Creator)
+		if err != nil {
+			k.Logger(ctx).Error("Error while parsing general key requester address: " + err.Error())
+			store.Delete(key)
+			continue
+		}
+
+		refund := sdk.NewCoins(generalKeyRequest.Fee)
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, creator, refund); err != nil {
+			k.Logger(ctx).Error("Error while refunding the general key request fee: " + err.Error())
+			continue
+		}
+
+		store.Delete(key)
+		k.RemoveGeneralKeyRequest(ctx, generalKeyRequest.Identity)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(types.GeneralKeyRequestExpiredEventType,
+				sdk.NewAttribute(types.GeneralKeyRequestExpiredEventIdentity, generalKeyRequest.Identity),
+				sdk.NewAttribute(types.GeneralKeyRequestExpiredEventCreator, generalKeyRequest.Creator),
+				sdk.NewAttribute(types.GeneralKeyRequestExpiredEventRefund, refund.String()),
+			),
+		)
+	}
+}
@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"fairyring/x/keyshare/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	GeneralKeyRequestIdentity = "general-key-request"
+)
+
+var _ types.IDTypeHooks = generalKeyRequestHooks{}
+
+// generalKeyRequestHooks serve the decryption keys requested on fairyring with MsgRequestGeneralKeyshare
+type generalKeyRequestHooks struct {
+	k Keeper
+}
+
+// ValidateRequest checks the general key request of the identity exists, is released and its key is not aggregated yet
+func (h generalKeyRequestHooks) ValidateRequest(ctx sdk.Context, idValue string) error {
+	generalKeyRequest, found := h.k.GetGeneralKeyRequest(ctx, idValue)
+	if !found {
+		return types.ErrGeneralKeyRequestNotFound.Wrapf("got id value: %s", idValue)
+	}
+	if generalKeyRequest.ReleaseHeight > uint64(ctx.BlockHeight()) {
+		return types.ErrGeneralKeyNotReleased.Wrapf("release height: %d, current height: %d", generalKeyRequest.ReleaseHeight, ctx.BlockHeight())
+	}
+	if generalKeyRequest.AggrKeyshare != "" {
+		return types.ErrAggKeyAlreadyExists.Wrapf(", identity: %s, Aggregated key: %s", idValue, generalKeyRequest.AggrKeyshare)
+	}
+	return nil
+}
+
+// OnAggregated saves the aggregated key in the general key request of the identity
+// and pays its escrowed fee to the reward pool
+func (h generalKeyRequestHooks) OnAggregated(ctx sdk.Context, idValue string, aggrKey string) error {
+	if err := h.ValidateRequest(ctx, idValue); err != nil {
+		return err
+	}
+
+	generalKeyRequest, _ := h.k.GetGeneralKeyRequest(ctx, idValue)
+	if err := h.k.collectRequestFee(ctx, generalKeyRequest.Fee); err != nil {
+		return err
+	}
+
+	generalKeyRequest.AggrKeyshare = aggrKey
+	h.k.SetGeneralKeyRequest(ctx, generalKeyRequest)
+
+	return nil
+}
+
+// DeliveryRoute returns no route, the keys of general key requests are queried on fairyring
+func (h generalKeyRequestHooks) DeliveryRoute(_ sdk.Context, _ string) (types.KeyDeliveryRoute, bool) {
+	return types.KeyDeliveryRoute{}, false
+}
@@ -0,0 +1,100 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	keepertest "fairyring/testutil/keeper"
+	"fairyring/testutil/sample"
+	"fairyring/x/keyshare/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequestGeneralKeyExpiry(t *testing.T) {
+	k, ctx := keepertest.KeyshareKeeper(t)
+	ctx = ctx.WithBlockHeight(1)
+
+	params := types.DefaultParams()
+	params.GeneralKeyRequestFee = sdk.NewCoin("ufairy", sdk.ZeroInt())
+	k.SetParams(ctx, params)
+	k.SetActivePubKey(ctx, types.ActivePubKey{PublicKey: "pubkey", Expiry: 10, Threshold: 1})
+
+	creator := sdk.MustAccAddressFromBech32(sample.AccAddress())
+
+	// The key shares of a request released at or after the active key expiry can not be verified
+	_, err := k.RequestGeneralKey(ctx, creator, "late", 10)
+	require.ErrorIs(t, err, types.ErrInvalidReleaseHeight)
+
+	pending, err := k.RequestGeneralKey(ctx, creator, "pending", 5)
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), pending.ExpiryHeight)
+
+	aggregated, err := k.RequestGeneralKey(ctx, creator, "aggregated", 5)
+	require.NoError(t, err)
+	aggregated.AggrKeyshare = "key"
+	k.SetGeneralKeyRequest(ctx, aggregated)
+
+	k.ExpireGeneralKeyRequests(ctx.WithBlockHeight(9))
+	_, found := k.GetGeneralKeyRequest(ctx, pending.Identity)
+	require.True(t, found)
+
+	k.ExpireGeneralKeyRequests(ctx.WithBlockHeight(10))
+	_, found = k.GetGeneralKeyRequest(ctx, pending.Identity)
+	require.False(t, found)
+	_, found = k.GetGeneralKeyRequest(ctx, aggregated.Identity)
+	require.True(t, found)
+}
+
+func TestExpireGeneralKeyRequestRefundRetry(t *testing.T) {
+	k, ctx, deps := keepertest.KeyshareKeeperWithDeps(t)
+
+	creator := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	fee := sdk.NewCoin("ufairy", sdk.NewInt(100))
+	k.SetGeneralKeyRequest(ctx, types.GeneralKeyRequest{
+		Identity:     "unfunded",
+		Creator:      creator.String(),
+		Fee:          fee,
+		ExpiryHeight: 10,
+	})
+	k.SetGeneralKeyExpiryQueue(ctx, 10, "unfunded")
+
+	// The refund fails while the module account can't pay it, the request is kept to be retried
+	k.ExpireGeneralKeyRequests(ctx.WithBlockHeight(10))
+	_, found := k.GetGeneralKeyRequest(ctx, "unfunded")
+	require.True(t, found)
+
+	require.NoError(t, banktestutil.FundModuleAccount(deps.BankKeeper, ctx, types.ModuleName, sdk.NewCoins(fee)))
+
+	k.ExpireGeneralKeyRequests(ctx.WithBlockHeight(11))
+	_, found = k.GetGeneralKeyRequest(ctx, "unfunded")
+	require.False(t, found)
+	require.Equal(t, fee, deps.BankKeeper.GetBalance(ctx, creator, fee.Denom))
+}
+
+func TestReleaseGeneralKeyRequestsLimit(t *testing.T) {
+	k, ctx := keepertest.KeyshareKeeper(t)
+	ctx = ctx.WithBlockHeight(5)
+
+	for i := 0; i <= types.MaxPrunedEntriesPerBlock; i++ {
+		k.SetGeneralKeyReleaseQueue(ctx, 5, fmt.Sprintf("identity-%d", i))
+	}
+
+	released := func() int {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		k.ReleaseGeneralKeyRequests(ctx)
+		count := 0
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == types.StartSendGeneralKeyShareEventType {
+				count++
+			}
+		}
+		return count
+	}
+
+	require.Equal(t, types.MaxPrunedEntriesPerBlock, released())
+	require.Equal(t, 1, released())
+	require.Equal(t, 0, released())
+}
@@ -14,6 +14,7 @@ func (k Keeper) SetGeneralKeyShare(ctx sdk.Context, generalKeyShare types.Genera
 		generalKeyShare.Validator,
 		generalKeyShare.IdType,
 		generalKeyShare.IdValue,
+		generalKeyShare.KeyShareIndex,
 	), b)
 }
 
@@ -23,6 +24,7 @@ func (k Keeper) GetGeneralKeyShare(
 	validator string,
 	idType string,
 	idValue string,
+	keyShareIndex uint64,
 ) (val types.GeneralKeyShare, found bool) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.GeneralKeyShareKeyPrefix))
 
@@ -30,6 +32,7 @@ func (k Keeper) GetGeneralKeyShare(
 		validator,
 		idType,
 		idValue,
+		keyShareIndex,
 	))
 	if b == nil {
 		return val, false
@@ -45,16 +48,33 @@ func (k Keeper) RemoveGeneralKeyShare(
 	validator string,
 	idType string,
 	idValue string,
-
+	keyShareIndex uint64,
 ) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.GeneralKeyShareKeyPrefix))
 	store.Delete(types.GeneralKeyShareKey(
 		validator,
 		idType,
 		idValue,
+		keyShareIndex,
 	))
 }
 
+// GetAllGeneralKeyShareByIdentity returns all generalKeyShare submitted by a validator for an identity
+func (k Keeper) GetAllGeneralKeyShareByIdentity(ctx sdk.Context, validator string, idType string, idValue string) (list []types.GeneralKeyShare) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.GeneralKeyShareKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, types.GeneralKeyShareIdentityKey(validator, idType, idValue))
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.GeneralKeyShare
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
 // GetAllGeneralKeyShare returns all generalKeyShare
 func (k Keeper) GetAllGeneralKeyShare(ctx sdk.Context) (list []types.GeneralKeyShare) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.GeneralKeyShareKeyPrefix))
@@ -35,6 +35,7 @@ func TestGeneralKeyShareGet(t *testing.T) {
 			item.Validator,
 			item.IdType,
 			item.IdValue,
+			item.KeyShareIndex,
 		)
 		require.True(t, found)
 		require.Equal(t,
@@ -51,11 +52,13 @@ func TestGeneralKeyShareRemove(t *testing.T) {
 			item.Validator,
 			item.IdType,
 			item.IdValue,
+			item.KeyShareIndex,
 		)
 		_, found := keeper.GetGeneralKeyShare(ctx,
 			item.Validator,
 			item.IdType,
 			item.IdValue,
+			item.KeyShareIndex,
 		)
 		require.False(t, found)
 	}
@@ -26,6 +26,7 @@ func (k Keeper) OnRecvGetAggrKeysharePacket(ctx sdk.Context, packet channeltypes
 		ctx.EventManager().EmitEvent(
 			sdk.NewEvent(types.StartSendGeneralKeyShareEventType,
 				sdk.NewAttribute(types.StartSendGeneralKeyShareEventIdentity, data.Identity),
+				sdk.NewAttribute(types.StartSendGeneralKeyShareEventIDType, PrivateGovIdentity),
 			),
 		)
 	}
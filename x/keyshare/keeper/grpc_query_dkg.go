@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	"context"
+
+	"fairyring/x/keyshare/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DkgRound returns a dkg round together with the deals and complaints submitted for it
+func (k Keeper) DkgRound(c context.Context, req *types.QueryGetDkgRoundRequest) (*types.QueryGetDkgRoundResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	round, found := k.GetDkgRound(ctx, req.Id)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetDkgRoundResponse{
+		DkgRound:   round,
+		Deals:      k.GetAllDkgDealByRound(ctx, req.Id),
+		Complaints: k.GetAllDkgComplaintByRound(ctx, req.Id),
+	}, nil
+}
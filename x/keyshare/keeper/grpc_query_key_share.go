@@ -48,14 +48,16 @@ func (k Keeper) KeyShare(c context.Context, req *types.QueryGetKeyShareRequest)
 	}
 	ctx := sdk.UnwrapSDKContext(c)
 
-	val, found := k.GetKeyShare(
+	// A validator holding more than one share index has one keyshare per index,
+	// the share with the lowest index is returned
+	list := k.GetAllKeyShareByHeight(
 		ctx,
 		req.Validator,
 		req.BlockHeight,
 	)
-	if !found {
+	if len(list) == 0 {
 		return nil, status.Error(codes.NotFound, "not found")
 	}
 
-	return &types.QueryGetKeyShareResponse{KeyShare: val}, nil
+	return &types.QueryGetKeyShareResponse{KeyShare: list[0]}, nil
 }
@@ -57,3 +57,18 @@ func (k Keeper) ValidatorSet(c context.Context, req *types.QueryGetValidatorSetR
 
 	return &types.QueryGetValidatorSetResponse{ValidatorSet: val}, nil
 }
+
+// ValidatorSetByShareIndex returns the validator a key share index of the active key is assigned to
+func (k Keeper) ValidatorSetByShareIndex(c context.Context, req *types.QueryGetValidatorSetByShareIndexRequest) (*types.QueryGetValidatorSetResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	val, found := k.GetValidatorSetByShareIndex(ctx, req.ShareIndex)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetValidatorSetResponse{ValidatorSet: val}, nil
+}
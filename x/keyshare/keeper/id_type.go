@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+
+	"fairyring/x/keyshare/types"
+)
+
+// RegisterIDType registers the hooks of an id type general key shares can be submitted for.
+// It panics if the id type is empty or already registered
+func (k Keeper) RegisterIDType(idType string, hooks types.IDTypeHooks) {
+	if idType == "" {
+		panic("id type can not be empty")
+	}
+	if _, found := k.idTypes[idType]; found {
+		panic(fmt.Sprintf("id type %s is already registered", idType))
+	}
+	k.idTypes[idType] = hooks
+}
+
+// GetIDTypeHooks returns the hooks registered for the id type
+func (k Keeper) GetIDTypeHooks(idType string) (types.IDTypeHooks, bool) {
+	hooks, found := k.idTypes[idType]
+	return hooks, found
+}
+
+// SupportedIDTypes returns the registered id types in sorted order
+func (k Keeper) SupportedIDTypes() []string {
+	idTypes := make([]string, 0, len(k.idTypes))
+	for idType := range k.idTypes {
+		idTypes = append(idTypes, idType)
+	}
+	sort.Strings(idTypes)
+	return idTypes
+}
+
+// isSupportedIDType returns true if general key shares can be submitted for the id type
+func (k Keeper) isSupportedIDType(idType string) bool {
+	_, found := k.idTypes[idType]
+	return found
+}
@@ -22,7 +22,10 @@ type (
 		paramstore       paramtypes.Subspace
 		connectionKeeper types.ConnectionKeeper
 		stakingKeeper    types.StakingKeeper
+		slashingKeeper   types.SlashingKeeper
 		pepKeeper        types.PepKeeper
+		bankKeeper       types.BankKeeper
+		idTypes          map[string]types.IDTypeHooks
 	}
 )
 
@@ -37,13 +40,15 @@ func NewKeeper(
 	connectionKeeper types.ConnectionKeeper,
 	pk types.PepKeeper,
 	stakingKeeper types.StakingKeeper,
+	slashingKeeper types.SlashingKeeper,
+	bankKeeper types.BankKeeper,
 ) *Keeper {
 	// set KeyTable if it has not already been set
 	if !ps.HasKeyTable() {
 		ps = ps.WithKeyTable(types.ParamKeyTable())
 	}
 
-	return &Keeper{
+	k := &Keeper{
 		IBCKeeper: types.NewIBCKeeper(
 			types.PortKey,
 			storeKey,
@@ -57,8 +62,16 @@ func NewKeeper(
 		paramstore:       ps,
 		pepKeeper:        pk,
 		stakingKeeper:    stakingKeeper,
+		slashingKeeper:   slashingKeeper,
 		connectionKeeper: connectionKeeper,
+		bankKeeper:       bankKeeper,
+		idTypes:          make(map[string]types.IDTypeHooks),
 	}
+
+	k.RegisterIDType(PrivateGovIdentity, privateGovIdentityHooks{k: *k})
+	k.RegisterIDType(GeneralKeyRequestIdentity, generalKeyRequestHooks{k: *k})
+
+	return k
 }
 
 func (k Keeper) StakingKeeper() types.StakingKeeper {
@@ -14,6 +14,7 @@ func (k Keeper) SetKeyShare(ctx sdk.Context, keyShare types.KeyShare) {
 	store.Set(types.KeyShareKey(
 		keyShare.Validator,
 		keyShare.BlockHeight,
+		keyShare.KeyShareIndex,
 	), b)
 }
 
@@ -22,13 +23,14 @@ func (k Keeper) GetKeyShare(
 	ctx sdk.Context,
 	validator string,
 	blockHeight uint64,
-
+	keyShareIndex uint64,
 ) (val types.KeyShare, found bool) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.KeyShareKeyPrefix))
 
 	b := store.Get(types.KeyShareKey(
 		validator,
 		blockHeight,
+		keyShareIndex,
 	))
 	if b == nil {
 		return val, false
@@ -43,15 +45,32 @@ func (k Keeper) RemoveKeyShare(
 	ctx sdk.Context,
 	validator string,
 	blockHeight uint64,
-
+	keyShareIndex uint64,
 ) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.KeyShareKeyPrefix))
 	store.Delete(types.KeyShareKey(
 		validator,
 		blockHeight,
+		keyShareIndex,
 	))
 }
 
+// GetAllKeyShareByHeight returns all keyShare submitted by a validator for a block height
+func (k Keeper) GetAllKeyShareByHeight(ctx sdk.Context, validator string, blockHeight uint64) (list []types.KeyShare) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.KeyShareKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyShareHeightKey(validator, blockHeight))
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.KeyShare
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
 // GetAllKeyShare returns all keyShare
 func (k Keeper) GetAllKeyShare(ctx sdk.Context) (list []types.KeyShare) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.KeyShareKeyPrefix))
@@ -0,0 +1,220 @@
+package keeper
+
+import (
+	"fairyring/x/keyshare/types"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// VerifyKeyShareAssignments checks the key share indices the dealer bound to each validator and
+// returns them weighted. Every index from 1 to totalShares must be bound exactly once to an active
+// registered validator. Without stake weighted aggregation every validator holds a single index with
+// a weight of 1, otherwise each validator must hold the number of indices given by the split of the
+// indices proportionally to the current voting power of the active validators, and is weighted by it
+func (k Keeper) VerifyKeyShareAssignments(ctx sdk.Context, assignments []types.KeyShareAssignment, totalShares uint64) ([]types.KeyShareAssignment, error) {
+	if err := types.ValidateKeyShareAssignments(assignments, totalShares); err != nil {
+		return nil, err
+	}
+
+	for _, a := range assignments {
+		val, found := k.GetValidatorSet(ctx, a.Validator)
+		if !found {
+			return nil, types.ErrValidatorNotRegistered.Wrap(a.Validator)
+		}
+		if !val.IsActive {
+			return nil, types.ErrValidatorPaused.Wrap(a.Validator)
+		}
+	}
+
+	weighted := make([]types.KeyShareAssignment, len(assignments))
+	if !k.StakeWeightedAggregation(ctx) {
+		for i, a := range assignments {
+			if len(a.ShareIndices) != 1 {
+				return nil, types.ErrInvalidKeyShareAssignments.Wrapf("expected a single share index for validator: %s, got: %d", a.Validator, len(a.ShareIndices))
+			}
+			weighted[i] = types.KeyShareAssignment{Validator: a.Validator, ShareIndices: a.ShareIndices, Weight: 1}
+		}
+		return weighted, nil
+	}
+
+	expected, err := k.AssignStakeWeightedShareIndices(ctx, totalShares)
+	if err != nil {
+		return nil, err
+	}
+
+	expectedShares := make(map[string]types.KeyShareAssignment, len(expected))
+	for _, e := range expected {
+		expectedShares[e.Validator] = e
+	}
+
+	if len(expected) != len(assignments) {
+		return nil, types.ErrInvalidKeyShareAssignments.Wrapf("expected %d validators to hold share indices, got: %d", len(expected), len(assignments))
+	}
+
+	for i, a := range assignments {
+		e := expectedShares[a.Validator]
+		if len(e.ShareIndices) != len(a.ShareIndices) {
+			return nil, types.ErrInvalidKeyShareAssignments.Wrapf("expected %d share indices for validator: %s, got: %d", len(e.ShareIndices), a.Validator, len(a.ShareIndices))
+		}
+		weighted[i] = types.KeyShareAssignment{Validator: a.Validator, ShareIndices: a.ShareIndices, Weight: e.Weight}
+	}
+
+	return weighted, nil
+}
+
+// AssignStakeWeightedShareIndices splits the share indices 1..totalShares among the active registered
+// validators proportionally to their current voting power, each validator being weighted by it
+func (k Keeper) AssignStakeWeightedShareIndices(ctx sdk.Context, totalShares uint64) ([]types.KeyShareAssignment, error) {
+	var validators []string
+	var powers []int64
+	for _, eachValidator := range k.GetAllValidatorSet(ctx) {
+		if !eachValidator.IsActive {
+			continue
+		}
+		validators = append(validators, eachValidator.Validator)
+		powers = append(powers, k.GetConsensusPower(ctx, eachValidator.Validator))
+	}
+
+	return types.AssignKeyShareIndices(validators, powers, totalShares)
+}
+
+// SetKeyShareAssignments binds every share index of the given assignments to its validator,
+// the owners of the previous active key are cleared
+func (k Keeper) SetKeyShareAssignments(ctx sdk.Context, assignments []types.KeyShareAssignment) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.KeyShareIndexOwnerKeyPrefix))
+
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	var staleKeys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		staleKeys = append(staleKeys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range staleKeys {
+		store.Delete(key)
+	}
+
+	for _, a := range assignments {
+		for _, index := range a.ShareIndices {
+			k.SetKeyShareIndexOwner(ctx, types.KeyShareIndexOwner{
+				Index:     index,
+				Validator: a.Validator,
+			})
+		}
+	}
+}
+
+// SetKeyShareIndexOwner set a specific keyShareIndexOwner in the store from its index
+func (k Keeper) SetKeyShareIndexOwner(ctx sdk.Context, owner types.KeyShareIndexOwner) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.KeyShareIndexOwnerKeyPrefix))
+	b := k.cdc.MustMarshal(&owner)
+	store.Set(types.KeyShareIndexOwnerKey(owner.Index), b)
+}
+
+// GetKeyShareIndexOwner returns a keyShareIndexOwner from its index
+func (k Keeper) GetKeyShareIndexOwner(ctx sdk.Context, index uint64) (val types.KeyShareIndexOwner, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.KeyShareIndexOwnerKeyPrefix))
+
+	b := store.Get(types.KeyShareIndexOwnerKey(index))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllKeyShareIndexOwner returns all keyShareIndexOwner ordered by index
+func (k Keeper) GetAllKeyShareIndexOwner(ctx sdk.Context) (list []types.KeyShareIndexOwner) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.KeyShareIndexOwnerKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.KeyShareIndexOwner
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// IsKeyShareIndexOwner returns true if the key share index of the active key is assigned to the validator
+func (k Keeper) IsKeyShareIndexOwner(ctx sdk.Context, validator string, index uint64) bool {
+	owner, found := k.GetKeyShareIndexOwner(ctx, index)
+	return found && owner.Validator == validator
+}
+
+// GetShareIndices returns the key share indices of the active key assigned to the validator
+func (k Keeper) GetShareIndices(ctx sdk.Context, validator string) (indices []uint64) {
+	for _, eachOwner := range k.GetAllKeyShareIndexOwner(ctx) {
+		if eachOwner.Validator == validator {
+			indices = append(indices, eachOwner.Index)
+		}
+	}
+	return
+}
+
+// GetValidatorSetByShareIndex returns the registered validator the key share index is assigned to
+func (k Keeper) GetValidatorSetByShareIndex(ctx sdk.Context, shareIndex uint64) (val types.ValidatorSet, found bool) {
+	owner, found := k.GetKeyShareIndexOwner(ctx, shareIndex)
+	if !found {
+		return val, false
+	}
+	return k.GetValidatorSet(ctx, owner.Validator)
+}
+
+// GetActiveShareCount returns the number of key share indices held by the active registered
+// validators, leaving out the excluded validator
+func (k Keeper) GetActiveShareCount(ctx sdk.Context, excluded string) (count uint64) {
+	for _, eachOwner := range k.GetAllKeyShareIndexOwner(ctx) {
+		if eachOwner.Validator == excluded {
+			continue
+		}
+		if val, found := k.GetValidatorSet(ctx, eachOwner.Validator); found && val.IsActive {
+			count++
+		}
+	}
+	return
+}
+
+// checkActiveSharesAboveThreshold returns an error if the active validators would hold fewer share
+// indices than the threshold of the active key once the given validator stops submitting
+func (k Keeper) checkActiveSharesAboveThreshold(ctx sdk.Context, validator string) error {
+	ak, found := k.GetActivePubKey(ctx)
+	if !found || ak.Threshold == 0 {
+		return nil
+	}
+
+	if activeShares := k.GetActiveShareCount(ctx, validator); activeShares < ak.Threshold {
+		return types.ErrNotEnoughActiveKeyShares.Wrapf("threshold: %d, active share indices left: %d", ak.Threshold, activeShares)
+	}
+	return nil
+}
+
+// GetConsensusPower returns the current consensus power of a registered validator,
+// 0 if it is not a staking validator
+func (k Keeper) GetConsensusPower(ctx sdk.Context, validator string) int64 {
+	accAddr, err := sdk.AccAddressFromBech32(validator)
+	if err != nil {
+		return 0
+	}
+
+	stakingValidator, found := k.stakingKeeper.GetValidator(ctx, sdk.ValAddress(accAddr))
+	if !found {
+		return 0
+	}
+
+	return stakingValidator.GetConsensusPower(k.stakingKeeper.PowerReduction(ctx))
+}
+
+// GetSlashPower returns the power used to slash a registered validator, which is its
+// current consensus power, or SlashPower if it can not be found
+func (k Keeper) GetSlashPower(ctx sdk.Context, validator string) int64 {
+	if power := k.GetConsensusPower(ctx, validator); power > 0 {
+		return power
+	}
+	return types.SlashPower
+}
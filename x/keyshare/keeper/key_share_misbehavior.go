@@ -0,0 +1,150 @@
+package keeper
+
+import (
+	"strconv"
+	"time"
+
+	"fairyring/x/keyshare/types"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetKeyShareMisbehavior set a specific keyShareMisbehavior in the store from its index
+func (k Keeper) SetKeyShareMisbehavior(ctx sdk.Context, keyShareMisbehavior types.KeyShareMisbehavior) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.KeyShareMisbehaviorKeyPrefix))
+	b := k.cdc.MustMarshal(&keyShareMisbehavior)
+	store.Set(types.KeyShareMisbehaviorKey(
+		keyShareMisbehavior.Validator,
+	), b)
+}
+
+// GetKeyShareMisbehavior returns a keyShareMisbehavior from its index
+func (k Keeper) GetKeyShareMisbehavior(
+	ctx sdk.Context,
+	validator string,
+) (val types.KeyShareMisbehavior, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.KeyShareMisbehaviorKeyPrefix))
+
+	b := store.Get(types.KeyShareMisbehaviorKey(
+		validator,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveKeyShareMisbehavior removes a keyShareMisbehavior from the store
+func (k Keeper) RemoveKeyShareMisbehavior(
+	ctx sdk.Context,
+	validator string,
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.KeyShareMisbehaviorKeyPrefix))
+	store.Delete(types.KeyShareMisbehaviorKey(
+		validator,
+	))
+}
+
+// GetAllKeyShareMisbehavior returns all keyShareMisbehavior
+func (k Keeper) GetAllKeyShareMisbehavior(ctx sdk.Context) (list []types.KeyShareMisbehavior) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.KeyShareMisbehaviorKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.KeyShareMisbehavior
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// IsKeyshareJailed returns true if the validator is jailed for submitting invalid key shares
+func (k Keeper) IsKeyshareJailed(ctx sdk.Context, validator string) bool {
+	misbehavior, found := k.GetKeyShareMisbehavior(ctx, validator)
+	return found && misbehavior.Jailed
+}
+
+// HandleInvalidKeyShare records an invalid key share submitted for the validator, identity is the
+// block height or the general identity of the key share. The validator is jailed once it submitted
+// MaxInvalidKeyshares invalid key shares within InvalidKeyshareWindow blocks
+func (k Keeper) HandleInvalidKeyShare(ctx sdk.Context, validator string, identity string, index uint64, keyShare string) {
+	height := uint64(ctx.BlockHeight())
+
+	misbehavior, found := k.GetKeyShareMisbehavior(ctx, validator)
+	if !found || misbehavior.WindowStartHeight+k.InvalidKeyshareWindow(ctx) <= height {
+		misbehavior = types.KeyShareMisbehavior{
+			Validator:         validator,
+			WindowStartHeight: height,
+		}
+	}
+	misbehavior.InvalidCount++
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.InvalidKeyShareEventType,
+			sdk.NewAttribute(types.InvalidKeyShareEventValidator, validator),
+			sdk.NewAttribute(types.InvalidKeyShareEventIdentity, identity),
+			sdk.NewAttribute(types.InvalidKeyShareEventIndex, strconv.FormatUint(index, 10)),
+			sdk.NewAttribute(types.InvalidKeyShareEventKeyShare, keyShare),
+			sdk.NewAttribute(types.InvalidKeyShareEventInvalidCount, strconv.FormatUint(misbehavior.InvalidCount, 10)),
+		),
+	)
+
+	maxInvalidKeyshares := k.MaxInvalidKeyshares(ctx)
+	if maxInvalidKeyshares == 0 || misbehavior.InvalidCount < maxInvalidKeyshares {
+		k.SetKeyShareMisbehavior(ctx, misbehavior)
+		return
+	}
+
+	k.jailKeyshareValidator(ctx, misbehavior, k.InvalidKeyshareJailDuration(ctx), types.JailReasonInvalidKeyShares)
+}
+
+// jailKeyshareValidator removes the validator from the validator set and jails it for jailDuration
+func (k Keeper) jailKeyshareValidator(ctx sdk.Context, misbehavior types.KeyShareMisbehavior, jailDuration time.Duration, reason string) {
+	jailedUntil := ctx.BlockTime().Add(jailDuration)
+
+	misbehavior.Jailed = true
+	misbehavior.JailedUntil = jailedUntil
+	k.SetKeyShareMisbehavior(ctx, misbehavior)
+	k.RemoveValidatorSet(ctx, misbehavior.Validator)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.KeyshareValidatorJailedEventType,
+			sdk.NewAttribute(types.KeyshareValidatorJailedEventValidator, misbehavior.Validator),
+			sdk.NewAttribute(types.KeyshareValidatorJailedEventReason, reason),
+			sdk.NewAttribute(types.KeyshareValidatorJailedEventInvalidCount, strconv.FormatUint(misbehavior.InvalidCount, 10)),
+			sdk.NewAttribute(types.KeyshareValidatorJailedEventJailedUntil, jailedUntil.Format(time.RFC3339)),
+		),
+	)
+
+	accAddr, err := sdk.AccAddressFromBech32(misbehavior.Validator)
+	if err != nil {
+		k.Logger(ctx).Error("Error while parsing jailed validator address: " + err.Error())
+		return
+	}
+
+	stakingValidator, found := k.stakingKeeper.GetValidator(ctx, sdk.ValAddress(accAddr))
+	if !found {
+		return
+	}
+
+	consAddr, err := stakingValidator.GetConsAddr()
+	if err != nil {
+		k.Logger(ctx).Error("Error while getting jailed validator cons addr: " + err.Error())
+		return
+	}
+
+	if !stakingValidator.IsJailed() {
+		k.slashingKeeper.Jail(ctx, consAddr)
+	}
+
+	// Never shorten a longer jail period set by the slashing module
+	if signInfo, found := k.slashingKeeper.GetValidatorSigningInfo(ctx, consAddr); found && signInfo.JailedUntil.Before(jailedUntil) {
+		k.slashingKeeper.JailUntil(ctx, consAddr, jailedUntil)
+	}
+}
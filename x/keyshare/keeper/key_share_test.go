@@ -34,6 +34,7 @@ func TestKeyShareGet(t *testing.T) {
 		rst, found := keeper.GetKeyShare(ctx,
 			item.Validator,
 			item.BlockHeight,
+			item.KeyShareIndex,
 		)
 		require.True(t, found)
 		require.Equal(t,
@@ -49,10 +50,12 @@ func TestKeyShareRemove(t *testing.T) {
 		keeper.RemoveKeyShare(ctx,
 			item.Validator,
 			item.BlockHeight,
+			item.KeyShareIndex,
 		)
 		_, found := keeper.GetKeyShare(ctx,
 			item.Validator,
 			item.BlockHeight,
+			item.KeyShareIndex,
 		)
 		require.False(t, found)
 	}
@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"fairyring/x/keyshare/types"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetKeyshareCommitment set a specific keyshareCommitment in the store from its index
+func (k Keeper) SetKeyshareCommitment(ctx sdk.Context, keyshareCommitment types.KeyshareCommitment) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.KeyshareCommitmentKeyPrefix))
+	b := k.cdc.MustMarshal(&keyshareCommitment)
+	store.Set(types.KeyshareCommitmentKey(
+		keyshareCommitment.Validator,
+		keyshareCommitment.BlockHeight,
+		keyshareCommitment.KeyShareIndex,
+	), b)
+}
+
+// GetKeyshareCommitment returns a keyshareCommitment from its index
+func (k Keeper) GetKeyshareCommitment(
+	ctx sdk.Context,
+	validator string,
+	blockHeight uint64,
+	keyShareIndex uint64,
+) (val types.KeyshareCommitment, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.KeyshareCommitmentKeyPrefix))
+
+	b := store.Get(types.KeyshareCommitmentKey(
+		validator,
+		blockHeight,
+		keyShareIndex,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveKeyshareCommitment removes a keyshareCommitment from the store
+func (k Keeper) RemoveKeyshareCommitment(
+	ctx sdk.Context,
+	validator string,
+	blockHeight uint64,
+	keyShareIndex uint64,
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.KeyshareCommitmentKeyPrefix))
+	store.Delete(types.KeyshareCommitmentKey(
+		validator,
+		blockHeight,
+		keyShareIndex,
+	))
+}
+
+// GetAllKeyshareCommitment returns all keyshareCommitment
+func (k Keeper) GetAllKeyshareCommitment(ctx sdk.Context) (list []types.KeyshareCommitment) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.KeyshareCommitmentKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.KeyshareCommitment
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// checkKeyshareReveal verifies a key share revealed in commit-reveal mode, it can only be revealed
+// from its block height and has to match the commitment of the validator
+func (k Keeper) checkKeyshareReveal(ctx sdk.Context, validator string, msg *types.MsgSendKeyshare) error {
+	if msg.BlockHeight > uint64(ctx.BlockHeight()) {
+		return types.ErrKeyshareRevealTooEarly.Wrapf("current height: %d, got: %d", ctx.BlockHeight(), msg.BlockHeight)
+	}
+
+	commitment, found := k.GetKeyshareCommitment(ctx, validator, msg.BlockHeight, msg.KeyShareIndex)
+	if !found {
+		return types.ErrKeyshareCommitmentNotFound.Wrapf("validator: %s, height: %d, index: %d", validator, msg.BlockHeight, msg.KeyShareIndex)
+	}
+
+	if commitment.Commitment != types.KeyshareCommitmentHash(validator, msg.BlockHeight, msg.KeyShareIndex, msg.Message) {
+		return types.ErrKeyshareCommitmentMismatch.Wrapf("validator: %s, height: %d, index: %d", validator, msg.BlockHeight, msg.KeyShareIndex)
+	}
+
+	return nil
+}
@@ -0,0 +1,142 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"fairyring/x/keyshare/types"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetKeyshareLivenessInfo set a specific keyshareLivenessInfo in the store from its index
+func (k Keeper) SetKeyshareLivenessInfo(ctx sdk.Context, keyshareLivenessInfo types.KeyshareLivenessInfo) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.KeyshareLivenessKeyPrefix))
+	b := k.cdc.MustMarshal(&keyshareLivenessInfo)
+	store.Set(types.KeyshareLivenessKey(
+		keyshareLivenessInfo.Validator,
+	), b)
+}
+
+// GetKeyshareLivenessInfo returns a keyshareLivenessInfo from its index
+func (k Keeper) GetKeyshareLivenessInfo(
+	ctx sdk.Context,
+	validator string,
+) (val types.KeyshareLivenessInfo, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.KeyshareLivenessKeyPrefix))
+
+	b := store.Get(types.KeyshareLivenessKey(
+		validator,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveKeyshareLivenessInfo removes a keyshareLivenessInfo from the store
+func (k Keeper) RemoveKeyshareLivenessInfo(
+	ctx sdk.Context,
+	validator string,
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.KeyshareLivenessKeyPrefix))
+	store.Delete(types.KeyshareLivenessKey(
+		validator,
+	))
+}
+
+// GetAllKeyshareLivenessInfo returns all keyshareLivenessInfo
+func (k Keeper) GetAllKeyshareLivenessInfo(ctx sdk.Context) (list []types.KeyshareLivenessInfo) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.KeyshareLivenessKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.KeyshareLivenessInfo
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// HandleKeyshareLiveness records for every validator whether it submitted the keyshare of the next height
+// by the end of the block. A validator missing more than KeyshareLivenessWindow - MinSubmittedPerWindow heights
+// of the last KeyshareLivenessWindow blocks is slashed and jailed for DowntimeJailDuration
+func (k Keeper) HandleKeyshareLiveness(ctx sdk.Context) {
+	// Validators cannot submit keyshares while there is no active public key
+	if val, found := k.GetActivePubKey(ctx); !found || len(val.PublicKey) == 0 {
+		return
+	}
+
+	params := k.GetParams(ctx)
+	height := uint64(ctx.BlockHeight())
+	window := params.KeyshareLivenessWindow
+	maxMissed := window - params.MinSubmittedPerWindowInt()
+
+	// The keyshare of the next height is expected by the end of the block, in commit-reveal mode
+	// keyshares are only revealed once their height is committed, so the previous height is expected
+	expectedHeight := height + 1
+	if params.KeyshareCommitReveal {
+		expectedHeight = height - 1
+	}
+
+	for _, eachValidator := range k.GetAllValidatorSet(ctx) {
+		// Paused validators & validators without a share index of the active key have nothing to submit
+		if !eachValidator.IsActive || len(k.GetShareIndices(ctx, eachValidator.Validator)) == 0 {
+			continue
+		}
+
+		info, found := k.GetKeyshareLivenessInfo(ctx, eachValidator.Validator)
+		if !found {
+			info = types.NewKeyshareLivenessInfo(eachValidator.Validator, height, window)
+		}
+
+		missed := k.GetLastSubmittedHeight(ctx, eachValidator.Validator) < expectedHeight
+		info.RecordHeight(window, missed)
+
+		if missed {
+			k.Logger(ctx).Info(fmt.Sprintf("Validator %s missed keyshare height: %d, missed: %d", eachValidator.Validator, expectedHeight, info.MissedCounter))
+		}
+
+		// Validators are only jailed once they have been tracked for a full window
+		if height < info.StartHeight+window || info.MissedCounter <= maxMissed {
+			k.SetKeyshareLivenessInfo(ctx, info)
+			continue
+		}
+
+		savedConsAddrByte, err := hex.DecodeString(eachValidator.ConsAddr)
+		if err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("Error while decoding validator %s cons addr: %s", eachValidator.Validator, err.Error()))
+			continue
+		}
+
+		var consAddr sdk.ConsAddress
+		err = consAddr.Unmarshal(savedConsAddrByte)
+		if err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("Error while unmarshaling validator %s cons addr: %s", eachValidator.Validator, err.Error()))
+			continue
+		}
+
+		k.stakingKeeper.Slash(
+			ctx,
+			consAddr,
+			ctx.BlockHeight()-1,
+			k.GetSlashPower(ctx, eachValidator.Validator),
+			params.SlashFractionNoKeyshare,
+		)
+
+		misbehavior, _ := k.GetKeyShareMisbehavior(ctx, eachValidator.Validator)
+		misbehavior.Validator = eachValidator.Validator
+		k.jailKeyshareValidator(ctx, misbehavior, params.DowntimeJailDuration, types.JailReasonDowntime)
+
+		// The validator starts over with a clean window once it registers again
+		k.RemoveKeyshareLivenessInfo(ctx, eachValidator.Validator)
+		telemetry.IncrCounter(1, types.KeyTotalIdleValSlashed)
+	}
+}
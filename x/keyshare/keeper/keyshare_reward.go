@@ -0,0 +1,212 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+
+	"fairyring/x/keyshare/types"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// SetRewardPool set the rewardPool in the store
+func (k Keeper) SetRewardPool(ctx sdk.Context, rewardPool types.RewardPool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RewardPoolKeyPrefix))
+	b := k.cdc.MustMarshal(&rewardPool)
+	store.Set([]byte{0}, b)
+}
+
+// GetRewardPool returns the rewardPool
+func (k Keeper) GetRewardPool(ctx sdk.Context) (val types.RewardPool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RewardPoolKeyPrefix))
+
+	b := store.Get([]byte{0})
+	if b == nil {
+		return val
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val
+}
+
+// SetValidatorRewardShares set a specific validatorRewardShares in the store from its index
+func (k Keeper) SetValidatorRewardShares(ctx sdk.Context, validatorRewardShares types.ValidatorRewardShares) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ValidatorRewardSharesKeyPrefix))
+	b := k.cdc.MustMarshal(&validatorRewardShares)
+	store.Set(types.ValidatorRewardSharesKey(
+		validatorRewardShares.Validator,
+	), b)
+}
+
+// GetValidatorRewardShares returns a validatorRewardShares from its index
+func (k Keeper) GetValidatorRewardShares(
+	ctx sdk.Context,
+	validator string,
+) (val types.ValidatorRewardShares, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ValidatorRewardSharesKeyPrefix))
+
+	b := store.Get(types.ValidatorRewardSharesKey(
+		validator,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllValidatorRewardShares returns all validatorRewardShares
+func (k Keeper) GetAllValidatorRewardShares(ctx sdk.Context) (list []types.ValidatorRewardShares) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ValidatorRewardSharesKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.ValidatorRewardShares
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// IncreaseAcceptedShares counts an accepted key share of the validator toward its rewards of the current epoch
+func (k Keeper) IncreaseAcceptedShares(ctx sdk.Context, validator string) {
+	shares, _ := k.GetValidatorRewardShares(ctx, validator)
+	shares.Validator = validator
+	shares.AcceptedShares++
+	k.SetValidatorRewardShares(ctx, shares)
+}
+
+// SetKeyShareAccepted marks the key share of the validator for the block height and share index
+// as counted toward the rewards. The marker is kept when the key share itself is pruned
+func (k Keeper) SetKeyShareAccepted(ctx sdk.Context, validator string, blockHeight uint64, keyShareIndex uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AcceptedKeyShareKeyPrefix))
+	store.Set(types.AcceptedKeyShareKey(validator, blockHeight, keyShareIndex), []byte{1})
+}
+
+// IsKeyShareAccepted returns true if the key share of the validator for the block height and share index
+// was already counted toward the rewards
+func (k Keeper) IsKeyShareAccepted(ctx sdk.Context, validator string, blockHeight uint64, keyShareIndex uint64) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AcceptedKeyShareKeyPrefix))
+	return store.Has(types.AcceptedKeyShareKey(validator, blockHeight, keyShareIndex))
+}
+
+// addToRewardPool adds coins already held by the keyshare module account to the reward pool
+func (k Keeper) addToRewardPool(ctx sdk.Context, coins sdk.Coins) {
+	if coins.IsZero() {
+		return
+	}
+	pool := k.GetRewardPool(ctx)
+	pool.Coins = pool.Coins.Add(coins...)
+	k.SetRewardPool(ctx, pool)
+}
+
+// CollectEncryptedTxFees moves the EncryptedTxFeeRewardShare of the encrypted tx fees
+// held by the sender module to the reward pool
+func (k Keeper) CollectEncryptedTxFees(ctx sdk.Context, senderModule string, fees sdk.Coins) error {
+	rewards := types.FeeShare(fees, k.EncryptedTxFeeRewardShare(ctx))
+	if rewards.IsZero() {
+		return nil
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, senderModule, types.ModuleName, rewards); err != nil {
+		return err
+	}
+
+	k.addToRewardPool(ctx, rewards)
+	return nil
+}
+
+// collectRequestFee moves the RequestFeeRewardShare of an escrowed request fee to the reward pool,
+// the rest of the fee is sent to the fee collector
+func (k Keeper) collectRequestFee(ctx sdk.Context, fee sdk.Coin) error {
+	fees := sdk.NewCoins(fee)
+	rewards := types.FeeShare(fees, k.RequestFeeRewardShare(ctx))
+
+	if remaining := fees.Sub(rewards...); !remaining.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, authtypes.FeeCollectorName, remaining); err != nil {
+			return err
+		}
+	}
+
+	k.addToRewardPool(ctx, rewards)
+	return nil
+}
+
+// NextRewardDistributionHeight returns the height the reward pool is next distributed at
+func (k Keeper) NextRewardDistributionHeight(ctx sdk.Context) uint64 {
+	epoch := k.RewardEpochBlocks(ctx)
+	height := uint64(ctx.BlockHeight())
+	return height - height%epoch + epoch
+}
+
+// GetPendingRewards returns the rewards the validator would receive if the reward pool was distributed now
+func (k Keeper) GetPendingRewards(ctx sdk.Context, validator string) sdk.Coins {
+	shares, found := k.GetValidatorRewardShares(ctx, validator)
+	if !found {
+		return sdk.NewCoins()
+	}
+
+	var totalShares uint64
+	for _, each := range k.GetAllValidatorRewardShares(ctx) {
+		totalShares += each.AcceptedShares
+	}
+
+	return types.RewardShare(k.GetRewardPool(ctx).Coins, shares.AcceptedShares, totalShares)
+}
+
+// DistributeKeyshareRewards distributes the reward pool to the validators proportionally to their key shares
+// accepted during the epoch at the end of every RewardEpochBlocks blocks. Amounts left by rounding stay in the pool
+func (k Keeper) DistributeKeyshareRewards(ctx sdk.Context) {
+	if uint64(ctx.BlockHeight())%k.RewardEpochBlocks(ctx) != 0 {
+		return
+	}
+
+	allShares := k.GetAllValidatorRewardShares(ctx)
+
+	var totalShares uint64
+	for _, each := range allShares {
+		totalShares += each.AcceptedShares
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ValidatorRewardSharesKeyPrefix))
+	pool := k.GetRewardPool(ctx)
+	poolCoins := pool.Coins
+
+	for _, each := range allShares {
+		store.Delete(types.ValidatorRewardSharesKey(each.Validator))
+
+		reward := types.RewardShare(poolCoins, each.AcceptedShares, totalShares)
+		if reward.IsZero() {
+			continue
+		}
+
+		validatorAddr, err := sdk.AccAddressFromBech32(each.Validator)
+		if err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("Error on converting validator addr: %s to AccAddr: %s", each.Validator, err.Error()))
+			continue
+		}
+
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, validatorAddr, reward); err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("Error on sending keyshare rewards to: %s, %s", each.Validator, err.Error()))
+			continue
+		}
+
+		pool.Coins = pool.Coins.Sub(reward...)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(types.KeyshareRewardsDistributedEventType,
+				sdk.NewAttribute(types.KeyshareRewardsDistributedEventValidator, each.Validator),
+				sdk.NewAttribute(types.KeyshareRewardsDistributedEventShares, strconv.FormatUint(each.AcceptedShares, 10)),
+				sdk.NewAttribute(types.KeyshareRewardsDistributedEventAmount, reward.String()),
+			),
+		)
+	}
+
+	k.SetRewardPool(ctx, pool)
+}
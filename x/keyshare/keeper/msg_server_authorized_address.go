@@ -27,14 +27,21 @@ func (k msgServer) CreateAuthorizedAddress(goCtx context.Context, msg *types.Msg
 		return nil, types.ErrOnlyValidatorCanAuthorizeAddr.Wrap(msg.Creator)
 	}
 
-	if k.GetAuthorizedCount(ctx, msg.Creator) >= 1 {
-		return nil, types.ErrExceedMaxAuthAddr
+	maxAuthorized := k.MaxAuthorizedAddresses(ctx)
+	if k.GetAuthorizedCount(ctx, msg.Creator) >= maxAuthorized {
+		return nil, types.ErrExceedMaxAuthAddr.Wrapf("max: %d", maxAuthorized)
+	}
+
+	if err := k.validateAuthorizedAddressGrant(ctx, msg.ExpiryHeight, msg.Scope); err != nil {
+		return nil, err
 	}
 
 	var authorizedAddress = types.AuthorizedAddress{
 		AuthorizedBy: msg.Creator,
 		Target:       msg.Target,
 		IsAuthorized: true,
+		ExpiryHeight: msg.ExpiryHeight,
+		Scope:        msg.Scope,
 	}
 
 	k.SetAuthorizedAddress(
@@ -64,10 +71,16 @@ func (k msgServer) UpdateAuthorizedAddress(goCtx context.Context, msg *types.Msg
 		return nil, types.ErrAuthorizeSelfAddress
 	}
 
+	if err := k.validateAuthorizedAddressGrant(ctx, msg.ExpiryHeight, msg.Scope); err != nil {
+		return nil, err
+	}
+
 	var authorizedAddress = types.AuthorizedAddress{
 		AuthorizedBy: msg.Creator,
 		Target:       msg.Target,
 		IsAuthorized: msg.IsAuthorized,
+		ExpiryHeight: msg.ExpiryHeight,
+		Scope:        msg.Scope,
 	}
 
 	k.SetAuthorizedAddress(ctx, authorizedAddress)
@@ -87,7 +100,7 @@ func (k msgServer) DeleteAuthorizedAddress(goCtx context.Context, msg *types.Msg
 	}
 
 	// Allow the authorized addr creator / target authorized address to remove authorization
-	if msg.Creator != valFound.AuthorizedBy && msg.Target != valFound.Target {
+	if msg.Creator != valFound.AuthorizedBy && msg.Creator != valFound.Target {
 		return nil, types.ErrNotTargetOrAuthAddrCreator
 	}
 
@@ -100,3 +113,19 @@ func (k msgServer) DeleteAuthorizedAddress(goCtx context.Context, msg *types.Msg
 
 	return &types.MsgDeleteAuthorizedAddressResponse{}, nil
 }
+
+// validateAuthorizedAddressGrant checks the expiry height of a grant is in the future
+// and its scope only holds supported general id types
+func (k msgServer) validateAuthorizedAddressGrant(ctx sdk.Context, expiryHeight uint64, scope types.AuthorizedScope) error {
+	if expiryHeight != 0 && expiryHeight <= uint64(ctx.BlockHeight()) {
+		return types.ErrInvalidAuthorizedAddrExpiry.Wrapf("expected a height above: %d, got: %d", ctx.BlockHeight(), expiryHeight)
+	}
+
+	for _, idType := range scope.GeneralIdTypes {
+		if !k.isSupportedIDType(idType) {
+			return types.ErrUnsupportedIDType.Wrapf("got: %s, supported id types: %v", idType, k.SupportedIDTypes())
+		}
+	}
+
+	return nil
+}
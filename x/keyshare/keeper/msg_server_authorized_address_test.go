@@ -5,7 +5,6 @@ import (
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/stretchr/testify/require"
 
 	keepertest "fairyring/testutil/keeper"
@@ -21,6 +20,10 @@ func TestAuthorizedAddressMsgServerCreate(t *testing.T) {
 	srv := keeper.NewMsgServerImpl(*k)
 	wctx := sdk.WrapSDKContext(ctx)
 	creator := "A"
+	k.SetValidatorSet(ctx, types.ValidatorSet{Index: creator, Validator: creator, IsActive: true})
+	params := k.GetParams(ctx)
+	params.MaxAuthorizedAddresses = 5
+	k.SetParams(ctx, params)
 	for i := 0; i < 5; i++ {
 		expected := &types.MsgCreateAuthorizedAddress{Creator: creator,
 			Target: strconv.Itoa(i),
@@ -54,20 +57,21 @@ func TestAuthorizedAddressMsgServerUpdate(t *testing.T) {
 			request: &types.MsgUpdateAuthorizedAddress{Creator: "B",
 				Target: strconv.Itoa(0),
 			},
-			err: sdkerrors.ErrUnauthorized,
+			err: types.ErrNotAuthorizedAddrCreator,
 		},
 		{
 			desc: "KeyNotFound",
 			request: &types.MsgUpdateAuthorizedAddress{Creator: creator,
 				Target: strconv.Itoa(100000),
 			},
-			err: sdkerrors.ErrKeyNotFound,
+			err: types.ErrAuthorizedAddrNotFound,
 		},
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
 			k, ctx := keepertest.KeyshareKeeper(t)
 			srv := keeper.NewMsgServerImpl(*k)
 			wctx := sdk.WrapSDKContext(ctx)
+			k.SetValidatorSet(ctx, types.ValidatorSet{Index: creator, Validator: creator, IsActive: true})
 			expected := &types.MsgCreateAuthorizedAddress{Creator: creator,
 				Target: strconv.Itoa(0),
 			}
@@ -103,25 +107,32 @@ func TestAuthorizedAddressMsgServerDelete(t *testing.T) {
 				Target: strconv.Itoa(0),
 			},
 		},
+		{
+			desc: "Target",
+			request: &types.MsgDeleteAuthorizedAddress{Creator: strconv.Itoa(0),
+				Target: strconv.Itoa(0),
+			},
+		},
 		{
 			desc: "Unauthorized",
 			request: &types.MsgDeleteAuthorizedAddress{Creator: "B",
 				Target: strconv.Itoa(0),
 			},
-			err: sdkerrors.ErrUnauthorized,
+			err: types.ErrNotTargetOrAuthAddrCreator,
 		},
 		{
 			desc: "KeyNotFound",
 			request: &types.MsgDeleteAuthorizedAddress{Creator: creator,
 				Target: strconv.Itoa(100000),
 			},
-			err: sdkerrors.ErrKeyNotFound,
+			err: types.ErrAuthorizedAddrNotFound,
 		},
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
 			k, ctx := keepertest.KeyshareKeeper(t)
 			srv := keeper.NewMsgServerImpl(*k)
 			wctx := sdk.WrapSDKContext(ctx)
+			k.SetValidatorSet(ctx, types.ValidatorSet{Index: creator, Validator: creator, IsActive: true})
 
 			_, err := srv.CreateAuthorizedAddress(wctx, &types.MsgCreateAuthorizedAddress{Creator: creator,
 				Target: strconv.Itoa(0),
@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	"fairyring/x/keyshare/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// CommitKeyshare stores the commitment of a validator to its key share of a future block height
+// in commit-reveal mode, the key share is then revealed with MsgSendKeyshare from that height
+func (k msgServer) CommitKeyshare(goCtx context.Context, msg *types.MsgCommitKeyshare) (*types.MsgCommitKeyshareResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.KeyshareCommitReveal(ctx) {
+		return nil, types.ErrCommitRevealDisabled
+	}
+
+	validatorInfo, err := k.getKeyshareValidator(ctx, msg.Creator, func(a types.AuthorizedAddress) bool {
+		return a.AllowsBlockKeyshares()
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	height := uint64(ctx.BlockHeight())
+	if msg.BlockHeight <= height || msg.BlockHeight > height+types.MaxKeyshareCommitAhead {
+		return nil, types.ErrInvalidBlockHeight.Wrapf("expected height between %d and %d, got: %d", height+1, height+types.MaxKeyshareCommitAhead, msg.BlockHeight)
+	}
+
+	if !k.IsKeyShareIndexOwner(ctx, validatorInfo.Validator, msg.KeyShareIndex) {
+		return nil, types.ErrKeyShareIndexNotAssigned.Wrapf("validator: %s, index: %d", validatorInfo.Validator, msg.KeyShareIndex)
+	}
+
+	if _, found := k.GetKeyshareCommitment(ctx, validatorInfo.Validator, msg.BlockHeight, msg.KeyShareIndex); found {
+		return nil, types.ErrKeyshareAlreadyCommitted.Wrapf("validator: %s, height: %d, index: %d", validatorInfo.Validator, msg.BlockHeight, msg.KeyShareIndex)
+	}
+
+	k.SetKeyshareCommitment(ctx, types.KeyshareCommitment{
+		Validator:       validatorInfo.Validator,
+		BlockHeight:     msg.BlockHeight,
+		KeyShareIndex:   msg.KeyShareIndex,
+		Commitment:      msg.Commitment,
+		CommittedHeight: height,
+	})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.KeyshareCommittedEventType,
+			sdk.NewAttribute(types.KeyshareCommittedEventValidator, validatorInfo.Validator),
+			sdk.NewAttribute(types.KeyshareCommittedEventBlockHeight, strconv.FormatUint(msg.BlockHeight, 10)),
+			sdk.NewAttribute(types.KeyshareCommittedEventKeyShareIndex, strconv.FormatUint(msg.KeyShareIndex, 10)),
+			sdk.NewAttribute(types.KeyshareCommittedEventCommitment, msg.Commitment),
+		),
+	)
+
+	return &types.MsgCommitKeyshareResponse{}, nil
+}
@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	"context"
+
+	"fairyring/x/keyshare/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DeRegisterValidator removes a validator from the validator set, it no longer has to submit key shares.
+// Its share indices stay bound to it until the next key is activated.
+func (k msgServer) DeRegisterValidator(goCtx context.Context, msg *types.MsgDeRegisterValidator) (*types.MsgDeRegisterValidatorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, found := k.GetValidatorSet(ctx, msg.Creator); !found {
+		return nil, types.ErrValidatorNotRegistered.Wrap(msg.Creator)
+	}
+
+	// Keys can no longer be aggregated if the active validators hold fewer share indices than the threshold
+	if err := k.checkActiveSharesAboveThreshold(ctx, msg.Creator); err != nil {
+		return nil, err
+	}
+
+	k.RemoveValidatorSet(ctx, msg.Creator)
+	k.RemoveKeyshareLivenessInfo(ctx, msg.Creator)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.DeRegisteredValidatorEventType,
+			sdk.NewAttribute(types.DeRegisteredValidatorEventCreator, msg.Creator),
+		),
+	)
+
+	return &types.MsgDeRegisterValidatorResponse{
+		Creator: msg.Creator,
+	}, nil
+}
@@ -0,0 +1,44 @@
+package keeper_test
+
+import (
+	"testing"
+
+	keepertest "fairyring/testutil/keeper"
+	"fairyring/testutil/sample"
+	"fairyring/x/keyshare/keeper"
+	"fairyring/x/keyshare/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeRegisterValidatorKeepsShareIndices(t *testing.T) {
+	k, ctx := keepertest.KeyshareKeeper(t)
+	msgServer := keeper.NewMsgServerImpl(*k)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	validators := []string{sample.AccAddress(), sample.AccAddress(), sample.AccAddress()}
+	assignments := make([]types.KeyShareAssignment, len(validators))
+	for i, v := range validators {
+		k.SetValidatorSet(ctx, types.ValidatorSet{Index: v, Validator: v, IsActive: true})
+		assignments[i] = types.KeyShareAssignment{Validator: v, ShareIndices: []uint64{uint64(i + 1)}, Weight: 1}
+	}
+	k.SetActivePubKey(ctx, types.ActivePubKey{Threshold: 2, Assignments: assignments})
+	k.SetKeyShareAssignments(ctx, assignments)
+
+	_, err := msgServer.DeRegisterValidator(wctx, &types.MsgDeRegisterValidator{Creator: validators[0]})
+	require.NoError(t, err)
+	require.True(t, k.IsKeyShareIndexOwner(ctx, validators[0], 1))
+	require.Equal(t, uint64(2), k.GetActiveShareCount(ctx, ""))
+
+	// Only one active share index would be left, below the threshold
+	_, err = msgServer.DeRegisterValidator(wctx, &types.MsgDeRegisterValidator{Creator: validators[1]})
+	require.ErrorIs(t, err, types.ErrNotEnoughActiveKeyShares)
+	_, found := k.GetValidatorSet(ctx, validators[1])
+	require.True(t, found)
+
+	// The validator takes its share index back once registered again
+	k.SetValidatorSet(ctx, types.ValidatorSet{Index: validators[0], Validator: validators[0], IsActive: true})
+	require.Equal(t, []uint64{1}, k.GetShareIndices(ctx, validators[0]))
+	require.Equal(t, uint64(3), k.GetActiveShareCount(ctx, ""))
+}
@@ -0,0 +1,272 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"fairyring/x/keyshare/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// StartDkgRound starts a new distributed key generation round among the registered validators
+func (k msgServer) StartDkgRound(goCtx context.Context, msg *types.MsgStartDkgRound) (*types.MsgStartDkgRoundResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, found := k.GetValidatorSet(ctx, msg.Creator); !found {
+		return nil, types.ErrValidatorNotRegistered.Wrap(msg.Creator)
+	}
+
+	if latest, found := k.GetLatestDkgRound(ctx); found && latest.IsInProgress() {
+		return nil, types.ErrDkgRoundInProgress.Wrapf("round id: %d", latest.Id)
+	}
+
+	if _, found := k.GetQueuedPubKey(ctx); found {
+		return nil, types.ErrQueuedKeyAlreadyExists.Wrap(msg.Creator)
+	}
+
+	// Paused validators do not take part in the key generation
+	var validatorList []types.ValidatorSet
+	for _, eachValidator := range k.GetAllValidatorSet(ctx) {
+		if eachValidator.IsActive {
+			validatorList = append(validatorList, eachValidator)
+		}
+	}
+	if len(validatorList) == 0 {
+		return nil, types.ErrNoRegisteredValidator
+	}
+
+	// One share index is dealt per active validator. With stake weighted aggregation the indices are split
+	// among the validators like for MsgCreateLatestPubKey, otherwise every validator holds a single index
+	totalShares := uint64(len(validatorList))
+	var assignments []types.KeyShareAssignment
+	if k.StakeWeightedAggregation(ctx) {
+		var err error
+		if assignments, err = k.AssignStakeWeightedShareIndices(ctx, totalShares); err != nil {
+			return nil, err
+		}
+	} else {
+		for i, eachValidator := range validatorList {
+			assignments = append(assignments, types.KeyShareAssignment{
+				Validator:    eachValidator.Validator,
+				ShareIndices: []uint64{uint64(i + 1)},
+				Weight:       1,
+			})
+		}
+	}
+
+	participants := make([]types.DkgParticipant, 0, len(assignments))
+	participantAddrs := make([]string, 0, len(assignments))
+	for _, a := range assignments {
+		participants = append(participants, types.DkgParticipant{
+			Validator:    a.Validator,
+			ShareIndices: a.ShareIndices,
+			Weight:       a.Weight,
+		})
+		participantAddrs = append(participantAddrs, a.Validator)
+	}
+
+	height := uint64(ctx.BlockHeight())
+	phaseDuration := k.DkgPhaseDuration(ctx)
+	roundID := k.GetDkgRoundCount(ctx) + 1
+	threshold := k.GetParams(ctx).AggregationThreshold(totalShares)
+
+	k.SetDkgRound(ctx, types.DkgRound{
+		Id:                     roundID,
+		Phase:                  types.DKG_PHASE_DEAL,
+		Threshold:              threshold,
+		Participants:           participants,
+		StartHeight:            height,
+		DealEndHeight:          height + phaseDuration,
+		ComplaintEndHeight:     height + 2*phaseDuration,
+		JustificationEndHeight: height + 3*phaseDuration,
+	})
+	k.SetDkgRoundCount(ctx, roundID)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.DkgRoundStartedEventType,
+			sdk.NewAttribute(types.DkgRoundStartedEventRoundID, strconv.FormatUint(roundID, 10)),
+			sdk.NewAttribute(types.DkgRoundStartedEventThreshold, strconv.FormatUint(threshold, 10)),
+			sdk.NewAttribute(types.DkgRoundStartedEventParticipant, strings.Join(participantAddrs, ",")),
+		),
+	)
+
+	return &types.MsgStartDkgRoundResponse{
+		RoundId:   roundID,
+		Threshold: threshold,
+	}, nil
+}
+
+// SubmitDkgDeal stores the coefficient commitments and encrypted shares dealt by a participant
+func (k msgServer) SubmitDkgDeal(goCtx context.Context, msg *types.MsgSubmitDkgDeal) (*types.MsgSubmitDkgDealResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	round, found := k.GetDkgRound(ctx, msg.RoundId)
+	if !found {
+		return nil, types.ErrDkgRoundNotFound.Wrapf("round id: %d", msg.RoundId)
+	}
+
+	if round.Phase != types.DKG_PHASE_DEAL {
+		return nil, types.ErrInvalidDkgPhase.Wrapf("expected phase: %s, got: %s", types.DKG_PHASE_DEAL, round.Phase)
+	}
+
+	if _, found = round.GetParticipant(msg.Creator); !found {
+		return nil, types.ErrNotDkgParticipant.Wrap(msg.Creator)
+	}
+
+	if _, found = k.GetDkgDeal(ctx, msg.RoundId, msg.Creator); found {
+		return nil, types.ErrDkgDealAlreadyExists.Wrap(msg.Creator)
+	}
+
+	if uint64(len(msg.Commitments)) != round.Threshold {
+		return nil, types.ErrInvalidDkgDeal.Wrapf("expected %d commitments, got: %d", round.Threshold, len(msg.Commitments))
+	}
+
+	if _, err := types.ParseDkgCommitments(msg.Commitments); err != nil {
+		return nil, err
+	}
+
+	totalShares := round.TotalShares()
+	if uint64(len(msg.EncryptedShares)) != totalShares {
+		return nil, types.ErrInvalidDkgDeal.Wrapf("expected %d encrypted shares, got: %d", totalShares, len(msg.EncryptedShares))
+	}
+
+	// Every share index from 1 to n must receive exactly one encrypted share
+	seenIndices := make(map[uint64]struct{}, totalShares)
+	for _, eachShare := range msg.EncryptedShares {
+		if eachShare.Index < 1 || eachShare.Index > totalShares {
+			return nil, types.ErrInvalidDkgDeal.Wrapf("expected share index within 1 and %d, got: %d", totalShares, eachShare.Index)
+		}
+		if _, found := seenIndices[eachShare.Index]; found {
+			return nil, types.ErrInvalidDkgDeal.Wrapf("duplicated encrypted share for index: %d", eachShare.Index)
+		}
+		seenIndices[eachShare.Index] = struct{}{}
+	}
+
+	k.SetDkgDeal(ctx, types.DkgDeal{
+		RoundId:         msg.RoundId,
+		Dealer:          msg.Creator,
+		Commitments:     msg.Commitments,
+		EncryptedShares: msg.EncryptedShares,
+	})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.DkgDealSubmittedEventType,
+			sdk.NewAttribute(types.DkgDealSubmittedEventRoundID, strconv.FormatUint(msg.RoundId, 10)),
+			sdk.NewAttribute(types.DkgDealSubmittedEventDealer, msg.Creator),
+		),
+	)
+
+	return &types.MsgSubmitDkgDealResponse{}, nil
+}
+
+// SubmitDkgComplaint records that the sender received an invalid share from a dealer
+func (k msgServer) SubmitDkgComplaint(goCtx context.Context, msg *types.MsgSubmitDkgComplaint) (*types.MsgSubmitDkgComplaintResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	round, found := k.GetDkgRound(ctx, msg.RoundId)
+	if !found {
+		return nil, types.ErrDkgRoundNotFound.Wrapf("round id: %d", msg.RoundId)
+	}
+
+	if round.Phase != types.DKG_PHASE_COMPLAINT {
+		return nil, types.ErrInvalidDkgPhase.Wrapf("expected phase: %s, got: %s", types.DKG_PHASE_COMPLAINT, round.Phase)
+	}
+
+	if _, found = round.GetParticipant(msg.Creator); !found {
+		return nil, types.ErrNotDkgParticipant.Wrap(msg.Creator)
+	}
+
+	if _, found = k.GetDkgDeal(ctx, msg.RoundId, msg.Dealer); !found {
+		return nil, types.ErrDkgDealNotFound.Wrapf("dealer: %s", msg.Dealer)
+	}
+
+	if _, found = k.GetDkgComplaint(ctx, msg.RoundId, msg.Dealer, msg.Creator); found {
+		return nil, types.ErrDkgComplaintAlreadyExists.Wrapf("dealer: %s", msg.Dealer)
+	}
+
+	k.SetDkgComplaint(ctx, types.DkgComplaint{
+		RoundId:    msg.RoundId,
+		Complainer: msg.Creator,
+		Dealer:     msg.Dealer,
+	})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.DkgComplaintSubmittedEventType,
+			sdk.NewAttribute(types.DkgComplaintSubmittedEventRoundID, strconv.FormatUint(msg.RoundId, 10)),
+			sdk.NewAttribute(types.DkgComplaintSubmittedEventDealer, msg.Dealer),
+			sdk.NewAttribute(types.DkgComplaintSubmittedEventComplainer, msg.Creator),
+		),
+	)
+
+	return &types.MsgSubmitDkgComplaintResponse{}, nil
+}
+
+// SubmitDkgJustification lets a dealer answer a complaint by revealing the disputed share,
+// which is verified against the dealer's coefficient commitments
+func (k msgServer) SubmitDkgJustification(goCtx context.Context, msg *types.MsgSubmitDkgJustification) (*types.MsgSubmitDkgJustificationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	round, found := k.GetDkgRound(ctx, msg.RoundId)
+	if !found {
+		return nil, types.ErrDkgRoundNotFound.Wrapf("round id: %d", msg.RoundId)
+	}
+
+	if round.Phase != types.DKG_PHASE_JUSTIFICATION {
+		return nil, types.ErrInvalidDkgPhase.Wrapf("expected phase: %s, got: %s", types.DKG_PHASE_JUSTIFICATION, round.Phase)
+	}
+
+	deal, found := k.GetDkgDeal(ctx, msg.RoundId, msg.Creator)
+	if !found {
+		return nil, types.ErrDkgDealNotFound.Wrapf("dealer: %s", msg.Creator)
+	}
+
+	complaint, found := k.GetDkgComplaint(ctx, msg.RoundId, msg.Creator, msg.Complainer)
+	if !found {
+		return nil, types.ErrDkgComplaintNotFound.Wrapf("complainer: %s", msg.Complainer)
+	}
+
+	if len(complaint.Justifications) != 0 {
+		return nil, types.ErrInvalidDkgJustification.Wrapf("complaint from %s is already justified", msg.Complainer)
+	}
+
+	complainer, found := round.GetParticipant(msg.Complainer)
+	if !found {
+		return nil, types.ErrNotDkgParticipant.Wrap(msg.Complainer)
+	}
+
+	commitments, err := types.ParseDkgCommitments(deal.Commitments)
+	if err != nil {
+		return nil, err
+	}
+
+	// A share is revealed for every share index of the complainer
+	if len(msg.Shares) != len(complainer.ShareIndices) {
+		return nil, types.ErrInvalidDkgJustification.Wrapf("expected %d shares, got: %d", len(complainer.ShareIndices), len(msg.Shares))
+	}
+
+	for i, index := range complainer.ShareIndices {
+		share, err := types.ParseDkgShare(msg.Shares[i])
+		if err != nil {
+			return nil, err
+		}
+
+		if !types.VerifyDkgShare(commitments, index, share) {
+			return nil, types.ErrInvalidDkgJustification.Wrapf("share does not match the commitments for index: %d", index)
+		}
+	}
+
+	complaint.Justifications = msg.Shares
+	k.SetDkgComplaint(ctx, complaint)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.DkgJustificationSubmittedEventType,
+			sdk.NewAttribute(types.DkgJustificationSubmittedEventRoundID, strconv.FormatUint(msg.RoundId, 10)),
+			sdk.NewAttribute(types.DkgJustificationSubmittedEventDealer, msg.Creator),
+			sdk.NewAttribute(types.DkgJustificationSubmittedEventComplainer, msg.Complainer),
+		),
+	)
+
+	return &types.MsgSubmitDkgJustificationResponse{}, nil
+}
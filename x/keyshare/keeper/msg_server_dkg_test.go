@@ -0,0 +1,159 @@
+package keeper_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	keepertest "fairyring/testutil/keeper"
+	"fairyring/testutil/sample"
+	"fairyring/x/keyshare/keeper"
+	"fairyring/x/keyshare/types"
+	peptypes "fairyring/x/pep/types"
+
+	distIBE "github.com/FairBlock/DistributedIBE"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubmitDkgDealShareIndices(t *testing.T) {
+	k, ctx := keepertest.KeyshareKeeper(t)
+	msgServer := keeper.NewMsgServerImpl(*k)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	participants := []types.DkgParticipant{
+		{Validator: sample.AccAddress(), ShareIndices: []uint64{1, 2}, Weight: 2},
+		{Validator: sample.AccAddress(), ShareIndices: []uint64{3}, Weight: 1},
+	}
+	k.SetDkgRound(ctx, types.DkgRound{
+		Id:           1,
+		Phase:        types.DKG_PHASE_DEAL,
+		Threshold:    2,
+		Participants: participants,
+	})
+
+	_, _, commits, err := distIBE.GenerateShares(3, 2)
+	require.NoError(t, err)
+	commitments := make([]string, len(commits))
+	for i, c := range commits {
+		b, err := c.MarshalBinary()
+		require.NoError(t, err)
+		commitments[i] = hex.EncodeToString(b)
+	}
+
+	shares := func(indices ...uint64) []types.DkgEncryptedShare {
+		list := make([]types.DkgEncryptedShare, len(indices))
+		for i, index := range indices {
+			list[i] = types.DkgEncryptedShare{Index: index, Data: "share"}
+		}
+		return list
+	}
+
+	for _, tc := range []struct {
+		desc    string
+		indices []uint64
+		err     error
+	}{
+		{desc: "ZeroIndex", indices: []uint64{0, 1, 2}, err: types.ErrInvalidDkgDeal},
+		{desc: "IndexOutOfRange", indices: []uint64{1, 2, 4}, err: types.ErrInvalidDkgDeal},
+		{desc: "DuplicatedIndex", indices: []uint64{1, 1, 2}, err: types.ErrInvalidDkgDeal},
+		{desc: "MissingIndex", indices: []uint64{1, 2}, err: types.ErrInvalidDkgDeal},
+		{desc: "Valid", indices: []uint64{3, 1, 2}},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := msgServer.SubmitDkgDeal(wctx, &types.MsgSubmitDkgDeal{
+				Creator:         participants[0].Validator,
+				RoundId:         1,
+				Commitments:     commitments,
+				EncryptedShares: shares(tc.indices...),
+			})
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestDkgRoundStakeWeightedShareIndices(t *testing.T) {
+	k, ctx, deps := keepertest.KeyshareKeeperWithDeps(t)
+	msgServer := keeper.NewMsgServerImpl(*k)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	params := k.GetParams(ctx)
+	params.StakeWeightedAggregation = true
+	k.SetParams(ctx, params)
+	deps.PepKeeper.SetParams(ctx, peptypes.DefaultParams())
+
+	bob, carol := sample.AccAddress(), sample.AccAddress()
+	for _, v := range []struct {
+		address string
+		power   int64
+	}{{alice, 4}, {bob, 2}, {carol, 1}} {
+		validator := setupStakingValidator(t, ctx, *k, deps, v.address)
+		validator.Tokens = sdk.TokensFromConsensusPower(v.power, sdk.DefaultPowerReduction)
+		deps.StakingKeeper.SetValidator(ctx, validator)
+		k.SetValidatorSet(ctx, types.ValidatorSet{Index: v.address, Validator: v.address, IsActive: true})
+	}
+
+	res, err := msgServer.StartDkgRound(wctx, &types.MsgStartDkgRound{Creator: alice})
+	require.NoError(t, err)
+
+	// The 3 share indices are split by voting power, carol is left without any
+	round, found := k.GetDkgRound(ctx, res.RoundId)
+	require.True(t, found)
+	require.Len(t, round.Participants, 2)
+	require.EqualValues(t, 3, round.TotalShares())
+	for _, p := range round.Participants {
+		switch p.Validator {
+		case alice:
+			require.Len(t, p.ShareIndices, 2)
+			require.EqualValues(t, 4, p.Weight)
+		case bob:
+			require.Len(t, p.ShareIndices, 1)
+			require.EqualValues(t, 2, p.Weight)
+		default:
+			t.Fatalf("unexpected participant: %s", p.Validator)
+		}
+	}
+
+	for _, dealer := range []string{alice, bob} {
+		_, _, commits, err := distIBE.GenerateShares(3, uint32(round.Threshold))
+		require.NoError(t, err)
+		commitments := make([]string, len(commits))
+		for i, c := range commits {
+			b, err := c.MarshalBinary()
+			require.NoError(t, err)
+			commitments[i] = hex.EncodeToString(b)
+		}
+		encryptedShares := make([]types.DkgEncryptedShare, 3)
+		for i := range encryptedShares {
+			encryptedShares[i] = types.DkgEncryptedShare{Index: uint64(i + 1), Data: "share"}
+		}
+		_, err = msgServer.SubmitDkgDeal(wctx, &types.MsgSubmitDkgDeal{
+			Creator:         dealer,
+			RoundId:         res.RoundId,
+			Commitments:     commitments,
+			EncryptedShares: encryptedShares,
+		})
+		require.NoError(t, err)
+	}
+
+	for _, height := range []uint64{round.DealEndHeight, round.ComplaintEndHeight, round.JustificationEndHeight} {
+		k.ProcessDkgRound(ctx.WithBlockHeight(int64(height + 1)))
+	}
+
+	round, _ = k.GetDkgRound(ctx, res.RoundId)
+	require.Equal(t, types.DKG_PHASE_FINALIZED, round.Phase)
+
+	queuedPubKey, found := k.GetQueuedPubKey(ctx)
+	require.True(t, found)
+	require.Len(t, queuedPubKey.Assignments, len(round.Participants))
+	for i, p := range round.Participants {
+		require.Equal(t, types.KeyShareAssignment{
+			Validator:    p.Validator,
+			ShareIndices: p.ShareIndices,
+			Weight:       p.Weight,
+		}, queuedPubKey.Assignments[i])
+	}
+}
@@ -5,10 +5,8 @@ import (
 	"encoding/hex"
 	"fmt"
 	"strconv"
-	"time"
 
 	distIBE "github.com/FairBlock/DistributedIBE"
-	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
 	bls "github.com/drand/kyber-bls12381"
 
 	"fairyring/x/keyshare/types"
@@ -16,56 +14,40 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-const (
-	PrivateGovIdentity = "private-gov-identity"
-)
-
-var SupportedIDTypes = []string{PrivateGovIdentity}
-
 func (k msgServer) CreateGeneralKeyShare(goCtx context.Context, msg *types.MsgCreateGeneralKeyShare) (*types.MsgCreateGeneralKeyShareResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	// check if validator is registered
-	validatorInfo, found := k.GetValidatorSet(ctx, msg.Creator)
-
-	if !found {
-		authorizedAddrInfo, found := k.GetAuthorizedAddress(ctx, msg.Creator)
-		if !found || !authorizedAddrInfo.IsAuthorized {
-			return nil, types.ErrAddrIsNotValidatorOrAuthorized.Wrap(msg.Creator)
-		}
-
-		authorizedByValInfo, found := k.GetValidatorSet(ctx, authorizedAddrInfo.AuthorizedBy)
-		if !found {
-			return nil, types.ErrAuthorizerIsNotValidator.Wrap(authorizedAddrInfo.AuthorizedBy)
-		}
-		validatorInfo = authorizedByValInfo
+	res, err := k.createGeneralKeyShare(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
 
-		// If the sender is in the validator set & authorized another address to submit key share
-	} else if count := k.GetAuthorizedCount(ctx, msg.Creator); count != 0 {
-		return nil, types.ErrAuthorizedAnotherAddress
+	if res.Success {
+		k.SetLastSubmittedHeight(ctx, k.keyshareValidatorAddress(ctx, msg.Creator), strconv.FormatInt(ctx.BlockHeight(), 10))
 	}
 
-	isSupportedIDType := false
-	for _, v := range SupportedIDTypes {
-		if v == msg.IdType {
-			isSupportedIDType = true
-			break
-		}
+	return res, nil
+}
+
+// createGeneralKeyShare verifies & stores a general key share and aggregates the key of its identity
+// once enough key shares are submitted. It does not update the last submitted height of the sender
+func (k msgServer) createGeneralKeyShare(ctx sdk.Context, msg *types.MsgCreateGeneralKeyShare) (*types.MsgCreateGeneralKeyShareResponse, error) {
+	// check if validator is registered or the sender is authorized to submit general key shares of the id type for a validator
+	validatorInfo, err := k.getKeyshareValidator(ctx, msg.Creator, func(a types.AuthorizedAddress) bool {
+		return a.AllowsGeneralKeyshares(msg.IdType)
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	if !isSupportedIDType {
-		return nil, types.ErrUnsupportedIDType.Wrapf(", supported id types: %v", SupportedIDTypes)
+	idTypeHooks, found := k.GetIDTypeHooks(msg.IdType)
+	if !found {
+		return nil, types.ErrUnsupportedIDType.Wrapf(", supported id types: %v", k.SupportedIDTypes())
 	}
 
-	switch msg.IdType {
-	case PrivateGovIdentity:
-		keyShareReq, found := k.GetKeyShareRequest(ctx, msg.IdValue)
-		if !found {
-			return nil, types.ErrKeyShareRequestNotFound.Wrapf(", got id value: %s", msg.IdValue)
-		}
-		if keyShareReq.AggrKeyshare != "" {
-			return nil, types.ErrAggKeyAlreadyExists.Wrapf(", identity: %s, Aggregated key: %s", msg.IdValue, keyShareReq.AggrKeyshare)
-		}
+	// Key shares are only accepted while the decryption key of the identity is pending
+	if err := idTypeHooks.ValidateRequest(ctx, msg.IdValue); err != nil {
+		return nil, err
 	}
 
 	// Setup
@@ -81,8 +63,13 @@ func (k msgServer) CreateGeneralKeyShare(goCtx context.Context, msg *types.MsgCr
 		return nil, types.ErrInvalidKeyShareIndex.Wrap(fmt.Sprintf("Expect Index within: %d, got: %d", commitmentsLen, msg.KeyShareIndex))
 	}
 
+	// Validators can only submit for the share indices assigned to them
+	if !k.IsKeyShareIndexOwner(ctx, validatorInfo.Validator, msg.KeyShareIndex) {
+		return nil, types.ErrKeyShareIndexNotAssigned.Wrapf("validator: %s, index: %d", validatorInfo.Validator, msg.KeyShareIndex)
+	}
+
 	// Parse the keyshare & commitment then verify it
-	_, _, err := parseKeyShareCommitment(suite, msg.KeyShare, commitments.Commitments[msg.KeyShareIndex-1], uint32(msg.KeyShareIndex), msg.IdValue)
+	_, _, err = parseKeyShareCommitment(suite, msg.KeyShare, commitments.Commitments[msg.KeyShareIndex-1], uint32(msg.KeyShareIndex), msg.IdValue)
 	if err != nil {
 		k.Logger(ctx).Error(fmt.Sprintf("Error in parsing & verifying general keyshare & commitment: %s", err.Error()))
 		k.Logger(ctx).Error(fmt.Sprintf("General KeyShare is: %v | Commitment is: %v | Index: %d", msg.KeyShare, commitments.Commitments, msg.KeyShareIndex))
@@ -102,10 +89,12 @@ func (k msgServer) CreateGeneralKeyShare(goCtx context.Context, msg *types.MsgCr
 		k.stakingKeeper.Slash(
 			ctx, consAddr,
 			ctx.BlockHeight()-1,
-			types.SlashPower,
+			k.GetSlashPower(ctx, validatorInfo.Validator),
 			k.SlashFractionWrongKeyshare(ctx),
 		)
 
+		k.HandleInvalidKeyShare(ctx, validatorInfo.Validator, msg.IdValue, msg.KeyShareIndex, msg.KeyShare)
+
 		return &types.MsgCreateGeneralKeyShareResponse{
 			Creator:             msg.Creator,
 			IdType:              msg.IdType,
@@ -119,7 +108,7 @@ func (k msgServer) CreateGeneralKeyShare(goCtx context.Context, msg *types.MsgCr
 	}
 
 	generalKeyShare := types.GeneralKeyShare{
-		Validator:           msg.Creator,
+		Validator:           validatorInfo.Validator,
 		IdType:              msg.IdType,
 		IdValue:             msg.IdValue,
 		KeyShare:            msg.KeyShare,
@@ -128,27 +117,34 @@ func (k msgServer) CreateGeneralKeyShare(goCtx context.Context, msg *types.MsgCr
 		ReceivedBlockHeight: uint64(ctx.BlockHeight()),
 	}
 
+	// Only the first submission of a general key share counts toward the rewards of the validator
+	if _, found := k.GetGeneralKeyShare(ctx, generalKeyShare.Validator, generalKeyShare.IdType, generalKeyShare.IdValue, generalKeyShare.KeyShareIndex); !found {
+		k.IncreaseAcceptedShares(ctx, generalKeyShare.Validator)
+	}
+
 	// Save the new general key share to state
 	k.SetGeneralKeyShare(ctx, generalKeyShare)
-	k.SetLastSubmittedHeight(ctx, msg.Creator, strconv.FormatInt(ctx.BlockHeight(), 10))
 
 	validatorList := k.GetAllValidatorSet(ctx)
 
 	// Get all the general key shares for the provided id value & id type
 	var stateGeneralKeyShares []types.GeneralKeyShare
 
+	// Every share counts once toward the threshold, so validators holding more share
+	// indices, proportionally to their power, weigh more in the aggregation.
+	// Shares of indices no longer assigned to the validator are left out so that
+	// each index is aggregated at most once
 	for _, eachValidator := range validatorList {
-		eachGeneralKeyShare, found := k.GetGeneralKeyShare(ctx, eachValidator.Validator, msg.IdType, msg.IdValue)
-		if !found {
-			continue
+		for _, eachKeyShare := range k.GetAllGeneralKeyShareByIdentity(ctx, eachValidator.Validator, msg.IdType, msg.IdValue) {
+			if !k.IsKeyShareIndexOwner(ctx, eachValidator.Validator, eachKeyShare.KeyShareIndex) {
+				continue
+			}
+			stateGeneralKeyShares = append(stateGeneralKeyShares, eachKeyShare)
 		}
-		stateGeneralKeyShares = append(stateGeneralKeyShares, eachGeneralKeyShare)
 	}
 
-	expectedThreshold := sdk.NewDecFromInt(
-		sdk.NewInt(types.KeyAggregationThresholdNumerator)).Quo(
-		sdk.NewDecFromInt(sdk.NewInt(types.KeyAggregationThresholdDenominator))).MulInt64(
-		int64(len(validatorList))).Ceil().TruncateInt64()
+	// The threshold is fixed by the active key, pausing validators does not lower it
+	expectedThreshold := int64(k.GetKeyAggregationThreshold(ctx))
 
 	// Emit KeyShare Submitted Event
 	ctx.EventManager().EmitEvent(
@@ -176,27 +172,6 @@ func (k msgServer) CreateGeneralKeyShare(goCtx context.Context, msg *types.MsgCr
 		}, nil
 	}
 
-	// Check if target general keyshare already aggregated a key
-	switch msg.IdType {
-	case PrivateGovIdentity:
-		keyShareReq, found := k.GetKeyShareRequest(ctx, msg.IdValue)
-		if !found {
-			return nil, types.ErrKeyShareRequestNotFound.Wrapf(", got id value: %s", msg.IdValue)
-		}
-
-		if keyShareReq.AggrKeyshare != "" {
-			return &types.MsgCreateGeneralKeyShareResponse{
-				Creator:             msg.Creator,
-				IdType:              msg.IdType,
-				IdValue:             msg.IdValue,
-				KeyShare:            msg.KeyShare,
-				KeyShareIndex:       msg.KeyShareIndex,
-				ReceivedBlockHeight: uint64(ctx.BlockHeight()),
-				Success:             true,
-			}, nil
-		}
-	}
-
 	// Get the active public key for aggregating
 	activePubKey, found := k.pepKeeper.GetActivePubKey(ctx)
 
@@ -248,34 +223,17 @@ func (k msgServer) CreateGeneralKeyShare(goCtx context.Context, msg *types.MsgCr
 		),
 	)
 
-	switch msg.IdType {
-	case PrivateGovIdentity:
-		keyShareReq, found := k.GetKeyShareRequest(ctx, msg.IdValue)
-		if !found {
-			return nil, types.ErrKeyShareRequestNotFound.Wrapf(", got id value: %s", msg.IdValue)
-		}
-		if keyShareReq.AggrKeyshare != "" {
-			return nil, types.ErrAggKeyAlreadyExists.Wrapf(", identity: %s, Aggregated key: %s", msg.IdValue, keyShareReq.AggrKeyshare)
-		}
-		keyShareReq.AggrKeyshare = skHex
-		k.SetKeyShareRequest(ctx, keyShareReq)
-		timeoutTimestamp := ctx.BlockTime().Add(time.Second * 20).UnixNano()
-
-		_, err = k.TransmitAggrKeyshareDataPacket(
-			ctx,
-			types.AggrKeyshareDataPacketData{
-				Identity:     keyShareReq.Identity,
-				Pubkey:       keyShareReq.Pubkey,
-				AggrKeyshare: keyShareReq.AggrKeyshare,
-				AggrHeight:   strconv.FormatInt(ctx.BlockHeight(), 10),
-				ProposalId:   keyShareReq.ProposalId,
-				Retries:      0,
-			},
-			keyShareReq.IbcInfo.PortID,
-			keyShareReq.IbcInfo.ChannelID,
-			clienttypes.ZeroHeight(),
-			uint64(timeoutTimestamp),
-		)
+	if err := idTypeHooks.OnAggregated(ctx, msg.IdValue, skHex); err != nil {
+		return nil, err
+	}
+
+	if route, found := idTypeHooks.DeliveryRoute(ctx, msg.IdValue); found {
+		packet := route.Packet
+		packet.AggrKeyshare = skHex
+		packet.AggrHeight = strconv.FormatInt(ctx.BlockHeight(), 10)
+		packet.Retries = 0
+
+		_, err = k.SendAggrKeyshare(ctx, packet, route.PortID, route.ChannelID)
 		if err != nil {
 			return nil, err
 		}
@@ -16,14 +16,25 @@ import (
 var _ = strconv.IntSize
 
 func TestGeneralKeyShareMsgServerCreate(t *testing.T) {
-	k, ctx := keepertest.KeyshareKeeper(t)
+	k, ctx, deps := keepertest.KeyshareKeeperWithDeps(t)
 	srv := keeper.NewMsgServerImpl(*k)
 	wctx := sdk.WrapSDKContext(ctx)
 	creator := "A"
+	other := "B"
+	for _, v := range []string{creator, other} {
+		k.SetValidatorSet(ctx, types.ValidatorSet{Index: v, Validator: v, IsActive: true})
+	}
+	shares := setupActiveKey(t, ctx, *k, deps, 2, creator, other)
+
 	for i := 0; i < 5; i++ {
+		idValue := strconv.Itoa(i)
+		k.SetGeneralKeyRequest(ctx, types.GeneralKeyRequest{Identity: idValue, Creator: creator})
+
 		expected := &types.MsgCreateGeneralKeyShare{Creator: creator,
-			IdType:  strconv.Itoa(i),
-			IdValue: strconv.Itoa(i),
+			IdType:        keeper.GeneralKeyRequestIdentity,
+			IdValue:       idValue,
+			KeyShare:      extractKeyShare(t, shares[0], 1, idValue),
+			KeyShareIndex: 1,
 		}
 		_, err := srv.CreateGeneralKeyShare(wctx, expected)
 		require.NoError(t, err)
@@ -31,8 +42,18 @@ func TestGeneralKeyShareMsgServerCreate(t *testing.T) {
 			expected.Creator,
 			expected.IdType,
 			expected.IdValue,
+			expected.KeyShareIndex,
 		)
 		require.True(t, found)
 		require.Equal(t, expected.Creator, rst.Validator)
+		require.Equal(t, expected.KeyShare, rst.KeyShare)
 	}
+
+	_, err := srv.CreateGeneralKeyShare(wctx, &types.MsgCreateGeneralKeyShare{Creator: creator,
+		IdType:        keeper.GeneralKeyRequestIdentity,
+		IdValue:       "not requested",
+		KeyShare:      extractKeyShare(t, shares[0], 1, "not requested"),
+		KeyShareIndex: 1,
+	})
+	require.ErrorIs(t, err, types.ErrGeneralKeyRequestNotFound)
 }
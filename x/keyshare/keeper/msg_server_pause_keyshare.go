@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	"fairyring/x/keyshare/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// PauseKeyshare pauses the keyshare duties of a registered validator for the given duration,
+// it is not expected to submit key shares until the pause is over
+func (k msgServer) PauseKeyshare(goCtx context.Context, msg *types.MsgPauseKeyshare) (*types.MsgPauseKeyshareResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	validator, found := k.GetValidatorSet(ctx, msg.Creator)
+	if !found {
+		return nil, types.ErrValidatorNotRegistered.Wrap(msg.Creator)
+	}
+
+	if !validator.IsActive {
+		return nil, types.ErrValidatorPaused.Wrapf("paused until: %s", validator.PausedUntil.Format(time.RFC3339))
+	}
+
+	maxDuration := k.MaxKeysharePauseDuration(ctx)
+	if msg.Duration > maxDuration {
+		return nil, types.ErrInvalidPauseDuration.Wrapf("expected at most: %s, got: %s", maxDuration, msg.Duration)
+	}
+
+	// Keys can no longer be aggregated if the active validators hold fewer share indices than the threshold
+	if err := k.checkActiveSharesAboveThreshold(ctx, msg.Creator); err != nil {
+		return nil, err
+	}
+
+	validator.IsActive = false
+	validator.PausedUntil = ctx.BlockTime().Add(msg.Duration)
+	k.SetValidatorSet(ctx, validator)
+
+	k.RemoveKeyshareLivenessInfo(ctx, msg.Creator)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.KeysharePausedEventType,
+			sdk.NewAttribute(types.KeysharePausedEventValidator, msg.Creator),
+			sdk.NewAttribute(types.KeysharePausedEventPausedUntil, validator.PausedUntil.Format(time.RFC3339)),
+		),
+	)
+
+	return &types.MsgPauseKeyshareResponse{
+		Creator:     msg.Creator,
+		PausedUntil: validator.PausedUntil,
+	}, nil
+}
@@ -2,9 +2,6 @@ package keeper
 
 import (
 	"context"
-	peptypes "fairyring/x/pep/types"
-	"strconv"
-
 	"fairyring/x/keyshare/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -29,50 +26,19 @@ func (k msgServer) CreateLatestPubKey(goCtx context.Context, msg *types.MsgCreat
 		return nil, types.ErrEmptyCommitments
 	}
 
-	commitments := types.Commitments{
-		Commitments: msg.Commitments,
-	}
+	totalShares := uint64(len(msg.Commitments))
 
-	expHeight := params.KeyExpiry + uint64(ctx.BlockHeight())
-	ak, found := k.GetActivePubKey(ctx)
-	if found {
-		expHeight = ak.Expiry + params.KeyExpiry
+	// The share indices are bound to the validators the dealer dealt them to
+	assignments, err := k.VerifyKeyShareAssignments(ctx, msg.Assignments, totalShares)
+	if err != nil {
+		return nil, err
 	}
 
-	var queuedPubKey = types.QueuedPubKey{
-		Creator:   msg.Creator,
-		PublicKey: msg.PublicKey,
-		Expiry:    expHeight,
+	threshold := params.AggregationThreshold(totalShares)
+	if _, err := k.QueuePubKey(ctx, msg.Creator, msg.PublicKey, msg.Commitments, threshold, assignments); err != nil {
+		return nil, err
 	}
 
-	k.SetQueuedCommitments(
-		ctx,
-		commitments,
-	)
-
-	k.SetQueuedPubKey(
-		ctx,
-		queuedPubKey,
-	)
-
-	k.pepKeeper.SetQueuedPubKey(
-		ctx,
-		peptypes.QueuedPubKey{
-			Creator:   msg.Creator,
-			PublicKey: msg.PublicKey,
-			Expiry:    expHeight,
-		},
-	)
-
-	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(types.QueuedPubKeyCreatedEventType,
-			sdk.NewAttribute(types.QueuedPubKeyCreatedEventActivePubkeyExpiryHeight, strconv.FormatUint(ak.Expiry, 10)),
-			sdk.NewAttribute(types.QueuedPubKeyCreatedEventExpiryHeight, strconv.FormatUint(expHeight, 10)),
-			sdk.NewAttribute(types.QueuedPubKeyCreatedEventCreator, msg.Creator),
-			sdk.NewAttribute(types.QueuedPubKeyCreatedEventPubkey, msg.PublicKey),
-		),
-	)
-
 	return &types.MsgCreateLatestPubKeyResponse{}, nil
 }
 
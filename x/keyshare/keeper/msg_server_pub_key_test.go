@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	keepertest "fairyring/testutil/keeper"
+	"fairyring/testutil/sample"
 	"fairyring/x/keyshare/keeper"
 	"fairyring/x/keyshare/types"
 )
@@ -19,15 +20,78 @@ func TestLatestPubKeyMsgServerCreate(t *testing.T) {
 	k, ctx := keepertest.KeyshareKeeper(t)
 	srv := keeper.NewMsgServerImpl(*k)
 	wctx := sdk.WrapSDKContext(ctx)
-	creator := "A"
-	for i := 0; i < 5; i++ {
-		expected := &types.MsgCreateLatestPubKey{Creator: creator,
-			PublicKey: strconv.Itoa(i),
-		}
-		_, err := srv.CreateLatestPubKey(wctx, expected)
-		require.NoError(t, err)
-		rst, found := k.GetActivePubKey(ctx)
-		require.True(t, found)
-		require.Equal(t, expected.Creator, rst.Creator)
+	creator := sample.AccAddress()
+	params := k.GetParams(ctx)
+	params.TrustedAddresses = []string{creator}
+	k.SetParams(ctx, params)
+
+	validators := []string{sample.AccAddress(), sample.AccAddress()}
+	for _, v := range validators {
+		k.SetValidatorSet(ctx, types.ValidatorSet{Index: v, Validator: v, IsActive: true})
+	}
+
+	expected := &types.MsgCreateLatestPubKey{Creator: creator,
+		PublicKey:   "pubkey",
+		Commitments: []string{"commitment1", "commitment2"},
+		Assignments: []types.KeyShareAssignment{
+			{Validator: validators[0], ShareIndices: []uint64{1}},
+			{Validator: validators[1], ShareIndices: []uint64{2}},
+		},
 	}
+
+	_, err := srv.CreateLatestPubKey(wctx, &types.MsgCreateLatestPubKey{Creator: validators[0],
+		PublicKey:   expected.PublicKey,
+		Commitments: expected.Commitments,
+		Assignments: expected.Assignments,
+	})
+	require.ErrorIs(t, err, types.ErrAddressNotTrusted)
+
+	_, err = srv.CreateLatestPubKey(wctx, expected)
+	require.NoError(t, err)
+	rst, found := k.GetQueuedPubKey(ctx)
+	require.True(t, found)
+	require.Equal(t, expected.Creator, rst.Creator)
+	require.Equal(t, expected.PublicKey, rst.PublicKey)
+	require.Equal(t, params.AggregationThreshold(2), rst.Threshold)
+
+	commitments, found := k.GetQueuedCommitments(ctx)
+	require.True(t, found)
+	require.Equal(t, expected.Commitments, commitments.Commitments)
+
+	_, err = srv.CreateLatestPubKey(wctx, expected)
+	require.ErrorIs(t, err, types.ErrQueuedKeyAlreadyExists)
+}
+
+func TestVerifyKeyShareAssignments(t *testing.T) {
+	k, ctx := keepertest.KeyshareKeeper(t)
+	validators := []string{sample.AccAddress(), sample.AccAddress()}
+	for _, v := range validators {
+		k.SetValidatorSet(ctx, types.ValidatorSet{Index: v, Validator: v, IsActive: true})
+	}
+
+	assignments, err := k.VerifyKeyShareAssignments(ctx, []types.KeyShareAssignment{
+		{Validator: validators[1], ShareIndices: []uint64{1}},
+		{Validator: validators[0], ShareIndices: []uint64{2}},
+	}, 2)
+	require.NoError(t, err)
+	require.Equal(t, []types.KeyShareAssignment{
+		{Validator: validators[1], ShareIndices: []uint64{1}, Weight: 1},
+		{Validator: validators[0], ShareIndices: []uint64{2}, Weight: 1},
+	}, assignments)
+
+	_, err = k.VerifyKeyShareAssignments(ctx, []types.KeyShareAssignment{
+		{Validator: validators[0], ShareIndices: []uint64{1}},
+		{Validator: sample.AccAddress(), ShareIndices: []uint64{2}},
+	}, 2)
+	require.ErrorIs(t, err, types.ErrValidatorNotRegistered)
+
+	_, err = k.VerifyKeyShareAssignments(ctx, []types.KeyShareAssignment{
+		{Validator: validators[0], ShareIndices: []uint64{1, 2}},
+	}, 2)
+	require.ErrorIs(t, err, types.ErrInvalidKeyShareAssignments)
+
+	_, err = k.VerifyKeyShareAssignments(ctx, []types.KeyShareAssignment{
+		{Validator: validators[0], ShareIndices: []uint64{1}},
+	}, 2)
+	require.ErrorIs(t, err, types.ErrInvalidKeyShareAssignments)
 }
@@ -19,6 +19,10 @@ func (k msgServer) RegisterValidator(goCtx context.Context, msg *types.MsgRegist
 		return nil, types.ErrValidatorAlreadyRegistered.Wrap(msg.Creator)
 	}
 
+	if k.IsKeyshareJailed(ctx, msg.Creator) {
+		return nil, types.ErrValidatorJailed.Wrap(msg.Creator)
+	}
+
 	accAddr, err := sdk.AccAddressFromBech32(msg.Creator)
 	if err != nil {
 		return nil, err
@@ -50,6 +54,9 @@ func (k msgServer) RegisterValidator(goCtx context.Context, msg *types.MsgRegist
 
 	k.SetValidatorSet(ctx, validator)
 
+	// Start tracking the liveness of the validator with a clean window
+	k.RemoveKeyshareLivenessInfo(ctx, msg.Creator)
+
 	// This is to prevent the validator be slashed immediately after registering
 	k.SetLastSubmittedHeight(ctx, msg.Creator, strconv.FormatInt(ctx.BlockHeight(), 10))
 
@@ -14,8 +14,9 @@ import (
 )
 
 func setupMsgServerRegisterValidator(t testing.TB) (types.MsgServer, keeper.Keeper, context.Context) {
-	k, ctx := keepertest.KeyshareKeeper(t)
+	k, ctx, deps := keepertest.KeyshareKeeperWithDeps(t)
 	keyshare.InitGenesis(ctx, *k, *types.DefaultGenesis())
+	setupStakingValidator(t, ctx, *k, deps, alice)
 
 	return keeper.NewMsgServerImpl(*k), *k, sdk.WrapSDKContext(ctx)
 }
@@ -36,9 +37,11 @@ func TestRegisterValidator(t *testing.T) {
 	aliceValidator, found := keeper.GetValidatorSet(ctx, alice)
 
 	require.True(t, found)
+	require.NotEmpty(t, aliceValidator.ConsAddr)
 	require.EqualValues(t, types.ValidatorSet{
 		Index:     alice,
 		Validator: alice,
+		ConsAddr:  aliceValidator.ConsAddr,
 		IsActive:  true,
 	}, aliceValidator)
 
@@ -46,13 +49,26 @@ func TestRegisterValidator(t *testing.T) {
 	require.Len(t, events, 1)
 	event := events[0]
 	require.EqualValues(t, sdk.StringEvent{
-		Type: "new validator registered",
+		Type: types.RegisteredValidatorEventType,
 		Attributes: []sdk.Attribute{
-			{Key: "creator", Value: alice},
+			{Key: types.RegisteredValidatorEventCreator, Value: alice},
 		},
 	}, event)
 }
 
+func TestRegisterNotStakingValidator(t *testing.T) {
+	k, ctx := keepertest.KeyshareKeeper(t)
+	msgServer := keeper.NewMsgServerImpl(*k)
+
+	_, err := msgServer.RegisterValidator(sdk.WrapSDKContext(ctx), &types.MsgRegisterValidator{
+		Creator: alice,
+	})
+	require.ErrorIs(t, err, types.ErrAccountNotStaking)
+
+	_, found := k.GetValidatorSet(ctx, alice)
+	require.False(t, found)
+}
+
 func TestDupicateRegister(t *testing.T) {
 	msgServer, _, context := setupMsgServerRegisterValidator(t)
 	_, err := msgServer.RegisterValidator(context, &types.MsgRegisterValidator{
@@ -66,9 +82,9 @@ func TestDupicateRegister(t *testing.T) {
 	require.Len(t, events, 1)
 	event := events[0]
 	require.EqualValues(t, sdk.StringEvent{
-		Type: "new validator registered",
+		Type: types.RegisteredValidatorEventType,
 		Attributes: []sdk.Attribute{
-			{Key: "creator", Value: alice},
+			{Key: types.RegisteredValidatorEventCreator, Value: alice},
 		},
 	}, event)
 
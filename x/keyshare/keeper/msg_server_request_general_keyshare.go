@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	"context"
+
+	"fairyring/x/keyshare/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RequestGeneralKeyshare requests the decryption key of an id for the creator, released at the given height
+func (k msgServer) RequestGeneralKeyshare(goCtx context.Context, msg *types.MsgRequestGeneralKeyshare) (*types.MsgRequestGeneralKeyshareResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	generalKeyRequest, err := k.RequestGeneralKey(ctx, creator, msg.Id, msg.ReleaseHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRequestGeneralKeyshareResponse{
+		Identity: generalKeyRequest.Identity,
+		Pubkey:   generalKeyRequest.Pubkey,
+	}, nil
+}
@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	"fairyring/x/keyshare/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ResendAggrKeyshare sends the aggregated key of a key share request again over the channel it was received on,
+// so that anyone can recover a delivery that failed or got stuck. The packet gets a fresh retry budget.
+// A resend is only allowed once the request failed or no packet is in flight or queued for a retry,
+// and at most once every AggrKeyshareResendCooldownBlocks blocks
+func (k msgServer) ResendAggrKeyshare(goCtx context.Context, msg *types.MsgResendAggrKeyshare) (*types.MsgResendAggrKeyshareResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	keyShareReq, found := k.GetKeyShareRequest(ctx, msg.Identity)
+	if !found {
+		return nil, types.ErrKeyShareRequestNotFound.Wrapf("identity: %s", msg.Identity)
+	}
+
+	if keyShareReq.Sent {
+		return nil, types.ErrKeyShareRequestAlreadySent.Wrapf("identity: %s", msg.Identity)
+	}
+
+	if keyShareReq.AggrKeyshare == "" {
+		return nil, types.ErrNoAggregatedKeyshare.Wrapf("identity: %s", msg.Identity)
+	}
+
+	if keyShareReq.IbcInfo == nil {
+		return nil, types.ErrKeyShareRequestNoIBCInfo.Wrapf("identity: %s", msg.Identity)
+	}
+
+	if !keyShareReq.Failed && (keyShareReq.InflightSequence != 0 || keyShareReq.RetryHeight != 0) {
+		return nil, types.ErrAggrKeyshareInFlight.Wrapf(
+			"identity: %s, sequence: %d, retry height: %d",
+			msg.Identity, keyShareReq.InflightSequence, keyShareReq.RetryHeight,
+		)
+	}
+
+	height := uint64(ctx.BlockHeight())
+	cooldown := k.AggrKeyshareResendCooldownBlocks(ctx)
+	if keyShareReq.LastResendHeight != 0 && height < keyShareReq.LastResendHeight+cooldown {
+		return nil, types.ErrAggrKeyshareResendCooldown.Wrapf(
+			"identity: %s, next resend allowed at height: %d",
+			msg.Identity, keyShareReq.LastResendHeight+cooldown,
+		)
+	}
+
+	// Keep a failed request around for the grace period after the resend so its acknowledgement can be recorded
+	if keyShareReq.Failed {
+		keyShareReq.ClosedHeight = height
+	}
+	keyShareReq.LastResendHeight = height
+	k.SetKeyShareRequest(ctx, keyShareReq)
+
+	packet := types.AggrKeyshareDataPacketData{
+		Identity:     keyShareReq.Identity,
+		Pubkey:       keyShareReq.Pubkey,
+		AggrKeyshare: keyShareReq.AggrKeyshare,
+		AggrHeight:   strconv.FormatUint(keyShareReq.AggrHeight, 10),
+		ProposalId:   keyShareReq.ProposalId,
+		Retries:      0,
+	}
+
+	sequence, err := k.SendAggrKeyshare(ctx, packet, keyShareReq.IbcInfo.PortID, keyShareReq.IbcInfo.ChannelID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgResendAggrKeyshareResponse{Sequence: sequence}, nil
+}
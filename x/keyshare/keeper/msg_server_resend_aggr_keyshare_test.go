@@ -0,0 +1,66 @@
+package keeper_test
+
+import (
+	"testing"
+
+	keepertest "fairyring/testutil/keeper"
+	"fairyring/testutil/sample"
+	"fairyring/x/keyshare/keeper"
+	"fairyring/x/keyshare/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResendAggrKeyshare(t *testing.T) {
+	k, ctx := keepertest.KeyshareKeeper(t)
+	msgServer := keeper.NewMsgServerImpl(*k)
+	params := k.GetParams(ctx)
+	params.AggrKeyshareResendCooldownBlocks = 10
+	k.SetParams(ctx, params)
+
+	ctx = ctx.WithBlockHeight(100)
+	wctx := sdk.WrapSDKContext(ctx)
+	creator := sample.AccAddress()
+	ibcInfo := &types.IBCInfo{PortID: "keyshare", ChannelID: "channel-0"}
+
+	for _, tc := range []struct {
+		desc string
+		req  types.KeyShareRequest
+		err  error
+	}{
+		{
+			desc: "InFlight",
+			req:  types.KeyShareRequest{Identity: "1/rq", AggrKeyshare: "key", IbcInfo: ibcInfo, InflightSequence: 3},
+			err:  types.ErrAggrKeyshareInFlight,
+		},
+		{
+			desc: "RetryQueued",
+			req:  types.KeyShareRequest{Identity: "2/rq", AggrKeyshare: "key", IbcInfo: ibcInfo, RetryHeight: 105},
+			err:  types.ErrAggrKeyshareInFlight,
+		},
+		{
+			desc: "Cooldown",
+			req:  types.KeyShareRequest{Identity: "3/rq", AggrKeyshare: "key", IbcInfo: ibcInfo, Failed: true, InflightSequence: 3, LastResendHeight: 95},
+			err:  types.ErrAggrKeyshareResendCooldown,
+		},
+		{
+			// The module owns no channel, so the packet is rejected once the request may be resent
+			desc: "FailedInFlight",
+			req:  types.KeyShareRequest{Identity: "4/rq", AggrKeyshare: "key", IbcInfo: ibcInfo, Failed: true, InflightSequence: 3, LastResendHeight: 90},
+			err:  channeltypes.ErrChannelNotFound,
+		},
+		{
+			desc: "Idle",
+			req:  types.KeyShareRequest{Identity: "5/rq", AggrKeyshare: "key", IbcInfo: ibcInfo},
+			err:  channeltypes.ErrChannelNotFound,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			k.SetKeyShareRequest(ctx, tc.req)
+			_, err := msgServer.ResendAggrKeyshare(wctx, &types.MsgResendAggrKeyshare{Creator: creator, Identity: tc.req.Identity})
+			require.ErrorIs(t, err, tc.err)
+		})
+	}
+}
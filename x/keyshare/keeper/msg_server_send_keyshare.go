@@ -23,34 +23,56 @@ import (
 func (k msgServer) SendKeyshare(goCtx context.Context, msg *types.MsgSendKeyshare) (*types.MsgSendKeyshareResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	// check if validator is registered
-	validatorInfo, found := k.GetValidatorSet(ctx, msg.Creator)
+	res, err := k.sendKeyshare(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
 
-	if !found {
-		authorizedAddrInfo, found := k.GetAuthorizedAddress(ctx, msg.Creator)
-		if !found || !authorizedAddrInfo.IsAuthorized {
-			return nil, types.ErrAddrIsNotValidatorOrAuthorized.Wrap(msg.Creator)
-		}
+	// Key shares of an authorized address are submitted on behalf of its validator
+	validator := k.keyshareValidatorAddress(ctx, msg.Creator)
+	if res.Success && msg.BlockHeight > k.GetLastSubmittedHeight(ctx, validator) {
+		k.SetLastSubmittedHeight(ctx, validator, strconv.FormatUint(msg.BlockHeight, 10))
+	}
 
-		authorizedByValInfo, found := k.GetValidatorSet(ctx, authorizedAddrInfo.AuthorizedBy)
-		if !found {
-			return nil, types.ErrAuthorizerIsNotValidator.Wrap(authorizedAddrInfo.AuthorizedBy)
-		}
-		validatorInfo = authorizedByValInfo
+	return res, nil
+}
+
+// sendKeyshare verifies & stores a keyshare and aggregates the key of its height once enough
+// keyshares are submitted. It does not update the last submitted height of the sender
+func (k msgServer) sendKeyshare(ctx sdk.Context, msg *types.MsgSendKeyshare) (*types.MsgSendKeyshareResponse, error) {
+	// check if validator is registered or the sender is authorized to submit block key shares for a validator
+	validatorInfo, err := k.getKeyshareValidator(ctx, msg.Creator, func(a types.AuthorizedAddress) bool {
+		return a.AllowsBlockKeyshares()
+	})
+	if err != nil {
+		return nil, err
+	}
 
-		// If the sender is in the validator set & authorized another address to submit key share
-	} else if count := k.GetAuthorizedCount(ctx, msg.Creator); count != 0 {
-		return nil, types.ErrAuthorizedAnotherAddress
+	// In commit-reveal mode key shares are only accepted from their block height, so they never
+	// travel through the mempool while encrypted txs can still target their height
+	commitReveal := k.KeyshareCommitReveal(ctx)
+	if commitReveal {
+		if err := k.checkKeyshareReveal(ctx, validatorInfo.Validator, msg); err != nil {
+			return nil, err
+		}
 	}
 
-	if uint64(ctx.BlockHeight()) > msg.BlockHeight {
-		return nil, types.ErrInvalidBlockHeight.Wrapf("key share height is lower than the current block height, expected height: %d, got: %d", ctx.BlockHeight(), msg.BlockHeight)
+	submissionWindow := k.KeyshareSubmissionWindow(ctx)
+	if msg.BlockHeight+submissionWindow < uint64(ctx.BlockHeight()) {
+		return nil, types.ErrInvalidBlockHeight.Wrapf("key share height is older than the submission window of %d blocks, current height: %d, got: %d", submissionWindow, ctx.BlockHeight(), msg.BlockHeight)
 	}
 
 	if msg.BlockHeight > uint64(ctx.BlockHeight())+1 {
 		return nil, types.ErrInvalidBlockHeight.Wrapf("key share height is higher than the current block height + 1, expected max height to be: %d, got: %d", ctx.BlockHeight()+1, msg.BlockHeight)
 	}
 
+	// Late key shares are only accepted while the key of their height is not aggregated yet
+	if msg.BlockHeight < uint64(ctx.BlockHeight()) {
+		if _, found := k.GetAggregatedKeyShare(ctx, msg.BlockHeight); found {
+			return nil, types.ErrAggKeyAlreadyExists.Wrapf("height: %d", msg.BlockHeight)
+		}
+	}
+
 	// Setup
 	suite := bls.NewBLS12381Suite()
 	ibeID := strconv.FormatUint(msg.BlockHeight, 10)
@@ -65,8 +87,13 @@ func (k msgServer) SendKeyshare(goCtx context.Context, msg *types.MsgSendKeyshar
 		return nil, types.ErrInvalidKeyShareIndex.Wrap(fmt.Sprintf("Expect Index within: %d, got: %d", commitmentsLen, msg.KeyShareIndex))
 	}
 
+	// Validators can only submit for the share indices assigned to them
+	if !k.IsKeyShareIndexOwner(ctx, validatorInfo.Validator, msg.KeyShareIndex) {
+		return nil, types.ErrKeyShareIndexNotAssigned.Wrapf("validator: %s, index: %d", validatorInfo.Validator, msg.KeyShareIndex)
+	}
+
 	// Parse the keyshare & commitment then verify it
-	_, _, err := parseKeyShareCommitment(suite, msg.Message, commitments.Commitments[msg.KeyShareIndex-1], uint32(msg.KeyShareIndex), ibeID)
+	_, _, err = parseKeyShareCommitment(suite, msg.Message, commitments.Commitments[msg.KeyShareIndex-1], uint32(msg.KeyShareIndex), ibeID)
 	if err != nil {
 		defer telemetry.IncrCounter(1, types.KeyTotalInvalidKeyShareSubmitted)
 		k.Logger(ctx).Error(fmt.Sprintf("Error in parsing & verifying keyshare & commitment: %s", err.Error()))
@@ -87,10 +114,12 @@ func (k msgServer) SendKeyshare(goCtx context.Context, msg *types.MsgSendKeyshar
 		k.stakingKeeper.Slash(
 			ctx, consAddr,
 			ctx.BlockHeight()-1,
-			types.SlashPower,
+			k.GetSlashPower(ctx, validatorInfo.Validator),
 			k.SlashFractionWrongKeyshare(ctx),
 		)
 
+		k.HandleInvalidKeyShare(ctx, validatorInfo.Validator, ibeID, msg.KeyShareIndex, msg.Message)
+
 		return &types.MsgSendKeyshareResponse{
 			Creator:             msg.Creator,
 			Keyshare:            msg.Message,
@@ -103,7 +132,7 @@ func (k msgServer) SendKeyshare(goCtx context.Context, msg *types.MsgSendKeyshar
 	}
 
 	keyShare := types.KeyShare{
-		Validator:           msg.Creator,
+		Validator:           validatorInfo.Validator,
 		BlockHeight:         msg.BlockHeight,
 		KeyShare:            msg.Message,
 		KeyShareIndex:       msg.KeyShareIndex,
@@ -111,28 +140,42 @@ func (k msgServer) SendKeyshare(goCtx context.Context, msg *types.MsgSendKeyshar
 		ReceivedBlockHeight: uint64(ctx.BlockHeight()),
 	}
 
+	// Only the first submission of a key share received before the key of its height is aggregated
+	// counts toward the rewards of the validator. The accepted marker is never pruned, so a key share
+	// resubmitted after the pruning of the stored one is not counted again
+	if _, aggregated := k.GetAggregatedKeyShare(ctx, keyShare.BlockHeight); !aggregated &&
+		!k.IsKeyShareAccepted(ctx, keyShare.Validator, keyShare.BlockHeight, keyShare.KeyShareIndex) {
+		k.SetKeyShareAccepted(ctx, keyShare.Validator, keyShare.BlockHeight, keyShare.KeyShareIndex)
+		k.IncreaseAcceptedShares(ctx, keyShare.Validator)
+	}
+
 	// Save the new keyshare to state
 	k.SetKeyShare(ctx, keyShare)
 
-	k.SetLastSubmittedHeight(ctx, msg.Creator, strconv.FormatUint(msg.BlockHeight, 10))
+	if commitReveal {
+		k.RemoveKeyshareCommitment(ctx, validatorInfo.Validator, msg.BlockHeight, msg.KeyShareIndex)
+	}
 
 	validatorList := k.GetAllValidatorSet(ctx)
 
 	// Get all the keyshares for the provided block height in state
 	var stateKeyShares []types.KeyShare
 
+	// Every share counts once toward the threshold, so validators holding more share
+	// indices, proportionally to their power, weigh more in the aggregation.
+	// Shares of indices no longer assigned to the validator are left out so that
+	// each index is aggregated at most once
 	for _, eachValidator := range validatorList {
-		eachKeyShare, found := k.GetKeyShare(ctx, eachValidator.Validator, msg.BlockHeight)
-		if !found {
-			continue
+		for _, eachKeyShare := range k.GetAllKeyShareByHeight(ctx, eachValidator.Validator, msg.BlockHeight) {
+			if !k.IsKeyShareIndexOwner(ctx, eachValidator.Validator, eachKeyShare.KeyShareIndex) {
+				continue
+			}
+			stateKeyShares = append(stateKeyShares, eachKeyShare)
 		}
-		stateKeyShares = append(stateKeyShares, eachKeyShare)
 	}
 
-	expectedThreshold := sdk.NewDecFromInt(
-		sdk.NewInt(types.KeyAggregationThresholdNumerator)).Quo(
-		sdk.NewDecFromInt(sdk.NewInt(types.KeyAggregationThresholdDenominator))).MulInt64(
-		int64(len(validatorList))).Ceil().TruncateInt64()
+	// The threshold is fixed by the active key, pausing validators does not lower it
+	expectedThreshold := int64(k.GetKeyAggregationThreshold(ctx))
 
 	// Emit KeyShare Submitted Event
 	ctx.EventManager().EmitEvent(
@@ -209,6 +252,8 @@ func (k msgServer) SendKeyshare(goCtx context.Context, msg *types.MsgSendKeyshar
 
 	k.SetAggregatedKeyShareLength(ctx, k.GetAggregatedKeyShareLength(ctx)+1)
 
+	k.pepKeeper.PushAggregatedKeyShare(ctx, msg.BlockHeight, skHex)
+
 	k.Logger(ctx).Info(fmt.Sprintf("Aggregated Decryption Key for Block %d: %s", msg.BlockHeight, skHex))
 
 	defer telemetry.IncrCounterWithLabels([]string{types.KeyTotalValidKeyShareSubmitted}, 1, []metrics.Label{telemetry.NewLabel("aggrkey", skHex)})
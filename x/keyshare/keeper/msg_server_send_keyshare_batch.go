@@ -0,0 +1,84 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	"fairyring/x/keyshare/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SendKeyshareBatch registers keyshares of several heights & general key shares of several identities
+// submitted by a validator in a single transaction. Every item is processed independently, a failing item
+// is reported in its result without reverting the others
+func (k msgServer) SendKeyshareBatch(goCtx context.Context, msg *types.MsgSendKeyshareBatch) (*types.MsgSendKeyshareBatchResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var lastSubmittedHeight uint64
+	resp := &types.MsgSendKeyshareBatchResponse{}
+
+	for _, item := range msg.Keyshares {
+		cacheCtx, writeCache := ctx.CacheContext()
+
+		res, err := k.sendKeyshare(cacheCtx, types.NewMsgSendKeyshare(msg.Creator, item.Message, item.KeyShareIndex, item.BlockHeight))
+		if err != nil {
+			res = &types.MsgSendKeyshareResponse{
+				Creator:             msg.Creator,
+				Keyshare:            item.Message,
+				KeyshareIndex:       item.KeyShareIndex,
+				ReceivedBlockHeight: uint64(ctx.BlockHeight()),
+				BlockHeight:         item.BlockHeight,
+				Success:             false,
+				ErrorMessage:        err.Error(),
+			}
+		} else {
+			// Invalid key shares are not an error, the slashing of the validator is kept
+			writeCache()
+		}
+
+		if res.Success && item.BlockHeight > lastSubmittedHeight {
+			lastSubmittedHeight = item.BlockHeight
+		}
+		resp.KeyshareResults = append(resp.KeyshareResults, res)
+	}
+
+	for _, item := range msg.GeneralKeyshares {
+		cacheCtx, writeCache := ctx.CacheContext()
+
+		res, err := k.createGeneralKeyShare(cacheCtx, &types.MsgCreateGeneralKeyShare{
+			Creator:       msg.Creator,
+			IdType:        item.IdType,
+			IdValue:       item.IdValue,
+			KeyShare:      item.KeyShare,
+			KeyShareIndex: item.KeyShareIndex,
+		})
+		if err != nil {
+			res = &types.MsgCreateGeneralKeyShareResponse{
+				Creator:             msg.Creator,
+				IdType:              item.IdType,
+				IdValue:             item.IdValue,
+				KeyShare:            item.KeyShare,
+				KeyShareIndex:       item.KeyShareIndex,
+				ReceivedBlockHeight: uint64(ctx.BlockHeight()),
+				Success:             false,
+				ErrorMessage:        err.Error(),
+			}
+		} else {
+			writeCache()
+		}
+
+		if res.Success && uint64(ctx.BlockHeight()) > lastSubmittedHeight {
+			lastSubmittedHeight = uint64(ctx.BlockHeight())
+		}
+		resp.GeneralKeyshareResults = append(resp.GeneralKeyshareResults, res)
+	}
+
+	// Update the last submitted height once for the whole batch
+	validator := k.keyshareValidatorAddress(ctx, msg.Creator)
+	if lastSubmittedHeight > k.GetLastSubmittedHeight(ctx, validator) {
+		k.SetLastSubmittedHeight(ctx, validator, strconv.FormatUint(lastSubmittedHeight, 10))
+	}
+
+	return resp, nil
+}
@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"context"
+	"fairyring/testutil/sample"
 	"fairyring/x/keyshare"
 	"fairyring/x/keyshare/keeper"
 	"fairyring/x/keyshare/types"
@@ -14,15 +15,17 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
-func setupMsgServerSendKeyshare(t testing.TB) (types.MsgServer, keeper.Keeper, context.Context) {
-	k, ctx := keepertest.KeyshareKeeper(t)
+func setupMsgServerSendKeyshare(t testing.TB) (types.MsgServer, keeper.Keeper, context.Context, keepertest.KeyshareDeps) {
+	k, ctx, deps := keepertest.KeyshareKeeperWithDeps(t)
+	ctx = ctx.WithBlockHeight(10)
 	keyshare.InitGenesis(ctx, *k, *types.DefaultGenesis())
+	setupStakingValidator(t, ctx, *k, deps, alice)
 
-	return keeper.NewMsgServerImpl(*k), *k, sdk.WrapSDKContext(ctx)
+	return keeper.NewMsgServerImpl(*k), *k, sdk.WrapSDKContext(ctx), deps
 }
 
 func TestSendKeyshare(t *testing.T) {
-	msgServer, keeper, context := setupMsgServerSendKeyshare(t)
+	msgServer, keeper, context, deps := setupMsgServerSendKeyshare(t)
 
 	ctx := sdk.UnwrapSDKContext(context)
 
@@ -35,47 +38,74 @@ func TestSendKeyshare(t *testing.T) {
 		Creator: alice,
 	}, *registerResponse)
 
+	bob := sample.AccAddress()
+	keeper.SetValidatorSet(ctx, types.ValidatorSet{Index: bob, Validator: bob, IsActive: true})
+	shares := setupActiveKey(t, ctx, keeper, deps, 2, alice, bob)
+
+	blockHeight := uint64(ctx.BlockHeight())
+	aliceShare := extractBlockKeyShare(t, shares[0], 1, blockHeight)
+
 	sendResponse, err1 := msgServer.SendKeyshare(context, &types.MsgSendKeyshare{
-		Creator:     alice,
-		BlockHeight: uint64(ctx.BlockHeight()),
-		Message:     "this is a test",
+		Creator:       alice,
+		Message:       aliceShare,
+		KeyShareIndex: 1,
+		BlockHeight:   blockHeight,
 	})
 
 	require.Nil(t, err1)
 	require.EqualValues(t, types.MsgSendKeyshareResponse{
 		Creator:             alice,
-		Keyshare:            "this is a test",
-		BlockHeight:         uint64(ctx.BlockHeight()),
-		ReceivedBlockHeight: uint64(ctx.BlockHeight()),
+		Keyshare:            aliceShare,
+		KeyshareIndex:       1,
+		BlockHeight:         blockHeight,
+		ReceivedBlockHeight: blockHeight,
+		Success:             true,
 	}, *sendResponse)
 
-	keyshare, found := keeper.GetKeyShare(ctx, alice, uint64(ctx.BlockHeight()))
+	keyshare, found := keeper.GetKeyShare(ctx, alice, blockHeight, 1)
 
 	require.True(t, found)
 	require.EqualValues(t, types.KeyShare{
 		Validator:           alice,
-		KeyShare:            "this is a test",
-		BlockHeight:         uint64(ctx.BlockHeight()),
-		ReceivedBlockHeight: uint64(ctx.BlockHeight()),
+		KeyShare:            aliceShare,
+		KeyShareIndex:       1,
+		BlockHeight:         blockHeight,
+		ReceivedBlockHeight: blockHeight,
 		ReceivedTimestamp:   keyshare.GetReceivedTimestamp(),
 	}, keyshare)
 
+	_, found = keeper.GetAggregatedKeyShare(ctx, blockHeight)
+	require.False(t, found)
+
 	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
 	require.Len(t, events, 2)
-	event := events[0]
+	event := events[1]
 	require.EqualValues(t, sdk.StringEvent{
-		Type: "keyshare sent",
+		Type: types.SendKeyshareEventType,
 		Attributes: []sdk.Attribute{
-			{Key: "validator", Value: alice},
-			{Key: "keyshare block height", Value: strconv.FormatUint(uint64(ctx.BlockHeight()), 10)},
-			{Key: "received block height", Value: strconv.FormatUint(uint64(ctx.BlockHeight()), 10)},
-			{Key: "keyshare message", Value: "this is a test"},
+			{Key: types.SendKeyshareEventValidator, Value: alice},
+			{Key: types.SendKeyshareEventKeyshareBlockHeight, Value: strconv.FormatUint(blockHeight, 10)},
+			{Key: types.SendKeyshareEventReceivedBlockHeight, Value: strconv.FormatUint(blockHeight, 10)},
+			{Key: types.SendKeyshareEventMessage, Value: aliceShare},
+			{Key: types.SendKeyshareEventIndex, Value: "1"},
 		},
 	}, event)
+
+	// The share of bob reaches the threshold and aggregates the key of the height
+	_, err2 := msgServer.SendKeyshare(context, &types.MsgSendKeyshare{
+		Creator:       bob,
+		Message:       extractBlockKeyShare(t, shares[1], 2, blockHeight),
+		KeyShareIndex: 2,
+		BlockHeight:   blockHeight,
+	})
+	require.Nil(t, err2)
+
+	_, found = keeper.GetAggregatedKeyShare(ctx, blockHeight)
+	require.True(t, found)
 }
 
 func TestNotRegisteredSend(t *testing.T) {
-	msgServer, _, context := setupMsgServerSendKeyshare(t)
+	msgServer, _, context, _ := setupMsgServerSendKeyshare(t)
 
 	ctx := sdk.UnwrapSDKContext(context)
 	sendResponse, err := msgServer.SendKeyshare(context, &types.MsgSendKeyshare{
@@ -87,11 +117,11 @@ func TestNotRegisteredSend(t *testing.T) {
 	require.NotNil(t, err)
 	require.Nil(t, sendResponse)
 
-	require.True(t, types.ErrValidatorNotRegistered.Is(err))
+	require.ErrorIs(t, err, types.ErrAddrIsNotValidatorOrAuthorized)
 }
 
 func TestMultipleSends(t *testing.T) {
-	msgServer, keeper, context := setupMsgServerSendKeyshare(t)
+	msgServer, keeper, context, deps := setupMsgServerSendKeyshare(t)
 
 	ctx := sdk.UnwrapSDKContext(context)
 
@@ -104,28 +134,87 @@ func TestMultipleSends(t *testing.T) {
 		Creator: alice,
 	}, *registerResponse)
 
+	bob := sample.AccAddress()
+	keeper.SetValidatorSet(ctx, types.ValidatorSet{Index: bob, Validator: bob, IsActive: true})
+	shares := setupActiveKey(t, ctx, keeper, deps, 2, alice, bob)
+
 	blockHeight := uint64(ctx.BlockHeight())
 
 	_, err1 := msgServer.SendKeyshare(context, &types.MsgSendKeyshare{
-		Creator:     alice,
-		BlockHeight: blockHeight,
-		Message:     "testing 1",
+		Creator:       alice,
+		Message:       extractBlockKeyShare(t, shares[0], 1, blockHeight-1),
+		KeyShareIndex: 1,
+		BlockHeight:   blockHeight - 1,
 	})
 
 	require.Nil(t, err1)
 
+	aliceShare := extractBlockKeyShare(t, shares[0], 1, blockHeight)
 	_, err2 := msgServer.SendKeyshare(context, &types.MsgSendKeyshare{
-		Creator:     alice,
-		BlockHeight: blockHeight,
-		Message:     "testing2",
+		Creator:       alice,
+		Message:       aliceShare,
+		KeyShareIndex: 1,
+		BlockHeight:   blockHeight,
 	})
 
 	require.Nil(t, err2)
 
 	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
-	require.Len(t, events, 2)
+	require.Len(t, events, 3)
+
+	keyshare, found := keeper.GetKeyShare(ctx, alice, blockHeight, 1)
+	require.True(t, found)
+	require.EqualValues(t, aliceShare, keyshare.GetKeyShare())
+	require.EqualValues(t, blockHeight, keeper.GetLastSubmittedHeight(ctx, alice))
+}
+
+func TestSendKeyshareRewardShares(t *testing.T) {
+	msgServer, keeper, context, deps := setupMsgServerSendKeyshare(t)
+
+	ctx := sdk.UnwrapSDKContext(context)
+
+	_, err := msgServer.RegisterValidator(context, &types.MsgRegisterValidator{
+		Creator: alice,
+	})
+	require.NoError(t, err)
 
-	keyshare, found := keeper.GetKeyShare(ctx, alice, blockHeight)
+	bob := sample.AccAddress()
+	carol := sample.AccAddress()
+	for _, v := range []string{bob, carol} {
+		keeper.SetValidatorSet(ctx, types.ValidatorSet{Index: v, Validator: v, IsActive: true})
+	}
+	shares := setupActiveKey(t, ctx, keeper, deps, 2, alice, bob, carol)
+
+	blockHeight := uint64(ctx.BlockHeight())
+	send := func(creator string, index uint64) {
+		_, err := msgServer.SendKeyshare(context, &types.MsgSendKeyshare{
+			Creator:       creator,
+			Message:       extractBlockKeyShare(t, shares[index-1], index, blockHeight),
+			KeyShareIndex: index,
+			BlockHeight:   blockHeight,
+		})
+		require.NoError(t, err)
+	}
+	acceptedShares := func(validator string) uint64 {
+		rewardShares, _ := keeper.GetValidatorRewardShares(ctx, validator)
+		return rewardShares.AcceptedShares
+	}
+
+	send(alice, 1)
+	require.EqualValues(t, 1, acceptedShares(alice))
+
+	// A key share resubmitted once the stored one is removed is not counted again
+	keeper.RemoveKeyShare(ctx, alice, blockHeight, 1)
+	send(alice, 1)
+	require.EqualValues(t, 1, acceptedShares(alice))
+
+	// The share of bob aggregates the key of the height and is counted
+	send(bob, 2)
+	_, found := keeper.GetAggregatedKeyShare(ctx, blockHeight)
 	require.True(t, found)
-	require.EqualValues(t, "testing2", keyshare.GetKeyShare())
+	require.EqualValues(t, 1, acceptedShares(bob))
+
+	// Key shares received after the aggregation are not counted
+	send(carol, 3)
+	require.EqualValues(t, 0, acceptedShares(carol))
 }
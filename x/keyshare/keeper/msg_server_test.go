@@ -1,5 +1,86 @@
 package keeper_test
 
+import (
+	"encoding/hex"
+	"strconv"
+	"testing"
+
+	keepertest "fairyring/testutil/keeper"
+	"fairyring/x/keyshare/keeper"
+	"fairyring/x/keyshare/types"
+	peptypes "fairyring/x/pep/types"
+
+	distIBE "github.com/FairBlock/DistributedIBE"
+	bls "github.com/drand/kyber-bls12381"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+)
+
 const (
 	alice = "cosmos1jmjfq0tplp9tmx4v9uemw72y4d2wa5nr3xn9d3"
 )
+
+// setupStakingValidator creates a bonded staking validator for the account holding the minimum
+// bonded amount required to register to the keyshare module
+func setupStakingValidator(t testing.TB, ctx sdk.Context, k keeper.Keeper, deps keepertest.KeyshareDeps, address string) stakingtypes.Validator {
+	accAddr, err := sdk.AccAddressFromBech32(address)
+	require.NoError(t, err)
+
+	validator, err := stakingtypes.NewValidator(sdk.ValAddress(accAddr), ed25519.GenPrivKey().PubKey(), stakingtypes.Description{})
+	require.NoError(t, err)
+	validator.Status = stakingtypes.Bonded
+	validator.Tokens = sdk.NewIntFromUint64(k.MinimumBonded(ctx))
+	deps.StakingKeeper.SetValidator(ctx, validator)
+
+	return validator
+}
+
+// setupActiveKey deals one key share to each owner and activates the public key of the shares
+// with the given threshold. The shares are returned in the order of their index
+func setupActiveKey(t testing.TB, ctx sdk.Context, k keeper.Keeper, deps keepertest.KeyshareDeps, threshold uint64, owners ...string) []distIBE.Share {
+	shares, mpk, _, err := distIBE.GenerateShares(uint32(len(owners)), uint32(threshold))
+	require.NoError(t, err)
+
+	suite := bls.NewBLS12381Suite()
+	commitments := make([]string, len(shares))
+	assignments := make([]types.KeyShareAssignment, len(owners))
+	for i, share := range shares {
+		commitment, err := suite.G1().Point().Mul(share.Value, suite.G1().Point().Base()).MarshalBinary()
+		require.NoError(t, err)
+		commitments[i] = hex.EncodeToString(commitment)
+		assignments[i] = types.KeyShareAssignment{Validator: owners[i], ShareIndices: []uint64{uint64(i + 1)}, Weight: 1}
+	}
+
+	mpkByte, err := mpk.MarshalBinary()
+	require.NoError(t, err)
+	pubKey := hex.EncodeToString(mpkByte)
+	expiry := uint64(ctx.BlockHeight()) + k.KeyExpiry(ctx)
+
+	k.SetActiveCommitments(ctx, types.Commitments{Commitments: commitments})
+	k.SetActivePubKey(ctx, types.ActivePubKey{
+		PublicKey:   pubKey,
+		Expiry:      expiry,
+		Threshold:   threshold,
+		Assignments: assignments,
+	})
+	k.SetKeyShareAssignments(ctx, assignments)
+	deps.PepKeeper.SetActivePubKey(ctx, peptypes.ActivePubKey{PublicKey: pubKey, Expiry: expiry})
+
+	return shares
+}
+
+// extractKeyShare returns the hex encoded key share of the share for the identity
+func extractKeyShare(t testing.TB, share distIBE.Share, index uint64, id string) string {
+	extracted := distIBE.Extract(bls.NewBLS12381Suite(), share.Value, uint32(index), []byte(id))
+	sk, err := extracted.SK.MarshalBinary()
+	require.NoError(t, err)
+	return hex.EncodeToString(sk)
+}
+
+// extractBlockKeyShare returns the hex encoded key share of the share for the block height
+func extractBlockKeyShare(t testing.TB, share distIBE.Share, index uint64, height uint64) string {
+	return extractKeyShare(t, share, index, strconv.FormatUint(height, 10))
+}
@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	"fairyring/x/keyshare/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// UnjailKeyshareValidator unjails a validator jailed for submitting invalid key shares once its jail period is over,
+// the validator can then register again to submit key shares
+func (k msgServer) UnjailKeyshareValidator(goCtx context.Context, msg *types.MsgUnjailKeyshareValidator) (*types.MsgUnjailKeyshareValidatorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	misbehavior, found := k.GetKeyShareMisbehavior(ctx, msg.Creator)
+	if !found || !misbehavior.Jailed {
+		return nil, types.ErrValidatorNotJailed.Wrap(msg.Creator)
+	}
+
+	if ctx.BlockTime().Before(misbehavior.JailedUntil) {
+		return nil, types.ErrValidatorStillJailed.Wrapf("jailed until: %s", misbehavior.JailedUntil.Format(time.RFC3339))
+	}
+
+	accAddr, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	stakingValidator, found := k.stakingKeeper.GetValidator(ctx, sdk.ValAddress(accAddr))
+	if !found {
+		return nil, types.ErrAccountNotStaking.Wrap(msg.Creator)
+	}
+
+	// The validator may already be unjailed through the slashing module
+	if stakingValidator.IsJailed() {
+		if err := k.slashingKeeper.Unjail(ctx, sdk.ValAddress(accAddr)); err != nil {
+			return nil, err
+		}
+	}
+
+	k.RemoveKeyShareMisbehavior(ctx, msg.Creator)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.KeyshareValidatorUnjailedEventType,
+			sdk.NewAttribute(types.KeyshareValidatorUnjailedEventValidator, msg.Creator),
+		),
+	)
+
+	return &types.MsgUnjailKeyshareValidatorResponse{}, nil
+}
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"time"
+
 	"fairyring/x/keyshare/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -15,6 +17,30 @@ func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 		k.SlashFractionNoKeyshare(ctx),
 		k.SlashFractionWrongKeyshare(ctx),
 		k.MaxIdledBlock(ctx),
+		k.DkgPhaseDuration(ctx),
+		k.KeyAggregationThreshold(ctx),
+		k.StakeWeightedAggregation(ctx),
+		k.KeyshareSubmissionWindow(ctx),
+		k.KeyShareRetentionBlocks(ctx),
+		k.MaxInvalidKeyshares(ctx),
+		k.InvalidKeyshareWindow(ctx),
+		k.InvalidKeyshareJailDuration(ctx),
+		k.KeyshareLivenessWindow(ctx),
+		k.MinSubmittedPerWindow(ctx),
+		k.DowntimeJailDuration(ctx),
+		k.MaxKeysharePauseDuration(ctx),
+		k.MaxAuthorizedAddresses(ctx),
+		k.KeyshareCommitReveal(ctx),
+		k.GeneralKeyRequestFee(ctx),
+		k.RewardEpochBlocks(ctx),
+		k.EncryptedTxFeeRewardShare(ctx),
+		k.RequestFeeRewardShare(ctx),
+		k.KeyShareRequestDeadlineBlocks(ctx),
+		k.KeyShareRequestGraceBlocks(ctx),
+		k.AggrKeyshareMaxRetries(ctx),
+		k.AggrKeysharePacketTimeout(ctx),
+		k.AggrKeyshareRetryBackoffBlocks(ctx),
+		k.AggrKeyshareResendCooldownBlocks(ctx),
 	)
 }
 
@@ -58,3 +84,147 @@ func (k Keeper) MaxIdledBlock(ctx sdk.Context) (res uint64) {
 	k.paramstore.Get(ctx, types.KeyMaxIdledBlock, &res)
 	return
 }
+
+// DkgPhaseDuration returns the DkgPhaseDuration param
+func (k Keeper) DkgPhaseDuration(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyDkgPhaseDuration, &res)
+	return
+}
+
+// KeyAggregationThreshold returns the KeyAggregationThreshold param
+func (k Keeper) KeyAggregationThreshold(ctx sdk.Context) (res sdk.Dec) {
+	k.paramstore.Get(ctx, types.KeyKeyAggregationThreshold, &res)
+	return
+}
+
+// StakeWeightedAggregation returns the StakeWeightedAggregation param
+func (k Keeper) StakeWeightedAggregation(ctx sdk.Context) (res bool) {
+	k.paramstore.Get(ctx, types.KeyStakeWeightedAggregation, &res)
+	return
+}
+
+// KeyshareSubmissionWindow returns the KeyshareSubmissionWindow param
+func (k Keeper) KeyshareSubmissionWindow(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyKeyshareSubmissionWindow, &res)
+	return
+}
+
+// KeyShareRetentionBlocks returns the KeyShareRetentionBlocks param
+func (k Keeper) KeyShareRetentionBlocks(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyKeyShareRetentionBlocks, &res)
+	return
+}
+
+// MaxInvalidKeyshares returns the MaxInvalidKeyshares param
+func (k Keeper) MaxInvalidKeyshares(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyMaxInvalidKeyshares, &res)
+	return
+}
+
+// InvalidKeyshareWindow returns the InvalidKeyshareWindow param
+func (k Keeper) InvalidKeyshareWindow(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyInvalidKeyshareWindow, &res)
+	return
+}
+
+// InvalidKeyshareJailDuration returns the InvalidKeyshareJailDuration param
+func (k Keeper) InvalidKeyshareJailDuration(ctx sdk.Context) (res time.Duration) {
+	k.paramstore.Get(ctx, types.KeyInvalidKeyshareJailDuration, &res)
+	return
+}
+
+// KeyshareLivenessWindow returns the KeyshareLivenessWindow param
+func (k Keeper) KeyshareLivenessWindow(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyKeyshareLivenessWindow, &res)
+	return
+}
+
+// MinSubmittedPerWindow returns the MinSubmittedPerWindow param
+func (k Keeper) MinSubmittedPerWindow(ctx sdk.Context) (res sdk.Dec) {
+	k.paramstore.Get(ctx, types.KeyMinSubmittedPerWindow, &res)
+	return
+}
+
+// DowntimeJailDuration returns the DowntimeJailDuration param
+func (k Keeper) DowntimeJailDuration(ctx sdk.Context) (res time.Duration) {
+	k.paramstore.Get(ctx, types.KeyDowntimeJailDuration, &res)
+	return
+}
+
+// MaxKeysharePauseDuration returns the MaxKeysharePauseDuration param
+func (k Keeper) MaxKeysharePauseDuration(ctx sdk.Context) (res time.Duration) {
+	k.paramstore.Get(ctx, types.KeyMaxKeysharePauseDuration, &res)
+	return
+}
+
+// MaxAuthorizedAddresses returns the MaxAuthorizedAddresses param
+func (k Keeper) MaxAuthorizedAddresses(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyMaxAuthorizedAddresses, &res)
+	return
+}
+
+// KeyshareCommitReveal returns the KeyshareCommitReveal param
+func (k Keeper) KeyshareCommitReveal(ctx sdk.Context) (res bool) {
+	k.paramstore.Get(ctx, types.KeyKeyshareCommitReveal, &res)
+	return
+}
+
+// GeneralKeyRequestFee returns the GeneralKeyRequestFee param
+func (k Keeper) GeneralKeyRequestFee(ctx sdk.Context) (res sdk.Coin) {
+	k.paramstore.Get(ctx, types.KeyGeneralKeyRequestFee, &res)
+	return
+}
+
+// RewardEpochBlocks returns the RewardEpochBlocks param
+func (k Keeper) RewardEpochBlocks(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyRewardEpochBlocks, &res)
+	return
+}
+
+// EncryptedTxFeeRewardShare returns the EncryptedTxFeeRewardShare param
+func (k Keeper) EncryptedTxFeeRewardShare(ctx sdk.Context) (res sdk.Dec) {
+	k.paramstore.Get(ctx, types.KeyEncryptedTxFeeRewardShare, &res)
+	return
+}
+
+// RequestFeeRewardShare returns the RequestFeeRewardShare param
+func (k Keeper) RequestFeeRewardShare(ctx sdk.Context) (res sdk.Dec) {
+	k.paramstore.Get(ctx, types.KeyRequestFeeRewardShare, &res)
+	return
+}
+
+// KeyShareRequestDeadlineBlocks returns the KeyShareRequestDeadlineBlocks param
+func (k Keeper) KeyShareRequestDeadlineBlocks(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyKeyShareRequestDeadlineBlocks, &res)
+	return
+}
+
+// KeyShareRequestGraceBlocks returns the KeyShareRequestGraceBlocks param
+func (k Keeper) KeyShareRequestGraceBlocks(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyKeyShareRequestGraceBlocks, &res)
+	return
+}
+
+// AggrKeyshareMaxRetries returns the AggrKeyshareMaxRetries param
+func (k Keeper) AggrKeyshareMaxRetries(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyAggrKeyshareMaxRetries, &res)
+	return
+}
+
+// AggrKeysharePacketTimeout returns the AggrKeysharePacketTimeout param
+func (k Keeper) AggrKeysharePacketTimeout(ctx sdk.Context) (res time.Duration) {
+	k.paramstore.Get(ctx, types.KeyAggrKeysharePacketTimeout, &res)
+	return
+}
+
+// AggrKeyshareRetryBackoffBlocks returns the AggrKeyshareRetryBackoffBlocks param
+func (k Keeper) AggrKeyshareRetryBackoffBlocks(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyAggrKeyshareRetryBackoffBlocks, &res)
+	return
+}
+
+// AggrKeyshareResendCooldownBlocks returns the AggrKeyshareResendCooldownBlocks param
+func (k Keeper) AggrKeyshareResendCooldownBlocks(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyAggrKeyshareResendCooldownBlocks, &res)
+	return
+}
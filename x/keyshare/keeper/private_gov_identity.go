@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	"fairyring/x/keyshare/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	PrivateGovIdentity = "private-gov-identity"
+)
+
+var _ types.IDTypeHooks = privateGovIdentityHooks{}
+
+// privateGovIdentityHooks serve the decryption keys requested over IBC for private governance proposals
+type privateGovIdentityHooks struct {
+	k Keeper
+}
+
+// ValidateRequest checks the key share request of the identity exists and its key is not aggregated yet
+func (h privateGovIdentityHooks) ValidateRequest(ctx sdk.Context, idValue string) error {
+	keyShareReq, found := h.k.GetKeyShareRequest(ctx, idValue)
+	if !found {
+		return types.ErrKeyShareRequestNotFound.Wrapf(", got id value: %s", idValue)
+	}
+	if keyShareReq.AggrKeyshare != "" {
+		return types.ErrAggKeyAlreadyExists.Wrapf(", identity: %s, Aggregated key: %s", idValue, keyShareReq.AggrKeyshare)
+	}
+	return nil
+}
+
+// OnAggregated saves the aggregated key in the key share request of the identity
+func (h privateGovIdentityHooks) OnAggregated(ctx sdk.Context, idValue string, aggrKey string) error {
+	if err := h.ValidateRequest(ctx, idValue); err != nil {
+		return err
+	}
+
+	keyShareReq, _ := h.k.GetKeyShareRequest(ctx, idValue)
+	keyShareReq.AggrKeyshare = aggrKey
+	keyShareReq.AggrHeight = uint64(ctx.BlockHeight())
+	h.k.SetKeyShareRequest(ctx, keyShareReq)
+
+	return nil
+}
+
+// DeliveryRoute returns the channel the key share request of the identity was received on
+func (h privateGovIdentityHooks) DeliveryRoute(ctx sdk.Context, idValue string) (types.KeyDeliveryRoute, bool) {
+	keyShareReq, found := h.k.GetKeyShareRequest(ctx, idValue)
+	if !found || keyShareReq.IbcInfo == nil {
+		return types.KeyDeliveryRoute{}, false
+	}
+
+	return types.KeyDeliveryRoute{
+		PortID:    keyShareReq.IbcInfo.PortID,
+		ChannelID: keyShareReq.IbcInfo.ChannelID,
+		Packet: types.AggrKeyshareDataPacketData{
+			Identity:   keyShareReq.Identity,
+			Pubkey:     keyShareReq.Pubkey,
+			ProposalId: keyShareReq.ProposalId,
+		},
+	}, true
+}
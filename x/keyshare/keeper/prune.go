@@ -0,0 +1,191 @@
+package keeper
+
+import (
+	"strconv"
+
+	"fairyring/x/keyshare/types"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// PruneKeyShares deletes the key shares of already aggregated heights & identities, the key shares
+// too old to ever be aggregated and the aggregated keys older than the KeyShareRetentionBlocks param.
+// At most MaxPrunedEntriesPerBlock entries of each store are scanned, starting where the previous block
+// stopped, so the stores are swept over the next blocks whatever their size
+func (k Keeper) PruneKeyShares(ctx sdk.Context) {
+	retention := k.KeyShareRetentionBlocks(ctx)
+	if retention == 0 {
+		return
+	}
+
+	height := uint64(ctx.BlockHeight())
+	if height <= retention {
+		return
+	}
+	expiredHeight := height - retention
+
+	prunedKeyShares := k.pruneKeyShares(ctx, expiredHeight)
+	prunedGeneralKeyShares := k.pruneGeneralKeyShares(ctx, expiredHeight)
+	prunedAggrKeys := k.pruneAggregatedKeyShares(ctx, expiredHeight)
+	prunedCommitments := k.pruneKeyshareCommitments(ctx, expiredHeight)
+
+	if prunedKeyShares == 0 && prunedGeneralKeyShares == 0 && prunedAggrKeys == 0 && prunedCommitments == 0 {
+		return
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.KeySharePrunedEventType,
+			sdk.NewAttribute(types.KeySharePrunedEventBlockHeight, strconv.FormatUint(height, 10)),
+			sdk.NewAttribute(types.KeySharePrunedEventKeyShares, strconv.FormatUint(prunedKeyShares, 10)),
+			sdk.NewAttribute(types.KeySharePrunedEventGeneralKeyShares, strconv.FormatUint(prunedGeneralKeyShares, 10)),
+			sdk.NewAttribute(types.KeySharePrunedEventAggregatedKeyShares, strconv.FormatUint(prunedAggrKeys, 10)),
+			sdk.NewAttribute(types.KeySharePrunedEventKeyshareCommitments, strconv.FormatUint(prunedCommitments, 10)),
+		),
+	)
+}
+
+// pruneKeyShares deletes the key shares of aggregated heights and of heights before expiredHeight
+func (k Keeper) pruneKeyShares(ctx sdk.Context, expiredHeight uint64) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.KeyShareKeyPrefix))
+
+	aggregated := make(map[uint64]bool)
+	var keys [][]byte
+
+	k.scanFromCursor(ctx, store, types.KeyShareKeyPrefix, func(key, value []byte) {
+		var val types.KeyShare
+		k.cdc.MustUnmarshal(value, &val)
+
+		isAggregated, checked := aggregated[val.BlockHeight]
+		if !checked {
+			_, isAggregated = k.GetAggregatedKeyShare(ctx, val.BlockHeight)
+			aggregated[val.BlockHeight] = isAggregated
+		}
+
+		if isAggregated || val.BlockHeight < expiredHeight {
+			keys = append(keys, key)
+		}
+	})
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	return uint64(len(keys))
+}
+
+// pruneGeneralKeyShares deletes the general key shares of identities with no pending
+// decryption key and the ones received before expiredHeight
+func (k Keeper) pruneGeneralKeyShares(ctx sdk.Context, expiredHeight uint64) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.GeneralKeyShareKeyPrefix))
+
+	var keys [][]byte
+
+	k.scanFromCursor(ctx, store, types.GeneralKeyShareKeyPrefix, func(key, value []byte) {
+		var val types.GeneralKeyShare
+		k.cdc.MustUnmarshal(value, &val)
+
+		isPending := true
+		if hooks, found := k.GetIDTypeHooks(val.IdType); found {
+			isPending = hooks.ValidateRequest(ctx, val.IdValue) == nil
+		}
+
+		if !isPending || val.ReceivedBlockHeight < expiredHeight {
+			keys = append(keys, key)
+		}
+	})
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	return uint64(len(keys))
+}
+
+// pruneAggregatedKeyShares deletes the aggregated keys of heights before expiredHeight
+func (k Keeper) pruneAggregatedKeyShares(ctx sdk.Context, expiredHeight uint64) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AggregatedKeyShareKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	var keys [][]byte
+
+	// Aggregated keys are sorted by height, so iteration stops at the first one to keep
+	for ; iterator.Valid() && len(keys) < types.MaxPrunedEntriesPerBlock; iterator.Next() {
+		var val types.AggregatedKeyShare
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+
+		if val.Height >= expiredHeight {
+			break
+		}
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	// Keep the length in line with the number of aggregated keys in state
+	pruned := uint64(len(keys))
+	if pruned > 0 {
+		length := k.GetAggregatedKeyShareLength(ctx)
+		if pruned > length {
+			length = pruned
+		}
+		k.SetAggregatedKeyShareLength(ctx, length-pruned)
+	}
+
+	return pruned
+}
+
+// pruneKeyshareCommitments deletes the keyshare commitments of heights before expiredHeight
+// that were never revealed
+func (k Keeper) pruneKeyshareCommitments(ctx sdk.Context, expiredHeight uint64) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.KeyshareCommitmentKeyPrefix))
+
+	var keys [][]byte
+
+	k.scanFromCursor(ctx, store, types.KeyshareCommitmentKeyPrefix, func(key, value []byte) {
+		var val types.KeyshareCommitment
+		k.cdc.MustUnmarshal(value, &val)
+
+		if val.BlockHeight < expiredHeight {
+			keys = append(keys, key)
+		}
+	})
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	return uint64(len(keys))
+}
+
+// scanFromCursor visits at most MaxPrunedEntriesPerBlock entries of the store, starting from the cursor
+// saved under the given name by the previous scan and wrapping around once the end of the store is reached.
+// The keys passed to visit are copies, so they can be deleted once the scan is over
+func (k Keeper) scanFromCursor(ctx sdk.Context, store prefix.Store, name string, visit func(key, value []byte)) {
+	cursorStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PruneCursorKeyPrefix))
+	cursorKey := []byte(name)
+	start := cursorStore.Get(cursorKey)
+
+	scanned := 0
+	var next []byte
+
+	iterator := store.Iterator(start, nil)
+	for ; iterator.Valid(); iterator.Next() {
+		if scanned == types.MaxPrunedEntriesPerBlock {
+			next = append([]byte{}, iterator.Key()...)
+			break
+		}
+		visit(append([]byte{}, iterator.Key()...), iterator.Value())
+		scanned++
+	}
+	iterator.Close()
+
+	if next == nil {
+		cursorStore.Delete(cursorKey)
+		return
+	}
+	cursorStore.Set(cursorKey, next)
+}
@@ -0,0 +1,37 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	keepertest "fairyring/testutil/keeper"
+	"fairyring/x/keyshare/types"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPruneKeySharesScansFromCursor(t *testing.T) {
+	keeper, ctx := keepertest.KeyshareKeeper(t)
+	params := keeper.GetParams(ctx)
+	params.KeyShareRetentionBlocks = 50
+	keeper.SetParams(ctx, params)
+
+	ctx = ctx.WithBlockHeight(100)
+
+	// A full scan worth of recent key shares is stored before the expired one
+	for i := 0; i < types.MaxPrunedEntriesPerBlock; i++ {
+		keeper.SetKeyShare(ctx, types.KeyShare{Validator: fmt.Sprintf("a%04d", i), BlockHeight: 99, KeyShareIndex: 1})
+	}
+	expired := types.KeyShare{Validator: "b", BlockHeight: 10, KeyShareIndex: 1}
+	keeper.SetKeyShare(ctx, expired)
+
+	keeper.PruneKeyShares(ctx)
+	_, found := keeper.GetKeyShare(ctx, expired.Validator, expired.BlockHeight, expired.KeyShareIndex)
+	require.True(t, found)
+
+	// The next block resumes the scan where the previous one stopped
+	keeper.PruneKeyShares(ctx)
+	_, found = keeper.GetKeyShare(ctx, expired.Validator, expired.BlockHeight, expired.KeyShareIndex)
+	require.False(t, found)
+	require.Len(t, keeper.GetAllKeyShare(ctx), types.MaxPrunedEntriesPerBlock)
+}
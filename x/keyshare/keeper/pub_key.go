@@ -1,7 +1,10 @@
 package keeper
 
 import (
+	"strconv"
+
 	"fairyring/x/keyshare/types"
+	peptypes "fairyring/x/pep/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -63,3 +66,83 @@ func (k Keeper) DeleteQueuedPubKey(ctx sdk.Context) {
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(types.KeyPrefix(types.QueuedPubKeyPrefix))
 }
+
+// QueuePubKey stores a new queued public key together with its commitments, the number
+// of key shares required to aggregate with it and the share indices assigned to each validator
+// when shares are weighted, mirrors it to the pep module and returns the expiry height assigned to it
+func (k Keeper) QueuePubKey(
+	ctx sdk.Context,
+	creator string,
+	publicKey string,
+	commitments []string,
+	threshold uint64,
+	assignments []types.KeyShareAssignment,
+) (uint64, error) {
+	if threshold == 0 || threshold > uint64(len(commitments)) {
+		return 0, types.ErrInvalidKeyAggregationThreshold.Wrapf("expected threshold within 1 and %d, got: %d", len(commitments), threshold)
+	}
+
+	expHeight := k.KeyExpiry(ctx) + uint64(ctx.BlockHeight())
+	ak, found := k.GetActivePubKey(ctx)
+	if found {
+		expHeight = ak.Expiry + k.KeyExpiry(ctx)
+	}
+
+	k.SetQueuedCommitments(
+		ctx,
+		types.Commitments{
+			Commitments: commitments,
+		},
+	)
+
+	k.SetQueuedPubKey(
+		ctx,
+		types.QueuedPubKey{
+			Creator:     creator,
+			PublicKey:   publicKey,
+			Expiry:      expHeight,
+			Threshold:   threshold,
+			Assignments: assignments,
+		},
+	)
+
+	pepQueuedPubKey := peptypes.QueuedPubKey{
+		Creator:   creator,
+		PublicKey: publicKey,
+		Expiry:    expHeight,
+	}
+	k.pepKeeper.SetQueuedPubKey(ctx, pepQueuedPubKey)
+	k.pepKeeper.PushQueuedPubKey(ctx, pepQueuedPubKey)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.QueuedPubKeyCreatedEventType,
+			sdk.NewAttribute(types.QueuedPubKeyCreatedEventActivePubkeyExpiryHeight, strconv.FormatUint(ak.Expiry, 10)),
+			sdk.NewAttribute(types.QueuedPubKeyCreatedEventExpiryHeight, strconv.FormatUint(expHeight, 10)),
+			sdk.NewAttribute(types.QueuedPubKeyCreatedEventCreator, creator),
+			sdk.NewAttribute(types.QueuedPubKeyCreatedEventPubkey, publicKey),
+			sdk.NewAttribute(types.QueuedPubKeyCreatedEventThreshold, strconv.FormatUint(threshold, 10)),
+		),
+	)
+
+	return expHeight, nil
+}
+
+// GetKeyAggregationThreshold returns the number of key shares required to aggregate a key.
+// The threshold snapshotted on the active public key is used so that a change of the
+// parameters or the validator set does not affect keys already in use. Keys activated
+// without a snapshot fall back to the threshold of the share indices they were dealt.
+func (k Keeper) GetKeyAggregationThreshold(ctx sdk.Context) uint64 {
+	ak, found := k.GetActivePubKey(ctx)
+	if !found {
+		return 0
+	}
+	if ak.Threshold > 0 {
+		return ak.Threshold
+	}
+
+	var shareCount uint64
+	for _, eachAssignment := range ak.Assignments {
+		shareCount += uint64(len(eachAssignment.ShareIndices))
+	}
+	return k.GetParams(ctx).AggregationThreshold(shareCount)
+}
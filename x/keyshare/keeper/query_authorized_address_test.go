@@ -47,7 +47,7 @@ func TestAuthorizedAddressQuerySingle(t *testing.T) {
 			request: &types.QueryGetAuthorizedAddressRequest{
 				Target: strconv.Itoa(100000),
 			},
-			err: status.Error(codes.NotFound, "not found"),
+			err: types.ErrAuthorizedAddrNotFound,
 		},
 		{
 			desc: "InvalidRequest",
@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"context"
+
+	"fairyring/x/keyshare/types"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) GeneralKeyRequestAll(goCtx context.Context, req *types.QueryAllGeneralKeyRequestRequest) (*types.QueryAllGeneralKeyRequestResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var generalKeyRequests []types.GeneralKeyRequest
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	store := ctx.KVStore(k.storeKey)
+	generalKeyRequestStore := prefix.NewStore(store, types.KeyPrefix(types.GeneralKeyRequestKeyPrefix))
+
+	pageRes, err := query.Paginate(generalKeyRequestStore, req.Pagination, func(key []byte, value []byte) error {
+		var generalKeyRequest types.GeneralKeyRequest
+		if err := k.cdc.Unmarshal(value, &generalKeyRequest); err != nil {
+			return err
+		}
+
+		generalKeyRequests = append(generalKeyRequests, generalKeyRequest)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllGeneralKeyRequestResponse{GeneralKeyRequest: generalKeyRequests, Pagination: pageRes}, nil
+}
+
+func (k Keeper) GeneralKeyRequest(goCtx context.Context, req *types.QueryGetGeneralKeyRequestRequest) (*types.QueryGetGeneralKeyRequestResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	val, found := k.GetGeneralKeyRequest(
+		ctx,
+		req.Identity,
+	)
+	if !found {
+		return nil, types.ErrGeneralKeyRequestNotFound
+	}
+
+	return &types.QueryGetGeneralKeyRequestResponse{GeneralKeyRequest: val}, nil
+}
@@ -45,15 +45,17 @@ func (k Keeper) GeneralKeyShare(goCtx context.Context, req *types.QueryGetGenera
 	}
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	val, found := k.GetGeneralKeyShare(
+	// A validator holding more than one share index has one general key share per index,
+	// the share with the lowest index is returned
+	list := k.GetAllGeneralKeyShareByIdentity(
 		ctx,
 		req.Validator,
 		req.IdType,
 		req.IdValue,
 	)
-	if !found {
+	if len(list) == 0 {
 		return nil, status.Error(codes.NotFound, "not found")
 	}
 
-	return &types.QueryGetGeneralKeyShareResponse{GeneralKeyShare: val}, nil
+	return &types.QueryGetGeneralKeyShareResponse{GeneralKeyShare: list[0]}, nil
 }
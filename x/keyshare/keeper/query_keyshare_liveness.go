@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"context"
+
+	"fairyring/x/keyshare/types"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) KeyshareLivenessAll(goCtx context.Context, req *types.QueryAllKeyshareLivenessRequest) (*types.QueryAllKeyshareLivenessResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var keyshareLivenessInfos []types.KeyshareLivenessInfo
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	store := ctx.KVStore(k.storeKey)
+	keyshareLivenessStore := prefix.NewStore(store, types.KeyPrefix(types.KeyshareLivenessKeyPrefix))
+
+	pageRes, err := query.Paginate(keyshareLivenessStore, req.Pagination, func(key []byte, value []byte) error {
+		var keyshareLivenessInfo types.KeyshareLivenessInfo
+		if err := k.cdc.Unmarshal(value, &keyshareLivenessInfo); err != nil {
+			return err
+		}
+
+		keyshareLivenessInfos = append(keyshareLivenessInfos, keyshareLivenessInfo)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllKeyshareLivenessResponse{KeyshareLivenessInfo: keyshareLivenessInfos, Pagination: pageRes}, nil
+}
+
+func (k Keeper) KeyshareLiveness(goCtx context.Context, req *types.QueryGetKeyshareLivenessRequest) (*types.QueryGetKeyshareLivenessResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	val, found := k.GetKeyshareLivenessInfo(
+		ctx,
+		req.Validator,
+	)
+	if !found {
+		return nil, types.ErrKeyshareLivenessNotFound
+	}
+
+	return &types.QueryGetKeyshareLivenessResponse{KeyshareLivenessInfo: val}, nil
+}
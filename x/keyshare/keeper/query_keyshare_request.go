@@ -0,0 +1,119 @@
+package keeper
+
+import (
+	"context"
+
+	"fairyring/x/keyshare/types"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) KeyShareRequestAll(goCtx context.Context, req *types.QueryAllKeyShareRequestRequest) (*types.QueryAllKeyShareRequestResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var keyShareRequests []types.KeyShareRequest
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	store := ctx.KVStore(k.storeKey)
+	keyShareRequestStore := prefix.NewStore(store, types.KeyPrefix(types.KeyShareRequestKeyPrefix))
+
+	pageRes, err := query.FilteredPaginate(keyShareRequestStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var keyShareRequest types.KeyShareRequest
+		if err := k.cdc.Unmarshal(value, &keyShareRequest); err != nil {
+			return false, err
+		}
+
+		if !matchKeyShareRequest(keyShareRequest, req) {
+			return false, nil
+		}
+
+		if accumulate {
+			keyShareRequests = append(keyShareRequests, keyShareRequest)
+		}
+		return true, nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllKeyShareRequestResponse{KeyShareRequest: keyShareRequests, Pagination: pageRes}, nil
+}
+
+func (k Keeper) KeyShareRequest(goCtx context.Context, req *types.QueryGetKeyShareRequestRequest) (*types.QueryGetKeyShareRequestResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	val, found := k.GetKeyShareRequest(
+		ctx,
+		req.Identity,
+	)
+	if !found {
+		return nil, types.ErrKeyShareRequestNotFound
+	}
+
+	return &types.QueryGetKeyShareRequestResponse{KeyShareRequest: val}, nil
+}
+
+func (k Keeper) KeyShareRequestsByStatus(goCtx context.Context, req *types.QueryKeyShareRequestsByStatusRequest) (*types.QueryKeyShareRequestsByStatusResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if _, ok := types.KeyShareRequestStatus_name[int32(req.Status)]; !ok {
+		return nil, status.Error(codes.InvalidArgument, "invalid key share request status")
+	}
+
+	var keyShareRequests []types.KeyShareRequest
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	store := ctx.KVStore(k.storeKey)
+	keyShareRequestStore := prefix.NewStore(store, types.KeyPrefix(types.KeyShareRequestKeyPrefix))
+
+	pageRes, err := query.FilteredPaginate(keyShareRequestStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var keyShareRequest types.KeyShareRequest
+		if err := k.cdc.Unmarshal(value, &keyShareRequest); err != nil {
+			return false, err
+		}
+
+		if keyShareRequest.Status() != req.Status {
+			return false, nil
+		}
+
+		if accumulate {
+			keyShareRequests = append(keyShareRequests, keyShareRequest)
+		}
+		return true, nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryKeyShareRequestsByStatusResponse{KeyShareRequests: keyShareRequests, Pagination: pageRes}, nil
+}
+
+// matchKeyShareRequest returns true if the key share request passes the filters set in the query
+func matchKeyShareRequest(keyShareRequest types.KeyShareRequest, req *types.QueryAllKeyShareRequestRequest) bool {
+	if req.CounterpartyChannel != "" &&
+		(keyShareRequest.Counterparty == nil || keyShareRequest.Counterparty.ChannelID != req.CounterpartyChannel) {
+		return false
+	}
+
+	if req.ProposalId != "" && keyShareRequest.ProposalId != req.ProposalId {
+		return false
+	}
+
+	if req.FilterBySent && keyShareRequest.Sent != req.Sent {
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,153 @@
+package keeper_test
+
+import (
+	"strconv"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "fairyring/testutil/keeper"
+	"fairyring/testutil/nullify"
+	"fairyring/x/keyshare/keeper"
+	"fairyring/x/keyshare/types"
+)
+
+func createNKeyShareRequest(keeper *keeper.Keeper, ctx sdk.Context, n int) []types.KeyShareRequest {
+	items := make([]types.KeyShareRequest, n)
+	for i := range items {
+		items[i].Identity = types.IdentityFromRequestCount(uint64(i + 1))
+		items[i].ProposalId = strconv.Itoa(i % 2)
+		items[i].Counterparty = &types.CounterPartyIBCInfo{ChannelID: "channel-" + strconv.Itoa(i%3)}
+		items[i].Sent = i%2 == 0
+
+		keeper.SetKeyShareRequest(ctx, items[i])
+	}
+	return items
+}
+
+func TestKeyShareRequestQuerySingle(t *testing.T) {
+	keeper, ctx := keepertest.KeyshareKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	msgs := createNKeyShareRequest(keeper, ctx, 2)
+	for _, tc := range []struct {
+		desc     string
+		request  *types.QueryGetKeyShareRequestRequest
+		response *types.QueryGetKeyShareRequestResponse
+		err      error
+	}{
+		{
+			desc:     "First",
+			request:  &types.QueryGetKeyShareRequestRequest{Identity: msgs[0].Identity},
+			response: &types.QueryGetKeyShareRequestResponse{KeyShareRequest: msgs[0]},
+		},
+		{
+			desc:     "Second",
+			request:  &types.QueryGetKeyShareRequestRequest{Identity: msgs[1].Identity},
+			response: &types.QueryGetKeyShareRequestResponse{KeyShareRequest: msgs[1]},
+		},
+		{
+			desc:    "KeyNotFound",
+			request: &types.QueryGetKeyShareRequestRequest{Identity: "100000/rq"},
+			err:     types.ErrKeyShareRequestNotFound,
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := keeper.KeyShareRequest(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t,
+					nullify.Fill(tc.response),
+					nullify.Fill(response),
+				)
+			}
+		})
+	}
+}
+
+func TestKeyShareRequestQueryPaginated(t *testing.T) {
+	keeper, ctx := keepertest.KeyshareKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	msgs := createNKeyShareRequest(keeper, ctx, 6)
+
+	request := func(next []byte, offset, limit uint64, total bool) *types.QueryAllKeyShareRequestRequest {
+		return &types.QueryAllKeyShareRequestRequest{
+			Pagination: &query.PageRequest{
+				Key:        next,
+				Offset:     offset,
+				Limit:      limit,
+				CountTotal: total,
+			},
+		}
+	}
+	t.Run("ByOffset", func(t *testing.T) {
+		step := 2
+		for i := 0; i < len(msgs); i += step {
+			resp, err := keeper.KeyShareRequestAll(wctx, request(nil, uint64(i), uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.KeyShareRequest), step)
+			require.Subset(t,
+				nullify.Fill(msgs),
+				nullify.Fill(resp.KeyShareRequest),
+			)
+		}
+	})
+	t.Run("ByKey", func(t *testing.T) {
+		step := 2
+		var next []byte
+		for i := 0; i < len(msgs); i += step {
+			resp, err := keeper.KeyShareRequestAll(wctx, request(next, 0, uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.KeyShareRequest), step)
+			require.Subset(t,
+				nullify.Fill(msgs),
+				nullify.Fill(resp.KeyShareRequest),
+			)
+			next = resp.Pagination.NextKey
+		}
+	})
+	t.Run("Total", func(t *testing.T) {
+		resp, err := keeper.KeyShareRequestAll(wctx, request(nil, 0, 0, true))
+		require.NoError(t, err)
+		require.Equal(t, len(msgs), int(resp.Pagination.Total))
+		require.ElementsMatch(t,
+			nullify.Fill(msgs),
+			nullify.Fill(resp.KeyShareRequest),
+		)
+	})
+	t.Run("Filtered", func(t *testing.T) {
+		req := request(nil, 0, 0, true)
+		req.CounterpartyChannel = "channel-0"
+		req.ProposalId = "0"
+		req.FilterBySent = true
+		req.Sent = true
+
+		// Only the first request is sent with proposal id 0 from channel-0, the fourth is on proposal id 1
+		resp, err := keeper.KeyShareRequestAll(wctx, req)
+		require.NoError(t, err)
+		require.Equal(t, 1, int(resp.Pagination.Total))
+		require.Equal(t, msgs[0].Identity, resp.KeyShareRequest[0].Identity)
+
+		req = request(nil, 0, 0, true)
+		req.FilterBySent = true
+		resp, err = keeper.KeyShareRequestAll(wctx, req)
+		require.NoError(t, err)
+		require.Equal(t, 3, int(resp.Pagination.Total))
+		for _, keyShareRequest := range resp.KeyShareRequest {
+			require.False(t, keyShareRequest.Sent)
+		}
+	})
+	t.Run("InvalidRequest", func(t *testing.T) {
+		_, err := keeper.KeyShareRequestAll(wctx, nil)
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+	})
+}
@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	"context"
+
+	"fairyring/x/keyshare/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) RewardPool(goCtx context.Context, req *types.QueryRewardPoolRequest) (*types.QueryRewardPoolResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryRewardPoolResponse{
+		RewardPool:             k.GetRewardPool(ctx),
+		NextDistributionHeight: k.NextRewardDistributionHeight(ctx),
+	}, nil
+}
+
+func (k Keeper) PendingRewards(goCtx context.Context, req *types.QueryPendingRewardsRequest) (*types.QueryPendingRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	shares, _ := k.GetValidatorRewardShares(ctx, req.Validator)
+
+	return &types.QueryPendingRewardsResponse{
+		AcceptedShares: shares.AcceptedShares,
+		PendingRewards: k.GetPendingRewards(ctx, req.Validator),
+	}, nil
+}
@@ -52,6 +52,11 @@ func (k Keeper) OnRecvRequestAggrKeysharePacket(
 		return packetAck, err
 	}
 
+	var deadline uint64
+	if deadlineBlocks := k.KeyShareRequestDeadlineBlocks(ctx); deadlineBlocks != 0 {
+		deadline = uint64(ctx.BlockHeight()) + deadlineBlocks
+	}
+
 	var keyshareRequest = types.KeyShareRequest{
 		Identity: id,
 		Pubkey:   activePubKey.PublicKey,
@@ -63,9 +68,11 @@ func (k Keeper) OnRecvRequestAggrKeysharePacket(
 			ChannelID: packet.SourceChannel,
 			PortID:    packet.SourcePort,
 		},
-		AggrKeyshare: "",
-		ProposalId:   data.ProposalId,
-		Sent:         false,
+		AggrKeyshare:  "",
+		ProposalId:    data.ProposalId,
+		Sent:          false,
+		CreatedHeight: uint64(ctx.BlockHeight()),
+		Deadline:      deadline,
 	}
 
 	k.SetKeyShareRequest(ctx, keyshareRequest)
@@ -1,6 +1,9 @@
 package keeper
 
 import (
+	"strconv"
+	"time"
+
 	"fairyring/x/keyshare/types"
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
@@ -62,3 +65,27 @@ func (k Keeper) GetAllValidatorSet(ctx sdk.Context) (list []types.ValidatorSet)
 
 	return
 }
+
+// ResumePausedValidators reactivates the validators whose keyshare pause is over
+func (k Keeper) ResumePausedValidators(ctx sdk.Context) {
+	for _, eachValidator := range k.GetAllValidatorSet(ctx) {
+		if !eachValidator.IsPauseOver(ctx.BlockTime()) {
+			continue
+		}
+
+		eachValidator.IsActive = true
+		eachValidator.PausedUntil = time.Time{}
+		k.SetValidatorSet(ctx, eachValidator)
+
+		// Like a new registration, the validator starts a clean liveness window
+		// and is not expected to have submitted the keyshares of the pause
+		k.RemoveKeyshareLivenessInfo(ctx, eachValidator.Validator)
+		k.SetLastSubmittedHeight(ctx, eachValidator.Validator, strconv.FormatInt(ctx.BlockHeight(), 10))
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(types.KeyshareResumedEventType,
+				sdk.NewAttribute(types.KeyshareResumedEventValidator, eachValidator.Validator),
+			),
+		)
+	}
+}
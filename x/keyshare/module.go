@@ -2,12 +2,9 @@ package keyshare
 
 import (
 	"context"
-	"encoding/hex"
 	"encoding/json"
 	peptypes "fairyring/x/pep/types"
 	"fmt"
-	"github.com/cosmos/cosmos-sdk/telemetry"
-	"strconv"
 
 	// this line is used by starport scaffolding # 1
 
@@ -173,6 +170,14 @@ func (am AppModule) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
 		}
 	}
 
+	am.keeper.ResumePausedValidators(ctx)
+
+	am.keeper.ExpireGeneralKeyRequests(ctx)
+
+	am.keeper.ReleaseGeneralKeyRequests(ctx)
+
+	am.keeper.ProcessDkgRound(ctx)
+
 	height := uint64(ctx.BlockHeight())
 
 	ak, foundAk := am.keeper.GetActivePubKey(ctx)
@@ -207,7 +212,12 @@ func (am AppModule) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
 	if foundQk {
 		if qk.Expiry > height {
 			am.keeper.SetActivePubKey(ctx, types.ActivePubKey(qk))
-			am.pepKeeper.SetActivePubKey(ctx, peptypes.ActivePubKey(qk))
+			am.keeper.SetKeyShareAssignments(ctx, qk.Assignments)
+			am.pepKeeper.SetActivePubKey(ctx, peptypes.ActivePubKey{
+				PublicKey: qk.PublicKey,
+				Creator:   qk.Creator,
+				Expiry:    qk.Expiry,
+			})
 			if foundQc {
 				am.keeper.SetActiveCommitments(ctx, qc)
 			}
@@ -223,51 +233,12 @@ func (am AppModule) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
 // EndBlock contains the logic that is automatically triggered at the end of each block
 func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
 	am.keeper.Logger(ctx).Info(fmt.Sprintf("End Blocker of Height: %d", ctx.BlockHeight()))
-	validators := am.keeper.GetAllValidatorSet(ctx)
-	params := am.keeper.GetParams(ctx)
-
-	for _, eachValidator := range validators {
-		lastSubmittedHeight := am.keeper.GetLastSubmittedHeight(ctx, eachValidator.Validator)
-		am.keeper.Logger(ctx).Info(fmt.Sprintf("Last submitted: %s: %d", eachValidator.Validator, lastSubmittedHeight))
-		// Validator will be slashed if their last submitted height is N block ago
-		// Lets say N is 10, and last submitted height is 0, current height is 10
-		// then he/she will be slashed
-		if lastSubmittedHeight+params.GetMaxIdledBlock() > uint64(ctx.BlockHeight()) {
-			continue
-		}
-
-		savedConsAddrByte, err := hex.DecodeString(eachValidator.ConsAddr)
-		if err != nil {
-			am.keeper.Logger(ctx).Error(fmt.Sprintf("Error while decoding validator %s cons addr: %s", eachValidator.Validator, err.Error()))
-			continue
-		}
-
-		var consAddr sdk.ConsAddress
-		err = consAddr.Unmarshal(savedConsAddrByte)
-		if err != nil {
-			am.keeper.Logger(ctx).Error(fmt.Sprintf("Error while unmarshaling validator %s cons addr: %s", eachValidator.Validator, err.Error()))
-			continue
-		}
-
-		if val, found := am.keeper.GetActivePubKey(ctx); !found || len(val.PublicKey) == 0 {
-			// Not slashing validator if there is no active public key
-			am.keeper.SetLastSubmittedHeight(ctx, eachValidator.Validator, strconv.FormatInt(ctx.BlockHeight(), 10))
-			continue
-		}
-
-		am.keeper.StakingKeeper().Slash(
-			ctx,
-			consAddr,
-			ctx.BlockHeight()-1,
-			types.SlashPower,
-			params.SlashFractionNoKeyshare,
-		)
-
-		// After being slashed, his/her last submitted height will be set to the current block
-		// So he/she won't be slashed in the next block instead he/she will be slashed if he didn't submit for N block again.
-		am.keeper.SetLastSubmittedHeight(ctx, eachValidator.Validator, strconv.FormatInt(ctx.BlockHeight(), 10))
-		telemetry.IncrCounter(1, types.KeyTotalIdleValSlashed)
-	}
+	am.keeper.HandleKeyshareLiveness(ctx)
+	am.keeper.RemoveExpiredAuthorizedAddresses(ctx)
+	am.keeper.PruneKeyShares(ctx)
+	am.keeper.ProcessAggrKeyshareRetries(ctx)
+	am.keeper.SweepKeyShareRequests(ctx)
+	am.keeper.DistributeKeyshareRewards(ctx)
 
 	return []abci.ValidatorUpdate{}
 }
@@ -48,6 +48,50 @@ const (
 	// TODO: Determine the simulation weight value
 	defaultWeightMsgCreateGeneralKeyShare int = 100
 
+	opWeightMsgStartDkgRound = "op_weight_msg_start_dkg_round"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgStartDkgRound int = 100
+
+	opWeightMsgSubmitDkgDeal = "op_weight_msg_submit_dkg_deal"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgSubmitDkgDeal int = 100
+
+	opWeightMsgSubmitDkgComplaint = "op_weight_msg_submit_dkg_complaint"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgSubmitDkgComplaint int = 100
+
+	opWeightMsgSubmitDkgJustification = "op_weight_msg_submit_dkg_justification"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgSubmitDkgJustification int = 100
+
+	opWeightMsgSendKeyshareBatch = "op_weight_msg_send_keyshare_batch"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgSendKeyshareBatch int = 100
+
+	opWeightMsgUnjailKeyshareValidator = "op_weight_msg_unjail_keyshare_validator"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgUnjailKeyshareValidator int = 100
+
+	opWeightMsgDeRegisterValidator = "op_weight_msg_deregister_validator"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgDeRegisterValidator int = 100
+
+	opWeightMsgPauseKeyshare = "op_weight_msg_pause_keyshare"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgPauseKeyshare int = 100
+
+	opWeightMsgCommitKeyshare = "op_weight_msg_commit_keyshare"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgCommitKeyshare int = 100
+
+	opWeightMsgRequestGeneralKeyshare = "op_weight_msg_request_general_keyshare"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgRequestGeneralKeyshare int = 100
+
+	opWeightMsgResendAggrKeyshare = "op_weight_msg_resend_aggr_keyshare"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgResendAggrKeyshare int = 100
+
 	// this line is used by starport scaffolding # simapp/module/const
 )
 
@@ -175,6 +219,127 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 		keysharesimulation.SimulateMsgCreateGeneralKeyShare(am.accountKeeper, am.bankKeeper, am.keeper),
 	))
 
+	var weightMsgStartDkgRound int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgStartDkgRound, &weightMsgStartDkgRound, nil,
+		func(_ *rand.Rand) {
+			weightMsgStartDkgRound = defaultWeightMsgStartDkgRound
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgStartDkgRound,
+		keysharesimulation.SimulateMsgStartDkgRound(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgSubmitDkgDeal int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgSubmitDkgDeal, &weightMsgSubmitDkgDeal, nil,
+		func(_ *rand.Rand) {
+			weightMsgSubmitDkgDeal = defaultWeightMsgSubmitDkgDeal
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgSubmitDkgDeal,
+		keysharesimulation.SimulateMsgSubmitDkgDeal(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgSubmitDkgComplaint int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgSubmitDkgComplaint, &weightMsgSubmitDkgComplaint, nil,
+		func(_ *rand.Rand) {
+			weightMsgSubmitDkgComplaint = defaultWeightMsgSubmitDkgComplaint
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgSubmitDkgComplaint,
+		keysharesimulation.SimulateMsgSubmitDkgComplaint(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgSubmitDkgJustification int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgSubmitDkgJustification, &weightMsgSubmitDkgJustification, nil,
+		func(_ *rand.Rand) {
+			weightMsgSubmitDkgJustification = defaultWeightMsgSubmitDkgJustification
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgSubmitDkgJustification,
+		keysharesimulation.SimulateMsgSubmitDkgJustification(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgSendKeyshareBatch int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgSendKeyshareBatch, &weightMsgSendKeyshareBatch, nil,
+		func(_ *rand.Rand) {
+			weightMsgSendKeyshareBatch = defaultWeightMsgSendKeyshareBatch
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgSendKeyshareBatch,
+		keysharesimulation.SimulateMsgSendKeyshareBatch(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgUnjailKeyshareValidator int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgUnjailKeyshareValidator, &weightMsgUnjailKeyshareValidator, nil,
+		func(_ *rand.Rand) {
+			weightMsgUnjailKeyshareValidator = defaultWeightMsgUnjailKeyshareValidator
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgUnjailKeyshareValidator,
+		keysharesimulation.SimulateMsgUnjailKeyshareValidator(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgDeRegisterValidator int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgDeRegisterValidator, &weightMsgDeRegisterValidator, nil,
+		func(_ *rand.Rand) {
+			weightMsgDeRegisterValidator = defaultWeightMsgDeRegisterValidator
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgDeRegisterValidator,
+		keysharesimulation.SimulateMsgDeRegisterValidator(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgPauseKeyshare int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgPauseKeyshare, &weightMsgPauseKeyshare, nil,
+		func(_ *rand.Rand) {
+			weightMsgPauseKeyshare = defaultWeightMsgPauseKeyshare
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgPauseKeyshare,
+		keysharesimulation.SimulateMsgPauseKeyshare(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgCommitKeyshare int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgCommitKeyshare, &weightMsgCommitKeyshare, nil,
+		func(_ *rand.Rand) {
+			weightMsgCommitKeyshare = defaultWeightMsgCommitKeyshare
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgCommitKeyshare,
+		keysharesimulation.SimulateMsgCommitKeyshare(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgRequestGeneralKeyshare int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgRequestGeneralKeyshare, &weightMsgRequestGeneralKeyshare, nil,
+		func(_ *rand.Rand) {
+			weightMsgRequestGeneralKeyshare = defaultWeightMsgRequestGeneralKeyshare
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgRequestGeneralKeyshare,
+		keysharesimulation.SimulateMsgRequestGeneralKeyshare(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgResendAggrKeyshare int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgResendAggrKeyshare, &weightMsgResendAggrKeyshare, nil,
+		func(_ *rand.Rand) {
+			weightMsgResendAggrKeyshare = defaultWeightMsgResendAggrKeyshare
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgResendAggrKeyshare,
+		keysharesimulation.SimulateMsgResendAggrKeyshare(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
 	// this line is used by starport scaffolding # simapp/module/operation
 
 	return operations
@@ -32,7 +32,7 @@ func SimulateMsgCreateGeneralKeyShare(
 			IdValue: strconv.Itoa(i),
 		}
 
-		_, found := k.GetGeneralKeyShare(ctx, msg.Creator, msg.IdType, msg.IdValue)
+		_, found := k.GetGeneralKeyShare(ctx, msg.Creator, msg.IdType, msg.IdValue, msg.KeyShareIndex)
 		if found {
 			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "GeneralKeyShare already exist"), nil, nil
 		}
@@ -0,0 +1,464 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: fairyring/keyshare/aggr_keyshare_retry.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// AggrKeyshareRetry is an aggregated key packet that failed to be delivered,
+// queued to be sent again over its channel at the given height
+type AggrKeyshareRetry struct {
+	Height    uint64                     `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	PortId    string                     `protobuf:"bytes,2,opt,name=portId,proto3" json:"portId,omitempty"`
+	ChannelId string                     `protobuf:"bytes,3,opt,name=channelId,proto3" json:"channelId,omitempty"`
+	Packet    AggrKeyshareDataPacketData `protobuf:"bytes,4,opt,name=packet,proto3" json:"packet"`
+}
+
+func (m *AggrKeyshareRetry) Reset()         { *m = AggrKeyshareRetry{} }
+func (m *AggrKeyshareRetry) String() string { return proto.CompactTextString(m) }
+func (*AggrKeyshareRetry) ProtoMessage()    {}
+func (*AggrKeyshareRetry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_af96096f1e0115cf, []int{0}
+}
+func (m *AggrKeyshareRetry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AggrKeyshareRetry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AggrKeyshareRetry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AggrKeyshareRetry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AggrKeyshareRetry.Merge(m, src)
+}
+func (m *AggrKeyshareRetry) XXX_Size() int {
+	return m.Size()
+}
+func (m *AggrKeyshareRetry) XXX_DiscardUnknown() {
+	xxx_messageInfo_AggrKeyshareRetry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AggrKeyshareRetry proto.InternalMessageInfo
+
+func (m *AggrKeyshareRetry) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *AggrKeyshareRetry) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *AggrKeyshareRetry) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *AggrKeyshareRetry) GetPacket() AggrKeyshareDataPacketData {
+	if m != nil {
+		return m.Packet
+	}
+	return AggrKeyshareDataPacketData{}
+}
+
+func init() {
+	proto.RegisterType((*AggrKeyshareRetry)(nil), "fairyring.keyshare.AggrKeyshareRetry")
+}
+
+func init() {
+	proto.RegisterFile("fairyring/keyshare/aggr_keyshare_retry.proto", fileDescriptor_af96096f1e0115cf)
+}
+
+var fileDescriptor_af96096f1e0115cf = []byte{
+	// 246 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x49, 0x4b, 0xcc, 0x2c,
+	0xaa, 0x2c, 0xca, 0xcc, 0x4b, 0xd7, 0xcf, 0x4e, 0xad, 0x2c, 0xce, 0x48, 0x2c, 0x4a, 0xd5, 0x4f,
+	0x4c, 0x4f, 0x2f, 0x8a, 0x87, 0xf1, 0xe2, 0x8b, 0x52, 0x4b, 0x8a, 0x2a, 0xf5, 0x0a, 0x8a, 0xf2,
+	0x4b, 0xf2, 0x85, 0x84, 0xe0, 0xaa, 0xf5, 0x60, 0xf2, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60,
+	0x69, 0x7d, 0x10, 0x0b, 0xa2, 0x52, 0x4a, 0x1e, 0x8b, 0xb9, 0x05, 0x89, 0xc9, 0xd9, 0xa9, 0x25,
+	0x10, 0x05, 0x4a, 0xeb, 0x19, 0xb9, 0x04, 0x1d, 0xd3, 0xd3, 0x8b, 0xbc, 0xa1, 0xb2, 0x41, 0x20,
+	0x6b, 0x84, 0xc4, 0xb8, 0xd8, 0x32, 0x52, 0x33, 0xd3, 0x33, 0x4a, 0x24, 0x18, 0x15, 0x18, 0x35,
+	0x58, 0x82, 0xa0, 0x3c, 0x90, 0x78, 0x41, 0x7e, 0x51, 0x89, 0x67, 0x8a, 0x04, 0x93, 0x02, 0xa3,
+	0x06, 0x67, 0x10, 0x94, 0x27, 0x24, 0xc3, 0xc5, 0x99, 0x9c, 0x91, 0x98, 0x97, 0x97, 0x9a, 0xe3,
+	0x99, 0x22, 0xc1, 0x0c, 0x96, 0x42, 0x08, 0x08, 0xf9, 0x70, 0xb1, 0x41, 0xec, 0x94, 0x60, 0x51,
+	0x60, 0xd4, 0xe0, 0x36, 0xd2, 0xd3, 0xc3, 0x74, 0xbf, 0x1e, 0xb2, 0x23, 0x5c, 0x12, 0x4b, 0x12,
+	0x03, 0xc0, 0x3a, 0x40, 0x2c, 0x27, 0x96, 0x13, 0xf7, 0xe4, 0x19, 0x82, 0xa0, 0x66, 0x38, 0x99,
+	0x9c, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb,
+	0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x14, 0xc2, 0xb3, 0x15, 0x08,
+	0xef, 0x96, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0xbd, 0x6b, 0x0c, 0x18, 0x00, 0xf9, 0x1c,
+	0x0a, 0xcb, 0x69, 0x01, 0x00, 0x00,
+}
+
+func (m *AggrKeyshareRetry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AggrKeyshareRetry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AggrKeyshareRetry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Packet.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAggrKeyshareRetry(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintAggrKeyshareRetry(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintAggrKeyshareRetry(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintAggrKeyshareRetry(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAggrKeyshareRetry(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAggrKeyshareRetry(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AggrKeyshareRetry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovAggrKeyshareRetry(uint64(m.Height))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovAggrKeyshareRetry(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovAggrKeyshareRetry(uint64(l))
+	}
+	l = m.Packet.Size()
+	n += 1 + l + sovAggrKeyshareRetry(uint64(l))
+	return n
+}
+
+func sovAggrKeyshareRetry(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAggrKeyshareRetry(x uint64) (n int) {
+	return sovAggrKeyshareRetry(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *AggrKeyshareRetry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAggrKeyshareRetry
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AggrKeyshareRetry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AggrKeyshareRetry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAggrKeyshareRetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAggrKeyshareRetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAggrKeyshareRetry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAggrKeyshareRetry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAggrKeyshareRetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAggrKeyshareRetry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAggrKeyshareRetry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAggrKeyshareRetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAggrKeyshareRetry
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAggrKeyshareRetry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Packet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAggrKeyshareRetry(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAggrKeyshareRetry
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAggrKeyshareRetry(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAggrKeyshareRetry
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAggrKeyshareRetry
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAggrKeyshareRetry
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAggrKeyshareRetry
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAggrKeyshareRetry
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAggrKeyshareRetry
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAggrKeyshareRetry        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAggrKeyshareRetry          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAggrKeyshareRetry = fmt.Errorf("proto: unexpected end of group")
+)
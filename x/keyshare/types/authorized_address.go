@@ -0,0 +1,45 @@
+package types
+
+// IsUnrestricted returns true if the scope allows every key share
+func (s AuthorizedScope) IsUnrestricted() bool {
+	return !s.BlockKeyshares && len(s.GeneralIdTypes) == 0
+}
+
+// Validate checks the general key share id types of the scope are neither empty nor duplicated
+func (s AuthorizedScope) Validate() error {
+	seen := make(map[string]struct{}, len(s.GeneralIdTypes))
+	for _, idType := range s.GeneralIdTypes {
+		if idType == "" {
+			return ErrInvalidAuthorizedScope.Wrap("empty general id type")
+		}
+		if _, ok := seen[idType]; ok {
+			return ErrInvalidAuthorizedScope.Wrapf("duplicated general id type: %s", idType)
+		}
+		seen[idType] = struct{}{}
+	}
+	return nil
+}
+
+// IsExpired returns true if the authorization is expired at the given height,
+// an expiry height of 0 never expires
+func (a AuthorizedAddress) IsExpired(height uint64) bool {
+	return a.ExpiryHeight != 0 && height >= a.ExpiryHeight
+}
+
+// AllowsBlockKeyshares returns true if the authorized address can submit block key shares
+func (a AuthorizedAddress) AllowsBlockKeyshares() bool {
+	return a.Scope.IsUnrestricted() || a.Scope.BlockKeyshares
+}
+
+// AllowsGeneralKeyshares returns true if the authorized address can submit general key shares of the id type
+func (a AuthorizedAddress) AllowsGeneralKeyshares(idType string) bool {
+	if a.Scope.IsUnrestricted() {
+		return true
+	}
+	for _, t := range a.Scope.GeneralIdTypes {
+		if t == idType {
+			return true
+		}
+	}
+	return false
+}
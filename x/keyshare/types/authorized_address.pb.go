@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -22,17 +23,73 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// AuthorizedScope restricts the key shares an authorized address can submit,
+// an empty scope allows every key share
+type AuthorizedScope struct {
+	BlockKeyshares bool     `protobuf:"varint,1,opt,name=blockKeyshares,proto3" json:"blockKeyshares,omitempty"`
+	GeneralIdTypes []string `protobuf:"bytes,2,rep,name=generalIdTypes,proto3" json:"generalIdTypes,omitempty"`
+}
+
+func (m *AuthorizedScope) Reset()         { *m = AuthorizedScope{} }
+func (m *AuthorizedScope) String() string { return proto.CompactTextString(m) }
+func (*AuthorizedScope) ProtoMessage()    {}
+func (*AuthorizedScope) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b09dee94c56d60e, []int{0}
+}
+func (m *AuthorizedScope) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AuthorizedScope) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AuthorizedScope.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AuthorizedScope) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuthorizedScope.Merge(m, src)
+}
+func (m *AuthorizedScope) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuthorizedScope) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuthorizedScope.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuthorizedScope proto.InternalMessageInfo
+
+func (m *AuthorizedScope) GetBlockKeyshares() bool {
+	if m != nil {
+		return m.BlockKeyshares
+	}
+	return false
+}
+
+func (m *AuthorizedScope) GetGeneralIdTypes() []string {
+	if m != nil {
+		return m.GeneralIdTypes
+	}
+	return nil
+}
+
 type AuthorizedAddress struct {
-	Target       string `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
-	IsAuthorized bool   `protobuf:"varint,2,opt,name=isAuthorized,proto3" json:"isAuthorized,omitempty"`
-	AuthorizedBy string `protobuf:"bytes,3,opt,name=authorizedBy,proto3" json:"authorizedBy,omitempty"`
+	Target       string          `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	IsAuthorized bool            `protobuf:"varint,2,opt,name=isAuthorized,proto3" json:"isAuthorized,omitempty"`
+	AuthorizedBy string          `protobuf:"bytes,3,opt,name=authorizedBy,proto3" json:"authorizedBy,omitempty"`
+	ExpiryHeight uint64          `protobuf:"varint,4,opt,name=expiryHeight,proto3" json:"expiryHeight,omitempty"`
+	Scope        AuthorizedScope `protobuf:"bytes,5,opt,name=scope,proto3" json:"scope"`
 }
 
 func (m *AuthorizedAddress) Reset()         { *m = AuthorizedAddress{} }
 func (m *AuthorizedAddress) String() string { return proto.CompactTextString(m) }
 func (*AuthorizedAddress) ProtoMessage()    {}
 func (*AuthorizedAddress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7b09dee94c56d60e, []int{0}
+	return fileDescriptor_7b09dee94c56d60e, []int{1}
 }
 func (m *AuthorizedAddress) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -82,7 +139,22 @@ func (m *AuthorizedAddress) GetAuthorizedBy() string {
 	return ""
 }
 
+func (m *AuthorizedAddress) GetExpiryHeight() uint64 {
+	if m != nil {
+		return m.ExpiryHeight
+	}
+	return 0
+}
+
+func (m *AuthorizedAddress) GetScope() AuthorizedScope {
+	if m != nil {
+		return m.Scope
+	}
+	return AuthorizedScope{}
+}
+
 func init() {
+	proto.RegisterType((*AuthorizedScope)(nil), "fairyring.keyshare.AuthorizedScope")
 	proto.RegisterType((*AuthorizedAddress)(nil), "fairyring.keyshare.AuthorizedAddress")
 }
 
@@ -91,19 +163,68 @@ func init() {
 }
 
 var fileDescriptor_7b09dee94c56d60e = []byte{
-	// 179 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x4e, 0x4b, 0xcc, 0x2c,
-	0xaa, 0x2c, 0xca, 0xcc, 0x4b, 0xd7, 0xcf, 0x4e, 0xad, 0x2c, 0xce, 0x48, 0x2c, 0x4a, 0xd5, 0x4f,
-	0x2c, 0x2d, 0xc9, 0xc8, 0x2f, 0xca, 0xac, 0x4a, 0x4d, 0x89, 0x4f, 0x4c, 0x49, 0x29, 0x4a, 0x2d,
-	0x2e, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x82, 0x2b, 0xd6, 0x83, 0x29, 0x56, 0x2a,
-	0xe6, 0x12, 0x74, 0x84, 0xab, 0x77, 0x84, 0x28, 0x17, 0x12, 0xe3, 0x62, 0x2b, 0x49, 0x2c, 0x4a,
-	0x4f, 0x2d, 0x91, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x0c, 0x82, 0xf2, 0x84, 0x94, 0xb8, 0x78, 0x32,
-	0x8b, 0x11, 0xca, 0x25, 0x98, 0x14, 0x18, 0x35, 0x38, 0x82, 0x50, 0xc4, 0x40, 0x6a, 0x10, 0x0e,
-	0x70, 0xaa, 0x94, 0x60, 0x06, 0x9b, 0x80, 0x22, 0xe6, 0x64, 0x72, 0xe2, 0x91, 0x1c, 0xe3, 0x85,
-	0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3,
-	0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x52, 0x08, 0xff, 0x54, 0x20, 0x7c, 0x54, 0x52, 0x59, 0x90, 0x5a,
-	0x9c, 0xc4, 0x06, 0xf6, 0x85, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0x25, 0xfc, 0x33, 0x00, 0xf4,
-	0x00, 0x00, 0x00,
+	// 296 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x91, 0x4f, 0x4b, 0xc3, 0x30,
+	0x18, 0xc6, 0x9b, 0xfd, 0xc3, 0x45, 0x51, 0x0c, 0x22, 0x61, 0x87, 0x58, 0x26, 0x48, 0x41, 0x68,
+	0x41, 0xbd, 0xcb, 0x7a, 0x52, 0xbc, 0x55, 0x4f, 0x5e, 0x24, 0x5b, 0x63, 0x1a, 0x36, 0x96, 0x92,
+	0x44, 0x58, 0xfd, 0x14, 0x7e, 0xac, 0x1d, 0x77, 0xdc, 0x49, 0xa4, 0xfd, 0x22, 0x92, 0x76, 0xae,
+	0xb4, 0xde, 0x92, 0x27, 0xbf, 0xf7, 0x79, 0xf3, 0x3e, 0x2f, 0xbc, 0x7e, 0xa7, 0x42, 0x65, 0x4a,
+	0x2c, 0x79, 0x30, 0x67, 0x99, 0x4e, 0xa8, 0x62, 0x01, 0xfd, 0x30, 0x89, 0x54, 0xe2, 0x93, 0xc5,
+	0x6f, 0x34, 0x8e, 0x15, 0xd3, 0xda, 0x4f, 0x95, 0x34, 0x12, 0xa1, 0x3d, 0xec, 0xff, 0xc1, 0xa3,
+	0x33, 0x2e, 0xb9, 0x2c, 0x9f, 0x03, 0x7b, 0xaa, 0xc8, 0x31, 0x85, 0x27, 0x93, 0xbd, 0xcb, 0xf3,
+	0x4c, 0xa6, 0x0c, 0x5d, 0xc1, 0xe3, 0xe9, 0x42, 0xce, 0xe6, 0x4f, 0xbb, 0x4a, 0x8d, 0x81, 0x0b,
+	0xbc, 0x83, 0xa8, 0xa5, 0x5a, 0x8e, 0xb3, 0x25, 0x53, 0x74, 0xf1, 0x18, 0xbf, 0x64, 0x29, 0xd3,
+	0xb8, 0xe3, 0x76, 0xbd, 0x61, 0xd4, 0x52, 0xc7, 0x5b, 0x00, 0x4f, 0xeb, 0x1e, 0x93, 0xea, 0xa3,
+	0xe8, 0x1c, 0x0e, 0x0c, 0x55, 0x9c, 0x99, 0xd2, 0x7d, 0x18, 0xed, 0x6e, 0x68, 0x0c, 0x8f, 0x84,
+	0xae, 0x71, 0xdc, 0x29, 0x7b, 0x37, 0x34, 0xcb, 0xd4, 0xa3, 0x87, 0x19, 0xee, 0x96, 0x0e, 0x0d,
+	0xcd, 0x32, 0x6c, 0x95, 0x0a, 0x95, 0x3d, 0x30, 0xc1, 0x13, 0x83, 0x7b, 0x2e, 0xf0, 0x7a, 0x51,
+	0x43, 0x43, 0xf7, 0xb0, 0xaf, 0xed, 0xc8, 0xb8, 0xef, 0x02, 0xef, 0xf0, 0xe6, 0xd2, 0xff, 0x1f,
+	0x9b, 0xdf, 0x4a, 0x27, 0xec, 0xad, 0xbf, 0x2f, 0x9c, 0xa8, 0xaa, 0x0b, 0xef, 0xd6, 0x39, 0x01,
+	0x9b, 0x9c, 0x80, 0x9f, 0x9c, 0x80, 0xaf, 0x82, 0x38, 0x9b, 0x82, 0x38, 0xdb, 0x82, 0x38, 0xaf,
+	0xa3, 0x7a, 0x5d, 0xab, 0x7a, 0x61, 0xc6, 0x06, 0x32, 0x1d, 0x94, 0xd1, 0xdf, 0xfe, 0x0e, 0x00,
+	0xb8, 0x47, 0x9d, 0xce, 0xd3, 0x01, 0x00, 0x00,
+}
+
+func (m *AuthorizedScope) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuthorizedScope) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuthorizedScope) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GeneralIdTypes) > 0 {
+		for iNdEx := len(m.GeneralIdTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.GeneralIdTypes[iNdEx])
+			copy(dAtA[i:], m.GeneralIdTypes[iNdEx])
+			i = encodeVarintAuthorizedAddress(dAtA, i, uint64(len(m.GeneralIdTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.BlockKeyshares {
+		i--
+		if m.BlockKeyshares {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
 func (m *AuthorizedAddress) Marshal() (dAtA []byte, err error) {
@@ -126,6 +247,21 @@ func (m *AuthorizedAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Scope.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAuthorizedAddress(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintAuthorizedAddress(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.AuthorizedBy) > 0 {
 		i -= len(m.AuthorizedBy)
 		copy(dAtA[i:], m.AuthorizedBy)
@@ -164,6 +300,24 @@ func encodeVarintAuthorizedAddress(dAtA []byte, offset int, v uint64) int {
 	dAtA[offset] = uint8(v)
 	return base
 }
+func (m *AuthorizedScope) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockKeyshares {
+		n += 2
+	}
+	if len(m.GeneralIdTypes) > 0 {
+		for _, s := range m.GeneralIdTypes {
+			l = len(s)
+			n += 1 + l + sovAuthorizedAddress(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *AuthorizedAddress) Size() (n int) {
 	if m == nil {
 		return 0
@@ -181,6 +335,11 @@ func (m *AuthorizedAddress) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovAuthorizedAddress(uint64(l))
 	}
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovAuthorizedAddress(uint64(m.ExpiryHeight))
+	}
+	l = m.Scope.Size()
+	n += 1 + l + sovAuthorizedAddress(uint64(l))
 	return n
 }
 
@@ -190,6 +349,108 @@ func sovAuthorizedAddress(x uint64) (n int) {
 func sozAuthorizedAddress(x uint64) (n int) {
 	return sovAuthorizedAddress(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
+func (m *AuthorizedScope) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthorizedAddress
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AuthorizedScope: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AuthorizedScope: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockKeyshares", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorizedAddress
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BlockKeyshares = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GeneralIdTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorizedAddress
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthorizedAddress
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthorizedAddress
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GeneralIdTypes = append(m.GeneralIdTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthorizedAddress(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthorizedAddress
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *AuthorizedAddress) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -303,6 +564,58 @@ func (m *AuthorizedAddress) Unmarshal(dAtA []byte) error {
 			}
 			m.AuthorizedBy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorizedAddress
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorizedAddress
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthorizedAddress
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthorizedAddress
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Scope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuthorizedAddress(dAtA[iNdEx:])
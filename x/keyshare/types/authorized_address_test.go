@@ -0,0 +1,38 @@
+package types_test
+
+import (
+	"testing"
+
+	"fairyring/x/keyshare/types"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthorizedScope_Validate(t *testing.T) {
+	require.NoError(t, types.AuthorizedScope{}.Validate())
+	require.NoError(t, types.AuthorizedScope{BlockKeyshares: true, GeneralIdTypes: []string{"a", "b"}}.Validate())
+	require.ErrorIs(t, types.AuthorizedScope{GeneralIdTypes: []string{""}}.Validate(), types.ErrInvalidAuthorizedScope)
+	require.ErrorIs(t, types.AuthorizedScope{GeneralIdTypes: []string{"a", "a"}}.Validate(), types.ErrInvalidAuthorizedScope)
+}
+
+func TestAuthorizedAddress_IsExpired(t *testing.T) {
+	require.False(t, types.AuthorizedAddress{}.IsExpired(100))
+	require.False(t, types.AuthorizedAddress{ExpiryHeight: 10}.IsExpired(9))
+	require.True(t, types.AuthorizedAddress{ExpiryHeight: 10}.IsExpired(10))
+	require.True(t, types.AuthorizedAddress{ExpiryHeight: 10}.IsExpired(11))
+}
+
+func TestAuthorizedAddress_Scope(t *testing.T) {
+	unrestricted := types.AuthorizedAddress{}
+	require.True(t, unrestricted.AllowsBlockKeyshares())
+	require.True(t, unrestricted.AllowsGeneralKeyshares("any"))
+
+	blockOnly := types.AuthorizedAddress{Scope: types.AuthorizedScope{BlockKeyshares: true}}
+	require.True(t, blockOnly.AllowsBlockKeyshares())
+	require.False(t, blockOnly.AllowsGeneralKeyshares("any"))
+
+	generalOnly := types.AuthorizedAddress{Scope: types.AuthorizedScope{GeneralIdTypes: []string{"gov"}}}
+	require.False(t, generalOnly.AllowsBlockKeyshares())
+	require.True(t, generalOnly.AllowsGeneralKeyshares("gov"))
+	require.False(t, generalOnly.AllowsGeneralKeyshares("other"))
+}
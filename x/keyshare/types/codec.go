@@ -21,6 +21,17 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateAuthorizedAddress{}, "keyshare/UpdateAuthorizedAddress", nil)
 	cdc.RegisterConcrete(&MsgDeleteAuthorizedAddress{}, "keyshare/DeleteAuthorizedAddress", nil)
 	cdc.RegisterConcrete(&MsgCreateGeneralKeyShare{}, "keyshare/CreateGeneralKeyShare", nil)
+	cdc.RegisterConcrete(&MsgStartDkgRound{}, "keyshare/StartDkgRound", nil)
+	cdc.RegisterConcrete(&MsgSubmitDkgDeal{}, "keyshare/SubmitDkgDeal", nil)
+	cdc.RegisterConcrete(&MsgSubmitDkgComplaint{}, "keyshare/SubmitDkgComplaint", nil)
+	cdc.RegisterConcrete(&MsgSubmitDkgJustification{}, "keyshare/SubmitDkgJustification", nil)
+	cdc.RegisterConcrete(&MsgSendKeyshareBatch{}, "keyshare/SendKeyshareBatch", nil)
+	cdc.RegisterConcrete(&MsgUnjailKeyshareValidator{}, "keyshare/UnjailKeyshareValidator", nil)
+	cdc.RegisterConcrete(&MsgDeRegisterValidator{}, "keyshare/DeRegisterValidator", nil)
+	cdc.RegisterConcrete(&MsgPauseKeyshare{}, "keyshare/PauseKeyshare", nil)
+	cdc.RegisterConcrete(&MsgCommitKeyshare{}, "keyshare/CommitKeyshare", nil)
+	cdc.RegisterConcrete(&MsgRequestGeneralKeyshare{}, "keyshare/RequestGeneralKeyshare", nil)
+	cdc.RegisterConcrete(&MsgResendAggrKeyshare{}, "keyshare/ResendAggrKeyshare", nil)
 
 	// this line is used by starport scaffolding # 2
 }
@@ -43,6 +54,31 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgCreateGeneralKeyShare{},
 	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgStartDkgRound{},
+		&MsgSubmitDkgDeal{},
+		&MsgSubmitDkgComplaint{},
+		&MsgSubmitDkgJustification{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSendKeyshareBatch{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgUnjailKeyshareValidator{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgDeRegisterValidator{},
+		&MsgPauseKeyshare{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgCommitKeyshare{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgRequestGeneralKeyshare{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgResendAggrKeyshare{},
+	)
 	// this line is used by starport scaffolding # 3
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
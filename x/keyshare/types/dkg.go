@@ -0,0 +1,111 @@
+package types
+
+import (
+	"encoding/hex"
+
+	"github.com/drand/kyber"
+	bls "github.com/drand/kyber-bls12381"
+)
+
+const (
+	// DkgShareHexLen is the length of a hex encoded share scalar revealed in a justification
+	DkgShareHexLen = 64
+)
+
+// IsInProgress returns true if the round has not been finalized or failed yet
+func (r DkgRound) IsInProgress() bool {
+	return r.Phase != DKG_PHASE_FINALIZED && r.Phase != DKG_PHASE_FAILED
+}
+
+// GetParticipant returns the participant entry of the given validator
+func (r DkgRound) GetParticipant(validator string) (DkgParticipant, bool) {
+	for _, p := range r.Participants {
+		if p.Validator == validator {
+			return p, true
+		}
+	}
+	return DkgParticipant{}, false
+}
+
+// TotalShares returns the number of share indices dealt to the participants
+func (r DkgRound) TotalShares() (total uint64) {
+	for _, p := range r.Participants {
+		total += uint64(len(p.ShareIndices))
+	}
+	return
+}
+
+// ParseDkgCommitments decodes the hex encoded G1 coefficient commitments of a deal
+func ParseDkgCommitments(commitments []string) ([]kyber.Point, error) {
+	suite := bls.NewBLS12381Suite()
+	points := make([]kyber.Point, 0, len(commitments))
+	for _, c := range commitments {
+		b, err := hex.DecodeString(c)
+		if err != nil {
+			return nil, ErrDecodingCommitment.Wrap(err.Error())
+		}
+		p := suite.G1().Point()
+		if err = p.UnmarshalBinary(b); err != nil {
+			return nil, ErrUnmarshallingCommitment.Wrap(err.Error())
+		}
+		points = append(points, p)
+	}
+	return points, nil
+}
+
+// ParseDkgShare decodes a hex encoded share scalar
+func ParseDkgShare(share string) (kyber.Scalar, error) {
+	b, err := hex.DecodeString(share)
+	if err != nil {
+		return nil, ErrInvalidDkgJustification.Wrap(err.Error())
+	}
+	s := bls.NewKyberScalar()
+	if err = s.UnmarshalBinary(b); err != nil {
+		return nil, ErrInvalidDkgJustification.Wrap(err.Error())
+	}
+	return s, nil
+}
+
+// EvalDkgCommitments evaluates the committed polynomial at the given index in the exponent,
+// returning the public commitment of the share dealt to that index
+func EvalDkgCommitments(commitments []kyber.Point, index uint64) kyber.Point {
+	suite := bls.NewBLS12381Suite()
+	x := bls.NewKyberScalar().SetInt64(int64(index))
+	xk := bls.NewKyberScalar().One()
+	result := suite.G1().Point().Null()
+	for _, c := range commitments {
+		result = suite.G1().Point().Add(result, suite.G1().Point().Mul(xk, c))
+		xk = bls.NewKyberScalar().Mul(xk, x)
+	}
+	return result
+}
+
+// VerifyDkgShare checks a revealed share against the dealer's coefficient commitments
+func VerifyDkgShare(commitments []kyber.Point, index uint64, share kyber.Scalar) bool {
+	suite := bls.NewBLS12381Suite()
+	expected := EvalDkgCommitments(commitments, index)
+	return suite.G1().Point().Mul(share, nil).Equal(expected)
+}
+
+// AggregateDkgDeals combines the commitments of all qualified deals into the master public key
+// and the per index share commitments used to verify key shares
+func AggregateDkgDeals(deals [][]kyber.Point, totalShares uint64) (kyber.Point, []kyber.Point) {
+	suite := bls.NewBLS12381Suite()
+	pubKey := suite.G1().Point().Null()
+	shareCommitments := make([]kyber.Point, totalShares)
+	for i := range shareCommitments {
+		shareCommitments[i] = suite.G1().Point().Null()
+	}
+
+	for _, commitments := range deals {
+		pubKey = suite.G1().Point().Add(pubKey, commitments[0])
+		for i := range shareCommitments {
+			shareCommitments[i] = suite.G1().Point().Add(
+				shareCommitments[i],
+				EvalDkgCommitments(commitments, uint64(i+1)),
+			)
+		}
+	}
+
+	return pubKey, shareCommitments
+}
@@ -0,0 +1,1952 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: fairyring/keyshare/dkg.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type DkgPhase int32
+
+const (
+	DKG_PHASE_DEAL          DkgPhase = 0
+	DKG_PHASE_COMPLAINT     DkgPhase = 1
+	DKG_PHASE_JUSTIFICATION DkgPhase = 2
+	DKG_PHASE_FINALIZED     DkgPhase = 3
+	DKG_PHASE_FAILED        DkgPhase = 4
+)
+
+var DkgPhase_name = map[int32]string{
+	0: "DKG_PHASE_DEAL",
+	1: "DKG_PHASE_COMPLAINT",
+	2: "DKG_PHASE_JUSTIFICATION",
+	3: "DKG_PHASE_FINALIZED",
+	4: "DKG_PHASE_FAILED",
+}
+
+var DkgPhase_value = map[string]int32{
+	"DKG_PHASE_DEAL":          0,
+	"DKG_PHASE_COMPLAINT":     1,
+	"DKG_PHASE_JUSTIFICATION": 2,
+	"DKG_PHASE_FINALIZED":     3,
+	"DKG_PHASE_FAILED":        4,
+}
+
+func (x DkgPhase) String() string {
+	return proto.EnumName(DkgPhase_name, int32(x))
+}
+
+func (DkgPhase) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2eb681380d9b9683, []int{0}
+}
+
+type DkgParticipant struct {
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	// share indices dealt to the participant, weighted by its voting power with stake weighted aggregation
+	ShareIndices []uint64 `protobuf:"varint,2,rep,packed,name=shareIndices,proto3" json:"shareIndices,omitempty"`
+	Weight       uint64   `protobuf:"varint,3,opt,name=weight,proto3" json:"weight,omitempty"`
+}
+
+func (m *DkgParticipant) Reset()         { *m = DkgParticipant{} }
+func (m *DkgParticipant) String() string { return proto.CompactTextString(m) }
+func (*DkgParticipant) ProtoMessage()    {}
+func (*DkgParticipant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2eb681380d9b9683, []int{0}
+}
+func (m *DkgParticipant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DkgParticipant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DkgParticipant.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DkgParticipant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DkgParticipant.Merge(m, src)
+}
+func (m *DkgParticipant) XXX_Size() int {
+	return m.Size()
+}
+func (m *DkgParticipant) XXX_DiscardUnknown() {
+	xxx_messageInfo_DkgParticipant.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DkgParticipant proto.InternalMessageInfo
+
+func (m *DkgParticipant) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+func (m *DkgParticipant) GetShareIndices() []uint64 {
+	if m != nil {
+		return m.ShareIndices
+	}
+	return nil
+}
+
+func (m *DkgParticipant) GetWeight() uint64 {
+	if m != nil {
+		return m.Weight
+	}
+	return 0
+}
+
+type DkgRound struct {
+	Id                     uint64           `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Phase                  DkgPhase         `protobuf:"varint,2,opt,name=phase,proto3,enum=fairyring.keyshare.DkgPhase" json:"phase,omitempty"`
+	Threshold              uint64           `protobuf:"varint,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	Participants           []DkgParticipant `protobuf:"bytes,4,rep,name=participants,proto3" json:"participants"`
+	StartHeight            uint64           `protobuf:"varint,5,opt,name=startHeight,proto3" json:"startHeight,omitempty"`
+	DealEndHeight          uint64           `protobuf:"varint,6,opt,name=dealEndHeight,proto3" json:"dealEndHeight,omitempty"`
+	ComplaintEndHeight     uint64           `protobuf:"varint,7,opt,name=complaintEndHeight,proto3" json:"complaintEndHeight,omitempty"`
+	JustificationEndHeight uint64           `protobuf:"varint,8,opt,name=justificationEndHeight,proto3" json:"justificationEndHeight,omitempty"`
+	PublicKey              string           `protobuf:"bytes,9,opt,name=publicKey,proto3" json:"publicKey,omitempty"`
+	Commitments            []string         `protobuf:"bytes,10,rep,name=commitments,proto3" json:"commitments,omitempty"`
+	QualifiedDealers       []string         `protobuf:"bytes,11,rep,name=qualifiedDealers,proto3" json:"qualifiedDealers,omitempty"`
+}
+
+func (m *DkgRound) Reset()         { *m = DkgRound{} }
+func (m *DkgRound) String() string { return proto.CompactTextString(m) }
+func (*DkgRound) ProtoMessage()    {}
+func (*DkgRound) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2eb681380d9b9683, []int{1}
+}
+func (m *DkgRound) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DkgRound) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DkgRound.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DkgRound) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DkgRound.Merge(m, src)
+}
+func (m *DkgRound) XXX_Size() int {
+	return m.Size()
+}
+func (m *DkgRound) XXX_DiscardUnknown() {
+	xxx_messageInfo_DkgRound.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DkgRound proto.InternalMessageInfo
+
+func (m *DkgRound) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *DkgRound) GetPhase() DkgPhase {
+	if m != nil {
+		return m.Phase
+	}
+	return DKG_PHASE_DEAL
+}
+
+func (m *DkgRound) GetThreshold() uint64 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *DkgRound) GetParticipants() []DkgParticipant {
+	if m != nil {
+		return m.Participants
+	}
+	return nil
+}
+
+func (m *DkgRound) GetStartHeight() uint64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *DkgRound) GetDealEndHeight() uint64 {
+	if m != nil {
+		return m.DealEndHeight
+	}
+	return 0
+}
+
+func (m *DkgRound) GetComplaintEndHeight() uint64 {
+	if m != nil {
+		return m.ComplaintEndHeight
+	}
+	return 0
+}
+
+func (m *DkgRound) GetJustificationEndHeight() uint64 {
+	if m != nil {
+		return m.JustificationEndHeight
+	}
+	return 0
+}
+
+func (m *DkgRound) GetPublicKey() string {
+	if m != nil {
+		return m.PublicKey
+	}
+	return ""
+}
+
+func (m *DkgRound) GetCommitments() []string {
+	if m != nil {
+		return m.Commitments
+	}
+	return nil
+}
+
+func (m *DkgRound) GetQualifiedDealers() []string {
+	if m != nil {
+		return m.QualifiedDealers
+	}
+	return nil
+}
+
+type DkgEncryptedShare struct {
+	Index uint64 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Data  string `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *DkgEncryptedShare) Reset()         { *m = DkgEncryptedShare{} }
+func (m *DkgEncryptedShare) String() string { return proto.CompactTextString(m) }
+func (*DkgEncryptedShare) ProtoMessage()    {}
+func (*DkgEncryptedShare) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2eb681380d9b9683, []int{2}
+}
+func (m *DkgEncryptedShare) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DkgEncryptedShare) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DkgEncryptedShare.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DkgEncryptedShare) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DkgEncryptedShare.Merge(m, src)
+}
+func (m *DkgEncryptedShare) XXX_Size() int {
+	return m.Size()
+}
+func (m *DkgEncryptedShare) XXX_DiscardUnknown() {
+	xxx_messageInfo_DkgEncryptedShare.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DkgEncryptedShare proto.InternalMessageInfo
+
+func (m *DkgEncryptedShare) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *DkgEncryptedShare) GetData() string {
+	if m != nil {
+		return m.Data
+	}
+	return ""
+}
+
+type DkgDeal struct {
+	RoundId         uint64              `protobuf:"varint,1,opt,name=roundId,proto3" json:"roundId,omitempty"`
+	Dealer          string              `protobuf:"bytes,2,opt,name=dealer,proto3" json:"dealer,omitempty"`
+	Commitments     []string            `protobuf:"bytes,3,rep,name=commitments,proto3" json:"commitments,omitempty"`
+	EncryptedShares []DkgEncryptedShare `protobuf:"bytes,4,rep,name=encryptedShares,proto3" json:"encryptedShares"`
+}
+
+func (m *DkgDeal) Reset()         { *m = DkgDeal{} }
+func (m *DkgDeal) String() string { return proto.CompactTextString(m) }
+func (*DkgDeal) ProtoMessage()    {}
+func (*DkgDeal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2eb681380d9b9683, []int{3}
+}
+func (m *DkgDeal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DkgDeal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DkgDeal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DkgDeal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DkgDeal.Merge(m, src)
+}
+func (m *DkgDeal) XXX_Size() int {
+	return m.Size()
+}
+func (m *DkgDeal) XXX_DiscardUnknown() {
+	xxx_messageInfo_DkgDeal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DkgDeal proto.InternalMessageInfo
+
+func (m *DkgDeal) GetRoundId() uint64 {
+	if m != nil {
+		return m.RoundId
+	}
+	return 0
+}
+
+func (m *DkgDeal) GetDealer() string {
+	if m != nil {
+		return m.Dealer
+	}
+	return ""
+}
+
+func (m *DkgDeal) GetCommitments() []string {
+	if m != nil {
+		return m.Commitments
+	}
+	return nil
+}
+
+func (m *DkgDeal) GetEncryptedShares() []DkgEncryptedShare {
+	if m != nil {
+		return m.EncryptedShares
+	}
+	return nil
+}
+
+type DkgComplaint struct {
+	RoundId    uint64 `protobuf:"varint,1,opt,name=roundId,proto3" json:"roundId,omitempty"`
+	Complainer string `protobuf:"bytes,2,opt,name=complainer,proto3" json:"complainer,omitempty"`
+	Dealer     string `protobuf:"bytes,3,opt,name=dealer,proto3" json:"dealer,omitempty"`
+	// shares revealed by the dealer for every share index of the complainer
+	Justifications []string `protobuf:"bytes,4,rep,name=justifications,proto3" json:"justifications,omitempty"`
+}
+
+func (m *DkgComplaint) Reset()         { *m = DkgComplaint{} }
+func (m *DkgComplaint) String() string { return proto.CompactTextString(m) }
+func (*DkgComplaint) ProtoMessage()    {}
+func (*DkgComplaint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2eb681380d9b9683, []int{4}
+}
+func (m *DkgComplaint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DkgComplaint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DkgComplaint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DkgComplaint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DkgComplaint.Merge(m, src)
+}
+func (m *DkgComplaint) XXX_Size() int {
+	return m.Size()
+}
+func (m *DkgComplaint) XXX_DiscardUnknown() {
+	xxx_messageInfo_DkgComplaint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DkgComplaint proto.InternalMessageInfo
+
+func (m *DkgComplaint) GetRoundId() uint64 {
+	if m != nil {
+		return m.RoundId
+	}
+	return 0
+}
+
+func (m *DkgComplaint) GetComplainer() string {
+	if m != nil {
+		return m.Complainer
+	}
+	return ""
+}
+
+func (m *DkgComplaint) GetDealer() string {
+	if m != nil {
+		return m.Dealer
+	}
+	return ""
+}
+
+func (m *DkgComplaint) GetJustifications() []string {
+	if m != nil {
+		return m.Justifications
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("fairyring.keyshare.DkgPhase", DkgPhase_name, DkgPhase_value)
+	proto.RegisterType((*DkgParticipant)(nil), "fairyring.keyshare.DkgParticipant")
+	proto.RegisterType((*DkgRound)(nil), "fairyring.keyshare.DkgRound")
+	proto.RegisterType((*DkgEncryptedShare)(nil), "fairyring.keyshare.DkgEncryptedShare")
+	proto.RegisterType((*DkgDeal)(nil), "fairyring.keyshare.DkgDeal")
+	proto.RegisterType((*DkgComplaint)(nil), "fairyring.keyshare.DkgComplaint")
+}
+
+func init() { proto.RegisterFile("fairyring/keyshare/dkg.proto", fileDescriptor_2eb681380d9b9683) }
+
+var fileDescriptor_2eb681380d9b9683 = []byte{
+	// 641 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0xcd, 0x4e, 0xdb, 0x40,
+	0x10, 0x8e, 0x63, 0xf3, 0x93, 0x81, 0xa6, 0xee, 0x16, 0x81, 0x45, 0x91, 0x6b, 0x59, 0x6d, 0x15,
+	0x71, 0x08, 0x12, 0xad, 0x7a, 0xeb, 0x21, 0xe0, 0x50, 0x5c, 0x52, 0x40, 0x06, 0x2e, 0x5c, 0xd0,
+	0xe2, 0x5d, 0x9c, 0xc5, 0x8e, 0xed, 0xda, 0x9b, 0x96, 0xbc, 0x01, 0xbd, 0xf5, 0x1d, 0xfa, 0x0a,
+	0x7d, 0x08, 0x8e, 0x9c, 0xaa, 0x9e, 0xaa, 0x0a, 0x5e, 0xa4, 0xf2, 0x1f, 0x8e, 0xa1, 0xe9, 0xcd,
+	0xf3, 0x7d, 0xdf, 0xcc, 0xce, 0xb7, 0x33, 0x5e, 0x58, 0x39, 0xc3, 0x2c, 0x1a, 0x45, 0xcc, 0x77,
+	0xd6, 0x5c, 0x3a, 0x8a, 0xfb, 0x38, 0xa2, 0x6b, 0xc4, 0x75, 0xda, 0x61, 0x14, 0xf0, 0x00, 0xa1,
+	0x3b, 0xb6, 0x5d, 0xb0, 0xcb, 0x0b, 0x4e, 0xe0, 0x04, 0x29, 0xbd, 0x96, 0x7c, 0x65, 0x4a, 0xfd,
+	0x1c, 0x9a, 0x86, 0xeb, 0xec, 0xe3, 0x88, 0x33, 0x9b, 0x85, 0xd8, 0xe7, 0x68, 0x05, 0x1a, 0x9f,
+	0xb1, 0xc7, 0x08, 0xe6, 0x41, 0xa4, 0x08, 0x9a, 0xd0, 0x6a, 0x58, 0x25, 0x80, 0x74, 0x98, 0x4f,
+	0xcb, 0x99, 0x3e, 0x61, 0x36, 0x8d, 0x95, 0xba, 0x26, 0xb6, 0x24, 0xab, 0x82, 0xa1, 0x45, 0x98,
+	0xfe, 0x42, 0x99, 0xd3, 0xe7, 0x8a, 0xa8, 0x09, 0x2d, 0xc9, 0xca, 0x23, 0xfd, 0xa7, 0x08, 0xb3,
+	0x86, 0xeb, 0x58, 0xc1, 0xd0, 0x27, 0xa8, 0x09, 0x75, 0x46, 0xd2, 0xfa, 0x92, 0x55, 0x67, 0x04,
+	0xad, 0xc3, 0x54, 0xd8, 0xc7, 0x31, 0x55, 0xea, 0x9a, 0xd0, 0x6a, 0xae, 0xaf, 0xb4, 0x1f, 0x5a,
+	0x68, 0x27, 0x9d, 0x26, 0x1a, 0x2b, 0x93, 0x26, 0xad, 0xf2, 0x7e, 0x44, 0xe3, 0x7e, 0xe0, 0x91,
+	0xfc, 0xac, 0x12, 0x40, 0x3d, 0x98, 0x0f, 0x4b, 0x5f, 0xb1, 0x22, 0x69, 0x62, 0x6b, 0x6e, 0x5d,
+	0x9f, 0x54, 0xb8, 0x94, 0x6e, 0x48, 0x57, 0xbf, 0x9f, 0xd7, 0xac, 0x4a, 0x36, 0xd2, 0x60, 0x2e,
+	0xe6, 0x38, 0xe2, 0xdb, 0x99, 0xb3, 0xa9, 0xf4, 0xb4, 0x71, 0x08, 0xbd, 0x80, 0x47, 0x84, 0x62,
+	0xaf, 0xeb, 0x93, 0x5c, 0x33, 0x9d, 0x6a, 0xaa, 0x20, 0x6a, 0x03, 0xb2, 0x83, 0x41, 0xe8, 0x61,
+	0xe6, 0xf3, 0x52, 0x3a, 0x93, 0x4a, 0xff, 0xc1, 0xa0, 0xb7, 0xb0, 0x78, 0x3e, 0x8c, 0x39, 0x3b,
+	0x63, 0x36, 0xe6, 0x2c, 0xf0, 0xcb, 0x9c, 0xd9, 0x34, 0x67, 0x02, 0x9b, 0xdc, 0x4d, 0x38, 0x3c,
+	0xf5, 0x98, 0xbd, 0x43, 0x47, 0x4a, 0x23, 0x1b, 0xe3, 0x1d, 0x90, 0xb8, 0xb1, 0x83, 0xc1, 0x80,
+	0xf1, 0x01, 0x4d, 0xae, 0x06, 0x34, 0xb1, 0xd5, 0xb0, 0xc6, 0x21, 0xb4, 0x0a, 0xf2, 0xa7, 0x21,
+	0xf6, 0xd8, 0x19, 0xa3, 0xc4, 0xa0, 0xd8, 0xa3, 0x51, 0xac, 0xcc, 0xa5, 0xb2, 0x07, 0xb8, 0xfe,
+	0x0e, 0x9e, 0x18, 0xae, 0xd3, 0xf5, 0xed, 0x68, 0x14, 0x72, 0x4a, 0x0e, 0x92, 0x3b, 0x45, 0x0b,
+	0x30, 0xc5, 0x7c, 0x42, 0x2f, 0xf2, 0x19, 0x67, 0x01, 0x42, 0x20, 0x11, 0xcc, 0x71, 0x3a, 0xe5,
+	0x86, 0x95, 0x7e, 0xeb, 0x3f, 0x04, 0x98, 0x31, 0x5c, 0x27, 0xa9, 0x86, 0x14, 0x98, 0x89, 0x92,
+	0xfd, 0x30, 0x8b, 0xdd, 0x28, 0xc2, 0x64, 0xab, 0x48, 0x7a, 0x5e, 0x9e, 0x9b, 0x47, 0xf7, 0xad,
+	0x88, 0x0f, 0xad, 0x1c, 0xc1, 0x63, 0x5a, 0xe9, 0xad, 0xd8, 0x85, 0x97, 0x13, 0x76, 0xa1, 0xea,
+	0x24, 0x5f, 0x87, 0xfb, 0x35, 0xf4, 0x4b, 0x01, 0xe6, 0x0d, 0xd7, 0xd9, 0x2c, 0x66, 0xf6, 0x9f,
+	0xde, 0x55, 0x80, 0x62, 0xb4, 0x77, 0xfd, 0x8f, 0x21, 0x63, 0xde, 0xc4, 0x8a, 0xb7, 0x57, 0xd0,
+	0xac, 0x8c, 0x37, 0x6b, 0xbc, 0x61, 0xdd, 0x43, 0x57, 0xbf, 0x0a, 0x30, 0x5b, 0xfc, 0x1c, 0x08,
+	0x41, 0xd3, 0xd8, 0x79, 0x7f, 0xb2, 0xbf, 0xdd, 0x39, 0xe8, 0x9e, 0x18, 0xdd, 0x4e, 0x4f, 0xae,
+	0xa1, 0x25, 0x78, 0x5a, 0x62, 0x9b, 0x7b, 0x1f, 0xf7, 0x7b, 0x1d, 0x73, 0xf7, 0x50, 0x16, 0xd0,
+	0x33, 0x58, 0x2a, 0x89, 0x0f, 0x47, 0x07, 0x87, 0xe6, 0x96, 0xb9, 0xd9, 0x39, 0x34, 0xf7, 0x76,
+	0xe5, 0x7a, 0x35, 0x6b, 0xcb, 0xdc, 0xed, 0xf4, 0xcc, 0xe3, 0xae, 0x21, 0x8b, 0x68, 0x01, 0xe4,
+	0x31, 0xa2, 0x63, 0xf6, 0xba, 0x86, 0x2c, 0x2d, 0x4b, 0x97, 0xdf, 0xd5, 0xda, 0xc6, 0x9b, 0xab,
+	0x1b, 0x55, 0xb8, 0xbe, 0x51, 0x85, 0x3f, 0x37, 0xaa, 0xf0, 0xed, 0x56, 0xad, 0x5d, 0xdf, 0xaa,
+	0xb5, 0x5f, 0xb7, 0x6a, 0xed, 0x78, 0xb9, 0x7c, 0xb3, 0x2e, 0xca, 0x57, 0x8b, 0x8f, 0x42, 0x1a,
+	0x9f, 0x4e, 0xa7, 0xcf, 0xd1, 0xeb, 0xbf, 0x03, 0x00, 0x74, 0xcc, 0x00, 0xf2, 0xd8, 0x04, 0x00,
+	0x00,
+}
+
+func (m *DkgParticipant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DkgParticipant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DkgParticipant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Weight != 0 {
+		i = encodeVarintDkg(dAtA, i, uint64(m.Weight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ShareIndices) > 0 {
+		dAtA2 := make([]byte, len(m.ShareIndices)*10)
+		var j1 int
+		for _, num := range m.ShareIndices {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintDkg(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintDkg(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DkgRound) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DkgRound) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DkgRound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.QualifiedDealers) > 0 {
+		for iNdEx := len(m.QualifiedDealers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.QualifiedDealers[iNdEx])
+			copy(dAtA[i:], m.QualifiedDealers[iNdEx])
+			i = encodeVarintDkg(dAtA, i, uint64(len(m.QualifiedDealers[iNdEx])))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.Commitments) > 0 {
+		for iNdEx := len(m.Commitments) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Commitments[iNdEx])
+			copy(dAtA[i:], m.Commitments[iNdEx])
+			i = encodeVarintDkg(dAtA, i, uint64(len(m.Commitments[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintDkg(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.JustificationEndHeight != 0 {
+		i = encodeVarintDkg(dAtA, i, uint64(m.JustificationEndHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.ComplaintEndHeight != 0 {
+		i = encodeVarintDkg(dAtA, i, uint64(m.ComplaintEndHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.DealEndHeight != 0 {
+		i = encodeVarintDkg(dAtA, i, uint64(m.DealEndHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintDkg(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Participants) > 0 {
+		for iNdEx := len(m.Participants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Participants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDkg(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Threshold != 0 {
+		i = encodeVarintDkg(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Phase != 0 {
+		i = encodeVarintDkg(dAtA, i, uint64(m.Phase))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintDkg(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DkgEncryptedShare) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DkgEncryptedShare) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DkgEncryptedShare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintDkg(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Index != 0 {
+		i = encodeVarintDkg(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DkgDeal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DkgDeal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DkgDeal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EncryptedShares) > 0 {
+		for iNdEx := len(m.EncryptedShares) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EncryptedShares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDkg(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Commitments) > 0 {
+		for iNdEx := len(m.Commitments) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Commitments[iNdEx])
+			copy(dAtA[i:], m.Commitments[iNdEx])
+			i = encodeVarintDkg(dAtA, i, uint64(len(m.Commitments[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Dealer) > 0 {
+		i -= len(m.Dealer)
+		copy(dAtA[i:], m.Dealer)
+		i = encodeVarintDkg(dAtA, i, uint64(len(m.Dealer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.RoundId != 0 {
+		i = encodeVarintDkg(dAtA, i, uint64(m.RoundId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DkgComplaint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DkgComplaint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DkgComplaint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Justifications) > 0 {
+		for iNdEx := len(m.Justifications) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Justifications[iNdEx])
+			copy(dAtA[i:], m.Justifications[iNdEx])
+			i = encodeVarintDkg(dAtA, i, uint64(len(m.Justifications[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Dealer) > 0 {
+		i -= len(m.Dealer)
+		copy(dAtA[i:], m.Dealer)
+		i = encodeVarintDkg(dAtA, i, uint64(len(m.Dealer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Complainer) > 0 {
+		i -= len(m.Complainer)
+		copy(dAtA[i:], m.Complainer)
+		i = encodeVarintDkg(dAtA, i, uint64(len(m.Complainer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.RoundId != 0 {
+		i = encodeVarintDkg(dAtA, i, uint64(m.RoundId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDkg(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDkg(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DkgParticipant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovDkg(uint64(l))
+	}
+	if len(m.ShareIndices) > 0 {
+		l = 0
+		for _, e := range m.ShareIndices {
+			l += sovDkg(uint64(e))
+		}
+		n += 1 + sovDkg(uint64(l)) + l
+	}
+	if m.Weight != 0 {
+		n += 1 + sovDkg(uint64(m.Weight))
+	}
+	return n
+}
+
+func (m *DkgRound) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovDkg(uint64(m.Id))
+	}
+	if m.Phase != 0 {
+		n += 1 + sovDkg(uint64(m.Phase))
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovDkg(uint64(m.Threshold))
+	}
+	if len(m.Participants) > 0 {
+		for _, e := range m.Participants {
+			l = e.Size()
+			n += 1 + l + sovDkg(uint64(l))
+		}
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovDkg(uint64(m.StartHeight))
+	}
+	if m.DealEndHeight != 0 {
+		n += 1 + sovDkg(uint64(m.DealEndHeight))
+	}
+	if m.ComplaintEndHeight != 0 {
+		n += 1 + sovDkg(uint64(m.ComplaintEndHeight))
+	}
+	if m.JustificationEndHeight != 0 {
+		n += 1 + sovDkg(uint64(m.JustificationEndHeight))
+	}
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovDkg(uint64(l))
+	}
+	if len(m.Commitments) > 0 {
+		for _, s := range m.Commitments {
+			l = len(s)
+			n += 1 + l + sovDkg(uint64(l))
+		}
+	}
+	if len(m.QualifiedDealers) > 0 {
+		for _, s := range m.QualifiedDealers {
+			l = len(s)
+			n += 1 + l + sovDkg(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DkgEncryptedShare) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovDkg(uint64(m.Index))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovDkg(uint64(l))
+	}
+	return n
+}
+
+func (m *DkgDeal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RoundId != 0 {
+		n += 1 + sovDkg(uint64(m.RoundId))
+	}
+	l = len(m.Dealer)
+	if l > 0 {
+		n += 1 + l + sovDkg(uint64(l))
+	}
+	if len(m.Commitments) > 0 {
+		for _, s := range m.Commitments {
+			l = len(s)
+			n += 1 + l + sovDkg(uint64(l))
+		}
+	}
+	if len(m.EncryptedShares) > 0 {
+		for _, e := range m.EncryptedShares {
+			l = e.Size()
+			n += 1 + l + sovDkg(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DkgComplaint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RoundId != 0 {
+		n += 1 + sovDkg(uint64(m.RoundId))
+	}
+	l = len(m.Complainer)
+	if l > 0 {
+		n += 1 + l + sovDkg(uint64(l))
+	}
+	l = len(m.Dealer)
+	if l > 0 {
+		n += 1 + l + sovDkg(uint64(l))
+	}
+	if len(m.Justifications) > 0 {
+		for _, s := range m.Justifications {
+			l = len(s)
+			n += 1 + l + sovDkg(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovDkg(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDkg(x uint64) (n int) {
+	return sovDkg(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DkgParticipant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDkg
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DkgParticipant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DkgParticipant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDkg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDkg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDkg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowDkg
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ShareIndices = append(m.ShareIndices, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowDkg
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthDkg
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthDkg
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ShareIndices) == 0 {
+					m.ShareIndices = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowDkg
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ShareIndices = append(m.ShareIndices, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareIndices", wireType)
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			m.Weight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDkg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Weight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDkg(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDkg
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DkgRound) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDkg
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DkgRound: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DkgRound: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDkg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			m.Phase = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDkg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Phase |= DkgPhase(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDkg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDkg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDkg
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDkg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Participants = append(m.Participants, DkgParticipant{})
+			if err := m.Participants[len(m.Participants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDkg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DealEndHeight", wireType)
+			}
+			m.DealEndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDkg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DealEndHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ComplaintEndHeight", wireType)
+			}
+			m.ComplaintEndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDkg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ComplaintEndHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JustificationEndHeight", wireType)
+			}
+			m.JustificationEndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDkg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JustificationEndHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDkg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDkg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDkg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitments", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDkg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDkg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDkg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commitments = append(m.Commitments, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QualifiedDealers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDkg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDkg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDkg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QualifiedDealers = append(m.QualifiedDealers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDkg(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDkg
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DkgEncryptedShare) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDkg
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DkgEncryptedShare: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DkgEncryptedShare: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDkg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDkg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDkg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDkg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDkg(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDkg
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DkgDeal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDkg
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DkgDeal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DkgDeal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoundId", wireType)
+			}
+			m.RoundId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDkg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RoundId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dealer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDkg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDkg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDkg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Dealer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitments", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDkg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDkg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDkg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commitments = append(m.Commitments, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EncryptedShares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDkg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDkg
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDkg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EncryptedShares = append(m.EncryptedShares, DkgEncryptedShare{})
+			if err := m.EncryptedShares[len(m.EncryptedShares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDkg(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDkg
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DkgComplaint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDkg
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DkgComplaint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DkgComplaint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoundId", wireType)
+			}
+			m.RoundId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDkg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RoundId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Complainer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDkg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDkg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDkg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Complainer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dealer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDkg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDkg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDkg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Dealer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Justifications", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDkg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDkg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDkg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Justifications = append(m.Justifications, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDkg(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDkg
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipDkg(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowDkg
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDkg
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDkg
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthDkg
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupDkg
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthDkg
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthDkg        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowDkg          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupDkg = fmt.Errorf("proto: unexpected end of group")
+)
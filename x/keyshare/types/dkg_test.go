@@ -0,0 +1,55 @@
+package types_test
+
+import (
+	"testing"
+
+	"fairyring/x/keyshare/types"
+
+	distIBE "github.com/FairBlock/DistributedIBE"
+	"github.com/drand/kyber"
+	bls "github.com/drand/kyber-bls12381"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyDkgShare(t *testing.T) {
+	shares, _, commits, err := distIBE.GenerateShares(4, 3)
+	require.NoError(t, err)
+
+	for i, share := range shares {
+		require.True(t, types.VerifyDkgShare(commits, uint64(i+1), share.Value))
+	}
+
+	require.False(t, types.VerifyDkgShare(commits, 1, shares[1].Value))
+	require.False(t, types.VerifyDkgShare(commits, 5, shares[0].Value))
+}
+
+func TestAggregateDkgDeals(t *testing.T) {
+	suite := bls.NewBLS12381Suite()
+	const participants = 4
+
+	var deals [][]kyber.Point
+	expectedPubKey := suite.G1().Point().Null()
+	expectedShares := make([]kyber.Scalar, participants)
+	for i := range expectedShares {
+		expectedShares[i] = bls.NewKyberScalar().Zero()
+	}
+
+	for dealer := 0; dealer < 3; dealer++ {
+		shares, mpk, commits, err := distIBE.GenerateShares(participants, 3)
+		require.NoError(t, err)
+
+		deals = append(deals, commits)
+		expectedPubKey = suite.G1().Point().Add(expectedPubKey, mpk)
+		for i, share := range shares {
+			expectedShares[i] = bls.NewKyberScalar().Add(expectedShares[i], share.Value)
+		}
+	}
+
+	pubKey, shareCommitments := types.AggregateDkgDeals(deals, participants)
+	require.True(t, pubKey.Equal(expectedPubKey))
+	require.Len(t, shareCommitments, participants)
+
+	for i, c := range shareCommitments {
+		require.True(t, c.Equal(suite.G1().Point().Mul(expectedShares[i], nil)))
+	}
+}
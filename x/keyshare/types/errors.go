@@ -33,6 +33,40 @@ var (
 	ErrInvalidVersion                 = sdkerrors.Register(ModuleName, 1123, "invalid version")
 	ErrRequestNotFound                = sdkerrors.Register(ModuleName, 1124, "no request found with this identity")
 	ErrNoAggregatedKeyshare           = sdkerrors.Register(ModuleName, 1125, "aggregated keyshare has not been generated")
+	ErrDkgRoundInProgress             = sdkerrors.Register(ModuleName, 1126, "a dkg round is already in progress")
+	ErrDkgRoundNotFound               = sdkerrors.Register(ModuleName, 1127, "dkg round not found")
+	ErrInvalidDkgPhase                = sdkerrors.Register(ModuleName, 1128, "dkg round is not in the expected phase")
+	ErrNotDkgParticipant              = sdkerrors.Register(ModuleName, 1129, "sender is not a participant of the dkg round")
+	ErrDkgDealAlreadyExists           = sdkerrors.Register(ModuleName, 1130, "dealer already submitted a deal for the dkg round")
+	ErrDkgDealNotFound                = sdkerrors.Register(ModuleName, 1131, "dkg deal not found")
+	ErrInvalidDkgDeal                 = sdkerrors.Register(ModuleName, 1132, "invalid dkg deal")
+	ErrDkgComplaintAlreadyExists      = sdkerrors.Register(ModuleName, 1133, "complaint against the dealer already submitted")
+	ErrDkgComplaintNotFound           = sdkerrors.Register(ModuleName, 1134, "dkg complaint not found")
+	ErrInvalidDkgJustification        = sdkerrors.Register(ModuleName, 1135, "invalid dkg justification")
+	ErrNoRegisteredValidator          = sdkerrors.Register(ModuleName, 1136, "no validator registered")
+	ErrInvalidKeyAggregationThreshold = sdkerrors.Register(ModuleName, 1137, "invalid key aggregation threshold")
+	ErrKeyShareIndexNotAssigned       = sdkerrors.Register(ModuleName, 1138, "key share index is not assigned to the validator")
+	ErrNoVotingPower                  = sdkerrors.Register(ModuleName, 1139, "registered validators have no voting power")
+	ErrInvalidKeyshareBatch           = sdkerrors.Register(ModuleName, 1140, "invalid keyshare batch")
+	ErrValidatorJailed                = sdkerrors.Register(ModuleName, 1141, "validator is jailed for submitting invalid key shares")
+	ErrValidatorNotJailed             = sdkerrors.Register(ModuleName, 1142, "validator is not jailed for submitting invalid key shares")
+	ErrValidatorStillJailed           = sdkerrors.Register(ModuleName, 1143, "validator jail period is not over")
+	ErrKeyshareLivenessNotFound       = sdkerrors.Register(ModuleName, 1144, "keyshare liveness info not found")
+	ErrValidatorPaused                = sdkerrors.Register(ModuleName, 1145, "validator keyshare duties are paused")
+	ErrInvalidPauseDuration           = sdkerrors.Register(ModuleName, 1146, "invalid keyshare pause duration")
+	ErrNotEnoughActiveKeyShares       = sdkerrors.Register(ModuleName, 1147, "not enough active key share indices left to aggregate keys")
+	ErrCommitRevealDisabled           = sdkerrors.Register(ModuleName, 1148, "keyshare commit-reveal is disabled")
+	ErrInvalidKeyshareCommitment      = sdkerrors.Register(ModuleName, 1149, "invalid keyshare commitment")
+	ErrKeyshareAlreadyCommitted       = sdkerrors.Register(ModuleName, 1150, "keyshare already committed")
+	ErrKeyshareCommitmentNotFound     = sdkerrors.Register(ModuleName, 1151, "keyshare commitment not found")
+	ErrKeyshareCommitmentMismatch     = sdkerrors.Register(ModuleName, 1152, "keyshare does not match its commitment")
+	ErrKeyshareRevealTooEarly         = sdkerrors.Register(ModuleName, 1153, "keyshare can not be revealed before its block height")
+	ErrInvalidGeneralKeyRequestID     = sdkerrors.Register(ModuleName, 1154, "invalid general key request id")
+	ErrGeneralKeyRequestExists        = sdkerrors.Register(ModuleName, 1155, "general key request already exists for the given identity")
+	ErrInvalidReleaseHeight           = sdkerrors.Register(ModuleName, 1156, "invalid general key release height")
+	ErrGeneralKeyRequestNotFound      = sdkerrors.Register(ModuleName, 1157, "general key request for the given identity not found")
+	ErrGeneralKeyNotReleased          = sdkerrors.Register(ModuleName, 1158, "general key can not be aggregated before its release height")
+	ErrInvalidKeyShareAssignments     = sdkerrors.Register(ModuleName, 1159, "invalid key share assignments")
 	ErrAddressAlreadyAuthorized       = sdkerrors.Register(ModuleName, 1900, "address is already authorized")
 	ErrAuthorizedAddrNotFound         = sdkerrors.Register(ModuleName, 1901, "target authorized address not found")
 	ErrNotAuthorizedAddrCreator       = sdkerrors.Register(ModuleName, 1902, "sender is not the creator of target authorized address")
@@ -40,10 +74,18 @@ var (
 	ErrAddrIsNotValidatorOrAuthorized = sdkerrors.Register(ModuleName, 1904, "sender is not validator / authorized address to submit key share")
 	ErrAuthorizerIsNotValidator       = sdkerrors.Register(ModuleName, 1905, "address authorized you is not a validator")
 	ErrOnlyValidatorCanAuthorizeAddr  = sdkerrors.Register(ModuleName, 1906, "only validator can authorize address to submit key share")
-	ErrExceedMaxAuthAddr              = sdkerrors.Register(ModuleName, 1907, "each validator can only authorize max 1 address to submit key share")
+	ErrExceedMaxAuthAddr              = sdkerrors.Register(ModuleName, 1907, "validator exceeds the max number of addresses authorized to submit key share")
 	ErrAuthorizeSelfAddress           = sdkerrors.Register(ModuleName, 1908, "unable to authorize sender own address")
 	ErrAuthorizedAnotherAddress       = sdkerrors.Register(ModuleName, 1909, "validator authorized another address to submit key share is not allow to submit key share")
 	ErrUnsupportedIDType              = sdkerrors.Register(ModuleName, 1910, "id type provided in general key share message is not supported")
 	ErrKeyShareRequestNotFound        = sdkerrors.Register(ModuleName, 1911, "key share request for the given identity not found")
 	ErrAggKeyAlreadyExists            = sdkerrors.Register(ModuleName, 1912, "aggregated key already exists for the given identity")
+	ErrAuthorizedAddrExpired          = sdkerrors.Register(ModuleName, 1913, "authorization of the address is expired")
+	ErrAuthorizedAddrOutOfScope       = sdkerrors.Register(ModuleName, 1914, "authorized address is not allowed to submit this key share")
+	ErrInvalidAuthorizedScope         = sdkerrors.Register(ModuleName, 1915, "invalid authorized address scope")
+	ErrInvalidAuthorizedAddrExpiry    = sdkerrors.Register(ModuleName, 1916, "invalid authorized address expiry height")
+	ErrKeyShareRequestAlreadySent     = sdkerrors.Register(ModuleName, 1917, "aggregated key of the key share request is already delivered")
+	ErrKeyShareRequestNoIBCInfo       = sdkerrors.Register(ModuleName, 1918, "key share request has no channel to deliver its aggregated key over")
+	ErrAggrKeyshareInFlight           = sdkerrors.Register(ModuleName, 1919, "aggregated key packet of the key share request is still in flight")
+	ErrAggrKeyshareResendCooldown     = sdkerrors.Register(ModuleName, 1920, "aggregated key of the key share request was resent too recently")
 )
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	peptypes "fairyring/x/pep/types"
 
 	sdkerrors "cosmossdk.io/errors"
@@ -9,6 +11,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
 	connTypes "github.com/cosmos/ibc-go/v7/modules/core/03-connection/types"
@@ -25,10 +28,14 @@ type AccountKeeper interface {
 // BankKeeper defines the expected interface needed to retrieve account balances.
 type BankKeeper interface {
 	SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error
 	// Methods imported from bank should be defined here
 }
 
-// PepKeeper defines the expected interface needed to get and set active and queued public keys
+// PepKeeper defines the expected interface needed to get and set active and queued public keys,
+// and to push new keys to the channels subscribed to the pep port
 type PepKeeper interface {
 	SetActivePubKey(ctx sdk.Context, activePubKey peptypes.ActivePubKey)
 	SetQueuedPubKey(ctx sdk.Context, queuedPubKey peptypes.QueuedPubKey)
@@ -36,6 +43,8 @@ type PepKeeper interface {
 	GetQueuedPubKey(ctx sdk.Context) (val peptypes.QueuedPubKey, found bool)
 	DeleteActivePubKey(ctx sdk.Context)
 	DeleteQueuedPubKey(ctx sdk.Context)
+	PushQueuedPubKey(ctx sdk.Context, queuedPubKey peptypes.QueuedPubKey)
+	PushAggregatedKeyShare(ctx sdk.Context, height uint64, data string)
 }
 
 // StakingKeeper defines the expected interface needed to retrieve the list of validators.
@@ -43,6 +52,15 @@ type StakingKeeper interface {
 	GetAllValidators(ctx sdk.Context) []stakingtypes.Validator
 	GetValidator(ctx sdk.Context, addr sdk.ValAddress) (stakingtypes.Validator, bool)
 	Slash(sdk.Context, sdk.ConsAddress, int64, int64, sdk.Dec) math.Int
+	PowerReduction(ctx sdk.Context) math.Int
+}
+
+// SlashingKeeper defines the expected interface needed to jail & unjail validators.
+type SlashingKeeper interface {
+	Jail(ctx sdk.Context, consAddr sdk.ConsAddress)
+	JailUntil(ctx sdk.Context, consAddr sdk.ConsAddress, jailTime time.Time)
+	Unjail(ctx sdk.Context, validatorAddr sdk.ValAddress) error
+	GetValidatorSigningInfo(ctx sdk.Context, address sdk.ConsAddress) (slashingtypes.ValidatorSigningInfo, bool)
 }
 
 // ConnectionKeeper defines the expected interfaces needed to retrieve connection info
@@ -0,0 +1,23 @@
+package types
+
+import "strings"
+
+// MaxGeneralKeyRequestIDLength is the max length of the id a general key request is made for
+const MaxGeneralKeyRequestIDLength = 128
+
+// GeneralKeyRequestIdentity returns the identity of a general key request, the id is prefixed
+// by the requester address so that it can't release the keys of block heights or other identities
+func GeneralKeyRequestIdentity(creator string, id string) string {
+	return creator + "/" + id
+}
+
+// ValidateGeneralKeyRequestID checks the id of a general key request is not empty and within the max length
+func ValidateGeneralKeyRequestID(id string) error {
+	if strings.TrimSpace(id) == "" {
+		return ErrInvalidGeneralKeyRequestID.Wrap("id can not be empty")
+	}
+	if len(id) > MaxGeneralKeyRequestIDLength {
+		return ErrInvalidGeneralKeyRequestID.Wrapf("expected at most %d characters, got: %d", MaxGeneralKeyRequestIDLength, len(id))
+	}
+	return nil
+}
@@ -0,0 +1,642 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: fairyring/keyshare/general_key_request.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// GeneralKeyRequest is a request made on fairyring for the decryption key of an identity,
+// key shares are submitted for it from its release height until its expiry height
+type GeneralKeyRequest struct {
+	Identity        string     `protobuf:"bytes,1,opt,name=identity,proto3" json:"identity,omitempty"`
+	Creator         string     `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	Pubkey          string     `protobuf:"bytes,3,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	RequestedHeight uint64     `protobuf:"varint,4,opt,name=requestedHeight,proto3" json:"requestedHeight,omitempty"`
+	ReleaseHeight   uint64     `protobuf:"varint,5,opt,name=releaseHeight,proto3" json:"releaseHeight,omitempty"`
+	Fee             types.Coin `protobuf:"bytes,6,opt,name=fee,proto3" json:"fee"`
+	AggrKeyshare    string     `protobuf:"bytes,7,opt,name=aggrKeyshare,proto3" json:"aggrKeyshare,omitempty"`
+	ExpiryHeight    uint64     `protobuf:"varint,8,opt,name=expiryHeight,proto3" json:"expiryHeight,omitempty"`
+}
+
+func (m *GeneralKeyRequest) Reset()         { *m = GeneralKeyRequest{} }
+func (m *GeneralKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*GeneralKeyRequest) ProtoMessage()    {}
+func (*GeneralKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d621b2e22364975, []int{0}
+}
+func (m *GeneralKeyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GeneralKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GeneralKeyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GeneralKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GeneralKeyRequest.Merge(m, src)
+}
+func (m *GeneralKeyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GeneralKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GeneralKeyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GeneralKeyRequest proto.InternalMessageInfo
+
+func (m *GeneralKeyRequest) GetIdentity() string {
+	if m != nil {
+		return m.Identity
+	}
+	return ""
+}
+
+func (m *GeneralKeyRequest) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *GeneralKeyRequest) GetPubkey() string {
+	if m != nil {
+		return m.Pubkey
+	}
+	return ""
+}
+
+func (m *GeneralKeyRequest) GetRequestedHeight() uint64 {
+	if m != nil {
+		return m.RequestedHeight
+	}
+	return 0
+}
+
+func (m *GeneralKeyRequest) GetReleaseHeight() uint64 {
+	if m != nil {
+		return m.ReleaseHeight
+	}
+	return 0
+}
+
+func (m *GeneralKeyRequest) GetFee() types.Coin {
+	if m != nil {
+		return m.Fee
+	}
+	return types.Coin{}
+}
+
+func (m *GeneralKeyRequest) GetAggrKeyshare() string {
+	if m != nil {
+		return m.AggrKeyshare
+	}
+	return ""
+}
+
+func (m *GeneralKeyRequest) GetExpiryHeight() uint64 {
+	if m != nil {
+		return m.ExpiryHeight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*GeneralKeyRequest)(nil), "fairyring.keyshare.GeneralKeyRequest")
+}
+
+func init() {
+	proto.RegisterFile("fairyring/keyshare/general_key_request.proto", fileDescriptor_1d621b2e22364975)
+}
+
+var fileDescriptor_1d621b2e22364975 = []byte{
+	// 329 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x91, 0xc1, 0x4e, 0x2a, 0x31,
+	0x14, 0x86, 0x67, 0x80, 0x0b, 0xdc, 0xde, 0x6b, 0x8c, 0x8d, 0x31, 0x75, 0x16, 0x95, 0x10, 0x17,
+	0xb3, 0x30, 0x33, 0x41, 0x7d, 0x02, 0x5c, 0x68, 0xc2, 0x6e, 0x96, 0x6e, 0x48, 0x07, 0x0e, 0xa5,
+	0x01, 0xa7, 0x63, 0x5b, 0x0c, 0x7d, 0x0b, 0x1f, 0xc4, 0x07, 0x61, 0xc9, 0xd2, 0x95, 0x31, 0xf0,
+	0x22, 0x66, 0x3a, 0x03, 0x04, 0x77, 0x3d, 0xff, 0xff, 0x25, 0x7f, 0xff, 0x73, 0xd0, 0xcd, 0x84,
+	0x09, 0x65, 0x95, 0xc8, 0x78, 0x3c, 0x03, 0xab, 0xa7, 0x4c, 0x41, 0xcc, 0x21, 0x03, 0xc5, 0xe6,
+	0xc3, 0x19, 0xd8, 0xa1, 0x82, 0xd7, 0x05, 0x68, 0x13, 0xe5, 0x4a, 0x1a, 0x89, 0xf1, 0x9e, 0x8e,
+	0x76, 0x74, 0x70, 0xce, 0x25, 0x97, 0xce, 0x8e, 0x8b, 0x57, 0x49, 0x06, 0x74, 0x24, 0xf5, 0x8b,
+	0xd4, 0x71, 0xca, 0x34, 0xc4, 0x6f, 0xbd, 0x14, 0x0c, 0xeb, 0xc5, 0x23, 0x29, 0xb2, 0xd2, 0xef,
+	0x7e, 0xd4, 0xd0, 0xd9, 0x63, 0x99, 0x33, 0x00, 0x9b, 0x94, 0x29, 0x38, 0x40, 0x6d, 0x31, 0x86,
+	0xcc, 0x08, 0x63, 0x89, 0xdf, 0xf1, 0xc3, 0xbf, 0xc9, 0x7e, 0xc6, 0x04, 0xb5, 0x46, 0x0a, 0x98,
+	0x91, 0x8a, 0xd4, 0x9c, 0xb5, 0x1b, 0xf1, 0x05, 0x6a, 0xe6, 0x8b, 0x74, 0x06, 0x96, 0xd4, 0x9d,
+	0x51, 0x4d, 0x38, 0x44, 0xa7, 0xd5, 0xf7, 0x61, 0xfc, 0x04, 0x82, 0x4f, 0x0d, 0x69, 0x74, 0xfc,
+	0xb0, 0x91, 0xfc, 0x96, 0xf1, 0x35, 0x3a, 0x51, 0x30, 0x07, 0xa6, 0xa1, 0xe2, 0xfe, 0x38, 0xee,
+	0x58, 0xc4, 0x3d, 0x54, 0x9f, 0x00, 0x90, 0x66, 0xc7, 0x0f, 0xff, 0xdd, 0x5e, 0x46, 0x65, 0xc3,
+	0xa8, 0x68, 0x18, 0x55, 0x0d, 0xa3, 0x07, 0x29, 0xb2, 0x7e, 0x63, 0xf5, 0x75, 0xe5, 0x25, 0x05,
+	0x8b, 0xbb, 0xe8, 0x3f, 0xe3, 0x5c, 0x0d, 0xaa, 0x65, 0x91, 0x96, 0xfb, 0xe0, 0x91, 0x56, 0x30,
+	0xb0, 0xcc, 0x85, 0xb2, 0x55, 0x76, 0xdb, 0x65, 0x1f, 0x69, 0xfd, 0xfb, 0xd5, 0x86, 0xfa, 0xeb,
+	0x0d, 0xf5, 0xbf, 0x37, 0xd4, 0x7f, 0xdf, 0x52, 0x6f, 0xbd, 0xa5, 0xde, 0xe7, 0x96, 0x7a, 0xcf,
+	0xc1, 0xe1, 0x80, 0xcb, 0xc3, 0x09, 0x8d, 0xcd, 0x41, 0xa7, 0x4d, 0xb7, 0xeb, 0xbb, 0x9f, 0x01,
+	0x00, 0x7b, 0x81, 0x20, 0x9b, 0xe5, 0x01, 0x00, 0x00,
+}
+
+func (m *GeneralKeyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GeneralKeyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GeneralKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintGeneralKeyRequest(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.AggrKeyshare) > 0 {
+		i -= len(m.AggrKeyshare)
+		copy(dAtA[i:], m.AggrKeyshare)
+		i = encodeVarintGeneralKeyRequest(dAtA, i, uint64(len(m.AggrKeyshare)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	{
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGeneralKeyRequest(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.ReleaseHeight != 0 {
+		i = encodeVarintGeneralKeyRequest(dAtA, i, uint64(m.ReleaseHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.RequestedHeight != 0 {
+		i = encodeVarintGeneralKeyRequest(dAtA, i, uint64(m.RequestedHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Pubkey) > 0 {
+		i -= len(m.Pubkey)
+		copy(dAtA[i:], m.Pubkey)
+		i = encodeVarintGeneralKeyRequest(dAtA, i, uint64(len(m.Pubkey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintGeneralKeyRequest(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintGeneralKeyRequest(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGeneralKeyRequest(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGeneralKeyRequest(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GeneralKeyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovGeneralKeyRequest(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovGeneralKeyRequest(uint64(l))
+	}
+	l = len(m.Pubkey)
+	if l > 0 {
+		n += 1 + l + sovGeneralKeyRequest(uint64(l))
+	}
+	if m.RequestedHeight != 0 {
+		n += 1 + sovGeneralKeyRequest(uint64(m.RequestedHeight))
+	}
+	if m.ReleaseHeight != 0 {
+		n += 1 + sovGeneralKeyRequest(uint64(m.ReleaseHeight))
+	}
+	l = m.Fee.Size()
+	n += 1 + l + sovGeneralKeyRequest(uint64(l))
+	l = len(m.AggrKeyshare)
+	if l > 0 {
+		n += 1 + l + sovGeneralKeyRequest(uint64(l))
+	}
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovGeneralKeyRequest(uint64(m.ExpiryHeight))
+	}
+	return n
+}
+
+func sovGeneralKeyRequest(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGeneralKeyRequest(x uint64) (n int) {
+	return sovGeneralKeyRequest(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GeneralKeyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGeneralKeyRequest
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GeneralKeyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GeneralKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGeneralKeyRequest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGeneralKeyRequest
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGeneralKeyRequest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGeneralKeyRequest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGeneralKeyRequest
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGeneralKeyRequest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pubkey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGeneralKeyRequest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGeneralKeyRequest
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGeneralKeyRequest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pubkey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestedHeight", wireType)
+			}
+			m.RequestedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGeneralKeyRequest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestedHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseHeight", wireType)
+			}
+			m.ReleaseHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGeneralKeyRequest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReleaseHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGeneralKeyRequest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGeneralKeyRequest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGeneralKeyRequest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggrKeyshare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGeneralKeyRequest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGeneralKeyRequest
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGeneralKeyRequest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AggrKeyshare = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGeneralKeyRequest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGeneralKeyRequest(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGeneralKeyRequest
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGeneralKeyRequest(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGeneralKeyRequest
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGeneralKeyRequest
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGeneralKeyRequest
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGeneralKeyRequest
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGeneralKeyRequest
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGeneralKeyRequest
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGeneralKeyRequest        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGeneralKeyRequest          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGeneralKeyRequest = fmt.Errorf("proto: unexpected end of group")
+)
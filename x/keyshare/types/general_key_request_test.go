@@ -0,0 +1,17 @@
+package types_test
+
+import (
+	"testing"
+
+	"fairyring/x/keyshare/types"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGeneralKeyRequestIdentity(t *testing.T) {
+	identity := types.GeneralKeyRequestIdentity("fairy1creator", "auction-1")
+	require.Equal(t, "fairy1creator/auction-1", identity)
+
+	// Requests of different creators never share an identity
+	require.NotEqual(t, identity, types.GeneralKeyRequestIdentity("fairy1other", "auction-1"))
+}
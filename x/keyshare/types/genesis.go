@@ -10,11 +10,20 @@ const DefaultIndex uint64 = 1
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		ValidatorSetList:       []ValidatorSet{},
-		KeyShareList:           []KeyShare{},
-		AggregatedKeyShareList: []AggregatedKeyShare{},
-		AuthorizedAddressList:  []AuthorizedAddress{},
-		GeneralKeyShareList:    []GeneralKeyShare{},
+		ValidatorSetList:          []ValidatorSet{},
+		KeyShareList:              []KeyShare{},
+		AggregatedKeyShareList:    []AggregatedKeyShare{},
+		AuthorizedAddressList:     []AuthorizedAddress{},
+		GeneralKeyShareList:       []GeneralKeyShare{},
+		DkgRoundList:              []DkgRound{},
+		DkgDealList:               []DkgDeal{},
+		DkgComplaintList:          []DkgComplaint{},
+		KeyShareMisbehaviorList:   []KeyShareMisbehavior{},
+		KeyshareLivenessInfoList:  []KeyshareLivenessInfo{},
+		KeyshareCommitmentList:    []KeyshareCommitment{},
+		GeneralKeyRequestList:     []GeneralKeyRequest{},
+		RewardPool:                RewardPool{},
+		ValidatorRewardSharesList: []ValidatorRewardShares{},
 		// this line is used by starport scaffolding # genesis/types/default
 		Params: DefaultParams(),
 	}
@@ -51,7 +60,7 @@ func (gs GenesisState) Validate() error {
 	keyShareIndexMap := make(map[string]struct{})
 
 	for _, elem := range gs.KeyShareList {
-		index := string(KeyShareKey(elem.Validator, elem.BlockHeight))
+		index := string(KeyShareKey(elem.Validator, elem.BlockHeight, elem.KeyShareIndex))
 		if _, ok := keyShareIndexMap[index]; ok {
 			return fmt.Errorf("duplicated index for keyShare")
 		}
@@ -75,18 +84,108 @@ func (gs GenesisState) Validate() error {
 		if _, ok := authorizedAddressIndexMap[index]; ok {
 			return fmt.Errorf("duplicated index for authorizedAddress")
 		}
+		if err := elem.Scope.Validate(); err != nil {
+			return err
+		}
 		authorizedAddressIndexMap[index] = struct{}{}
 	}
 	// Check for duplicated index in generalKeyShare
 	generalKeyShareIndexMap := make(map[string]struct{})
 
 	for _, elem := range gs.GeneralKeyShareList {
-		index := string(GeneralKeyShareKey(elem.Validator, elem.IdType, elem.IdValue))
+		index := string(GeneralKeyShareKey(elem.Validator, elem.IdType, elem.IdValue, elem.KeyShareIndex))
 		if _, ok := generalKeyShareIndexMap[index]; ok {
 			return fmt.Errorf("duplicated index for generalKeyShare")
 		}
 		generalKeyShareIndexMap[index] = struct{}{}
 	}
+	// Check for duplicated index in dkgRound
+	dkgRoundIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.DkgRoundList {
+		index := string(DkgRoundKey(elem.Id))
+		if _, ok := dkgRoundIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for dkgRound")
+		}
+		dkgRoundIndexMap[index] = struct{}{}
+		if elem.Id > gs.DkgRoundCount {
+			return fmt.Errorf("dkgRound id should be lower or equal than the last id")
+		}
+	}
+	// Check for duplicated index in dkgDeal
+	dkgDealIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.DkgDealList {
+		index := string(DkgDealKey(elem.RoundId, elem.Dealer))
+		if _, ok := dkgDealIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for dkgDeal")
+		}
+		dkgDealIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated index in dkgComplaint
+	dkgComplaintIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.DkgComplaintList {
+		index := string(DkgComplaintKey(elem.RoundId, elem.Dealer, elem.Complainer))
+		if _, ok := dkgComplaintIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for dkgComplaint")
+		}
+		dkgComplaintIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated index in keyShareMisbehavior
+	keyShareMisbehaviorIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.KeyShareMisbehaviorList {
+		index := string(KeyShareMisbehaviorKey(elem.Validator))
+		if _, ok := keyShareMisbehaviorIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for keyShareMisbehavior")
+		}
+		keyShareMisbehaviorIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated index in keyshareLivenessInfo
+	keyshareLivenessInfoIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.KeyshareLivenessInfoList {
+		index := string(KeyshareLivenessKey(elem.Validator))
+		if _, ok := keyshareLivenessInfoIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for keyshareLivenessInfo")
+		}
+		keyshareLivenessInfoIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated index in keyshareCommitment
+	keyshareCommitmentIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.KeyshareCommitmentList {
+		index := string(KeyshareCommitmentKey(elem.Validator, elem.BlockHeight, elem.KeyShareIndex))
+		if _, ok := keyshareCommitmentIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for keyshareCommitment")
+		}
+		keyshareCommitmentIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated index in generalKeyRequest
+	generalKeyRequestIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.GeneralKeyRequestList {
+		index := string(GeneralKeyRequestKey(elem.Identity))
+		if _, ok := generalKeyRequestIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for generalKeyRequest")
+		}
+		generalKeyRequestIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated index in validatorRewardShares
+	validatorRewardSharesIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.ValidatorRewardSharesList {
+		index := string(ValidatorRewardSharesKey(elem.Validator))
+		if _, ok := validatorRewardSharesIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for validatorRewardShares")
+		}
+		validatorRewardSharesIndexMap[index] = struct{}{}
+	}
+
+	if err := gs.RewardPool.Coins.Validate(); err != nil {
+		return fmt.Errorf("invalid reward pool: %w", err)
+	}
 	// this line is used by starport scaffolding # genesis/types/validate
 
 	return gs.Params.Validate()
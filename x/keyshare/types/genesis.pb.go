@@ -30,12 +30,22 @@ type GenesisState struct {
 	ValidatorSetList []ValidatorSet `protobuf:"bytes,3,rep,name=validatorSetList,proto3" json:"validatorSetList"`
 	KeyShareList     []KeyShare     `protobuf:"bytes,4,rep,name=keyShareList,proto3" json:"keyShareList"`
 	// this line is used by starport scaffolding # genesis/proto/state
-	AggregatedKeyShareList []AggregatedKeyShare `protobuf:"bytes,5,rep,name=aggregatedKeyShareList,proto3" json:"aggregatedKeyShareList"`
-	ActivePubKey           ActivePubKey         `protobuf:"bytes,6,opt,name=activePubKey,proto3" json:"activePubKey"`
-	QueuedPubKey           QueuedPubKey         `protobuf:"bytes,7,opt,name=queuedPubKey,proto3" json:"queuedPubKey"`
-	AuthorizedAddressList  []AuthorizedAddress  `protobuf:"bytes,8,rep,name=authorizedAddressList,proto3" json:"authorizedAddressList"`
-	RequestCount           uint64               `protobuf:"varint,9,opt,name=request_count,json=requestCount,proto3" json:"request_count,omitempty"`
-	GeneralKeyShareList    []GeneralKeyShare    `protobuf:"bytes,10,rep,name=generalKeyShareList,proto3" json:"generalKeyShareList"`
+	AggregatedKeyShareList    []AggregatedKeyShare    `protobuf:"bytes,5,rep,name=aggregatedKeyShareList,proto3" json:"aggregatedKeyShareList"`
+	ActivePubKey              ActivePubKey            `protobuf:"bytes,6,opt,name=activePubKey,proto3" json:"activePubKey"`
+	QueuedPubKey              QueuedPubKey            `protobuf:"bytes,7,opt,name=queuedPubKey,proto3" json:"queuedPubKey"`
+	AuthorizedAddressList     []AuthorizedAddress     `protobuf:"bytes,8,rep,name=authorizedAddressList,proto3" json:"authorizedAddressList"`
+	RequestCount              uint64                  `protobuf:"varint,9,opt,name=request_count,json=requestCount,proto3" json:"request_count,omitempty"`
+	GeneralKeyShareList       []GeneralKeyShare       `protobuf:"bytes,10,rep,name=generalKeyShareList,proto3" json:"generalKeyShareList"`
+	DkgRoundList              []DkgRound              `protobuf:"bytes,11,rep,name=dkgRoundList,proto3" json:"dkgRoundList"`
+	DkgDealList               []DkgDeal               `protobuf:"bytes,12,rep,name=dkgDealList,proto3" json:"dkgDealList"`
+	DkgComplaintList          []DkgComplaint          `protobuf:"bytes,13,rep,name=dkgComplaintList,proto3" json:"dkgComplaintList"`
+	DkgRoundCount             uint64                  `protobuf:"varint,14,opt,name=dkgRoundCount,proto3" json:"dkgRoundCount,omitempty"`
+	KeyShareMisbehaviorList   []KeyShareMisbehavior   `protobuf:"bytes,15,rep,name=keyShareMisbehaviorList,proto3" json:"keyShareMisbehaviorList"`
+	KeyshareLivenessInfoList  []KeyshareLivenessInfo  `protobuf:"bytes,16,rep,name=keyshareLivenessInfoList,proto3" json:"keyshareLivenessInfoList"`
+	KeyshareCommitmentList    []KeyshareCommitment    `protobuf:"bytes,17,rep,name=keyshareCommitmentList,proto3" json:"keyshareCommitmentList"`
+	GeneralKeyRequestList     []GeneralKeyRequest     `protobuf:"bytes,18,rep,name=generalKeyRequestList,proto3" json:"generalKeyRequestList"`
+	RewardPool                RewardPool              `protobuf:"bytes,19,opt,name=rewardPool,proto3" json:"rewardPool"`
+	ValidatorRewardSharesList []ValidatorRewardShares `protobuf:"bytes,20,rep,name=validatorRewardSharesList,proto3" json:"validatorRewardSharesList"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -141,6 +151,76 @@ func (m *GenesisState) GetGeneralKeyShareList() []GeneralKeyShare {
 	return nil
 }
 
+func (m *GenesisState) GetDkgRoundList() []DkgRound {
+	if m != nil {
+		return m.DkgRoundList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDkgDealList() []DkgDeal {
+	if m != nil {
+		return m.DkgDealList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDkgComplaintList() []DkgComplaint {
+	if m != nil {
+		return m.DkgComplaintList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDkgRoundCount() uint64 {
+	if m != nil {
+		return m.DkgRoundCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetKeyShareMisbehaviorList() []KeyShareMisbehavior {
+	if m != nil {
+		return m.KeyShareMisbehaviorList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetKeyshareLivenessInfoList() []KeyshareLivenessInfo {
+	if m != nil {
+		return m.KeyshareLivenessInfoList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetKeyshareCommitmentList() []KeyshareCommitment {
+	if m != nil {
+		return m.KeyshareCommitmentList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetGeneralKeyRequestList() []GeneralKeyRequest {
+	if m != nil {
+		return m.GeneralKeyRequestList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRewardPool() RewardPool {
+	if m != nil {
+		return m.RewardPool
+	}
+	return RewardPool{}
+}
+
+func (m *GenesisState) GetValidatorRewardSharesList() []ValidatorRewardShares {
+	if m != nil {
+		return m.ValidatorRewardSharesList
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "fairyring.keyshare.GenesisState")
 }
@@ -148,37 +228,54 @@ func init() {
 func init() { proto.RegisterFile("fairyring/keyshare/genesis.proto", fileDescriptor_6629804056e1ba8d) }
 
 var fileDescriptor_6629804056e1ba8d = []byte{
-	// 477 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x93, 0x4f, 0x6f, 0xd3, 0x30,
-	0x18, 0x87, 0x1b, 0xd6, 0x75, 0xcc, 0x2b, 0x12, 0x32, 0xff, 0xa2, 0x08, 0x65, 0xd1, 0x26, 0xa6,
-	0x0a, 0x44, 0x2a, 0x0d, 0x0e, 0x5c, 0x3b, 0x24, 0x26, 0x28, 0x87, 0x91, 0x4a, 0x1c, 0xe0, 0x10,
-	0xb9, 0xf3, 0x4b, 0x66, 0x75, 0xd4, 0x99, 0xed, 0x4c, 0x84, 0x4f, 0xc1, 0xc7, 0xda, 0x71, 0x47,
-	0x4e, 0x68, 0x6a, 0xbf, 0x08, 0x8a, 0xe3, 0x34, 0x69, 0xeb, 0xde, 0x52, 0xf7, 0xf9, 0x3d, 0x7e,
-	0xdf, 0xd7, 0x36, 0x0a, 0x7e, 0x10, 0x26, 0x72, 0xc1, 0xa6, 0x49, 0x7f, 0x02, 0xb9, 0xbc, 0x20,
-	0x02, 0xfa, 0x09, 0x4c, 0x41, 0x32, 0x19, 0xa6, 0x82, 0x2b, 0x8e, 0xf1, 0x82, 0x08, 0x2b, 0xc2,
-	0x7b, 0x9c, 0xf0, 0x84, 0xeb, 0xbf, 0xfb, 0xc5, 0x57, 0x49, 0x7a, 0xfb, 0x16, 0x57, 0x4a, 0x04,
-	0xf9, 0x69, 0x54, 0xde, 0x91, 0x05, 0xb8, 0x26, 0x97, 0x8c, 0x12, 0xc5, 0x45, 0x2c, 0x41, 0x19,
-	0xee, 0xc0, 0xc2, 0x4d, 0x20, 0x8f, 0xf5, 0x97, 0x61, 0x5e, 0x5b, 0x18, 0x92, 0x24, 0x02, 0x12,
-	0xa2, 0x80, 0xc6, 0xab, 0xb8, 0xad, 0xcf, 0x34, 0x1b, 0x17, 0x9c, 0x21, 0x5e, 0xd9, 0x84, 0x99,
-	0xba, 0xe0, 0x82, 0xfd, 0x06, 0x1a, 0x13, 0x4a, 0x05, 0xc8, 0xaa, 0x93, 0x97, 0x1b, 0xc6, 0x26,
-	0xc8, 0xe5, 0xea, 0xd6, 0x07, 0x77, 0xdb, 0xa8, 0x7b, 0x5a, 0x8e, 0x74, 0xa4, 0x88, 0x02, 0xfc,
-	0x0e, 0x75, 0xca, 0xb1, 0xb8, 0x4e, 0xe0, 0xf4, 0xf6, 0x8e, 0xbd, 0x70, 0x7d, 0xc4, 0xe1, 0x99,
-	0x26, 0x4e, 0xda, 0x37, 0xff, 0xf6, 0x5b, 0x91, 0xe1, 0xf1, 0x33, 0xb4, 0x93, 0x72, 0xa1, 0x62,
-	0x46, 0xdd, 0x7b, 0x81, 0xd3, 0xdb, 0x8d, 0x3a, 0xc5, 0xcf, 0x8f, 0x14, 0x47, 0xe8, 0xe1, 0x62,
-	0x90, 0x23, 0x50, 0x9f, 0x99, 0x54, 0xee, 0x56, 0xb0, 0xd5, 0xdb, 0x3b, 0x0e, 0x6c, 0xf2, 0xaf,
-	0x0d, 0xd6, 0x6c, 0xb1, 0x96, 0xc7, 0x1f, 0x50, 0x77, 0x02, 0xf9, 0xa8, 0x08, 0x68, 0x5f, 0x5b,
-	0xfb, 0x9e, 0xdb, 0x7c, 0x43, 0xc3, 0x19, 0xd7, 0x52, 0x0e, 0x53, 0xf4, 0xb4, 0x3e, 0x98, 0x61,
-	0xd3, 0xb8, 0xad, 0x8d, 0x47, 0x36, 0xe3, 0x60, 0x2d, 0x61, 0xdc, 0x1b, 0x5c, 0xf8, 0x13, 0xea,
-	0x92, 0x73, 0xc5, 0xae, 0xe1, 0x2c, 0x1b, 0x0f, 0x21, 0x77, 0x3b, 0x7a, 0xb4, 0xd6, 0xee, 0x07,
-	0x0d, 0xae, 0xaa, 0xb8, 0x99, 0x2d, 0x5c, 0x57, 0x19, 0x64, 0x40, 0x8d, 0x6b, 0x67, 0xb3, 0xeb,
-	0x4b, 0x83, 0xab, 0x5c, 0xcd, 0x2c, 0x26, 0xe8, 0x49, 0x7d, 0x8b, 0x06, 0xe5, 0x25, 0xd2, 0xcd,
-	0xdf, 0xd7, 0xcd, 0xbf, 0xb0, 0x16, 0xb8, 0x1a, 0x30, 0x66, 0xbb, 0x09, 0x1f, 0xa2, 0x07, 0x02,
-	0xae, 0x32, 0x90, 0x2a, 0x3e, 0xe7, 0xd9, 0x54, 0xb9, 0xbb, 0x81, 0xd3, 0x6b, 0x47, 0x5d, 0xb3,
-	0xf8, 0xbe, 0x58, 0xc3, 0xdf, 0xd1, 0x23, 0x73, 0x41, 0x97, 0x8e, 0x00, 0xe9, 0x2a, 0x0e, 0x6d,
-	0x55, 0x9c, 0x2e, 0xe3, 0xa6, 0x06, 0x9b, 0xe5, 0xe4, 0xed, 0xcd, 0xcc, 0x77, 0x6e, 0x67, 0xbe,
-	0x73, 0x37, 0xf3, 0x9d, 0x3f, 0x73, 0xbf, 0x75, 0x3b, 0xf7, 0x5b, 0x7f, 0xe7, 0x7e, 0xeb, 0x9b,
-	0x57, 0x3f, 0x94, 0x5f, 0xf5, 0x53, 0x51, 0x79, 0x0a, 0x72, 0xdc, 0xd1, 0xef, 0xe3, 0xcd, 0xff,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0x6e, 0x6d, 0x5a, 0xc7, 0x84, 0x04, 0x00, 0x00,
+	// 745 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x95, 0x4f, 0x53, 0xd3, 0x40,
+	0x18, 0xc6, 0x1b, 0xc1, 0x22, 0xdb, 0xa2, 0xb8, 0xa0, 0xc4, 0xca, 0x94, 0x0e, 0x28, 0xd6, 0x7f,
+	0x65, 0x06, 0x3d, 0x78, 0x85, 0x76, 0x64, 0xb0, 0x38, 0x83, 0x61, 0xc6, 0x83, 0x1e, 0x32, 0x5b,
+	0x76, 0x09, 0x31, 0x4d, 0xb6, 0x6c, 0x36, 0xd5, 0xfa, 0x29, 0xfc, 0x3c, 0x7e, 0x02, 0x8e, 0x1c,
+	0x3d, 0x39, 0x0e, 0x7c, 0x11, 0x27, 0x9b, 0xdd, 0x26, 0x6d, 0x37, 0xb9, 0xa5, 0xc9, 0xf3, 0xfc,
+	0xde, 0xf7, 0x4d, 0xdf, 0x67, 0x03, 0x1a, 0x67, 0xc8, 0x65, 0x23, 0xe6, 0x06, 0xce, 0x8e, 0x47,
+	0x46, 0xe1, 0x39, 0x62, 0x64, 0xc7, 0x21, 0x01, 0x09, 0xdd, 0xb0, 0x35, 0x60, 0x94, 0x53, 0x08,
+	0xc7, 0x8a, 0x96, 0x52, 0xd4, 0x56, 0x1d, 0xea, 0x50, 0xf1, 0x78, 0x27, 0xbe, 0x4a, 0x94, 0xb5,
+	0x0d, 0x0d, 0x6b, 0x80, 0x18, 0xf2, 0x25, 0xaa, 0xb6, 0xad, 0x11, 0x0c, 0x51, 0xdf, 0xc5, 0x88,
+	0x53, 0x66, 0x87, 0x84, 0x4b, 0xdd, 0xa6, 0x46, 0xe7, 0x91, 0x91, 0x2d, 0xae, 0xa4, 0xe6, 0xb5,
+	0x46, 0x83, 0x1c, 0x87, 0x11, 0x07, 0x71, 0x82, 0xed, 0x69, 0xb9, 0x6e, 0xce, 0x41, 0xd4, 0x8b,
+	0x75, 0x52, 0xf1, 0x52, 0x07, 0x8c, 0xf8, 0x39, 0x65, 0xee, 0x4f, 0x82, 0x6d, 0x84, 0x31, 0x23,
+	0xa1, 0x9a, 0xe4, 0x45, 0xce, 0x6b, 0x63, 0xa8, 0x3f, 0x53, 0x7a, 0x5d, 0xa3, 0xc5, 0x9e, 0x23,
+	0x9f, 0xb6, 0x8a, 0x66, 0xb5, 0x7d, 0x37, 0xec, 0x91, 0x73, 0x34, 0x74, 0x29, 0x2b, 0xa8, 0xac,
+	0x2e, 0xec, 0xbe, 0x3b, 0x24, 0x41, 0xda, 0xe5, 0xab, 0x22, 0xed, 0x29, 0xf5, 0x7d, 0x97, 0xfb,
+	0x24, 0xe0, 0x05, 0xea, 0xec, 0x4c, 0x8c, 0x5c, 0x44, 0x24, 0x54, 0xea, 0x66, 0x11, 0x9b, 0x91,
+	0xef, 0x88, 0xe1, 0x44, 0xb9, 0xf9, 0xbb, 0x0a, 0xaa, 0x07, 0xc9, 0x4a, 0x9d, 0x70, 0xc4, 0x09,
+	0x7c, 0x07, 0xca, 0xc9, 0x5a, 0x98, 0x46, 0xc3, 0x68, 0x56, 0x76, 0x6b, 0xad, 0xd9, 0x15, 0x6b,
+	0x1d, 0x0b, 0xc5, 0xfe, 0xfc, 0xe5, 0xdf, 0x8d, 0x92, 0x25, 0xf5, 0x70, 0x0d, 0x2c, 0x0c, 0x28,
+	0xe3, 0xb6, 0x8b, 0xcd, 0x5b, 0x0d, 0xa3, 0xb9, 0x68, 0x95, 0xe3, 0x9f, 0x87, 0x18, 0x5a, 0x60,
+	0x79, 0xbc, 0x48, 0x27, 0x84, 0x1f, 0xb9, 0x21, 0x37, 0xe7, 0x1a, 0x73, 0xcd, 0xca, 0x6e, 0x43,
+	0x07, 0xff, 0x9c, 0xd1, 0xca, 0x12, 0x33, 0x7e, 0xf8, 0x1e, 0x54, 0x3d, 0x32, 0x3a, 0x89, 0x0d,
+	0x82, 0x37, 0x2f, 0x78, 0xeb, 0x3a, 0x5e, 0x57, 0xea, 0x24, 0x6b, 0xc2, 0x07, 0x31, 0x78, 0x98,
+	0x2e, 0x66, 0x37, 0x4b, 0xbc, 0x2d, 0x88, 0xdb, 0x3a, 0xe2, 0xde, 0x8c, 0x43, 0xb2, 0x73, 0x58,
+	0xf0, 0x03, 0xa8, 0xa2, 0x53, 0xee, 0x0e, 0xc9, 0x71, 0xd4, 0xeb, 0x92, 0x91, 0x59, 0x6e, 0x18,
+	0x79, 0xd3, 0xef, 0x65, 0x74, 0xaa, 0xe3, 0xac, 0x37, 0x66, 0x5d, 0x44, 0x24, 0x22, 0x58, 0xb2,
+	0x16, 0xf2, 0x59, 0x9f, 0x32, 0x3a, 0xc5, 0xca, 0x7a, 0x21, 0x02, 0x0f, 0xd2, 0x14, 0xed, 0x25,
+	0x21, 0x12, 0xc3, 0xdf, 0x11, 0xc3, 0x3f, 0xd5, 0x36, 0x38, 0x6d, 0x90, 0x64, 0x3d, 0x09, 0x6e,
+	0x81, 0x25, 0xb9, 0x9b, 0xf6, 0x29, 0x8d, 0x02, 0x6e, 0x2e, 0x36, 0x8c, 0xe6, 0xbc, 0x55, 0x95,
+	0x37, 0xdb, 0xf1, 0x3d, 0xf8, 0x15, 0xac, 0xc8, 0x65, 0x9e, 0xf8, 0x0b, 0x80, 0xe8, 0x62, 0x4b,
+	0xd7, 0xc5, 0xc1, 0xa4, 0x5c, 0xf6, 0xa0, 0xa3, 0xc4, 0xab, 0x82, 0x3d, 0xc7, 0xa2, 0x51, 0x80,
+	0x05, 0xb5, 0x92, 0xbf, 0x2a, 0x1d, 0xa9, 0x53, 0x2f, 0x2b, 0xeb, 0x83, 0x6d, 0x50, 0xc1, 0x9e,
+	0xd3, 0x21, 0xa8, 0x2f, 0x30, 0x55, 0x81, 0x79, 0x9c, 0x83, 0x89, 0x65, 0x92, 0x92, 0x75, 0xc5,
+	0x59, 0xc0, 0x9e, 0xd3, 0xa6, 0xfe, 0xa0, 0x8f, 0xdc, 0x20, 0xc9, 0xc2, 0x52, 0x7e, 0x16, 0x3a,
+	0x19, 0xad, 0xca, 0xc2, 0xb4, 0x1f, 0x3e, 0x01, 0x4b, 0xaa, 0x51, 0xf1, 0x3a, 0xcd, 0xbb, 0xe2,
+	0x15, 0x4f, 0xde, 0x84, 0x0e, 0x58, 0x53, 0x9b, 0xff, 0x31, 0x3d, 0xb8, 0x44, 0x03, 0xf7, 0x44,
+	0x03, 0xcf, 0x8a, 0xc2, 0x93, 0xb1, 0xc8, 0x3e, 0xf2, 0x68, 0xf0, 0x1b, 0x30, 0x95, 0xfd, 0x48,
+	0x1e, 0x79, 0x87, 0xc1, 0x19, 0x15, 0x95, 0x96, 0x45, 0xa5, 0x66, 0x4e, 0xa5, 0x19, 0x8f, 0x2c,
+	0x95, 0xcb, 0x8b, 0xe3, 0xab, 0x9e, 0xb5, 0xc7, 0x47, 0xa6, 0xa8, 0x74, 0x3f, 0x3f, 0xbe, 0xdd,
+	0x19, 0x87, 0x8a, 0xaf, 0x9e, 0x15, 0xc7, 0x24, 0x5d, 0x2c, 0x2b, 0x59, 0x5c, 0x51, 0x04, 0xe6,
+	0xc7, 0xe4, 0x60, 0xda, 0xa0, 0x62, 0xa2, 0x25, 0xc1, 0x0e, 0x00, 0xc9, 0xb9, 0x7c, 0x4c, 0x69,
+	0xdf, 0x5c, 0x11, 0x99, 0xae, 0xeb, 0xb8, 0xd6, 0x58, 0x25, 0x81, 0x19, 0x1f, 0xf4, 0xc1, 0xa3,
+	0xf1, 0x49, 0x99, 0x08, 0xc5, 0x3f, 0x94, 0x64, 0x7a, 0x55, 0x34, 0xfb, 0xbc, 0xf0, 0xc8, 0xcd,
+	0x9a, 0x24, 0x3f, 0x9f, 0xb8, 0xff, 0xf6, 0xf2, 0xba, 0x6e, 0x5c, 0x5d, 0xd7, 0x8d, 0x7f, 0xd7,
+	0x75, 0xe3, 0xd7, 0x4d, 0xbd, 0x74, 0x75, 0x53, 0x2f, 0xfd, 0xb9, 0xa9, 0x97, 0xbe, 0xd4, 0xd2,
+	0x0f, 0xd0, 0x8f, 0xf4, 0x13, 0xc4, 0x47, 0x03, 0x12, 0xf6, 0xca, 0xe2, 0xcb, 0xf3, 0xe6, 0xff,
+	0x00, 0xe4, 0x5d, 0xed, 0x76, 0xde, 0x08, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -201,6 +298,143 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ValidatorRewardSharesList) > 0 {
+		for iNdEx := len(m.ValidatorRewardSharesList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorRewardSharesList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	{
+		size, err := m.RewardPool.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x9a
+	if len(m.GeneralKeyRequestList) > 0 {
+		for iNdEx := len(m.GeneralKeyRequestList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GeneralKeyRequestList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.KeyshareCommitmentList) > 0 {
+		for iNdEx := len(m.KeyshareCommitmentList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.KeyshareCommitmentList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.KeyshareLivenessInfoList) > 0 {
+		for iNdEx := len(m.KeyshareLivenessInfoList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.KeyshareLivenessInfoList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.KeyShareMisbehaviorList) > 0 {
+		for iNdEx := len(m.KeyShareMisbehaviorList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.KeyShareMisbehaviorList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if m.DkgRoundCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.DkgRoundCount))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.DkgComplaintList) > 0 {
+		for iNdEx := len(m.DkgComplaintList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DkgComplaintList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.DkgDealList) > 0 {
+		for iNdEx := len(m.DkgDealList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DkgDealList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.DkgRoundList) > 0 {
+		for iNdEx := len(m.DkgRoundList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DkgRoundList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
 	if len(m.GeneralKeyShareList) > 0 {
 		for iNdEx := len(m.GeneralKeyShareList) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -376,6 +610,59 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.DkgRoundList) > 0 {
+		for _, e := range m.DkgRoundList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DkgDealList) > 0 {
+		for _, e := range m.DkgDealList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DkgComplaintList) > 0 {
+		for _, e := range m.DkgComplaintList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.DkgRoundCount != 0 {
+		n += 1 + sovGenesis(uint64(m.DkgRoundCount))
+	}
+	if len(m.KeyShareMisbehaviorList) > 0 {
+		for _, e := range m.KeyShareMisbehaviorList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.KeyshareLivenessInfoList) > 0 {
+		for _, e := range m.KeyshareLivenessInfoList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.KeyshareCommitmentList) > 0 {
+		for _, e := range m.KeyshareCommitmentList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.GeneralKeyRequestList) > 0 {
+		for _, e := range m.GeneralKeyRequestList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.RewardPool.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if len(m.ValidatorRewardSharesList) > 0 {
+		for _, e := range m.ValidatorRewardSharesList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -734,6 +1021,330 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DkgRoundList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DkgRoundList = append(m.DkgRoundList, DkgRound{})
+			if err := m.DkgRoundList[len(m.DkgRoundList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DkgDealList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DkgDealList = append(m.DkgDealList, DkgDeal{})
+			if err := m.DkgDealList[len(m.DkgDealList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DkgComplaintList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DkgComplaintList = append(m.DkgComplaintList, DkgComplaint{})
+			if err := m.DkgComplaintList[len(m.DkgComplaintList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DkgRoundCount", wireType)
+			}
+			m.DkgRoundCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DkgRoundCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyShareMisbehaviorList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyShareMisbehaviorList = append(m.KeyShareMisbehaviorList, KeyShareMisbehavior{})
+			if err := m.KeyShareMisbehaviorList[len(m.KeyShareMisbehaviorList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyshareLivenessInfoList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyshareLivenessInfoList = append(m.KeyshareLivenessInfoList, KeyshareLivenessInfo{})
+			if err := m.KeyshareLivenessInfoList[len(m.KeyshareLivenessInfoList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyshareCommitmentList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyshareCommitmentList = append(m.KeyshareCommitmentList, KeyshareCommitment{})
+			if err := m.KeyshareCommitmentList[len(m.KeyshareCommitmentList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GeneralKeyRequestList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GeneralKeyRequestList = append(m.GeneralKeyRequestList, GeneralKeyRequest{})
+			if err := m.GeneralKeyRequestList[len(m.GeneralKeyRequestList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorRewardSharesList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorRewardSharesList = append(m.ValidatorRewardSharesList, ValidatorRewardShares{})
+			if err := m.ValidatorRewardSharesList[len(m.ValidatorRewardSharesList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -5,6 +5,7 @@ import (
 
 	"fairyring/x/keyshare/types"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -131,6 +132,18 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "invalid authorizedAddress scope",
+			genState: &types.GenesisState{
+				AuthorizedAddressList: []types.AuthorizedAddress{
+					{
+						Target: "0",
+						Scope:  types.AuthorizedScope{GeneralIdTypes: []string{""}},
+					},
+				},
+			},
+			valid: false,
+		},
 		{
 			desc: "duplicated generalKeyShare",
 			genState: &types.GenesisState{
@@ -149,6 +162,93 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "duplicated keyShareMisbehavior",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				KeyShareMisbehaviorList: []types.KeyShareMisbehavior{
+					{
+						Validator: "0",
+					},
+					{
+						Validator: "0",
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated keyshareLivenessInfo",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				KeyshareLivenessInfoList: []types.KeyshareLivenessInfo{
+					{
+						Validator: "0",
+					},
+					{
+						Validator: "0",
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated keyshareCommitment",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				KeyshareCommitmentList: []types.KeyshareCommitment{
+					{
+						Validator:     "0",
+						BlockHeight:   1,
+						KeyShareIndex: 1,
+					},
+					{
+						Validator:     "0",
+						BlockHeight:   1,
+						KeyShareIndex: 1,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated generalKeyRequest",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				GeneralKeyRequestList: []types.GeneralKeyRequest{
+					{
+						Identity: "0",
+					},
+					{
+						Identity: "0",
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated validatorRewardShares",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				ValidatorRewardSharesList: []types.ValidatorRewardShares{
+					{
+						Validator: "0",
+					},
+					{
+						Validator: "0",
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid reward pool",
+			genState: &types.GenesisState{
+				Params:     types.DefaultParams(),
+				RewardPool: types.RewardPool{Coins: sdk.Coins{sdk.Coin{Denom: "ufairy", Amount: sdk.NewInt(-1)}}},
+			},
+			valid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
@@ -0,0 +1,26 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// IDTypeHooks are registered in the keyshare keeper for every id type general key shares
+// can be submitted for, so that other modules can request decryption keys for their own identities
+type IDTypeHooks interface {
+	// ValidateRequest returns an error if no decryption key is pending for the id value,
+	// either because it was never requested or because it is already aggregated
+	ValidateRequest(ctx sdk.Context, idValue string) error
+	// OnAggregated is called with the decryption key aggregated for the id value
+	OnAggregated(ctx sdk.Context, idValue string, aggrKey string) error
+	// DeliveryRoute returns the IBC channel the aggregated key of the id value is sent over,
+	// found is false if the key is only delivered through OnAggregated
+	DeliveryRoute(ctx sdk.Context, idValue string) (route KeyDeliveryRoute, found bool)
+}
+
+// KeyDeliveryRoute is the IBC channel an aggregated general key is sent over and the packet carrying it.
+// The aggregated key, its height & the retries of the packet are set by the keeper
+type KeyDeliveryRoute struct {
+	PortID    string
+	ChannelID string
+	Packet    AggrKeyshareDataPacketData
+}
@@ -0,0 +1,66 @@
+package types
+
+import "encoding/binary"
+
+var _ binary.ByteOrder
+
+const (
+	// DkgRoundKeyPrefix is the prefix to retrieve all DkgRound
+	DkgRoundKeyPrefix = "DkgRound/value/"
+
+	// DkgRoundCountKeyPrefix is the prefix to retrieve the id of the latest DkgRound
+	DkgRoundCountKeyPrefix = "DkgRound/count/"
+
+	// DkgDealKeyPrefix is the prefix to retrieve all DkgDeal
+	DkgDealKeyPrefix = "DkgDeal/value/"
+
+	// DkgComplaintKeyPrefix is the prefix to retrieve all DkgComplaint
+	DkgComplaintKeyPrefix = "DkgComplaint/value/"
+)
+
+// DkgRoundKey returns the store key to retrieve a DkgRound from the index fields
+func DkgRoundKey(
+	id uint64,
+) []byte {
+	var key []byte
+
+	idBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(idBytes, id)
+	key = append(key, idBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// DkgDealKey returns the store key to retrieve a DkgDeal from the index fields
+func DkgDealKey(
+	roundId uint64,
+	dealer string,
+) []byte {
+	var key []byte
+
+	key = append(key, DkgRoundKey(roundId)...)
+
+	dealerBytes := []byte(dealer)
+	key = append(key, dealerBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// DkgComplaintKey returns the store key to retrieve a DkgComplaint from the index fields
+func DkgComplaintKey(
+	roundId uint64,
+	dealer string,
+	complainer string,
+) []byte {
+	var key []byte
+
+	key = append(key, DkgDealKey(roundId, dealer)...)
+
+	complainerBytes := []byte(complainer)
+	key = append(key, complainerBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
@@ -0,0 +1,67 @@
+package types
+
+import "encoding/binary"
+
+var _ binary.ByteOrder
+
+const (
+	// GeneralKeyRequestKeyPrefix is the prefix to retrieve all GeneralKeyRequest
+	GeneralKeyRequestKeyPrefix = "GeneralKeyRequest/value/"
+
+	// GeneralKeyReleaseQueueKeyPrefix is the prefix of the general key requests ordered by release height
+	GeneralKeyReleaseQueueKeyPrefix = "GeneralKeyRequest/release/"
+
+	// GeneralKeyExpiryQueueKeyPrefix is the prefix of the general key requests ordered by expiry height
+	GeneralKeyExpiryQueueKeyPrefix = "GeneralKeyRequest/expiry/"
+)
+
+// GeneralKeyRequestKey returns the store key to retrieve a GeneralKeyRequest from the index fields
+func GeneralKeyRequestKey(
+	identity string,
+) []byte {
+	var key []byte
+
+	identityBytes := []byte(identity)
+	key = append(key, identityBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// GeneralKeyReleaseQueueKey returns the release queue key of a general key request
+func GeneralKeyReleaseQueueKey(
+	releaseHeight uint64,
+	identity string,
+) []byte {
+	var key []byte
+
+	releaseHeightBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(releaseHeightBytes, releaseHeight)
+	key = append(key, releaseHeightBytes...)
+	key = append(key, []byte("/")...)
+
+	identityBytes := []byte(identity)
+	key = append(key, identityBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// GeneralKeyExpiryQueueKey returns the expiry queue key of a general key request
+func GeneralKeyExpiryQueueKey(
+	expiryHeight uint64,
+	identity string,
+) []byte {
+	var key []byte
+
+	expiryHeightBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(expiryHeightBytes, expiryHeight)
+	key = append(key, expiryHeightBytes...)
+	key = append(key, []byte("/")...)
+
+	identityBytes := []byte(identity)
+	key = append(key, identityBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
@@ -14,6 +14,24 @@ func GeneralKeyShareKey(
 	validator string,
 	idType string,
 	idValue string,
+	keyShareIndex uint64,
+) []byte {
+	key := GeneralKeyShareIdentityKey(validator, idType, idValue)
+
+	keyShareIndexBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(keyShareIndexBytes, keyShareIndex)
+	key = append(key, keyShareIndexBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// GeneralKeyShareIdentityKey returns the store key prefix of all the GeneralKeyShare
+// submitted by a validator for an identity
+func GeneralKeyShareIdentityKey(
+	validator string,
+	idType string,
+	idValue string,
 ) []byte {
 	var key []byte
 
@@ -13,6 +13,23 @@ const (
 func KeyShareKey(
 	validator string,
 	blockHeight uint64,
+	keyShareIndex uint64,
+) []byte {
+	key := KeyShareHeightKey(validator, blockHeight)
+
+	keyShareIndexBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(keyShareIndexBytes, keyShareIndex)
+	key = append(key, keyShareIndexBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// KeyShareHeightKey returns the store key prefix of all the KeyShare
+// submitted by a validator for a block height
+func KeyShareHeightKey(
+	validator string,
+	blockHeight uint64,
 ) []byte {
 	var key []byte
 
@@ -0,0 +1,24 @@
+package types
+
+import "encoding/binary"
+
+var _ binary.ByteOrder
+
+const (
+	// KeyShareIndexOwnerKeyPrefix is the prefix to retrieve all KeyShareIndexOwner
+	KeyShareIndexOwnerKeyPrefix = "KeyShareIndexOwner/value/"
+)
+
+// KeyShareIndexOwnerKey returns the store key to retrieve a KeyShareIndexOwner from the index fields
+func KeyShareIndexOwnerKey(
+	index uint64,
+) []byte {
+	var key []byte
+
+	indexBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(indexBytes, index)
+	key = append(key, indexBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
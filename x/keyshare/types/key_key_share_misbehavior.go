@@ -0,0 +1,23 @@
+package types
+
+import "encoding/binary"
+
+var _ binary.ByteOrder
+
+const (
+	// KeyShareMisbehaviorKeyPrefix is the prefix to retrieve all KeyShareMisbehavior
+	KeyShareMisbehaviorKeyPrefix = "KeyShareMisbehavior/value/"
+)
+
+// KeyShareMisbehaviorKey returns the store key to retrieve a KeyShareMisbehavior from the index fields
+func KeyShareMisbehaviorKey(
+	validator string,
+) []byte {
+	var key []byte
+
+	validatorBytes := []byte(validator)
+	key = append(key, validatorBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
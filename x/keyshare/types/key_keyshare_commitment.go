@@ -0,0 +1,35 @@
+package types
+
+import "encoding/binary"
+
+var _ binary.ByteOrder
+
+const (
+	// KeyshareCommitmentKeyPrefix is the prefix to retrieve all KeyshareCommitment
+	KeyshareCommitmentKeyPrefix = "KeyshareCommitment/value/"
+)
+
+// KeyshareCommitmentKey returns the store key to retrieve a KeyshareCommitment from the index fields
+func KeyshareCommitmentKey(
+	validator string,
+	blockHeight uint64,
+	keyShareIndex uint64,
+) []byte {
+	var key []byte
+
+	validatorBytes := []byte(validator)
+	key = append(key, validatorBytes...)
+	key = append(key, []byte("/")...)
+
+	blockHeightBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(blockHeightBytes, blockHeight)
+	key = append(key, blockHeightBytes...)
+	key = append(key, []byte("/")...)
+
+	keyShareIndexBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(keyShareIndexBytes, keyShareIndex)
+	key = append(key, keyShareIndexBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
@@ -0,0 +1,23 @@
+package types
+
+import "encoding/binary"
+
+var _ binary.ByteOrder
+
+const (
+	// KeyshareLivenessKeyPrefix is the prefix to retrieve all KeyshareLivenessInfo
+	KeyshareLivenessKeyPrefix = "KeyshareLiveness/value/"
+)
+
+// KeyshareLivenessKey returns the store key to retrieve a KeyshareLivenessInfo from the index fields
+func KeyshareLivenessKey(
+	validator string,
+) []byte {
+	var key []byte
+
+	validatorBytes := []byte(validator)
+	key = append(key, validatorBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
@@ -11,8 +11,30 @@ var _ binary.ByteOrder
 const (
 	// KeyShareRequestKeyPrefix is the prefix to retrieve all Keyshare requests
 	KeyShareRequestKeyPrefix = "KeyshareRequest/value/"
+
+	// AggrKeyshareRetryQueueKeyPrefix is the prefix of the aggregated key packets queued to be sent again, ordered by height
+	AggrKeyshareRetryQueueKeyPrefix = "KeyshareRequest/retry/"
 )
 
+// AggrKeyshareRetryQueueKey returns the retry queue key of an aggregated key packet
+func AggrKeyshareRetryQueueKey(
+	height uint64,
+	identity string,
+) []byte {
+	var key []byte
+
+	heightBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(heightBytes, height)
+	key = append(key, heightBytes...)
+	key = append(key, []byte("/")...)
+
+	identityBytes := []byte(identity)
+	key = append(key, identityBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
 func IdentityFromRequestCount(
 	reqCount uint64,
 ) string {
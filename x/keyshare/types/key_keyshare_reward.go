@@ -0,0 +1,38 @@
+package types
+
+import "encoding/binary"
+
+var _ binary.ByteOrder
+
+const (
+	// RewardPoolKeyPrefix is the prefix to retrieve the RewardPool
+	RewardPoolKeyPrefix = "RewardPool/value/"
+
+	// ValidatorRewardSharesKeyPrefix is the prefix to retrieve all ValidatorRewardShares
+	ValidatorRewardSharesKeyPrefix = "ValidatorRewardShares/value/"
+
+	// AcceptedKeyShareKeyPrefix is the prefix of the markers of the key shares counted toward the rewards
+	AcceptedKeyShareKeyPrefix = "AcceptedKeyShare/value/"
+)
+
+// ValidatorRewardSharesKey returns the store key to retrieve a ValidatorRewardShares from the index fields
+func ValidatorRewardSharesKey(
+	validator string,
+) []byte {
+	var key []byte
+
+	validatorBytes := []byte(validator)
+	key = append(key, validatorBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// AcceptedKeyShareKey returns the store key of the marker of a key share counted toward the rewards
+func AcceptedKeyShareKey(
+	validator string,
+	blockHeight uint64,
+	keyShareIndex uint64,
+) []byte {
+	return KeyShareKey(validator, blockHeight, keyShareIndex)
+}
@@ -0,0 +1,123 @@
+package types
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AssignKeyShareIndices splits the key share indices 1..totalShares among the validators
+// proportionally to their voting power using the largest remainder method.
+// Ties on the remainder are broken by the order of the validators, validators
+// that end up without any share are left out.
+func AssignKeyShareIndices(validators []string, powers []int64, totalShares uint64) ([]KeyShareAssignment, error) {
+	if len(validators) != len(powers) {
+		return nil, ErrNoVotingPower.Wrapf("expected %d powers, got: %d", len(validators), len(powers))
+	}
+
+	totalPower := sdk.ZeroInt()
+	for _, p := range powers {
+		if p > 0 {
+			totalPower = totalPower.AddRaw(p)
+		}
+	}
+	if totalPower.IsZero() {
+		return nil, ErrNoVotingPower
+	}
+
+	shares := make([]uint64, len(validators))
+	remainders := make([]sdk.Int, len(validators))
+	assigned := uint64(0)
+	for i, p := range powers {
+		if p <= 0 {
+			remainders[i] = sdk.ZeroInt()
+			continue
+		}
+		quota := sdk.NewInt(p).Mul(sdk.NewIntFromUint64(totalShares))
+		shares[i] = quota.Quo(totalPower).Uint64()
+		remainders[i] = quota.Mod(totalPower)
+		assigned += shares[i]
+	}
+
+	order := make([]int, len(validators))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return remainders[order[a]].GT(remainders[order[b]])
+	})
+	// The leftover is always lower than the number of validators with power
+	for i := 0; assigned < totalShares; i++ {
+		shares[order[i]]++
+		assigned++
+	}
+
+	var assignments []KeyShareAssignment
+	next := uint64(1)
+	for i, v := range validators {
+		if shares[i] == 0 {
+			continue
+		}
+		indices := make([]uint64, 0, shares[i])
+		for j := uint64(0); j < shares[i]; j++ {
+			indices = append(indices, next)
+			next++
+		}
+		assignments = append(assignments, KeyShareAssignment{
+			Validator:    v,
+			ShareIndices: indices,
+			Weight:       uint64(powers[i]),
+		})
+	}
+
+	return assignments, nil
+}
+
+// NewKeyShareAssignments groups the owners of the key share indices, owners[i] holding
+// the index i+1, into one assignment per validator following the order of their first index
+func NewKeyShareAssignments(owners []string) []KeyShareAssignment {
+	var assignments []KeyShareAssignment
+	positions := make(map[string]int)
+	for i, owner := range owners {
+		pos, found := positions[owner]
+		if !found {
+			pos = len(assignments)
+			positions[owner] = pos
+			assignments = append(assignments, KeyShareAssignment{Validator: owner})
+		}
+		assignments[pos].ShareIndices = append(assignments[pos].ShareIndices, uint64(i+1))
+	}
+	return assignments
+}
+
+// ValidateKeyShareAssignments checks that the assignments bind every key share index
+// from 1 to totalShares exactly once, each validator appearing in a single assignment
+func ValidateKeyShareAssignments(assignments []KeyShareAssignment, totalShares uint64) error {
+	validators := make(map[string]struct{}, len(assignments))
+	seenIndices := make(map[uint64]struct{}, totalShares)
+	for _, a := range assignments {
+		if _, found := validators[a.Validator]; found {
+			return ErrInvalidKeyShareAssignments.Wrapf("duplicated assignment for validator: %s", a.Validator)
+		}
+		validators[a.Validator] = struct{}{}
+
+		if len(a.ShareIndices) == 0 {
+			return ErrInvalidKeyShareAssignments.Wrapf("no share index assigned to validator: %s", a.Validator)
+		}
+
+		for _, index := range a.ShareIndices {
+			if index < 1 || index > totalShares {
+				return ErrInvalidKeyShareAssignments.Wrapf("expected share index within 1 and %d, got: %d", totalShares, index)
+			}
+			if _, found := seenIndices[index]; found {
+				return ErrInvalidKeyShareAssignments.Wrapf("share index %d is assigned more than once", index)
+			}
+			seenIndices[index] = struct{}{}
+		}
+	}
+
+	if uint64(len(seenIndices)) != totalShares {
+		return ErrInvalidKeyShareAssignments.Wrapf("expected %d share indices to be assigned, got: %d", totalShares, len(seenIndices))
+	}
+	return nil
+}
@@ -0,0 +1,172 @@
+package types_test
+
+import (
+	"testing"
+
+	"fairyring/x/keyshare/types"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAssignKeyShareIndices(t *testing.T) {
+	for _, tc := range []struct {
+		desc        string
+		validators  []string
+		powers      []int64
+		totalShares uint64
+		expected    []types.KeyShareAssignment
+		err         error
+	}{
+		{
+			desc:        "equal power",
+			validators:  []string{"a", "b", "c"},
+			powers:      []int64{10, 10, 10},
+			totalShares: 3,
+			expected: []types.KeyShareAssignment{
+				{Validator: "a", ShareIndices: []uint64{1}, Weight: 10},
+				{Validator: "b", ShareIndices: []uint64{2}, Weight: 10},
+				{Validator: "c", ShareIndices: []uint64{3}, Weight: 10},
+			},
+		},
+		{
+			desc:        "proportional to power",
+			validators:  []string{"a", "b", "c"},
+			powers:      []int64{50, 30, 20},
+			totalShares: 10,
+			expected: []types.KeyShareAssignment{
+				{Validator: "a", ShareIndices: []uint64{1, 2, 3, 4, 5}, Weight: 50},
+				{Validator: "b", ShareIndices: []uint64{6, 7, 8}, Weight: 30},
+				{Validator: "c", ShareIndices: []uint64{9, 10}, Weight: 20},
+			},
+		},
+		{
+			desc:        "largest remainder gets the leftover",
+			validators:  []string{"a", "b", "c"},
+			powers:      []int64{1, 1, 2},
+			totalShares: 5,
+			expected: []types.KeyShareAssignment{
+				{Validator: "a", ShareIndices: []uint64{1}, Weight: 1},
+				{Validator: "b", ShareIndices: []uint64{2}, Weight: 1},
+				{Validator: "c", ShareIndices: []uint64{3, 4, 5}, Weight: 2},
+			},
+		},
+		{
+			desc:        "minimum bonded cluster gets no share",
+			validators:  []string{"a", "b", "c"},
+			powers:      []int64{1, 1, 1000},
+			totalShares: 4,
+			expected: []types.KeyShareAssignment{
+				{Validator: "c", ShareIndices: []uint64{1, 2, 3, 4}, Weight: 1000},
+			},
+		},
+		{
+			desc:        "zero power is skipped",
+			validators:  []string{"a", "b"},
+			powers:      []int64{0, 5},
+			totalShares: 2,
+			expected: []types.KeyShareAssignment{
+				{Validator: "b", ShareIndices: []uint64{1, 2}, Weight: 5},
+			},
+		},
+		{
+			desc:        "no power",
+			validators:  []string{"a", "b"},
+			powers:      []int64{0, 0},
+			totalShares: 2,
+			err:         types.ErrNoVotingPower,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			assignments, err := types.AssignKeyShareIndices(tc.validators, tc.powers, tc.totalShares)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, assignments)
+
+			total := 0
+			for _, a := range assignments {
+				total += len(a.ShareIndices)
+			}
+			require.Equal(t, int(tc.totalShares), total)
+		})
+	}
+}
+
+func TestNewKeyShareAssignments(t *testing.T) {
+	require.Equal(t, []types.KeyShareAssignment{
+		{Validator: "b", ShareIndices: []uint64{1, 3}},
+		{Validator: "a", ShareIndices: []uint64{2}},
+	}, types.NewKeyShareAssignments([]string{"b", "a", "b"}))
+
+	require.Nil(t, types.NewKeyShareAssignments(nil))
+}
+
+func TestValidateKeyShareAssignments(t *testing.T) {
+	for _, tc := range []struct {
+		desc        string
+		assignments []types.KeyShareAssignment
+		valid       bool
+	}{
+		{
+			desc: "valid",
+			assignments: []types.KeyShareAssignment{
+				{Validator: "a", ShareIndices: []uint64{3, 1}},
+				{Validator: "b", ShareIndices: []uint64{2}},
+			},
+			valid: true,
+		},
+		{
+			desc: "zero index",
+			assignments: []types.KeyShareAssignment{
+				{Validator: "a", ShareIndices: []uint64{0, 1}},
+				{Validator: "b", ShareIndices: []uint64{2}},
+			},
+		},
+		{
+			desc: "index out of range",
+			assignments: []types.KeyShareAssignment{
+				{Validator: "a", ShareIndices: []uint64{1, 4}},
+				{Validator: "b", ShareIndices: []uint64{2}},
+			},
+		},
+		{
+			desc: "index assigned twice",
+			assignments: []types.KeyShareAssignment{
+				{Validator: "a", ShareIndices: []uint64{1, 2}},
+				{Validator: "b", ShareIndices: []uint64{2, 3}},
+			},
+		},
+		{
+			desc: "missing index",
+			assignments: []types.KeyShareAssignment{
+				{Validator: "a", ShareIndices: []uint64{1}},
+				{Validator: "b", ShareIndices: []uint64{2}},
+			},
+		},
+		{
+			desc: "duplicated validator",
+			assignments: []types.KeyShareAssignment{
+				{Validator: "a", ShareIndices: []uint64{1}},
+				{Validator: "a", ShareIndices: []uint64{2, 3}},
+			},
+		},
+		{
+			desc: "validator without index",
+			assignments: []types.KeyShareAssignment{
+				{Validator: "a", ShareIndices: []uint64{1, 2, 3}},
+				{Validator: "b"},
+			},
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := types.ValidateKeyShareAssignments(tc.assignments, 3)
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, types.ErrInvalidKeyShareAssignments)
+			}
+		})
+	}
+}
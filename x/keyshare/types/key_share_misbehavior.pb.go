@@ -0,0 +1,493 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: fairyring/keyshare/key_share_misbehavior.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "github.com/gogo/protobuf/types"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// KeyShareMisbehavior tracks the invalid key shares submitted by a validator
+type KeyShareMisbehavior struct {
+	Validator         string    `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	InvalidCount      uint64    `protobuf:"varint,2,opt,name=invalidCount,proto3" json:"invalidCount,omitempty"`
+	WindowStartHeight uint64    `protobuf:"varint,3,opt,name=windowStartHeight,proto3" json:"windowStartHeight,omitempty"`
+	Jailed            bool      `protobuf:"varint,4,opt,name=jailed,proto3" json:"jailed,omitempty"`
+	JailedUntil       time.Time `protobuf:"bytes,5,opt,name=jailedUntil,proto3,stdtime" json:"jailedUntil"`
+}
+
+func (m *KeyShareMisbehavior) Reset()         { *m = KeyShareMisbehavior{} }
+func (m *KeyShareMisbehavior) String() string { return proto.CompactTextString(m) }
+func (*KeyShareMisbehavior) ProtoMessage()    {}
+func (*KeyShareMisbehavior) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0ac79d9222c62ad8, []int{0}
+}
+func (m *KeyShareMisbehavior) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KeyShareMisbehavior) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_KeyShareMisbehavior.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *KeyShareMisbehavior) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KeyShareMisbehavior.Merge(m, src)
+}
+func (m *KeyShareMisbehavior) XXX_Size() int {
+	return m.Size()
+}
+func (m *KeyShareMisbehavior) XXX_DiscardUnknown() {
+	xxx_messageInfo_KeyShareMisbehavior.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KeyShareMisbehavior proto.InternalMessageInfo
+
+func (m *KeyShareMisbehavior) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+func (m *KeyShareMisbehavior) GetInvalidCount() uint64 {
+	if m != nil {
+		return m.InvalidCount
+	}
+	return 0
+}
+
+func (m *KeyShareMisbehavior) GetWindowStartHeight() uint64 {
+	if m != nil {
+		return m.WindowStartHeight
+	}
+	return 0
+}
+
+func (m *KeyShareMisbehavior) GetJailed() bool {
+	if m != nil {
+		return m.Jailed
+	}
+	return false
+}
+
+func (m *KeyShareMisbehavior) GetJailedUntil() time.Time {
+	if m != nil {
+		return m.JailedUntil
+	}
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterType((*KeyShareMisbehavior)(nil), "fairyring.keyshare.KeyShareMisbehavior")
+}
+
+func init() {
+	proto.RegisterFile("fairyring/keyshare/key_share_misbehavior.proto", fileDescriptor_0ac79d9222c62ad8)
+}
+
+var fileDescriptor_0ac79d9222c62ad8 = []byte{
+	// 300 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x90, 0x41, 0x4b, 0xfb, 0x30,
+	0x18, 0xc6, 0x9b, 0xff, 0x7f, 0x8e, 0x2d, 0xf3, 0x62, 0x14, 0x29, 0x45, 0xb2, 0xb2, 0x53, 0x0f,
+	0x92, 0x82, 0xfa, 0x09, 0x26, 0x88, 0x20, 0x5e, 0x3a, 0xbd, 0x78, 0x19, 0x19, 0xcb, 0xd2, 0x68,
+	0xdb, 0x94, 0x34, 0xdb, 0xec, 0xb7, 0xd8, 0xc7, 0xda, 0x71, 0x47, 0x4f, 0x2a, 0x2d, 0x7e, 0x0f,
+	0x69, 0x6a, 0xad, 0xe2, 0xed, 0x79, 0xde, 0xf7, 0x97, 0xbc, 0x3c, 0x0f, 0x24, 0x0b, 0x2a, 0x54,
+	0xae, 0x44, 0xc2, 0xfd, 0x27, 0x96, 0x67, 0x21, 0x55, 0xac, 0x12, 0x53, 0xa3, 0xa6, 0xb1, 0xc8,
+	0x66, 0x2c, 0xa4, 0x2b, 0x21, 0x15, 0x49, 0x95, 0xd4, 0x12, 0xa1, 0x6f, 0x9e, 0x34, 0xbc, 0x73,
+	0xc4, 0x25, 0x97, 0x66, 0xed, 0x57, 0xaa, 0x26, 0x9d, 0x21, 0x97, 0x92, 0x47, 0xcc, 0x37, 0x6e,
+	0xb6, 0x5c, 0xf8, 0x5a, 0xc4, 0x2c, 0xd3, 0x34, 0x4e, 0x6b, 0x60, 0xf4, 0x01, 0xe0, 0xe1, 0x0d,
+	0xcb, 0x27, 0xd5, 0x1f, 0xb7, 0xed, 0x21, 0x74, 0x02, 0xfb, 0x2b, 0x1a, 0x89, 0x39, 0xd5, 0x52,
+	0xd9, 0xc0, 0x05, 0x5e, 0x3f, 0x68, 0x07, 0x68, 0x04, 0xf7, 0x45, 0x62, 0xec, 0xa5, 0x5c, 0x26,
+	0xda, 0xfe, 0xe7, 0x02, 0xaf, 0x13, 0xfc, 0x9a, 0xa1, 0x53, 0x78, 0xb0, 0x16, 0xc9, 0x5c, 0xae,
+	0x27, 0x9a, 0x2a, 0x7d, 0xcd, 0x04, 0x0f, 0xb5, 0xfd, 0xdf, 0x80, 0x7f, 0x17, 0xe8, 0x18, 0x76,
+	0x1f, 0xa9, 0x88, 0xd8, 0xdc, 0xee, 0xb8, 0xc0, 0xeb, 0x05, 0x5f, 0x0e, 0x5d, 0xc1, 0x41, 0xad,
+	0xee, 0x13, 0x2d, 0x22, 0x7b, 0xcf, 0x05, 0xde, 0xe0, 0xcc, 0x21, 0x75, 0x2c, 0xd2, 0xc4, 0x22,
+	0x77, 0x4d, 0xac, 0x71, 0x6f, 0xfb, 0x3a, 0xb4, 0x36, 0x6f, 0x43, 0x10, 0xfc, 0x7c, 0x38, 0xbe,
+	0xd8, 0x16, 0x18, 0xec, 0x0a, 0x0c, 0xde, 0x0b, 0x0c, 0x36, 0x25, 0xb6, 0x76, 0x25, 0xb6, 0x5e,
+	0x4a, 0x6c, 0x3d, 0x38, 0x6d, 0xf9, 0xcf, 0x6d, 0xfd, 0x3a, 0x4f, 0x59, 0x36, 0xeb, 0x9a, 0x03,
+	0xe7, 0x9f, 0x03, 0x00, 0xed, 0xba, 0xa5, 0x6f, 0xa1, 0x01, 0x00, 0x00,
+}
+
+func (m *KeyShareMisbehavior) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KeyShareMisbehavior) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KeyShareMisbehavior) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.JailedUntil, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.JailedUntil):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintKeyShareMisbehavior(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x2a
+	if m.Jailed {
+		i--
+		if m.Jailed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.WindowStartHeight != 0 {
+		i = encodeVarintKeyShareMisbehavior(dAtA, i, uint64(m.WindowStartHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.InvalidCount != 0 {
+		i = encodeVarintKeyShareMisbehavior(dAtA, i, uint64(m.InvalidCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintKeyShareMisbehavior(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintKeyShareMisbehavior(dAtA []byte, offset int, v uint64) int {
+	offset -= sovKeyShareMisbehavior(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *KeyShareMisbehavior) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovKeyShareMisbehavior(uint64(l))
+	}
+	if m.InvalidCount != 0 {
+		n += 1 + sovKeyShareMisbehavior(uint64(m.InvalidCount))
+	}
+	if m.WindowStartHeight != 0 {
+		n += 1 + sovKeyShareMisbehavior(uint64(m.WindowStartHeight))
+	}
+	if m.Jailed {
+		n += 2
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.JailedUntil)
+	n += 1 + l + sovKeyShareMisbehavior(uint64(l))
+	return n
+}
+
+func sovKeyShareMisbehavior(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozKeyShareMisbehavior(x uint64) (n int) {
+	return sovKeyShareMisbehavior(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *KeyShareMisbehavior) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeyShareMisbehavior
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KeyShareMisbehavior: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KeyShareMisbehavior: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyShareMisbehavior
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeyShareMisbehavior
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeyShareMisbehavior
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidCount", wireType)
+			}
+			m.InvalidCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyShareMisbehavior
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InvalidCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowStartHeight", wireType)
+			}
+			m.WindowStartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyShareMisbehavior
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowStartHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jailed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyShareMisbehavior
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Jailed = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailedUntil", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyShareMisbehavior
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthKeyShareMisbehavior
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeyShareMisbehavior
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.JailedUntil, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeyShareMisbehavior(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthKeyShareMisbehavior
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipKeyShareMisbehavior(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowKeyShareMisbehavior
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowKeyShareMisbehavior
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowKeyShareMisbehavior
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthKeyShareMisbehavior
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupKeyShareMisbehavior
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthKeyShareMisbehavior
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthKeyShareMisbehavior        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowKeyShareMisbehavior          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupKeyShareMisbehavior = fmt.Errorf("proto: unexpected end of group")
+)
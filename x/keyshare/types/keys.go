@@ -24,13 +24,20 @@ const (
 )
 
 const (
-	KeyAggregationThresholdPercentage  = 2.0 / 3.0
-	KeyAggregationThresholdNumerator   = 2
-	KeyAggregationThresholdDenominator = 3
+	SlashPower int64 = 100
 )
 
 const (
-	SlashPower int64 = 100
+	// MaxPrunedEntriesPerBlock bounds the number of entries of each store scanned & pruned in a single block
+	MaxPrunedEntriesPerBlock = 1000
+
+	// PruneCursorKeyPrefix is the prefix of the key each pruned store is scanned from in the next block
+	PruneCursorKeyPrefix = "PruneCursor/value/"
+)
+
+const (
+	// MaxAggrKeyshareRetryBackoffShift caps the exponential backoff of the aggregated key packet retries
+	MaxAggrKeyshareRetryBackoffShift = 16
 )
 
 const (
@@ -38,6 +45,11 @@ const (
 	RegisteredValidatorEventCreator = "creator"
 )
 
+const (
+	DeRegisteredValidatorEventType    = "validator-deregistered"
+	DeRegisteredValidatorEventCreator = "creator"
+)
+
 const (
 	SendKeyshareEventType                = "keyshare-sent"
 	SendKeyshareEventValidator           = "validator"
@@ -50,6 +62,22 @@ const (
 const (
 	StartSendGeneralKeyShareEventType     = "start-send-general-keyshare"
 	StartSendGeneralKeyShareEventIdentity = "start-send-general-keyshare-identity"
+	StartSendGeneralKeyShareEventIDType   = "start-send-general-keyshare-id-type"
+)
+
+const (
+	GeneralKeyRequestedEventType          = "general-key-requested"
+	GeneralKeyRequestedEventIdentity      = "general-key-requested-identity"
+	GeneralKeyRequestedEventCreator       = "general-key-requested-creator"
+	GeneralKeyRequestedEventReleaseHeight = "general-key-requested-release-height"
+	GeneralKeyRequestedEventFee           = "general-key-requested-fee"
+)
+
+const (
+	GeneralKeyRequestExpiredEventType     = "general-key-request-expired"
+	GeneralKeyRequestExpiredEventIdentity = "general-key-request-expired-identity"
+	GeneralKeyRequestExpiredEventCreator  = "general-key-request-expired-creator"
+	GeneralKeyRequestExpiredEventRefund   = "general-key-request-expired-refund"
 )
 
 const (
@@ -83,6 +111,150 @@ const (
 	QueuedPubKeyCreatedEventExpiryHeight             = "queued-pubkey-created-expiry-height"
 	QueuedPubKeyCreatedEventCreator                  = "queued-pubkey-created-creator"
 	QueuedPubKeyCreatedEventPubkey                   = "queued-pubkey-created-pubkey"
+	QueuedPubKeyCreatedEventThreshold                = "queued-pubkey-created-threshold"
+)
+
+const (
+	DkgRoundStartedEventType        = "dkg-round-started"
+	DkgRoundStartedEventRoundID     = "dkg-round-started-round-id"
+	DkgRoundStartedEventThreshold   = "dkg-round-started-threshold"
+	DkgRoundStartedEventParticipant = "dkg-round-started-participants"
+)
+
+const (
+	DkgDealSubmittedEventType    = "dkg-deal-submitted"
+	DkgDealSubmittedEventRoundID = "dkg-deal-submitted-round-id"
+	DkgDealSubmittedEventDealer  = "dkg-deal-submitted-dealer"
+)
+
+const (
+	DkgComplaintSubmittedEventType       = "dkg-complaint-submitted"
+	DkgComplaintSubmittedEventRoundID    = "dkg-complaint-submitted-round-id"
+	DkgComplaintSubmittedEventDealer     = "dkg-complaint-submitted-dealer"
+	DkgComplaintSubmittedEventComplainer = "dkg-complaint-submitted-complainer"
+)
+
+const (
+	DkgJustificationSubmittedEventType       = "dkg-justification-submitted"
+	DkgJustificationSubmittedEventRoundID    = "dkg-justification-submitted-round-id"
+	DkgJustificationSubmittedEventDealer     = "dkg-justification-submitted-dealer"
+	DkgJustificationSubmittedEventComplainer = "dkg-justification-submitted-complainer"
+)
+
+const (
+	DkgRoundPhaseChangedEventType    = "dkg-round-phase-changed"
+	DkgRoundPhaseChangedEventRoundID = "dkg-round-phase-changed-round-id"
+	DkgRoundPhaseChangedEventPhase   = "dkg-round-phase-changed-phase"
+	DkgRoundPhaseChangedEventPubKey  = "dkg-round-phase-changed-pubkey"
+)
+
+const (
+	InvalidKeyShareEventType         = "invalid-keyshare-submitted"
+	InvalidKeyShareEventValidator    = "invalid-keyshare-submitted-validator"
+	InvalidKeyShareEventIdentity     = "invalid-keyshare-submitted-identity"
+	InvalidKeyShareEventIndex        = "invalid-keyshare-submitted-index"
+	InvalidKeyShareEventKeyShare     = "invalid-keyshare-submitted-keyshare"
+	InvalidKeyShareEventInvalidCount = "invalid-keyshare-submitted-invalid-count"
+)
+
+const (
+	KeyshareValidatorJailedEventType         = "keyshare-validator-jailed"
+	KeyshareValidatorJailedEventValidator    = "keyshare-validator-jailed-validator"
+	KeyshareValidatorJailedEventReason       = "keyshare-validator-jailed-reason"
+	KeyshareValidatorJailedEventInvalidCount = "keyshare-validator-jailed-invalid-count"
+	KeyshareValidatorJailedEventJailedUntil  = "keyshare-validator-jailed-jailed-until"
+)
+
+const (
+	JailReasonInvalidKeyShares = "invalid-keyshares"
+	JailReasonDowntime         = "downtime"
+)
+
+const (
+	KeyshareValidatorUnjailedEventType      = "keyshare-validator-unjailed"
+	KeyshareValidatorUnjailedEventValidator = "keyshare-validator-unjailed-validator"
+)
+
+const (
+	KeyshareCommittedEventType          = "keyshare-committed"
+	KeyshareCommittedEventValidator     = "keyshare-committed-validator"
+	KeyshareCommittedEventBlockHeight   = "keyshare-committed-block-height"
+	KeyshareCommittedEventKeyShareIndex = "keyshare-committed-keyshare-index"
+	KeyshareCommittedEventCommitment    = "keyshare-committed-commitment"
+)
+
+const (
+	AuthorizedAddressExpiredEventType         = "authorized-address-expired"
+	AuthorizedAddressExpiredEventTarget       = "authorized-address-expired-target"
+	AuthorizedAddressExpiredEventAuthorizedBy = "authorized-address-expired-authorized-by"
+)
+
+const (
+	KeysharePausedEventType        = "keyshare-paused"
+	KeysharePausedEventValidator   = "keyshare-paused-validator"
+	KeysharePausedEventPausedUntil = "keyshare-paused-paused-until"
+)
+
+const (
+	KeyshareResumedEventType      = "keyshare-resumed"
+	KeyshareResumedEventValidator = "keyshare-resumed-validator"
+)
+
+const (
+	KeySharePrunedEventType                = "keyshare-pruned"
+	KeySharePrunedEventBlockHeight         = "keyshare-pruned-block-height"
+	KeySharePrunedEventKeyShares           = "keyshare-pruned-keyshares"
+	KeySharePrunedEventGeneralKeyShares    = "keyshare-pruned-general-keyshares"
+	KeySharePrunedEventAggregatedKeyShares = "keyshare-pruned-aggregated-keyshares"
+	KeySharePrunedEventKeyshareCommitments = "keyshare-pruned-keyshare-commitments"
+)
+
+const (
+	KeyShareRequestFailedEventType     = "keyshare-request-failed"
+	KeyShareRequestFailedEventIdentity = "keyshare-request-failed-identity"
+	KeyShareRequestFailedEventReason   = "keyshare-request-failed-reason"
+)
+
+const (
+	KeyShareRequestFailureDeadline = "deadline"
+	KeyShareRequestFailureRetries  = "retries"
+	KeyShareRequestFailureSend     = "send-error"
+)
+
+const (
+	AggrKeyshareSendAttemptEventType             = "aggr-keyshare-send-attempt"
+	AggrKeyshareSendAttemptEventIdentity         = "aggr-keyshare-send-attempt-identity"
+	AggrKeyshareSendAttemptEventPort             = "aggr-keyshare-send-attempt-port"
+	AggrKeyshareSendAttemptEventChannel          = "aggr-keyshare-send-attempt-channel"
+	AggrKeyshareSendAttemptEventSequence         = "aggr-keyshare-send-attempt-sequence"
+	AggrKeyshareSendAttemptEventRetry            = "aggr-keyshare-send-attempt-retry"
+	AggrKeyshareSendAttemptEventTimeoutTimestamp = "aggr-keyshare-send-attempt-timeout-timestamp"
+)
+
+const (
+	AggrKeyshareRetryScheduledEventType     = "aggr-keyshare-retry-scheduled"
+	AggrKeyshareRetryScheduledEventIdentity = "aggr-keyshare-retry-scheduled-identity"
+	AggrKeyshareRetryScheduledEventRetry    = "aggr-keyshare-retry-scheduled-retry"
+	AggrKeyshareRetryScheduledEventHeight   = "aggr-keyshare-retry-scheduled-height"
+	AggrKeyshareRetryScheduledEventReason   = "aggr-keyshare-retry-scheduled-reason"
+)
+
+const (
+	AggrKeyshareRetryReasonErrorAck = "error-ack"
+	AggrKeyshareRetryReasonTimeout  = "timeout"
+)
+
+const (
+	AggrKeyshareDeliveredEventType     = "aggr-keyshare-delivered"
+	AggrKeyshareDeliveredEventIdentity = "aggr-keyshare-delivered-identity"
+	AggrKeyshareDeliveredEventRetry    = "aggr-keyshare-delivered-retry"
+)
+
+const (
+	KeyshareRewardsDistributedEventType      = "keyshare-rewards-distributed"
+	KeyshareRewardsDistributedEventValidator = "keyshare-rewards-distributed-validator"
+	KeyshareRewardsDistributedEventShares    = "keyshare-rewards-distributed-shares"
+	KeyshareRewardsDistributedEventAmount    = "keyshare-rewards-distributed-amount"
 )
 
 const (
@@ -0,0 +1,41 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+)
+
+// MaxKeyshareCommitAhead is the number of blocks ahead of the current height
+// a validator can commit to the key share of a block height
+const MaxKeyshareCommitAhead = 100
+
+// KeyshareCommitmentHash returns the hex encoded sha256 commitment of the key share a validator
+// reveals for a block height & key share index
+func KeyshareCommitmentHash(validator string, blockHeight uint64, keyShareIndex uint64, keyShare string) string {
+	heightBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(heightBytes, blockHeight)
+
+	indexBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(indexBytes, keyShareIndex)
+
+	h := sha256.New()
+	h.Write([]byte(validator))
+	h.Write(heightBytes)
+	h.Write(indexBytes)
+	h.Write([]byte(keyShare))
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// ValidateKeyshareCommitment checks the commitment is a hex encoded sha256 hash
+func ValidateKeyshareCommitment(commitment string) error {
+	b, err := hex.DecodeString(commitment)
+	if err != nil {
+		return ErrInvalidKeyshareCommitment.Wrap(err.Error())
+	}
+	if len(b) != sha256.Size {
+		return ErrInvalidKeyshareCommitment.Wrapf("expected %d bytes, got: %d", sha256.Size, len(b))
+	}
+	return nil
+}
@@ -0,0 +1,26 @@
+package types_test
+
+import (
+	"testing"
+
+	"fairyring/x/keyshare/types"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeyshareCommitmentHash(t *testing.T) {
+	commitment := types.KeyshareCommitmentHash("validator", 10, 1, "abcd")
+	require.NoError(t, types.ValidateKeyshareCommitment(commitment))
+	require.Equal(t, commitment, types.KeyshareCommitmentHash("validator", 10, 1, "abcd"))
+
+	// The commitment is bound to the validator, the height, the index & the key share
+	require.NotEqual(t, commitment, types.KeyshareCommitmentHash("other", 10, 1, "abcd"))
+	require.NotEqual(t, commitment, types.KeyshareCommitmentHash("validator", 11, 1, "abcd"))
+	require.NotEqual(t, commitment, types.KeyshareCommitmentHash("validator", 10, 2, "abcd"))
+	require.NotEqual(t, commitment, types.KeyshareCommitmentHash("validator", 10, 1, "abce"))
+}
+
+func TestValidateKeyshareCommitment(t *testing.T) {
+	require.ErrorIs(t, types.ValidateKeyshareCommitment("zz"), types.ErrInvalidKeyshareCommitment)
+	require.ErrorIs(t, types.ValidateKeyshareCommitment("abcd"), types.ErrInvalidKeyshareCommitment)
+}
@@ -0,0 +1,50 @@
+package types
+
+// NewKeyshareLivenessInfo returns the liveness info of a validator tracked from startHeight
+// with a liveness window of the given size
+func NewKeyshareLivenessInfo(validator string, startHeight uint64, window uint64) KeyshareLivenessInfo {
+	return KeyshareLivenessInfo{
+		Validator:    validator,
+		StartHeight:  startHeight,
+		MissedBitmap: make([]byte, livenessBitmapLen(window)),
+		WindowSize:   window,
+	}
+}
+
+// RecordHeight records whether the validator missed the key share height in the current slot
+// of the liveness window and moves on to the next slot. The missed counter is updated with the
+// slot overwritten, so it always holds the number of heights missed within the last window
+func (l *KeyshareLivenessInfo) RecordHeight(window uint64, missed bool) {
+	// Start over if the window size changed, the slots of the previous window no longer line up
+	if l.WindowSize != window {
+		l.MissedBitmap = make([]byte, livenessBitmapLen(window))
+		l.WindowSize = window
+		l.IndexOffset = 0
+		l.MissedCounter = 0
+	}
+
+	index := l.IndexOffset % window
+	l.IndexOffset++
+
+	previous := l.IsMissed(index)
+	switch {
+	case !previous && missed:
+		l.MissedBitmap[index/8] |= 1 << (index % 8)
+		l.MissedCounter++
+	case previous && !missed:
+		l.MissedBitmap[index/8] &^= 1 << (index % 8)
+		l.MissedCounter--
+	}
+}
+
+// IsMissed returns true if the key share height of the given window slot was missed
+func (l KeyshareLivenessInfo) IsMissed(index uint64) bool {
+	if index/8 >= uint64(len(l.MissedBitmap)) {
+		return false
+	}
+	return l.MissedBitmap[index/8]&(1<<(index%8)) != 0
+}
+
+func livenessBitmapLen(window uint64) int {
+	return int((window + 7) / 8)
+}
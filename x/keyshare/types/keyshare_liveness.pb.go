@@ -0,0 +1,517 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: fairyring/keyshare/keyshare_liveness.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// KeyshareLivenessInfo tracks the key share heights missed by a validator
+// over the last KeyshareLivenessWindow blocks
+type KeyshareLivenessInfo struct {
+	Validator     string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	StartHeight   uint64 `protobuf:"varint,2,opt,name=startHeight,proto3" json:"startHeight,omitempty"`
+	IndexOffset   uint64 `protobuf:"varint,3,opt,name=indexOffset,proto3" json:"indexOffset,omitempty"`
+	MissedCounter uint64 `protobuf:"varint,4,opt,name=missedCounter,proto3" json:"missedCounter,omitempty"`
+	MissedBitmap  []byte `protobuf:"bytes,5,opt,name=missedBitmap,proto3" json:"missedBitmap,omitempty"`
+	WindowSize    uint64 `protobuf:"varint,6,opt,name=windowSize,proto3" json:"windowSize,omitempty"`
+}
+
+func (m *KeyshareLivenessInfo) Reset()         { *m = KeyshareLivenessInfo{} }
+func (m *KeyshareLivenessInfo) String() string { return proto.CompactTextString(m) }
+func (*KeyshareLivenessInfo) ProtoMessage()    {}
+func (*KeyshareLivenessInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4d7af101b98597af, []int{0}
+}
+func (m *KeyshareLivenessInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KeyshareLivenessInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_KeyshareLivenessInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *KeyshareLivenessInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KeyshareLivenessInfo.Merge(m, src)
+}
+func (m *KeyshareLivenessInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *KeyshareLivenessInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_KeyshareLivenessInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KeyshareLivenessInfo proto.InternalMessageInfo
+
+func (m *KeyshareLivenessInfo) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+func (m *KeyshareLivenessInfo) GetStartHeight() uint64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *KeyshareLivenessInfo) GetIndexOffset() uint64 {
+	if m != nil {
+		return m.IndexOffset
+	}
+	return 0
+}
+
+func (m *KeyshareLivenessInfo) GetMissedCounter() uint64 {
+	if m != nil {
+		return m.MissedCounter
+	}
+	return 0
+}
+
+func (m *KeyshareLivenessInfo) GetMissedBitmap() []byte {
+	if m != nil {
+		return m.MissedBitmap
+	}
+	return nil
+}
+
+func (m *KeyshareLivenessInfo) GetWindowSize() uint64 {
+	if m != nil {
+		return m.WindowSize
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*KeyshareLivenessInfo)(nil), "fairyring.keyshare.KeyshareLivenessInfo")
+}
+
+func init() {
+	proto.RegisterFile("fairyring/keyshare/keyshare_liveness.proto", fileDescriptor_4d7af101b98597af)
+}
+
+var fileDescriptor_4d7af101b98597af = []byte{
+	// 254 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0xd0, 0x3f, 0x4e, 0xc3, 0x30,
+	0x14, 0xc7, 0xf1, 0x18, 0x4a, 0xa5, 0x9a, 0xb2, 0x58, 0x0c, 0x16, 0x42, 0x56, 0x54, 0x31, 0x44,
+	0x0c, 0x65, 0x80, 0x13, 0x94, 0x05, 0x04, 0x12, 0x52, 0xd8, 0x58, 0x90, 0x51, 0x5e, 0xda, 0x27,
+	0x5a, 0x3b, 0xb2, 0x1f, 0x6d, 0xc3, 0x29, 0x38, 0x16, 0x63, 0x47, 0x46, 0xe4, 0x5c, 0x04, 0x91,
+	0xfe, 0x49, 0xba, 0xd9, 0x1f, 0x7d, 0xf5, 0x86, 0x1f, 0xbf, 0xcc, 0x35, 0xba, 0xd2, 0xa1, 0x19,
+	0x5f, 0xbd, 0x43, 0xe9, 0x27, 0xda, 0xc1, 0xee, 0xf1, 0x3a, 0xc5, 0x39, 0x18, 0xf0, 0x7e, 0x58,
+	0x38, 0x4b, 0x56, 0x88, 0x5d, 0x3b, 0xdc, 0x26, 0x83, 0xc0, 0xf8, 0xe9, 0xc3, 0xe6, 0xf3, 0xb8,
+	0xc9, 0xef, 0x4d, 0x6e, 0xc5, 0x39, 0xef, 0xcd, 0xf5, 0x14, 0x33, 0x4d, 0xd6, 0x49, 0x16, 0xb3,
+	0xa4, 0x97, 0x36, 0x20, 0x62, 0x7e, 0xec, 0x49, 0x3b, 0xba, 0x03, 0x1c, 0x4f, 0x48, 0x1e, 0xc4,
+	0x2c, 0xe9, 0xa4, 0x6d, 0xfa, 0x2f, 0xd0, 0x64, 0xb0, 0x7c, 0xca, 0x73, 0x0f, 0x24, 0x0f, 0xd7,
+	0x45, 0x8b, 0xc4, 0x05, 0x3f, 0x99, 0xa1, 0xf7, 0x90, 0xdd, 0xda, 0x0f, 0x43, 0xe0, 0x64, 0xa7,
+	0x6e, 0xf6, 0x51, 0x0c, 0x78, 0x7f, 0x0d, 0x23, 0xa4, 0x99, 0x2e, 0xe4, 0x51, 0xcc, 0x92, 0x7e,
+	0xba, 0x67, 0x42, 0x71, 0xbe, 0x40, 0x93, 0xd9, 0xc5, 0x33, 0x7e, 0x82, 0xec, 0xd6, 0x67, 0x5a,
+	0x32, 0xba, 0xf9, 0x0e, 0x8a, 0xad, 0x82, 0x62, 0xbf, 0x41, 0xb1, 0xaf, 0x4a, 0x45, 0xab, 0x4a,
+	0x45, 0x3f, 0x95, 0x8a, 0x5e, 0xce, 0x9a, 0xf9, 0x96, 0xcd, 0x80, 0x54, 0x16, 0xe0, 0xdf, 0xba,
+	0xf5, 0x6a, 0xd7, 0x7f, 0x03, 0x00, 0x93, 0x86, 0x85, 0xca, 0x63, 0x01, 0x00, 0x00,
+}
+
+func (m *KeyshareLivenessInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KeyshareLivenessInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KeyshareLivenessInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WindowSize != 0 {
+		i = encodeVarintKeyshareLiveness(dAtA, i, uint64(m.WindowSize))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.MissedBitmap) > 0 {
+		i -= len(m.MissedBitmap)
+		copy(dAtA[i:], m.MissedBitmap)
+		i = encodeVarintKeyshareLiveness(dAtA, i, uint64(len(m.MissedBitmap)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.MissedCounter != 0 {
+		i = encodeVarintKeyshareLiveness(dAtA, i, uint64(m.MissedCounter))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.IndexOffset != 0 {
+		i = encodeVarintKeyshareLiveness(dAtA, i, uint64(m.IndexOffset))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintKeyshareLiveness(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintKeyshareLiveness(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintKeyshareLiveness(dAtA []byte, offset int, v uint64) int {
+	offset -= sovKeyshareLiveness(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *KeyshareLivenessInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovKeyshareLiveness(uint64(l))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovKeyshareLiveness(uint64(m.StartHeight))
+	}
+	if m.IndexOffset != 0 {
+		n += 1 + sovKeyshareLiveness(uint64(m.IndexOffset))
+	}
+	if m.MissedCounter != 0 {
+		n += 1 + sovKeyshareLiveness(uint64(m.MissedCounter))
+	}
+	l = len(m.MissedBitmap)
+	if l > 0 {
+		n += 1 + l + sovKeyshareLiveness(uint64(l))
+	}
+	if m.WindowSize != 0 {
+		n += 1 + sovKeyshareLiveness(uint64(m.WindowSize))
+	}
+	return n
+}
+
+func sovKeyshareLiveness(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozKeyshareLiveness(x uint64) (n int) {
+	return sovKeyshareLiveness(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *KeyshareLivenessInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeyshareLiveness
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KeyshareLivenessInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KeyshareLivenessInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyshareLiveness
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeyshareLiveness
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeyshareLiveness
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyshareLiveness
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexOffset", wireType)
+			}
+			m.IndexOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyshareLiveness
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IndexOffset |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedCounter", wireType)
+			}
+			m.MissedCounter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyshareLiveness
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedCounter |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedBitmap", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyshareLiveness
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthKeyshareLiveness
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeyshareLiveness
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MissedBitmap = append(m.MissedBitmap[:0], dAtA[iNdEx:postIndex]...)
+			if m.MissedBitmap == nil {
+				m.MissedBitmap = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowSize", wireType)
+			}
+			m.WindowSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyshareLiveness
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeyshareLiveness(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthKeyshareLiveness
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipKeyshareLiveness(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowKeyshareLiveness
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowKeyshareLiveness
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowKeyshareLiveness
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthKeyshareLiveness
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupKeyshareLiveness
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthKeyshareLiveness
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthKeyshareLiveness        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowKeyshareLiveness          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupKeyshareLiveness = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,68 @@
+package types_test
+
+import (
+	"testing"
+
+	"fairyring/x/keyshare/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeyshareLivenessInfo_RecordHeight(t *testing.T) {
+	const window = 10
+	info := types.NewKeyshareLivenessInfo("validator", 1, window)
+
+	// Miss the first 3 heights, submit the rest of the window
+	for i := 0; i < window; i++ {
+		info.RecordHeight(window, i < 3)
+	}
+	require.Equal(t, uint64(3), info.MissedCounter)
+	require.True(t, info.IsMissed(0))
+	require.False(t, info.IsMissed(3))
+
+	// Submitting in the next window clears the slots missed in the previous one
+	info.RecordHeight(window, false)
+	info.RecordHeight(window, false)
+	require.Equal(t, uint64(1), info.MissedCounter)
+	require.False(t, info.IsMissed(0))
+
+	// Missing a slot already missed does not count twice
+	info.RecordHeight(window, true)
+	require.Equal(t, uint64(1), info.MissedCounter)
+
+	info.RecordHeight(window, true)
+	require.Equal(t, uint64(2), info.MissedCounter)
+	require.True(t, info.IsMissed(3))
+}
+
+func TestKeyshareLivenessInfo_RecordHeightWindowChange(t *testing.T) {
+	info := types.NewKeyshareLivenessInfo("validator", 1, 10)
+	for i := 0; i < 5; i++ {
+		info.RecordHeight(10, true)
+	}
+	require.Equal(t, uint64(5), info.MissedCounter)
+
+	info.RecordHeight(20, true)
+	require.Equal(t, uint64(1), info.MissedCounter)
+	require.Equal(t, uint64(1), info.IndexOffset)
+	require.Len(t, info.MissedBitmap, 3)
+	require.Equal(t, uint64(20), info.WindowSize)
+
+	// Window sizes sharing the same bitmap length start over as well
+	info.RecordHeight(17, true)
+	require.Equal(t, uint64(1), info.MissedCounter)
+	require.Equal(t, uint64(1), info.IndexOffset)
+	require.Equal(t, uint64(17), info.WindowSize)
+}
+
+func TestParams_MinSubmittedPerWindowInt(t *testing.T) {
+	p := types.DefaultParams()
+	p.KeyshareLivenessWindow = 100
+	require.Equal(t, uint64(50), p.MinSubmittedPerWindowInt())
+
+	p.KeyshareLivenessWindow = 7
+	p.MinSubmittedPerWindow = sdk.NewDecWithPrec(6, 1)
+	require.Equal(t, uint64(4), p.MinSubmittedPerWindowInt())
+}
@@ -0,0 +1,20 @@
+package types
+
+// Status returns the delivery status of the key share request
+func (r KeyShareRequest) Status() KeyShareRequestStatus {
+	switch {
+	case r.Sent:
+		return KeyShareRequestStatus_KEY_SHARE_REQUEST_STATUS_SENT
+	case r.Failed:
+		return KeyShareRequestStatus_KEY_SHARE_REQUEST_STATUS_FAILED
+	case r.AggrKeyshare != "":
+		return KeyShareRequestStatus_KEY_SHARE_REQUEST_STATUS_AGGREGATED
+	default:
+		return KeyShareRequestStatus_KEY_SHARE_REQUEST_STATUS_PENDING
+	}
+}
+
+// IsClosed returns true once the key share request was delivered or marked failed
+func (r KeyShareRequest) IsClosed() bool {
+	return r.Sent || r.Failed
+}
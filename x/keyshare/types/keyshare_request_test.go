@@ -0,0 +1,52 @@
+package types_test
+
+import (
+	"testing"
+
+	"fairyring/x/keyshare/types"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeyShareRequestStatus(t *testing.T) {
+	for _, tc := range []struct {
+		desc    string
+		request types.KeyShareRequest
+		status  types.KeyShareRequestStatus
+		closed  bool
+	}{
+		{
+			desc:    "pending",
+			request: types.KeyShareRequest{Identity: "1/rq"},
+			status:  types.KeyShareRequestStatus_KEY_SHARE_REQUEST_STATUS_PENDING,
+		},
+		{
+			desc:    "aggregated",
+			request: types.KeyShareRequest{Identity: "1/rq", AggrKeyshare: "key"},
+			status:  types.KeyShareRequestStatus_KEY_SHARE_REQUEST_STATUS_AGGREGATED,
+		},
+		{
+			desc:    "sent",
+			request: types.KeyShareRequest{Identity: "1/rq", AggrKeyshare: "key", Sent: true},
+			status:  types.KeyShareRequestStatus_KEY_SHARE_REQUEST_STATUS_SENT,
+			closed:  true,
+		},
+		{
+			desc:    "failed",
+			request: types.KeyShareRequest{Identity: "1/rq", AggrKeyshare: "key", Failed: true},
+			status:  types.KeyShareRequestStatus_KEY_SHARE_REQUEST_STATUS_FAILED,
+			closed:  true,
+		},
+		{
+			desc:    "sent after failing",
+			request: types.KeyShareRequest{Identity: "1/rq", AggrKeyshare: "key", Sent: true, Failed: true},
+			status:  types.KeyShareRequestStatus_KEY_SHARE_REQUEST_STATUS_SENT,
+			closed:  true,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			require.Equal(t, tc.status, tc.request.Status())
+			require.Equal(t, tc.closed, tc.request.IsClosed())
+		})
+	}
+}
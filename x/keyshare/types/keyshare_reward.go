@@ -0,0 +1,25 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RewardShare returns the part of the coins proportional to shares out of totalShares, rounded down
+func RewardShare(coins sdk.Coins, shares uint64, totalShares uint64) sdk.Coins {
+	if totalShares == 0 {
+		return sdk.NewCoins()
+	}
+
+	reward := sdk.NewCoins()
+	for _, coin := range coins {
+		amount := coin.Amount.Mul(sdk.NewIntFromUint64(shares)).Quo(sdk.NewIntFromUint64(totalShares))
+		reward = reward.Add(sdk.NewCoin(coin.Denom, amount))
+	}
+	return reward
+}
+
+// FeeShare returns the part of the fees given by the share fraction, rounded down
+func FeeShare(fees sdk.Coins, share sdk.Dec) sdk.Coins {
+	shareFees, _ := sdk.NewDecCoinsFromCoins(fees...).MulDecTruncate(share).TruncateDecimal()
+	return shareFees
+}
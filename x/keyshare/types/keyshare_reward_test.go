@@ -0,0 +1,28 @@
+package types_test
+
+import (
+	"testing"
+
+	"fairyring/x/keyshare/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRewardShare(t *testing.T) {
+	pool := sdk.NewCoins(sdk.NewInt64Coin("ufairy", 100), sdk.NewInt64Coin("uusdc", 10))
+
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("ufairy", 33), sdk.NewInt64Coin("uusdc", 3)), types.RewardShare(pool, 1, 3))
+	require.Equal(t, pool, types.RewardShare(pool, 3, 3))
+	require.True(t, types.RewardShare(pool, 1, 0).IsZero())
+	// Amounts rounded down to zero are left out
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("ufairy", 1)), types.RewardShare(pool, 1, 100))
+}
+
+func TestFeeShare(t *testing.T) {
+	fees := sdk.NewCoins(sdk.NewInt64Coin("ufairy", 101))
+
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("ufairy", 50)), types.FeeShare(fees, sdk.NewDecWithPrec(5, 1)))
+	require.Equal(t, fees, types.FeeShare(fees, sdk.OneDec()))
+	require.True(t, types.FeeShare(fees, sdk.ZeroDec()).IsZero())
+}
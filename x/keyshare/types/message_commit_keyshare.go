@@ -0,0 +1,52 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserror "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgCommitKeyshare = "commit_keyshare"
+
+var _ sdk.Msg = &MsgCommitKeyshare{}
+
+func NewMsgCommitKeyshare(creator string, blockHeight uint64, keyShareIndex uint64, commitment string) *MsgCommitKeyshare {
+	return &MsgCommitKeyshare{
+		Creator:       creator,
+		BlockHeight:   blockHeight,
+		KeyShareIndex: keyShareIndex,
+		Commitment:    commitment,
+	}
+}
+
+func (msg *MsgCommitKeyshare) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCommitKeyshare) Type() string {
+	return TypeMsgCommitKeyshare
+}
+
+func (msg *MsgCommitKeyshare) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgCommitKeyshare) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgCommitKeyshare) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(cosmoserror.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.KeyShareIndex < 1 {
+		return ErrInvalidShare.Wrapf("expected key share index to be at least 1, got: %d", msg.KeyShareIndex)
+	}
+	return ValidateKeyshareCommitment(msg.Commitment)
+}
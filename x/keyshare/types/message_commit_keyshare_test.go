@@ -0,0 +1,62 @@
+package types
+
+import (
+	"testing"
+
+	"fairyring/testutil/sample"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgCommitKeyshare_ValidateBasic(t *testing.T) {
+	commitment := KeyshareCommitmentHash("validator", 10, 1, "abcd")
+
+	tests := []struct {
+		name string
+		msg  MsgCommitKeyshare
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgCommitKeyshare{
+				Creator:       "invalid_address",
+				KeyShareIndex: 1,
+				Commitment:    commitment,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid index",
+			msg: MsgCommitKeyshare{
+				Creator:    sample.AccAddress(),
+				Commitment: commitment,
+			},
+			err: ErrInvalidShare,
+		}, {
+			name: "invalid commitment",
+			msg: MsgCommitKeyshare{
+				Creator:       sample.AccAddress(),
+				KeyShareIndex: 1,
+				Commitment:    "abcd",
+			},
+			err: ErrInvalidKeyshareCommitment,
+		}, {
+			name: "valid",
+			msg: MsgCommitKeyshare{
+				Creator:       sample.AccAddress(),
+				BlockHeight:   10,
+				KeyShareIndex: 1,
+				Commitment:    commitment,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package types
+
+import (
+	"time"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserror "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgPauseKeyshare = "pause_keyshare"
+
+var _ sdk.Msg = &MsgPauseKeyshare{}
+
+func NewMsgPauseKeyshare(creator string, duration time.Duration) *MsgPauseKeyshare {
+	return &MsgPauseKeyshare{
+		Creator:  creator,
+		Duration: duration,
+	}
+}
+
+func (msg *MsgPauseKeyshare) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgPauseKeyshare) Type() string {
+	return TypeMsgPauseKeyshare
+}
+
+func (msg *MsgPauseKeyshare) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgPauseKeyshare) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgPauseKeyshare) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(cosmoserror.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.Duration <= 0 {
+		return ErrInvalidPauseDuration.Wrapf("pause duration must be positive, got: %s", msg.Duration)
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"fairyring/testutil/sample"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgPauseKeyshare_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgPauseKeyshare
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgPauseKeyshare{
+				Creator:  "invalid_address",
+				Duration: time.Hour,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "zero duration",
+			msg: MsgPauseKeyshare{
+				Creator: sample.AccAddress(),
+			},
+			err: ErrInvalidPauseDuration,
+		}, {
+			name: "negative duration",
+			msg: MsgPauseKeyshare{
+				Creator:  sample.AccAddress(),
+				Duration: -time.Hour,
+			},
+			err: ErrInvalidPauseDuration,
+		}, {
+			name: "valid",
+			msg: MsgPauseKeyshare{
+				Creator:  sample.AccAddress(),
+				Duration: time.Hour,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
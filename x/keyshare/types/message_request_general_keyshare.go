@@ -0,0 +1,51 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserror "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgRequestGeneralKeyshare = "request_general_keyshare"
+
+var _ sdk.Msg = &MsgRequestGeneralKeyshare{}
+
+func NewMsgRequestGeneralKeyshare(creator string, id string, releaseHeight uint64) *MsgRequestGeneralKeyshare {
+	return &MsgRequestGeneralKeyshare{
+		Creator:       creator,
+		Id:            id,
+		ReleaseHeight: releaseHeight,
+	}
+}
+
+func (msg *MsgRequestGeneralKeyshare) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRequestGeneralKeyshare) Type() string {
+	return TypeMsgRequestGeneralKeyshare
+}
+
+func (msg *MsgRequestGeneralKeyshare) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgRequestGeneralKeyshare) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgRequestGeneralKeyshare) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(cosmoserror.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.ReleaseHeight == 0 {
+		return ErrInvalidReleaseHeight.Wrap("release height can not be 0")
+	}
+	return ValidateGeneralKeyRequestID(msg.Id)
+}
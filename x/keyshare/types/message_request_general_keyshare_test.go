@@ -0,0 +1,68 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	"fairyring/testutil/sample"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgRequestGeneralKeyshare_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgRequestGeneralKeyshare
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgRequestGeneralKeyshare{
+				Creator:       "invalid_address",
+				Id:            "auction-1",
+				ReleaseHeight: 100,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "zero release height",
+			msg: MsgRequestGeneralKeyshare{
+				Creator: sample.AccAddress(),
+				Id:      "auction-1",
+			},
+			err: ErrInvalidReleaseHeight,
+		}, {
+			name: "empty id",
+			msg: MsgRequestGeneralKeyshare{
+				Creator:       sample.AccAddress(),
+				Id:            " ",
+				ReleaseHeight: 100,
+			},
+			err: ErrInvalidGeneralKeyRequestID,
+		}, {
+			name: "id too long",
+			msg: MsgRequestGeneralKeyshare{
+				Creator:       sample.AccAddress(),
+				Id:            strings.Repeat("a", MaxGeneralKeyRequestIDLength+1),
+				ReleaseHeight: 100,
+			},
+			err: ErrInvalidGeneralKeyRequestID,
+		}, {
+			name: "valid",
+			msg: MsgRequestGeneralKeyshare{
+				Creator:       sample.AccAddress(),
+				Id:            "auction-1",
+				ReleaseHeight: 100,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserror "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgResendAggrKeyshare = "resend_aggr_keyshare"
+
+var _ sdk.Msg = &MsgResendAggrKeyshare{}
+
+func NewMsgResendAggrKeyshare(creator string, identity string) *MsgResendAggrKeyshare {
+	return &MsgResendAggrKeyshare{
+		Creator:  creator,
+		Identity: identity,
+	}
+}
+
+func (msg *MsgResendAggrKeyshare) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgResendAggrKeyshare) Type() string {
+	return TypeMsgResendAggrKeyshare
+}
+
+func (msg *MsgResendAggrKeyshare) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgResendAggrKeyshare) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgResendAggrKeyshare) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(cosmoserror.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.Identity == "" {
+		return sdkerrors.Wrap(cosmoserror.ErrInvalidRequest, "identity can not be empty")
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+package types
+
+import (
+	"testing"
+
+	"fairyring/testutil/sample"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgResendAggrKeyshare_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgResendAggrKeyshare
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgResendAggrKeyshare{
+				Creator:  "invalid_address",
+				Identity: "1/rq",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "empty identity",
+			msg: MsgResendAggrKeyshare{
+				Creator: sample.AccAddress(),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid address",
+			msg: MsgResendAggrKeyshare{
+				Creator:  sample.AccAddress(),
+				Identity: "1/rq",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserror "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	TypeMsgSendKeyshareBatch = "send_keyshare_batch"
+	MaxKeyshareBatchSize     = 100
+)
+
+var _ sdk.Msg = &MsgSendKeyshareBatch{}
+
+func NewMsgSendKeyshareBatch(creator string, keyshares []BatchKeyshare, generalKeyshares []BatchGeneralKeyshare) *MsgSendKeyshareBatch {
+	return &MsgSendKeyshareBatch{
+		Creator:          creator,
+		Keyshares:        keyshares,
+		GeneralKeyshares: generalKeyshares,
+	}
+}
+
+func (msg *MsgSendKeyshareBatch) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSendKeyshareBatch) Type() string {
+	return TypeMsgSendKeyshareBatch
+}
+
+func (msg *MsgSendKeyshareBatch) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgSendKeyshareBatch) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSendKeyshareBatch) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(cosmoserror.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	total := len(msg.Keyshares) + len(msg.GeneralKeyshares)
+	if total == 0 {
+		return ErrInvalidKeyshareBatch.Wrap("batch has no key shares")
+	}
+	if total > MaxKeyshareBatchSize {
+		return ErrInvalidKeyshareBatch.Wrapf("expected at most %d key shares, got: %d", MaxKeyshareBatchSize, total)
+	}
+
+	for _, item := range msg.Keyshares {
+		if err := NewMsgSendKeyshare(msg.Creator, item.Message, item.KeyShareIndex, item.BlockHeight).ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	for _, item := range msg.GeneralKeyshares {
+		if item.KeyShareIndex < 1 {
+			return ErrInvalidShare.Wrapf("expected key share index to be at least 1, got: %d", item.KeyShareIndex)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	"fairyring/testutil/sample"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgSendKeyshareBatch_ValidateBasic(t *testing.T) {
+	validKeyshare := BatchKeyshare{
+		Message:       strings.Repeat("a", KeyShareHexLen),
+		KeyShareIndex: 1,
+		BlockHeight:   10,
+	}
+	validGeneralKeyshare := BatchGeneralKeyshare{
+		IdType:        "private-gov-identity",
+		IdValue:       "1",
+		KeyShare:      strings.Repeat("a", KeyShareHexLen),
+		KeyShareIndex: 1,
+	}
+
+	tests := []struct {
+		name string
+		msg  MsgSendKeyshareBatch
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgSendKeyshareBatch{
+				Creator:   "invalid_address",
+				Keyshares: []BatchKeyshare{validKeyshare},
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "empty batch",
+			msg: MsgSendKeyshareBatch{
+				Creator: sample.AccAddress(),
+			},
+			err: ErrInvalidKeyshareBatch,
+		}, {
+			name: "batch too large",
+			msg: MsgSendKeyshareBatch{
+				Creator:   sample.AccAddress(),
+				Keyshares: make([]BatchKeyshare, MaxKeyshareBatchSize+1),
+			},
+			err: ErrInvalidKeyshareBatch,
+		}, {
+			name: "invalid keyshare length",
+			msg: MsgSendKeyshareBatch{
+				Creator:   sample.AccAddress(),
+				Keyshares: []BatchKeyshare{{Message: "ab", KeyShareIndex: 1, BlockHeight: 10}},
+			},
+			err: ErrInvalidKeyShareLength,
+		}, {
+			name: "invalid general keyshare index",
+			msg: MsgSendKeyshareBatch{
+				Creator:          sample.AccAddress(),
+				GeneralKeyshares: []BatchGeneralKeyshare{{IdType: "private-gov-identity", IdValue: "1"}},
+			},
+			err: ErrInvalidShare,
+		}, {
+			name: "valid batch",
+			msg: MsgSendKeyshareBatch{
+				Creator:          sample.AccAddress(),
+				Keyshares:        []BatchKeyshare{validKeyshare},
+				GeneralKeyshares: []BatchGeneralKeyshare{validGeneralKeyshare},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
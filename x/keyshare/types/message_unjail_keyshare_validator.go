@@ -0,0 +1,46 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserror "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgUnjailKeyshareValidator = "unjail_keyshare_validator"
+
+var _ sdk.Msg = &MsgUnjailKeyshareValidator{}
+
+func NewMsgUnjailKeyshareValidator(creator string) *MsgUnjailKeyshareValidator {
+	return &MsgUnjailKeyshareValidator{
+		Creator: creator,
+	}
+}
+
+func (msg *MsgUnjailKeyshareValidator) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUnjailKeyshareValidator) Type() string {
+	return TypeMsgUnjailKeyshareValidator
+}
+
+func (msg *MsgUnjailKeyshareValidator) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgUnjailKeyshareValidator) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUnjailKeyshareValidator) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(cosmoserror.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	return nil
+}
@@ -16,11 +16,14 @@ var _ sdk.Msg = &MsgCreateAuthorizedAddress{}
 func NewMsgCreateAuthorizedAddress(
 	creator string,
 	target string,
-
+	expiryHeight uint64,
+	scope AuthorizedScope,
 ) *MsgCreateAuthorizedAddress {
 	return &MsgCreateAuthorizedAddress{
-		Creator: creator,
-		Target:  target,
+		Creator:      creator,
+		Target:       target,
+		ExpiryHeight: expiryHeight,
+		Scope:        scope,
 	}
 }
 
@@ -50,7 +53,7 @@ func (msg *MsgCreateAuthorizedAddress) ValidateBasic() error {
 	if err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
 	}
-	return nil
+	return msg.Scope.Validate()
 }
 
 var _ sdk.Msg = &MsgUpdateAuthorizedAddress{}
@@ -59,11 +62,15 @@ func NewMsgUpdateAuthorizedAddress(
 	creator string,
 	target string,
 	isAuthorized bool,
+	expiryHeight uint64,
+	scope AuthorizedScope,
 ) *MsgUpdateAuthorizedAddress {
 	return &MsgUpdateAuthorizedAddress{
 		Creator:      creator,
 		Target:       target,
 		IsAuthorized: isAuthorized,
+		ExpiryHeight: expiryHeight,
+		Scope:        scope,
 	}
 }
 
@@ -93,7 +100,7 @@ func (msg *MsgUpdateAuthorizedAddress) ValidateBasic() error {
 	if err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
 	}
-	return nil
+	return msg.Scope.Validate()
 }
 
 var _ sdk.Msg = &MsgDeleteAuthorizedAddress{}
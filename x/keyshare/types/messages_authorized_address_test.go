@@ -20,11 +20,25 @@ func TestMsgCreateAuthorizedAddress_ValidateBasic(t *testing.T) {
 				Creator: "invalid_address",
 			},
 			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "duplicated general id type",
+			msg: MsgCreateAuthorizedAddress{
+				Creator: sample.AccAddress(),
+				Scope:   AuthorizedScope{GeneralIdTypes: []string{"private-gov-identity", "private-gov-identity"}},
+			},
+			err: ErrInvalidAuthorizedScope,
 		}, {
 			name: "valid address",
 			msg: MsgCreateAuthorizedAddress{
 				Creator: sample.AccAddress(),
 			},
+		}, {
+			name: "valid scope",
+			msg: MsgCreateAuthorizedAddress{
+				Creator:      sample.AccAddress(),
+				ExpiryHeight: 100,
+				Scope:        AuthorizedScope{GeneralIdTypes: []string{"private-gov-identity"}},
+			},
 		},
 	}
 	for _, tt := range tests {
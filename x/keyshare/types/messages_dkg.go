@@ -0,0 +1,226 @@
+package types
+
+import (
+	"encoding/hex"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserror "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	TypeMsgStartDkgRound          = "start_dkg_round"
+	TypeMsgSubmitDkgDeal          = "submit_dkg_deal"
+	TypeMsgSubmitDkgComplaint     = "submit_dkg_complaint"
+	TypeMsgSubmitDkgJustification = "submit_dkg_justification"
+)
+
+var _ sdk.Msg = &MsgStartDkgRound{}
+
+func NewMsgStartDkgRound(creator string) *MsgStartDkgRound {
+	return &MsgStartDkgRound{
+		Creator: creator,
+	}
+}
+
+func (msg *MsgStartDkgRound) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgStartDkgRound) Type() string {
+	return TypeMsgStartDkgRound
+}
+
+func (msg *MsgStartDkgRound) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgStartDkgRound) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgStartDkgRound) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(cosmoserror.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgSubmitDkgDeal{}
+
+func NewMsgSubmitDkgDeal(
+	creator string,
+	roundId uint64,
+	commitments []string,
+	encryptedShares []DkgEncryptedShare,
+) *MsgSubmitDkgDeal {
+	return &MsgSubmitDkgDeal{
+		Creator:         creator,
+		RoundId:         roundId,
+		Commitments:     commitments,
+		EncryptedShares: encryptedShares,
+	}
+}
+
+func (msg *MsgSubmitDkgDeal) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSubmitDkgDeal) Type() string {
+	return TypeMsgSubmitDkgDeal
+}
+
+func (msg *MsgSubmitDkgDeal) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgSubmitDkgDeal) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSubmitDkgDeal) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(cosmoserror.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if len(msg.Commitments) == 0 {
+		return ErrEmptyCommitments
+	}
+	for _, c := range msg.Commitments {
+		if len(c) != CommitmentHexLength {
+			return ErrInvalidCommitmentLength.Wrapf("expected hex encoding commitment to be length: %d", CommitmentHexLength)
+		}
+		if _, err = hex.DecodeString(c); err != nil {
+			return ErrInvalidCommitment.Wrapf("expected hex encoded commitment, got: %s", c)
+		}
+	}
+	if len(msg.EncryptedShares) == 0 {
+		return ErrInvalidDkgDeal.Wrap("encrypted shares are empty")
+	}
+	seen := make(map[uint64]struct{})
+	for _, s := range msg.EncryptedShares {
+		if s.Index < 1 {
+			return ErrInvalidDkgDeal.Wrapf("expected share index to be at least 1, got: %d", s.Index)
+		}
+		if _, found := seen[s.Index]; found {
+			return ErrInvalidDkgDeal.Wrapf("duplicated encrypted share for index: %d", s.Index)
+		}
+		seen[s.Index] = struct{}{}
+		if len(s.Data) == 0 {
+			return ErrInvalidDkgDeal.Wrapf("encrypted share for index %d is empty", s.Index)
+		}
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgSubmitDkgComplaint{}
+
+func NewMsgSubmitDkgComplaint(creator string, roundId uint64, dealer string) *MsgSubmitDkgComplaint {
+	return &MsgSubmitDkgComplaint{
+		Creator: creator,
+		RoundId: roundId,
+		Dealer:  dealer,
+	}
+}
+
+func (msg *MsgSubmitDkgComplaint) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSubmitDkgComplaint) Type() string {
+	return TypeMsgSubmitDkgComplaint
+}
+
+func (msg *MsgSubmitDkgComplaint) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgSubmitDkgComplaint) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSubmitDkgComplaint) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(cosmoserror.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	_, err = sdk.AccAddressFromBech32(msg.Dealer)
+	if err != nil {
+		return sdkerrors.Wrapf(cosmoserror.ErrInvalidAddress, "invalid dealer address (%s)", err)
+	}
+	if msg.Creator == msg.Dealer {
+		return ErrInvalidDkgDeal.Wrap("unable to complain against own deal")
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgSubmitDkgJustification{}
+
+func NewMsgSubmitDkgJustification(creator string, roundId uint64, complainer string, shares []string) *MsgSubmitDkgJustification {
+	return &MsgSubmitDkgJustification{
+		Creator:    creator,
+		RoundId:    roundId,
+		Complainer: complainer,
+		Shares:     shares,
+	}
+}
+
+func (msg *MsgSubmitDkgJustification) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSubmitDkgJustification) Type() string {
+	return TypeMsgSubmitDkgJustification
+}
+
+func (msg *MsgSubmitDkgJustification) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgSubmitDkgJustification) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSubmitDkgJustification) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(cosmoserror.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	_, err = sdk.AccAddressFromBech32(msg.Complainer)
+	if err != nil {
+		return sdkerrors.Wrapf(cosmoserror.ErrInvalidAddress, "invalid complainer address (%s)", err)
+	}
+	if len(msg.Shares) == 0 {
+		return ErrInvalidDkgJustification.Wrap("expected at least one share")
+	}
+	for _, share := range msg.Shares {
+		if len(share) != DkgShareHexLen {
+			return ErrInvalidDkgJustification.Wrapf("expected hex encoded share length to be %d", DkgShareHexLen)
+		}
+		if _, err = hex.DecodeString(share); err != nil {
+			return ErrInvalidDkgJustification.Wrapf("expected hex encoded share, got: %s", share)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,154 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	"fairyring/testutil/sample"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgSubmitDkgDeal_ValidateBasic(t *testing.T) {
+	validCommitment := strings.Repeat("a", CommitmentHexLength)
+	tests := []struct {
+		name string
+		msg  MsgSubmitDkgDeal
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgSubmitDkgDeal{
+				Creator: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "empty commitments",
+			msg: MsgSubmitDkgDeal{
+				Creator: sample.AccAddress(),
+			},
+			err: ErrEmptyCommitments,
+		}, {
+			name: "invalid commitment",
+			msg: MsgSubmitDkgDeal{
+				Creator:     sample.AccAddress(),
+				Commitments: []string{"abcd"},
+			},
+			err: ErrInvalidCommitmentLength,
+		}, {
+			name: "duplicated share index",
+			msg: MsgSubmitDkgDeal{
+				Creator:     sample.AccAddress(),
+				Commitments: []string{validCommitment},
+				EncryptedShares: []DkgEncryptedShare{
+					{Index: 1, Data: "share"},
+					{Index: 1, Data: "share"},
+				},
+			},
+			err: ErrInvalidDkgDeal,
+		}, {
+			name: "valid deal",
+			msg: MsgSubmitDkgDeal{
+				Creator:     sample.AccAddress(),
+				Commitments: []string{validCommitment},
+				EncryptedShares: []DkgEncryptedShare{
+					{Index: 1, Data: "share"},
+					{Index: 2, Data: "share"},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgSubmitDkgComplaint_ValidateBasic(t *testing.T) {
+	addr := sample.AccAddress()
+	tests := []struct {
+		name string
+		msg  MsgSubmitDkgComplaint
+		err  error
+	}{
+		{
+			name: "invalid dealer",
+			msg: MsgSubmitDkgComplaint{
+				Creator: sample.AccAddress(),
+				Dealer:  "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "complain against self",
+			msg: MsgSubmitDkgComplaint{
+				Creator: addr,
+				Dealer:  addr,
+			},
+			err: ErrInvalidDkgDeal,
+		}, {
+			name: "valid complaint",
+			msg: MsgSubmitDkgComplaint{
+				Creator: sample.AccAddress(),
+				Dealer:  sample.AccAddress(),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgSubmitDkgJustification_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgSubmitDkgJustification
+		err  error
+	}{
+		{
+			name: "invalid share length",
+			msg: MsgSubmitDkgJustification{
+				Creator:    sample.AccAddress(),
+				Complainer: sample.AccAddress(),
+				Shares:     []string{strings.Repeat("0", DkgShareHexLen), "abcd"},
+			},
+			err: ErrInvalidDkgJustification,
+		}, {
+			name: "no share",
+			msg: MsgSubmitDkgJustification{
+				Creator:    sample.AccAddress(),
+				Complainer: sample.AccAddress(),
+			},
+			err: ErrInvalidDkgJustification,
+		}, {
+			name: "valid justification",
+			msg: MsgSubmitDkgJustification{
+				Creator:    sample.AccAddress(),
+				Complainer: sample.AccAddress(),
+				Shares:     []string{strings.Repeat("0", DkgShareHexLen), strings.Repeat("1", DkgShareHexLen)},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -19,11 +19,13 @@ func NewMsgCreateLatestPubKey(
 	creator string,
 	publicKey string,
 	commitments []string,
+	assignments []KeyShareAssignment,
 ) *MsgCreateLatestPubKey {
 	return &MsgCreateLatestPubKey{
 		Creator:     creator,
 		PublicKey:   publicKey,
 		Commitments: commitments,
+		Assignments: assignments,
 	}
 }
 
@@ -67,5 +69,10 @@ func (msg *MsgCreateLatestPubKey) ValidateBasic() error {
 			return ErrInvalidCommitment.Wrapf("expected hex encoded commitment, got: %s", c)
 		}
 	}
-	return nil
+	for _, a := range msg.Assignments {
+		if _, err = sdk.AccAddressFromBech32(a.Validator); err != nil {
+			return sdkerrors.Wrapf(cosmoserror.ErrInvalidAddress, "invalid assigned validator address (%s)", err)
+		}
+	}
+	return ValidateKeyShareAssignments(msg.Assignments, uint64(len(msg.Commitments)))
 }
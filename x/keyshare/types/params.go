@@ -2,6 +2,8 @@ package types
 
 import (
 	fmt "fmt"
+	"math"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
@@ -39,6 +41,129 @@ var (
 	DefaultMaxIdledBlock uint64 = 10
 )
 
+var (
+	KeyDkgPhaseDuration            = []byte("DkgPhaseDuration")
+	DefaultDkgPhaseDuration uint64 = 20
+)
+
+var (
+	KeyKeyAggregationThreshold = []byte("KeyAggregationThreshold")
+	// DefaultKeyAggregationThreshold is 2/3 truncated to the Dec precision, so that
+	// ceil(n * threshold) is exactly ceil(2n / 3)
+	DefaultKeyAggregationThreshold = sdk.NewDec(2).QuoTruncate(sdk.NewDec(3))
+)
+
+var (
+	KeyStakeWeightedAggregation          = []byte("StakeWeightedAggregation")
+	DefaultStakeWeightedAggregation bool = false
+)
+
+var (
+	KeyKeyshareSubmissionWindow            = []byte("KeyshareSubmissionWindow")
+	DefaultKeyshareSubmissionWindow uint64 = 5
+)
+
+var (
+	KeyKeyShareRetentionBlocks            = []byte("KeyShareRetentionBlocks")
+	DefaultKeyShareRetentionBlocks uint64 = 1000
+)
+
+var (
+	KeyMaxInvalidKeyshares            = []byte("MaxInvalidKeyshares")
+	DefaultMaxInvalidKeyshares uint64 = 3
+)
+
+var (
+	KeyInvalidKeyshareWindow            = []byte("InvalidKeyshareWindow")
+	DefaultInvalidKeyshareWindow uint64 = 100
+)
+
+var (
+	KeyInvalidKeyshareJailDuration                   = []byte("InvalidKeyshareJailDuration")
+	DefaultInvalidKeyshareJailDuration time.Duration = 10 * time.Minute
+)
+
+var (
+	KeyKeyshareLivenessWindow            = []byte("KeyshareLivenessWindow")
+	DefaultKeyshareLivenessWindow uint64 = 100
+)
+
+var (
+	KeyMinSubmittedPerWindow     = []byte("MinSubmittedPerWindow")
+	DefaultMinSubmittedPerWindow = sdk.NewDecWithPrec(5, 1) // 0.5
+)
+
+var (
+	KeyDowntimeJailDuration                   = []byte("DowntimeJailDuration")
+	DefaultDowntimeJailDuration time.Duration = 10 * time.Minute
+)
+
+var (
+	KeyMaxKeysharePauseDuration                   = []byte("MaxKeysharePauseDuration")
+	DefaultMaxKeysharePauseDuration time.Duration = 24 * time.Hour
+)
+
+var (
+	KeyMaxAuthorizedAddresses            = []byte("MaxAuthorizedAddresses")
+	DefaultMaxAuthorizedAddresses uint64 = 2
+)
+
+var (
+	KeyKeyshareCommitReveal          = []byte("KeyshareCommitReveal")
+	DefaultKeyshareCommitReveal bool = false
+)
+
+var (
+	KeyGeneralKeyRequestFee     = []byte("GeneralKeyRequestFee")
+	DefaultGeneralKeyRequestFee = sdk.NewCoin("ufairy", sdk.NewInt(300000))
+)
+
+var (
+	KeyRewardEpochBlocks            = []byte("RewardEpochBlocks")
+	DefaultRewardEpochBlocks uint64 = 100
+)
+
+var (
+	KeyEncryptedTxFeeRewardShare     = []byte("EncryptedTxFeeRewardShare")
+	DefaultEncryptedTxFeeRewardShare = sdk.NewDecWithPrec(5, 1) // 0.5
+)
+
+var (
+	KeyRequestFeeRewardShare     = []byte("RequestFeeRewardShare")
+	DefaultRequestFeeRewardShare = sdk.OneDec()
+)
+
+var (
+	KeyKeyShareRequestDeadlineBlocks = []byte("KeyShareRequestDeadlineBlocks")
+	// DefaultKeyShareRequestDeadlineBlocks is about 14 days with 6 second blocks, long enough for a governance voting period
+	DefaultKeyShareRequestDeadlineBlocks uint64 = 201600
+)
+
+var (
+	KeyKeyShareRequestGraceBlocks            = []byte("KeyShareRequestGraceBlocks")
+	DefaultKeyShareRequestGraceBlocks uint64 = 1000
+)
+
+var (
+	KeyAggrKeyshareMaxRetries            = []byte("AggrKeyshareMaxRetries")
+	DefaultAggrKeyshareMaxRetries uint64 = 5
+)
+
+var (
+	KeyAggrKeysharePacketTimeout                   = []byte("AggrKeysharePacketTimeout")
+	DefaultAggrKeysharePacketTimeout time.Duration = 20 * time.Second
+)
+
+var (
+	KeyAggrKeyshareRetryBackoffBlocks            = []byte("AggrKeyshareRetryBackoffBlocks")
+	DefaultAggrKeyshareRetryBackoffBlocks uint64 = 5
+)
+
+var (
+	KeyAggrKeyshareResendCooldownBlocks            = []byte("AggrKeyshareResendCooldownBlocks")
+	DefaultAggrKeyshareResendCooldownBlocks uint64 = 100
+)
+
 // ParamKeyTable the param key table for launch module
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
@@ -52,14 +177,62 @@ func NewParams(
 	noKeyShareFraction sdk.Dec,
 	wrongKeyShareFraction sdk.Dec,
 	maxIdledBlock uint64,
+	dkgPhaseDuration uint64,
+	keyAggregationThreshold sdk.Dec,
+	stakeWeightedAggregation bool,
+	keyshareSubmissionWindow uint64,
+	keyShareRetentionBlocks uint64,
+	maxInvalidKeyshares uint64,
+	invalidKeyshareWindow uint64,
+	invalidKeyshareJailDuration time.Duration,
+	keyshareLivenessWindow uint64,
+	minSubmittedPerWindow sdk.Dec,
+	downtimeJailDuration time.Duration,
+	maxKeysharePauseDuration time.Duration,
+	maxAuthorizedAddresses uint64,
+	keyshareCommitReveal bool,
+	generalKeyRequestFee sdk.Coin,
+	rewardEpochBlocks uint64,
+	encryptedTxFeeRewardShare sdk.Dec,
+	requestFeeRewardShare sdk.Dec,
+	keyShareRequestDeadlineBlocks uint64,
+	keyShareRequestGraceBlocks uint64,
+	aggrKeyshareMaxRetries uint64,
+	aggrKeysharePacketTimeout time.Duration,
+	aggrKeyshareRetryBackoffBlocks uint64,
+	aggrKeyshareResendCooldownBlocks uint64,
 ) Params {
 	return Params{
-		KeyExpiry:                  keyExp,
-		TrustedAddresses:           trAddrs,
-		SlashFractionNoKeyshare:    noKeyShareFraction,
-		SlashFractionWrongKeyshare: wrongKeyShareFraction,
-		MaxIdledBlock:              maxIdledBlock,
-		MinimumBonded:              minimumBonded,
+		KeyExpiry:                        keyExp,
+		TrustedAddresses:                 trAddrs,
+		SlashFractionNoKeyshare:          noKeyShareFraction,
+		SlashFractionWrongKeyshare:       wrongKeyShareFraction,
+		MaxIdledBlock:                    maxIdledBlock,
+		MinimumBonded:                    minimumBonded,
+		DkgPhaseDuration:                 dkgPhaseDuration,
+		KeyAggregationThreshold:          keyAggregationThreshold,
+		StakeWeightedAggregation:         stakeWeightedAggregation,
+		KeyshareSubmissionWindow:         keyshareSubmissionWindow,
+		KeyShareRetentionBlocks:          keyShareRetentionBlocks,
+		MaxInvalidKeyshares:              maxInvalidKeyshares,
+		InvalidKeyshareWindow:            invalidKeyshareWindow,
+		InvalidKeyshareJailDuration:      invalidKeyshareJailDuration,
+		KeyshareLivenessWindow:           keyshareLivenessWindow,
+		MinSubmittedPerWindow:            minSubmittedPerWindow,
+		DowntimeJailDuration:             downtimeJailDuration,
+		MaxKeysharePauseDuration:         maxKeysharePauseDuration,
+		MaxAuthorizedAddresses:           maxAuthorizedAddresses,
+		KeyshareCommitReveal:             keyshareCommitReveal,
+		GeneralKeyRequestFee:             generalKeyRequestFee,
+		RewardEpochBlocks:                rewardEpochBlocks,
+		EncryptedTxFeeRewardShare:        encryptedTxFeeRewardShare,
+		RequestFeeRewardShare:            requestFeeRewardShare,
+		KeyShareRequestDeadlineBlocks:    keyShareRequestDeadlineBlocks,
+		KeyShareRequestGraceBlocks:       keyShareRequestGraceBlocks,
+		AggrKeyshareMaxRetries:           aggrKeyshareMaxRetries,
+		AggrKeysharePacketTimeout:        aggrKeysharePacketTimeout,
+		AggrKeyshareRetryBackoffBlocks:   aggrKeyshareRetryBackoffBlocks,
+		AggrKeyshareResendCooldownBlocks: aggrKeyshareResendCooldownBlocks,
 	}
 }
 
@@ -72,6 +245,30 @@ func DefaultParams() Params {
 		DefaultSlashFractionNoKeyShare,
 		DefaultSlashFractionWrongKeyShare,
 		DefaultMaxIdledBlock,
+		DefaultDkgPhaseDuration,
+		DefaultKeyAggregationThreshold,
+		DefaultStakeWeightedAggregation,
+		DefaultKeyshareSubmissionWindow,
+		DefaultKeyShareRetentionBlocks,
+		DefaultMaxInvalidKeyshares,
+		DefaultInvalidKeyshareWindow,
+		DefaultInvalidKeyshareJailDuration,
+		DefaultKeyshareLivenessWindow,
+		DefaultMinSubmittedPerWindow,
+		DefaultDowntimeJailDuration,
+		DefaultMaxKeysharePauseDuration,
+		DefaultMaxAuthorizedAddresses,
+		DefaultKeyshareCommitReveal,
+		DefaultGeneralKeyRequestFee,
+		DefaultRewardEpochBlocks,
+		DefaultEncryptedTxFeeRewardShare,
+		DefaultRequestFeeRewardShare,
+		DefaultKeyShareRequestDeadlineBlocks,
+		DefaultKeyShareRequestGraceBlocks,
+		DefaultAggrKeyshareMaxRetries,
+		DefaultAggrKeysharePacketTimeout,
+		DefaultAggrKeyshareRetryBackoffBlocks,
+		DefaultAggrKeyshareResendCooldownBlocks,
 	)
 }
 
@@ -84,6 +281,30 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeySlashFractionNoKeyShare, &p.SlashFractionNoKeyshare, validateSlashFractionNoKeyshare),
 		paramtypes.NewParamSetPair(KeySlashFractionWrongKeyShare, &p.SlashFractionWrongKeyshare, validateSlashFractionWrongKeyshare),
 		paramtypes.NewParamSetPair(KeyMaxIdledBlock, &p.MaxIdledBlock, validateMaxIdledBlock),
+		paramtypes.NewParamSetPair(KeyDkgPhaseDuration, &p.DkgPhaseDuration, validateDkgPhaseDuration),
+		paramtypes.NewParamSetPair(KeyKeyAggregationThreshold, &p.KeyAggregationThreshold, validateKeyAggregationThreshold),
+		paramtypes.NewParamSetPair(KeyStakeWeightedAggregation, &p.StakeWeightedAggregation, validateStakeWeightedAggregation),
+		paramtypes.NewParamSetPair(KeyKeyshareSubmissionWindow, &p.KeyshareSubmissionWindow, validateKeyshareSubmissionWindow),
+		paramtypes.NewParamSetPair(KeyKeyShareRetentionBlocks, &p.KeyShareRetentionBlocks, validateKeyShareRetentionBlocks),
+		paramtypes.NewParamSetPair(KeyMaxInvalidKeyshares, &p.MaxInvalidKeyshares, validateMaxInvalidKeyshares),
+		paramtypes.NewParamSetPair(KeyInvalidKeyshareWindow, &p.InvalidKeyshareWindow, validateInvalidKeyshareWindow),
+		paramtypes.NewParamSetPair(KeyInvalidKeyshareJailDuration, &p.InvalidKeyshareJailDuration, validateInvalidKeyshareJailDuration),
+		paramtypes.NewParamSetPair(KeyKeyshareLivenessWindow, &p.KeyshareLivenessWindow, validateKeyshareLivenessWindow),
+		paramtypes.NewParamSetPair(KeyMinSubmittedPerWindow, &p.MinSubmittedPerWindow, validateMinSubmittedPerWindow),
+		paramtypes.NewParamSetPair(KeyDowntimeJailDuration, &p.DowntimeJailDuration, validateDowntimeJailDuration),
+		paramtypes.NewParamSetPair(KeyMaxKeysharePauseDuration, &p.MaxKeysharePauseDuration, validateMaxKeysharePauseDuration),
+		paramtypes.NewParamSetPair(KeyMaxAuthorizedAddresses, &p.MaxAuthorizedAddresses, validateMaxAuthorizedAddresses),
+		paramtypes.NewParamSetPair(KeyKeyshareCommitReveal, &p.KeyshareCommitReveal, validateKeyshareCommitReveal),
+		paramtypes.NewParamSetPair(KeyGeneralKeyRequestFee, &p.GeneralKeyRequestFee, validateGeneralKeyRequestFee),
+		paramtypes.NewParamSetPair(KeyRewardEpochBlocks, &p.RewardEpochBlocks, validateRewardEpochBlocks),
+		paramtypes.NewParamSetPair(KeyEncryptedTxFeeRewardShare, &p.EncryptedTxFeeRewardShare, validateEncryptedTxFeeRewardShare),
+		paramtypes.NewParamSetPair(KeyRequestFeeRewardShare, &p.RequestFeeRewardShare, validateRequestFeeRewardShare),
+		paramtypes.NewParamSetPair(KeyKeyShareRequestDeadlineBlocks, &p.KeyShareRequestDeadlineBlocks, validateKeyShareRequestDeadlineBlocks),
+		paramtypes.NewParamSetPair(KeyKeyShareRequestGraceBlocks, &p.KeyShareRequestGraceBlocks, validateKeyShareRequestGraceBlocks),
+		paramtypes.NewParamSetPair(KeyAggrKeyshareMaxRetries, &p.AggrKeyshareMaxRetries, validateAggrKeyshareMaxRetries),
+		paramtypes.NewParamSetPair(KeyAggrKeysharePacketTimeout, &p.AggrKeysharePacketTimeout, validateAggrKeysharePacketTimeout),
+		paramtypes.NewParamSetPair(KeyAggrKeyshareRetryBackoffBlocks, &p.AggrKeyshareRetryBackoffBlocks, validateAggrKeyshareRetryBackoffBlocks),
+		paramtypes.NewParamSetPair(KeyAggrKeyshareResendCooldownBlocks, &p.AggrKeyshareResendCooldownBlocks, validateAggrKeyshareResendCooldownBlocks),
 	}
 }
 
@@ -100,6 +321,107 @@ func (p Params) Validate() error {
 	if err := validateMinimumBonded(p.MinimumBonded); err != nil {
 		return err
 	}
+
+	if err := validateDkgPhaseDuration(p.DkgPhaseDuration); err != nil {
+		return err
+	}
+
+	if err := validateKeyAggregationThreshold(p.KeyAggregationThreshold); err != nil {
+		return err
+	}
+
+	if err := validateKeyshareSubmissionWindow(p.KeyshareSubmissionWindow); err != nil {
+		return err
+	}
+
+	if err := validateKeyShareRetentionBlocks(p.KeyShareRetentionBlocks); err != nil {
+		return err
+	}
+
+	if err := validateMaxInvalidKeyshares(p.MaxInvalidKeyshares); err != nil {
+		return err
+	}
+
+	if err := validateInvalidKeyshareWindow(p.InvalidKeyshareWindow); err != nil {
+		return err
+	}
+
+	if err := validateInvalidKeyshareJailDuration(p.InvalidKeyshareJailDuration); err != nil {
+		return err
+	}
+
+	if err := validateKeyshareLivenessWindow(p.KeyshareLivenessWindow); err != nil {
+		return err
+	}
+
+	if err := validateMinSubmittedPerWindow(p.MinSubmittedPerWindow); err != nil {
+		return err
+	}
+
+	if err := validateDowntimeJailDuration(p.DowntimeJailDuration); err != nil {
+		return err
+	}
+
+	if err := validateMaxKeysharePauseDuration(p.MaxKeysharePauseDuration); err != nil {
+		return err
+	}
+
+	if err := validateMaxAuthorizedAddresses(p.MaxAuthorizedAddresses); err != nil {
+		return err
+	}
+
+	if err := validateKeyshareCommitReveal(p.KeyshareCommitReveal); err != nil {
+		return err
+	}
+
+	if err := validateGeneralKeyRequestFee(p.GeneralKeyRequestFee); err != nil {
+		return err
+	}
+
+	if err := validateRewardEpochBlocks(p.RewardEpochBlocks); err != nil {
+		return err
+	}
+
+	if err := validateEncryptedTxFeeRewardShare(p.EncryptedTxFeeRewardShare); err != nil {
+		return err
+	}
+
+	if err := validateRequestFeeRewardShare(p.RequestFeeRewardShare); err != nil {
+		return err
+	}
+
+	if err := validateKeyShareRequestDeadlineBlocks(p.KeyShareRequestDeadlineBlocks); err != nil {
+		return err
+	}
+
+	if err := validateKeyShareRequestGraceBlocks(p.KeyShareRequestGraceBlocks); err != nil {
+		return err
+	}
+
+	if err := validateAggrKeyshareMaxRetries(p.AggrKeyshareMaxRetries); err != nil {
+		return err
+	}
+
+	if err := validateAggrKeysharePacketTimeout(p.AggrKeysharePacketTimeout); err != nil {
+		return err
+	}
+
+	if err := validateAggrKeyshareRetryBackoffBlocks(p.AggrKeyshareRetryBackoffBlocks); err != nil {
+		return err
+	}
+
+	if err := validateAggrKeyshareResendCooldownBlocks(p.AggrKeyshareResendCooldownBlocks); err != nil {
+		return err
+	}
+
+	// Aggregated keys are used to reject late key shares, so they must outlive the submission window
+	if p.KeyShareRetentionBlocks != 0 && p.KeyShareRetentionBlocks <= p.KeyshareSubmissionWindow {
+		return fmt.Errorf(
+			"key share retention blocks %d must exceed the keyshare submission window %d",
+			p.KeyShareRetentionBlocks,
+			p.KeyshareSubmissionWindow,
+		)
+	}
 	return nil
 }
 
@@ -181,3 +503,317 @@ func validateMaxIdledBlock(v interface{}) error {
 
 	return nil
 }
+
+// validateDkgPhaseDuration validates the DkgPhaseDuration param
+func validateDkgPhaseDuration(v interface{}) error {
+	val, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	if val == 0 {
+		return fmt.Errorf("dkg phase duration must be positive")
+	}
+
+	return nil
+}
+
+// validateKeyAggregationThreshold validates the KeyAggregationThreshold param
+func validateKeyAggregationThreshold(v interface{}) error {
+	val, ok := v.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	if val.IsNil() || !val.IsPositive() || val.GT(sdk.OneDec()) {
+		return fmt.Errorf("key aggregation threshold must be within (0, 1], got: %v", val)
+	}
+
+	return nil
+}
+
+// validateStakeWeightedAggregation validates the StakeWeightedAggregation param
+func validateStakeWeightedAggregation(v interface{}) error {
+	_, ok := v.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+// validateKeyshareSubmissionWindow validates the KeyshareSubmissionWindow param
+func validateKeyshareSubmissionWindow(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+// validateKeyShareRetentionBlocks validates the KeyShareRetentionBlocks param
+func validateKeyShareRetentionBlocks(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+// validateMaxInvalidKeyshares validates the MaxInvalidKeyshares param
+func validateMaxInvalidKeyshares(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+// validateInvalidKeyshareWindow validates the InvalidKeyshareWindow param
+func validateInvalidKeyshareWindow(v interface{}) error {
+	val, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	if val == 0 {
+		return fmt.Errorf("invalid keyshare window must be positive")
+	}
+
+	return nil
+}
+
+// validateInvalidKeyshareJailDuration validates the InvalidKeyshareJailDuration param
+func validateInvalidKeyshareJailDuration(v interface{}) error {
+	val, ok := v.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	if val <= 0 {
+		return fmt.Errorf("invalid keyshare jail duration must be positive, got: %s", val)
+	}
+
+	return nil
+}
+
+// validateKeyshareLivenessWindow validates the KeyshareLivenessWindow param
+func validateKeyshareLivenessWindow(v interface{}) error {
+	val, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	if val == 0 {
+		return fmt.Errorf("keyshare liveness window must be positive")
+	}
+
+	return nil
+}
+
+// validateMinSubmittedPerWindow validates the MinSubmittedPerWindow param
+func validateMinSubmittedPerWindow(v interface{}) error {
+	val, ok := v.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	if val.IsNil() || val.IsNegative() || val.GT(sdk.OneDec()) {
+		return fmt.Errorf("invalid parameter value, expected value between 0 and 1, got %v", val)
+	}
+
+	return nil
+}
+
+// validateDowntimeJailDuration validates the DowntimeJailDuration param
+func validateDowntimeJailDuration(v interface{}) error {
+	val, ok := v.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	if val <= 0 {
+		return fmt.Errorf("downtime jail duration must be positive, got: %s", val)
+	}
+
+	return nil
+}
+
+// validateMaxKeysharePauseDuration validates the MaxKeysharePauseDuration param
+func validateMaxKeysharePauseDuration(v interface{}) error {
+	val, ok := v.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	if val <= 0 {
+		return fmt.Errorf("max keyshare pause duration must be positive, got: %s", val)
+	}
+
+	return nil
+}
+
+// validateMaxAuthorizedAddresses validates the MaxAuthorizedAddresses param
+func validateMaxAuthorizedAddresses(v interface{}) error {
+	val, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	if val == 0 {
+		return fmt.Errorf("max authorized addresses must be positive")
+	}
+
+	return nil
+}
+
+// validateKeyshareCommitReveal validates the KeyshareCommitReveal param
+func validateKeyshareCommitReveal(v interface{}) error {
+	_, ok := v.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+// validateGeneralKeyRequestFee validates the GeneralKeyRequestFee param
+func validateGeneralKeyRequestFee(v interface{}) error {
+	fee, ok := v.(sdk.Coin)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if err := fee.Validate(); err != nil {
+		return fmt.Errorf("invalid general key request fee: %w", err)
+	}
+
+	return nil
+}
+
+// validateRewardEpochBlocks validates the RewardEpochBlocks param
+func validateRewardEpochBlocks(v interface{}) error {
+	val, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	if val == 0 {
+		return fmt.Errorf("reward epoch blocks must be positive")
+	}
+
+	return nil
+}
+
+// validateEncryptedTxFeeRewardShare validates the EncryptedTxFeeRewardShare param
+func validateEncryptedTxFeeRewardShare(v interface{}) error {
+	val, ok := v.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	if val.IsNil() || val.IsNegative() || val.GT(sdk.OneDec()) {
+		return fmt.Errorf("invalid parameter value, expected value between 0 and 1, got %v", val)
+	}
+
+	return nil
+}
+
+// validateRequestFeeRewardShare validates the RequestFeeRewardShare param
+func validateRequestFeeRewardShare(v interface{}) error {
+	val, ok := v.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	if val.IsNil() || val.IsNegative() || val.GT(sdk.OneDec()) {
+		return fmt.Errorf("invalid parameter value, expected value between 0 and 1, got %v", val)
+	}
+
+	return nil
+}
+
+// validateKeyShareRequestDeadlineBlocks validates the KeyShareRequestDeadlineBlocks param
+func validateKeyShareRequestDeadlineBlocks(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+// validateKeyShareRequestGraceBlocks validates the KeyShareRequestGraceBlocks param
+func validateKeyShareRequestGraceBlocks(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+// validateAggrKeyshareMaxRetries validates the AggrKeyshareMaxRetries param
+func validateAggrKeyshareMaxRetries(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+// validateAggrKeysharePacketTimeout validates the AggrKeysharePacketTimeout param
+func validateAggrKeysharePacketTimeout(v interface{}) error {
+	val, ok := v.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	if val <= 0 {
+		return fmt.Errorf("aggregated keyshare packet timeout must be positive, got: %s", val)
+	}
+
+	return nil
+}
+
+// validateAggrKeyshareRetryBackoffBlocks validates the AggrKeyshareRetryBackoffBlocks param, the longest
+// backoff must fit in an uint64 once added to a block height
+func validateAggrKeyshareRetryBackoffBlocks(v interface{}) error {
+	val, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if maxBackoff := uint64(math.MaxUint64) >> (MaxAggrKeyshareRetryBackoffShift + 1); val > maxBackoff {
+		return fmt.Errorf("aggregated keyshare retry backoff blocks must not exceed %d, got: %d", maxBackoff, val)
+	}
+
+	return nil
+}
+
+// validateAggrKeyshareResendCooldownBlocks validates the AggrKeyshareResendCooldownBlocks param
+func validateAggrKeyshareResendCooldownBlocks(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+// AggrKeyshareRetryDelay returns the number of blocks to wait before sending an aggregated key packet
+// for the given retry, which is AggrKeyshareRetryBackoffBlocks doubled on every retry after the first one
+func (p Params) AggrKeyshareRetryDelay(retry uint64) uint64 {
+	if retry == 0 || p.AggrKeyshareRetryBackoffBlocks == 0 {
+		return 0
+	}
+
+	shift := retry - 1
+	if shift > MaxAggrKeyshareRetryBackoffShift {
+		shift = MaxAggrKeyshareRetryBackoffShift
+	}
+	return p.AggrKeyshareRetryBackoffBlocks << shift
+}
+
+// MinSubmittedPerWindowInt returns the minimum number of key share heights a validator has to submit
+// within the liveness window, which is MinSubmittedPerWindow * KeyshareLivenessWindow rounded to an integer
+func (p Params) MinSubmittedPerWindowInt() uint64 {
+	return uint64(p.MinSubmittedPerWindow.MulInt64(int64(p.KeyshareLivenessWindow)).RoundInt64())
+}
+
+// AggregationThreshold returns the number of key shares required to aggregate a key
+// when n shares have been dealt, which is ceil(n * KeyAggregationThreshold)
+func (p Params) AggregationThreshold(n uint64) uint64 {
+	return p.KeyAggregationThreshold.MulInt64(int64(n)).Ceil().TruncateInt().Uint64()
+}
@@ -6,17 +6,22 @@ package types
 import (
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "github.com/gogo/protobuf/types"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -31,7 +36,33 @@ type Params struct {
 	SlashFractionNoKeyshare    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=slash_fraction_no_keyshare,json=slashFractionNoKeyshare,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_no_keyshare"`
 	SlashFractionWrongKeyshare github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=slash_fraction_wrong_keyshare,json=slashFractionWrongKeyshare,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_wrong_keyshare"`
 	MinimumBonded              uint64                                 `protobuf:"varint,5,opt,name=minimum_bonded,json=minimumBonded,proto3" json:"minimum_bonded,omitempty"`
-	MaxIdledBlock              uint64                                 `protobuf:"varint,6,opt,name=max_idled_block,json=maxIdledBlock,proto3" json:"max_idled_block,omitempty"`
+	// Deprecated: idle validators are tracked with the keyshare liveness params
+	MaxIdledBlock    uint64 `protobuf:"varint,6,opt,name=max_idled_block,json=maxIdledBlock,proto3" json:"max_idled_block,omitempty"`
+	DkgPhaseDuration uint64 `protobuf:"varint,7,opt,name=dkg_phase_duration,json=dkgPhaseDuration,proto3" json:"dkg_phase_duration,omitempty"`
+	// key_aggregation_threshold is the fraction of the dealt key shares required to aggregate a key, in (0, 1]
+	KeyAggregationThreshold          github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=key_aggregation_threshold,json=keyAggregationThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"key_aggregation_threshold"`
+	StakeWeightedAggregation         bool                                   `protobuf:"varint,10,opt,name=stake_weighted_aggregation,json=stakeWeightedAggregation,proto3" json:"stake_weighted_aggregation,omitempty"`
+	KeyshareSubmissionWindow         uint64                                 `protobuf:"varint,11,opt,name=keyshare_submission_window,json=keyshareSubmissionWindow,proto3" json:"keyshare_submission_window,omitempty"`
+	KeyShareRetentionBlocks          uint64                                 `protobuf:"varint,12,opt,name=key_share_retention_blocks,json=keyShareRetentionBlocks,proto3" json:"key_share_retention_blocks,omitempty"`
+	MaxInvalidKeyshares              uint64                                 `protobuf:"varint,13,opt,name=max_invalid_keyshares,json=maxInvalidKeyshares,proto3" json:"max_invalid_keyshares,omitempty"`
+	InvalidKeyshareWindow            uint64                                 `protobuf:"varint,14,opt,name=invalid_keyshare_window,json=invalidKeyshareWindow,proto3" json:"invalid_keyshare_window,omitempty"`
+	InvalidKeyshareJailDuration      time.Duration                          `protobuf:"bytes,15,opt,name=invalid_keyshare_jail_duration,json=invalidKeyshareJailDuration,proto3,stdduration" json:"invalid_keyshare_jail_duration"`
+	KeyshareLivenessWindow           uint64                                 `protobuf:"varint,16,opt,name=keyshare_liveness_window,json=keyshareLivenessWindow,proto3" json:"keyshare_liveness_window,omitempty"`
+	MinSubmittedPerWindow            github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,17,opt,name=min_submitted_per_window,json=minSubmittedPerWindow,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_submitted_per_window"`
+	DowntimeJailDuration             time.Duration                          `protobuf:"bytes,18,opt,name=downtime_jail_duration,json=downtimeJailDuration,proto3,stdduration" json:"downtime_jail_duration"`
+	MaxKeysharePauseDuration         time.Duration                          `protobuf:"bytes,19,opt,name=max_keyshare_pause_duration,json=maxKeysharePauseDuration,proto3,stdduration" json:"max_keyshare_pause_duration"`
+	MaxAuthorizedAddresses           uint64                                 `protobuf:"varint,20,opt,name=max_authorized_addresses,json=maxAuthorizedAddresses,proto3" json:"max_authorized_addresses,omitempty"`
+	KeyshareCommitReveal             bool                                   `protobuf:"varint,21,opt,name=keyshare_commit_reveal,json=keyshareCommitReveal,proto3" json:"keyshare_commit_reveal,omitempty"`
+	GeneralKeyRequestFee             types1.Coin                            `protobuf:"bytes,22,opt,name=general_key_request_fee,json=generalKeyRequestFee,proto3" json:"general_key_request_fee"`
+	RewardEpochBlocks                uint64                                 `protobuf:"varint,23,opt,name=reward_epoch_blocks,json=rewardEpochBlocks,proto3" json:"reward_epoch_blocks,omitempty"`
+	EncryptedTxFeeRewardShare        github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,24,opt,name=encrypted_tx_fee_reward_share,json=encryptedTxFeeRewardShare,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"encrypted_tx_fee_reward_share"`
+	RequestFeeRewardShare            github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,25,opt,name=request_fee_reward_share,json=requestFeeRewardShare,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"request_fee_reward_share"`
+	KeyShareRequestDeadlineBlocks    uint64                                 `protobuf:"varint,26,opt,name=key_share_request_deadline_blocks,json=keyShareRequestDeadlineBlocks,proto3" json:"key_share_request_deadline_blocks,omitempty"`
+	KeyShareRequestGraceBlocks       uint64                                 `protobuf:"varint,27,opt,name=key_share_request_grace_blocks,json=keyShareRequestGraceBlocks,proto3" json:"key_share_request_grace_blocks,omitempty"`
+	AggrKeyshareMaxRetries           uint64                                 `protobuf:"varint,28,opt,name=aggr_keyshare_max_retries,json=aggrKeyshareMaxRetries,proto3" json:"aggr_keyshare_max_retries,omitempty"`
+	AggrKeysharePacketTimeout        time.Duration                          `protobuf:"bytes,29,opt,name=aggr_keyshare_packet_timeout,json=aggrKeysharePacketTimeout,proto3,stdduration" json:"aggr_keyshare_packet_timeout"`
+	AggrKeyshareRetryBackoffBlocks   uint64                                 `protobuf:"varint,30,opt,name=aggr_keyshare_retry_backoff_blocks,json=aggrKeyshareRetryBackoffBlocks,proto3" json:"aggr_keyshare_retry_backoff_blocks,omitempty"`
+	AggrKeyshareResendCooldownBlocks uint64                                 `protobuf:"varint,31,opt,name=aggr_keyshare_resend_cooldown_blocks,json=aggrKeyshareResendCooldownBlocks,proto3" json:"aggr_keyshare_resend_cooldown_blocks,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -94,6 +125,146 @@ func (m *Params) GetMaxIdledBlock() uint64 {
 	return 0
 }
 
+func (m *Params) GetDkgPhaseDuration() uint64 {
+	if m != nil {
+		return m.DkgPhaseDuration
+	}
+	return 0
+}
+
+func (m *Params) GetStakeWeightedAggregation() bool {
+	if m != nil {
+		return m.StakeWeightedAggregation
+	}
+	return false
+}
+
+func (m *Params) GetKeyshareSubmissionWindow() uint64 {
+	if m != nil {
+		return m.KeyshareSubmissionWindow
+	}
+	return 0
+}
+
+func (m *Params) GetKeyShareRetentionBlocks() uint64 {
+	if m != nil {
+		return m.KeyShareRetentionBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetMaxInvalidKeyshares() uint64 {
+	if m != nil {
+		return m.MaxInvalidKeyshares
+	}
+	return 0
+}
+
+func (m *Params) GetInvalidKeyshareWindow() uint64 {
+	if m != nil {
+		return m.InvalidKeyshareWindow
+	}
+	return 0
+}
+
+func (m *Params) GetInvalidKeyshareJailDuration() time.Duration {
+	if m != nil {
+		return m.InvalidKeyshareJailDuration
+	}
+	return 0
+}
+
+func (m *Params) GetKeyshareLivenessWindow() uint64 {
+	if m != nil {
+		return m.KeyshareLivenessWindow
+	}
+	return 0
+}
+
+func (m *Params) GetDowntimeJailDuration() time.Duration {
+	if m != nil {
+		return m.DowntimeJailDuration
+	}
+	return 0
+}
+
+func (m *Params) GetMaxKeysharePauseDuration() time.Duration {
+	if m != nil {
+		return m.MaxKeysharePauseDuration
+	}
+	return 0
+}
+
+func (m *Params) GetMaxAuthorizedAddresses() uint64 {
+	if m != nil {
+		return m.MaxAuthorizedAddresses
+	}
+	return 0
+}
+
+func (m *Params) GetKeyshareCommitReveal() bool {
+	if m != nil {
+		return m.KeyshareCommitReveal
+	}
+	return false
+}
+
+func (m *Params) GetGeneralKeyRequestFee() types1.Coin {
+	if m != nil {
+		return m.GeneralKeyRequestFee
+	}
+	return types1.Coin{}
+}
+
+func (m *Params) GetRewardEpochBlocks() uint64 {
+	if m != nil {
+		return m.RewardEpochBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetKeyShareRequestDeadlineBlocks() uint64 {
+	if m != nil {
+		return m.KeyShareRequestDeadlineBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetKeyShareRequestGraceBlocks() uint64 {
+	if m != nil {
+		return m.KeyShareRequestGraceBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetAggrKeyshareMaxRetries() uint64 {
+	if m != nil {
+		return m.AggrKeyshareMaxRetries
+	}
+	return 0
+}
+
+func (m *Params) GetAggrKeysharePacketTimeout() time.Duration {
+	if m != nil {
+		return m.AggrKeysharePacketTimeout
+	}
+	return 0
+}
+
+func (m *Params) GetAggrKeyshareRetryBackoffBlocks() uint64 {
+	if m != nil {
+		return m.AggrKeyshareRetryBackoffBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetAggrKeyshareResendCooldownBlocks() uint64 {
+	if m != nil {
+		return m.AggrKeyshareResendCooldownBlocks
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "fairyring.keyshare.Params")
 }
@@ -101,30 +272,73 @@ func init() {
 func init() { proto.RegisterFile("fairyring/keyshare/params.proto", fileDescriptor_09ef7bd565425b36) }
 
 var fileDescriptor_09ef7bd565425b36 = []byte{
-	// 359 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x91, 0x4f, 0x4b, 0xfb, 0x30,
-	0x1c, 0xc6, 0xdb, 0xdf, 0xf6, 0x1b, 0x2c, 0xfc, 0xfe, 0x68, 0x10, 0x2c, 0x85, 0xb5, 0x43, 0x70,
-	0x0c, 0xc4, 0xf6, 0xa0, 0x27, 0x6f, 0x16, 0x15, 0x44, 0x10, 0xd9, 0x45, 0xf0, 0x12, 0xd2, 0x26,
-	0xeb, 0x42, 0x97, 0xa6, 0x26, 0x1d, 0xb6, 0x2f, 0xc1, 0x9b, 0x47, 0x8f, 0xbe, 0x9c, 0x1d, 0x77,
-	0x14, 0x0f, 0x43, 0xb6, 0x37, 0x22, 0x8d, 0xdd, 0x86, 0x1e, 0x3d, 0x25, 0x7c, 0xbe, 0x4f, 0x9e,
-	0xe7, 0x4b, 0x1e, 0xe0, 0x0e, 0x31, 0x93, 0xa5, 0x64, 0x69, 0xec, 0x27, 0xb4, 0x54, 0x23, 0x2c,
-	0xa9, 0x9f, 0x61, 0x89, 0xb9, 0xf2, 0x32, 0x29, 0x72, 0x01, 0xe1, 0x5a, 0xe0, 0xad, 0x04, 0xf6,
-	0x4e, 0x2c, 0x62, 0xa1, 0xc7, 0x7e, 0x75, 0xfb, 0x54, 0xee, 0x3d, 0x36, 0x40, 0xeb, 0x46, 0x3f,
-	0x85, 0x1d, 0x00, 0x12, 0x5a, 0x22, 0x5a, 0x64, 0x4c, 0x96, 0x96, 0xd9, 0x35, 0xfb, 0xcd, 0x41,
-	0x3b, 0xa1, 0xe5, 0xb9, 0x06, 0xf0, 0x00, 0x6c, 0xe7, 0x72, 0xa2, 0x72, 0x4a, 0x10, 0x26, 0x44,
-	0x52, 0xa5, 0xa8, 0xb2, 0x7e, 0x75, 0x1b, 0xfd, 0xf6, 0x60, 0xab, 0x1e, 0x9c, 0xae, 0x38, 0x4c,
-	0x80, 0xad, 0xc6, 0x58, 0x8d, 0xd0, 0x50, 0xe2, 0x28, 0x67, 0x22, 0x45, 0xa9, 0x40, 0xab, 0x55,
-	0xac, 0x46, 0xd7, 0xec, 0xff, 0x09, 0xbc, 0xe9, 0xdc, 0x35, 0xde, 0xe6, 0x6e, 0x2f, 0x66, 0xf9,
-	0x68, 0x12, 0x7a, 0x91, 0xe0, 0x7e, 0x24, 0x14, 0x17, 0xaa, 0x3e, 0x0e, 0x15, 0x49, 0xfc, 0xbc,
-	0xcc, 0xa8, 0xf2, 0xce, 0x68, 0x34, 0xd8, 0xd5, 0x8e, 0x17, 0xb5, 0xe1, 0xb5, 0xb8, 0xaa, 0xed,
-	0xe0, 0x3d, 0xe8, 0x7c, 0x0b, 0x7b, 0x90, 0x22, 0x8d, 0x37, 0x79, 0xcd, 0x1f, 0xe5, 0xd9, 0x5f,
-	0xf2, 0x6e, 0x2b, 0xcb, 0x75, 0xe4, 0x3e, 0xf8, 0xc7, 0x59, 0xca, 0xf8, 0x84, 0xa3, 0x50, 0xa4,
-	0x84, 0x12, 0xeb, 0xb7, 0xfe, 0xaf, 0xbf, 0x35, 0x0d, 0x34, 0x84, 0x3d, 0xf0, 0x9f, 0xe3, 0x02,
-	0x31, 0x32, 0xa6, 0x04, 0x85, 0x63, 0x11, 0x25, 0x56, 0xab, 0xd6, 0xe1, 0xe2, 0xb2, 0xa2, 0x41,
-	0x05, 0x4f, 0x9a, 0xcf, 0x2f, 0xae, 0x11, 0x1c, 0x4f, 0x17, 0x8e, 0x39, 0x5b, 0x38, 0xe6, 0xfb,
-	0xc2, 0x31, 0x9f, 0x96, 0x8e, 0x31, 0x5b, 0x3a, 0xc6, 0xeb, 0xd2, 0x31, 0xee, 0xec, 0x4d, 0xe1,
-	0xc5, 0xa6, 0x72, 0xbd, 0x6a, 0xd8, 0xd2, 0x45, 0x1e, 0x7d, 0x04, 0x00, 0x00, 0xff, 0xff, 0x6b,
-	0xfc, 0xe2, 0xef, 0x15, 0x02, 0x00, 0x00,
+	// 1048 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcb, 0x6e, 0xdb, 0x46,
+	0x17, 0xb6, 0xfe, 0xf8, 0x77, 0xed, 0xc9, 0xcd, 0xa6, 0x6f, 0x94, 0x1c, 0x53, 0x6a, 0xd0, 0x06,
+	0x06, 0xda, 0x52, 0x48, 0x1a, 0x14, 0xbd, 0x6d, 0x2c, 0x3b, 0x6e, 0xeb, 0xb4, 0x81, 0x41, 0x1b,
+	0x35, 0xda, 0xcd, 0x60, 0x44, 0x1e, 0x51, 0x13, 0x5e, 0x86, 0x99, 0x21, 0x6d, 0xb1, 0x4f, 0xd1,
+	0x65, 0x96, 0x7d, 0x8c, 0x3e, 0x42, 0x96, 0x59, 0x16, 0x5d, 0xa4, 0x85, 0xfd, 0x22, 0xc5, 0x0c,
+	0x67, 0xa8, 0x4b, 0x36, 0x86, 0x56, 0xb6, 0xe6, 0x7c, 0xe7, 0x3b, 0xe7, 0x7c, 0xe7, 0x02, 0xa2,
+	0xf6, 0x80, 0x50, 0x5e, 0x72, 0x9a, 0x86, 0xdd, 0x08, 0x4a, 0x31, 0x24, 0x1c, 0xba, 0x19, 0xe1,
+	0x24, 0x11, 0x6e, 0xc6, 0x59, 0xce, 0x2c, 0xab, 0x06, 0xb8, 0x06, 0xd0, 0xda, 0x08, 0x59, 0xc8,
+	0x94, 0xb9, 0x2b, 0xff, 0xab, 0x90, 0x2d, 0x27, 0x64, 0x2c, 0x8c, 0xa1, 0xab, 0x7e, 0xf5, 0x8b,
+	0x41, 0x37, 0x28, 0x38, 0xc9, 0x29, 0x4b, 0x8d, 0xdd, 0x67, 0x22, 0x61, 0xa2, 0xdb, 0x27, 0x02,
+	0xba, 0x17, 0x8f, 0xfb, 0x90, 0x93, 0xc7, 0x5d, 0x9f, 0x51, 0x6d, 0x7f, 0xf8, 0xe7, 0x1a, 0x5a,
+	0x3a, 0x51, 0xa1, 0xad, 0x5d, 0x84, 0x22, 0x28, 0x31, 0x8c, 0x32, 0xca, 0x4b, 0xbb, 0xd1, 0x69,
+	0xec, 0x2d, 0x7a, 0x2b, 0x11, 0x94, 0xcf, 0xd4, 0x83, 0xf5, 0x09, 0x5a, 0xcb, 0x79, 0x21, 0x72,
+	0x08, 0x30, 0x09, 0x02, 0x0e, 0x42, 0x80, 0xb0, 0xff, 0xd7, 0xb9, 0xb5, 0xb7, 0xe2, 0xad, 0x6a,
+	0xc3, 0xbe, 0x79, 0xb7, 0x22, 0xd4, 0x12, 0x31, 0x11, 0x43, 0x3c, 0xe0, 0xc4, 0x97, 0xe9, 0xe0,
+	0x94, 0x61, 0x53, 0x8a, 0x7d, 0xab, 0xd3, 0xd8, 0xbb, 0xd3, 0x73, 0xdf, 0xbc, 0x6b, 0x2f, 0xfc,
+	0xfd, 0xae, 0xfd, 0x28, 0xa4, 0xf9, 0xb0, 0xe8, 0xbb, 0x3e, 0x4b, 0xba, 0x3a, 0xdb, 0xea, 0xcf,
+	0x67, 0x22, 0x88, 0xba, 0x79, 0x99, 0x81, 0x70, 0x0f, 0xc1, 0xf7, 0xb6, 0x15, 0xe3, 0x91, 0x26,
+	0x7c, 0xc1, 0x9e, 0x6b, 0x3a, 0xeb, 0x15, 0xda, 0x9d, 0x09, 0x76, 0xc9, 0x59, 0x1a, 0x8e, 0xe3,
+	0x2d, 0xce, 0x15, 0xaf, 0x35, 0x15, 0xef, 0x5c, 0x52, 0xd6, 0x21, 0x3f, 0x46, 0xf7, 0x12, 0x9a,
+	0xd2, 0xa4, 0x48, 0x70, 0x9f, 0xa5, 0x01, 0x04, 0xf6, 0xff, 0x95, 0x5e, 0x77, 0xf5, 0x6b, 0x4f,
+	0x3d, 0x5a, 0x8f, 0xd0, 0xfd, 0x84, 0x8c, 0x30, 0x0d, 0x62, 0x08, 0x70, 0x3f, 0x66, 0x7e, 0x64,
+	0x2f, 0x69, 0x1c, 0x19, 0xfd, 0x20, 0x5f, 0x7b, 0xf2, 0xd1, 0xfa, 0x14, 0x59, 0x41, 0x14, 0xe2,
+	0x6c, 0x48, 0x04, 0x60, 0xd3, 0x41, 0xfb, 0x03, 0x05, 0x5d, 0x0d, 0xa2, 0xf0, 0x44, 0x1a, 0x0e,
+	0xf5, 0xbb, 0xf5, 0x12, 0x35, 0x65, 0xa3, 0x48, 0x18, 0x72, 0x08, 0xd5, 0x13, 0xce, 0x87, 0x1c,
+	0xc4, 0x90, 0xc5, 0x81, 0xbd, 0x3c, 0x9f, 0xb6, 0x11, 0x94, 0xfb, 0x63, 0xbe, 0x33, 0x43, 0x67,
+	0x7d, 0x8b, 0x5a, 0x22, 0x27, 0x11, 0xe0, 0x4b, 0xa0, 0xe1, 0x50, 0x35, 0x7f, 0x0c, 0xb3, 0x51,
+	0xa7, 0xb1, 0xb7, 0xec, 0xd9, 0x0a, 0x71, 0xae, 0x01, 0x13, 0x34, 0xd2, 0xdb, 0x34, 0x01, 0x8b,
+	0xa2, 0x9f, 0x50, 0x21, 0x54, 0x7b, 0x68, 0x1a, 0xb0, 0x4b, 0xfb, 0xb6, 0xaa, 0xcf, 0x36, 0x88,
+	0xd3, 0x1a, 0x70, 0xae, 0xec, 0xd6, 0x37, 0xca, 0x1b, 0x57, 0xee, 0x1c, 0x72, 0x48, 0x55, 0xad,
+	0x4a, 0x47, 0x61, 0xdf, 0x51, 0xde, 0x32, 0xf1, 0x53, 0x09, 0xf0, 0x8c, 0x5d, 0x29, 0x2a, 0xac,
+	0x27, 0x68, 0x53, 0x49, 0x9f, 0x5e, 0x90, 0x98, 0x06, 0xf5, 0x2c, 0x08, 0xfb, 0xae, 0xf2, 0x5b,
+	0x97, 0x0d, 0xa8, 0x6c, 0xa6, 0xa9, 0xc2, 0xfa, 0x02, 0x6d, 0xcf, 0xe2, 0x4d, 0xae, 0xf7, 0x94,
+	0xd7, 0x26, 0x9d, 0x76, 0xd1, 0x89, 0x0e, 0x91, 0xf3, 0x9e, 0xdf, 0x4b, 0x42, 0xe3, 0x71, 0x2b,
+	0xef, 0x77, 0x1a, 0x7b, 0xb7, 0x9f, 0x34, 0xdd, 0x6a, 0x5b, 0x5d, 0xb3, 0xad, 0xae, 0xe9, 0x69,
+	0x6f, 0x59, 0x36, 0xec, 0xf5, 0x3f, 0xed, 0x86, 0xb7, 0x33, 0x13, 0xe3, 0x98, 0xd0, 0xb8, 0x6e,
+	0xfd, 0x97, 0xa8, 0x96, 0x0b, 0xc7, 0xf4, 0x02, 0x52, 0x10, 0xc2, 0xa4, 0xb8, 0xaa, 0x52, 0xdc,
+	0x32, 0xf6, 0x1f, 0xb5, 0x59, 0xe7, 0x18, 0x22, 0x3b, 0xa1, 0x69, 0xd5, 0x85, 0x5c, 0xf6, 0x31,
+	0x03, 0x6e, 0x3c, 0xd7, 0xe6, 0x9a, 0x99, 0xcd, 0x84, 0xa6, 0xa7, 0x86, 0xee, 0x04, 0xb8, 0x0e,
+	0xf4, 0x0b, 0xda, 0x0a, 0xd8, 0x65, 0x9a, 0xd3, 0x64, 0x56, 0x04, 0xeb, 0xe6, 0x22, 0x6c, 0x18,
+	0x8a, 0xa9, 0xea, 0xfb, 0x68, 0x47, 0xf6, 0xb4, 0x56, 0x20, 0x23, 0xc5, 0xe4, 0xbe, 0xac, 0xdf,
+	0x9c, 0xdf, 0x4e, 0xc8, 0xc8, 0x08, 0x7c, 0x22, 0x59, 0x26, 0x15, 0x96, 0x31, 0x48, 0x91, 0x0f,
+	0x19, 0xa7, 0xbf, 0x4d, 0x5d, 0xbb, 0x8d, 0x4a, 0xe1, 0x84, 0x8c, 0xf6, 0x6b, 0xf3, 0xf8, 0xe6,
+	0x3d, 0x45, 0xb5, 0xf6, 0xd8, 0x67, 0x49, 0x42, 0x73, 0xcc, 0xe1, 0x02, 0x48, 0x6c, 0x6f, 0xaa,
+	0x35, 0xd9, 0x30, 0xd6, 0x03, 0x65, 0xf4, 0x94, 0xcd, 0xfa, 0x19, 0x6d, 0x87, 0x90, 0x02, 0x27,
+	0xb1, 0xac, 0x0b, 0x73, 0x78, 0x55, 0x80, 0xc8, 0xf1, 0x00, 0xc0, 0xde, 0xd2, 0xf5, 0x54, 0xea,
+	0xbb, 0xf2, 0x84, 0xbb, 0xfa, 0x84, 0xbb, 0x07, 0x8c, 0xa6, 0xbd, 0x45, 0x59, 0x8f, 0xb7, 0xa1,
+	0xfd, 0x9f, 0x43, 0xe9, 0x55, 0xde, 0x47, 0x00, 0x96, 0x8b, 0xd6, 0x39, 0x5c, 0x12, 0x1e, 0x60,
+	0xc8, 0x98, 0x3f, 0x34, 0x5b, 0xb3, 0xad, 0x4a, 0x58, 0xab, 0x4c, 0xcf, 0xa4, 0x45, 0xef, 0x4b,
+	0x86, 0x76, 0x21, 0xf5, 0x79, 0x99, 0xc9, 0xd9, 0xc8, 0x47, 0x32, 0x01, 0xac, 0x09, 0xaa, 0x23,
+	0x6a, 0xcf, 0x35, 0x24, 0xcd, 0x9a, 0xf4, 0x6c, 0x74, 0x04, 0xe0, 0x29, 0x46, 0xb5, 0xb0, 0x72,
+	0x22, 0x27, 0xaa, 0x9d, 0x0e, 0xd6, 0x9c, 0x6f, 0x22, 0x79, 0x5d, 0xff, 0x64, 0xa0, 0xef, 0xd1,
+	0x87, 0x93, 0x77, 0xa4, 0x0a, 0x19, 0x00, 0x09, 0x62, 0x9a, 0x82, 0x11, 0xa6, 0xa5, 0x84, 0xd9,
+	0x1d, 0x9f, 0x13, 0x05, 0x3b, 0xd4, 0x28, 0x2d, 0x52, 0x0f, 0x39, 0xef, 0x33, 0x85, 0x9c, 0xf8,
+	0x35, 0xcd, 0x8e, 0xa2, 0x69, 0xcd, 0xd0, 0x7c, 0x27, 0x21, 0x9a, 0xe3, 0x2b, 0xd4, 0x94, 0x27,
+	0x74, 0x3c, 0xc5, 0x72, 0xdc, 0x38, 0xe4, 0x9c, 0x82, 0xb0, 0x1f, 0x54, 0x13, 0x26, 0x01, 0x66,
+	0x3c, 0x7f, 0x22, 0x23, 0xaf, 0xb2, 0x5a, 0x01, 0x7a, 0x30, 0xed, 0x9a, 0x11, 0x3f, 0x82, 0x1c,
+	0xcb, 0x45, 0x61, 0x45, 0x6e, 0xef, 0xde, 0x7c, 0x01, 0x9a, 0x93, 0x21, 0x4e, 0x14, 0xcd, 0x59,
+	0xc5, 0x62, 0x1d, 0xa3, 0x87, 0xd3, 0x51, 0x64, 0x72, 0x25, 0xee, 0x13, 0x3f, 0x62, 0x83, 0x81,
+	0x29, 0xd4, 0x51, 0x99, 0x3a, 0x93, 0x34, 0x32, 0xcd, 0xb2, 0x57, 0xc1, 0x74, 0xb1, 0x2f, 0xd0,
+	0x47, 0xb3, 0x5c, 0x02, 0xd2, 0x00, 0xfb, 0x8c, 0xc5, 0x72, 0xc5, 0x0d, 0x5b, 0x5b, 0xb1, 0x75,
+	0xa6, 0xd9, 0x24, 0xf2, 0x40, 0x03, 0x2b, 0xbe, 0xaf, 0x17, 0x5f, 0xff, 0xd1, 0x5e, 0x38, 0x5e,
+	0x5c, 0x5e, 0x59, 0x45, 0xbd, 0xa7, 0x6f, 0xae, 0x9c, 0xc6, 0xdb, 0x2b, 0xa7, 0xf1, 0xef, 0x95,
+	0xd3, 0xf8, 0xfd, 0xda, 0x59, 0x78, 0x7b, 0xed, 0x2c, 0xfc, 0x75, 0xed, 0x2c, 0xfc, 0xda, 0x1a,
+	0x7f, 0x5f, 0x8d, 0xc6, 0x5f, 0x58, 0x6a, 0x4e, 0xfa, 0x4b, 0x4a, 0x95, 0xcf, 0xff, 0x1b, 0x00,
+	0xdd, 0x26, 0x22, 0x03, 0x84, 0x09, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -147,6 +361,205 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AggrKeyshareResendCooldownBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.AggrKeyshareResendCooldownBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf8
+	}
+	if m.AggrKeyshareRetryBackoffBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.AggrKeyshareRetryBackoffBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf0
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.AggrKeysharePacketTimeout, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.AggrKeysharePacketTimeout):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintParams(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xea
+	if m.AggrKeyshareMaxRetries != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.AggrKeyshareMaxRetries))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe0
+	}
+	if m.KeyShareRequestGraceBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.KeyShareRequestGraceBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd8
+	}
+	if m.KeyShareRequestDeadlineBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.KeyShareRequestDeadlineBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd0
+	}
+	{
+		size := m.RequestFeeRewardShare.Size()
+		i -= size
+		if _, err := m.RequestFeeRewardShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xca
+	{
+		size := m.EncryptedTxFeeRewardShare.Size()
+		i -= size
+		if _, err := m.EncryptedTxFeeRewardShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xc2
+	if m.RewardEpochBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.RewardEpochBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
+	{
+		size, err := m.GeneralKeyRequestFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xb2
+	if m.KeyshareCommitReveal {
+		i--
+		if m.KeyshareCommitReveal {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if m.MaxAuthorizedAddresses != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxAuthorizedAddresses))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxKeysharePauseDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxKeysharePauseDuration):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintParams(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x9a
+	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.DowntimeJailDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DowntimeJailDuration):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintParams(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x92
+	{
+		size := m.MinSubmittedPerWindow.Size()
+		i -= size
+		if _, err := m.MinSubmittedPerWindow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x8a
+	if m.KeyshareLivenessWindow != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.KeyshareLivenessWindow))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	n5, err5 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.InvalidKeyshareJailDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.InvalidKeyshareJailDuration):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintParams(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x7a
+	if m.InvalidKeyshareWindow != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.InvalidKeyshareWindow))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.MaxInvalidKeyshares != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxInvalidKeyshares))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.KeyShareRetentionBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.KeyShareRetentionBlocks))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.KeyshareSubmissionWindow != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.KeyshareSubmissionWindow))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.StakeWeightedAggregation {
+		i--
+		if m.StakeWeightedAggregation {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	{
+		size := m.KeyAggregationThreshold.Size()
+		i -= size
+		if _, err := m.KeyAggregationThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if m.DkgPhaseDuration != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DkgPhaseDuration))
+		i--
+		dAtA[i] = 0x38
+	}
 	if m.MaxIdledBlock != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.MaxIdledBlock))
 		i--
@@ -230,6 +643,69 @@ func (m *Params) Size() (n int) {
 	if m.MaxIdledBlock != 0 {
 		n += 1 + sovParams(uint64(m.MaxIdledBlock))
 	}
+	if m.DkgPhaseDuration != 0 {
+		n += 1 + sovParams(uint64(m.DkgPhaseDuration))
+	}
+	l = m.KeyAggregationThreshold.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.StakeWeightedAggregation {
+		n += 2
+	}
+	if m.KeyshareSubmissionWindow != 0 {
+		n += 1 + sovParams(uint64(m.KeyshareSubmissionWindow))
+	}
+	if m.KeyShareRetentionBlocks != 0 {
+		n += 1 + sovParams(uint64(m.KeyShareRetentionBlocks))
+	}
+	if m.MaxInvalidKeyshares != 0 {
+		n += 1 + sovParams(uint64(m.MaxInvalidKeyshares))
+	}
+	if m.InvalidKeyshareWindow != 0 {
+		n += 1 + sovParams(uint64(m.InvalidKeyshareWindow))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.InvalidKeyshareJailDuration)
+	n += 1 + l + sovParams(uint64(l))
+	if m.KeyshareLivenessWindow != 0 {
+		n += 2 + sovParams(uint64(m.KeyshareLivenessWindow))
+	}
+	l = m.MinSubmittedPerWindow.Size()
+	n += 2 + l + sovParams(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DowntimeJailDuration)
+	n += 2 + l + sovParams(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxKeysharePauseDuration)
+	n += 2 + l + sovParams(uint64(l))
+	if m.MaxAuthorizedAddresses != 0 {
+		n += 2 + sovParams(uint64(m.MaxAuthorizedAddresses))
+	}
+	if m.KeyshareCommitReveal {
+		n += 3
+	}
+	l = m.GeneralKeyRequestFee.Size()
+	n += 2 + l + sovParams(uint64(l))
+	if m.RewardEpochBlocks != 0 {
+		n += 2 + sovParams(uint64(m.RewardEpochBlocks))
+	}
+	l = m.EncryptedTxFeeRewardShare.Size()
+	n += 2 + l + sovParams(uint64(l))
+	l = m.RequestFeeRewardShare.Size()
+	n += 2 + l + sovParams(uint64(l))
+	if m.KeyShareRequestDeadlineBlocks != 0 {
+		n += 2 + sovParams(uint64(m.KeyShareRequestDeadlineBlocks))
+	}
+	if m.KeyShareRequestGraceBlocks != 0 {
+		n += 2 + sovParams(uint64(m.KeyShareRequestGraceBlocks))
+	}
+	if m.AggrKeyshareMaxRetries != 0 {
+		n += 2 + sovParams(uint64(m.AggrKeyshareMaxRetries))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.AggrKeysharePacketTimeout)
+	n += 2 + l + sovParams(uint64(l))
+	if m.AggrKeyshareRetryBackoffBlocks != 0 {
+		n += 2 + sovParams(uint64(m.AggrKeyshareRetryBackoffBlocks))
+	}
+	if m.AggrKeyshareResendCooldownBlocks != 0 {
+		n += 2 + sovParams(uint64(m.AggrKeyshareResendCooldownBlocks))
+	}
 	return n
 }
 
@@ -423,6 +899,590 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DkgPhaseDuration", wireType)
+			}
+			m.DkgPhaseDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DkgPhaseDuration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyAggregationThreshold", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.KeyAggregationThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakeWeightedAggregation", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.StakeWeightedAggregation = bool(v != 0)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyshareSubmissionWindow", wireType)
+			}
+			m.KeyshareSubmissionWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeyshareSubmissionWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyShareRetentionBlocks", wireType)
+			}
+			m.KeyShareRetentionBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeyShareRetentionBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxInvalidKeyshares", wireType)
+			}
+			m.MaxInvalidKeyshares = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxInvalidKeyshares |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidKeyshareWindow", wireType)
+			}
+			m.InvalidKeyshareWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InvalidKeyshareWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidKeyshareJailDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.InvalidKeyshareJailDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyshareLivenessWindow", wireType)
+			}
+			m.KeyshareLivenessWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeyshareLivenessWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSubmittedPerWindow", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinSubmittedPerWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeJailDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.DowntimeJailDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxKeysharePauseDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MaxKeysharePauseDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAuthorizedAddresses", wireType)
+			}
+			m.MaxAuthorizedAddresses = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAuthorizedAddresses |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyshareCommitReveal", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.KeyshareCommitReveal = bool(v != 0)
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GeneralKeyRequestFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GeneralKeyRequestFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardEpochBlocks", wireType)
+			}
+			m.RewardEpochBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RewardEpochBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EncryptedTxFeeRewardShare", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EncryptedTxFeeRewardShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestFeeRewardShare", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RequestFeeRewardShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 26:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyShareRequestDeadlineBlocks", wireType)
+			}
+			m.KeyShareRequestDeadlineBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeyShareRequestDeadlineBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 27:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyShareRequestGraceBlocks", wireType)
+			}
+			m.KeyShareRequestGraceBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeyShareRequestGraceBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 28:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggrKeyshareMaxRetries", wireType)
+			}
+			m.AggrKeyshareMaxRetries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AggrKeyshareMaxRetries |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggrKeysharePacketTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.AggrKeysharePacketTimeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 30:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggrKeyshareRetryBackoffBlocks", wireType)
+			}
+			m.AggrKeyshareRetryBackoffBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AggrKeyshareRetryBackoffBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 31:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggrKeyshareResendCooldownBlocks", wireType)
+			}
+			m.AggrKeyshareResendCooldownBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AggrKeyshareResendCooldownBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
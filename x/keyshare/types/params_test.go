@@ -0,0 +1,240 @@
+package types_test
+
+import (
+	"math"
+	"testing"
+
+	"fairyring/x/keyshare/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParams_Validate(t *testing.T) {
+	for _, tc := range []struct {
+		desc   string
+		params func() types.Params
+		valid  bool
+	}{
+		{
+			desc:   "default is valid",
+			params: types.DefaultParams,
+			valid:  true,
+		},
+		{
+			desc: "unanimous threshold",
+			params: func() types.Params {
+				p := types.DefaultParams()
+				p.KeyAggregationThreshold = sdk.OneDec()
+				return p
+			},
+			valid: true,
+		},
+		{
+			desc: "zero threshold",
+			params: func() types.Params {
+				p := types.DefaultParams()
+				p.KeyAggregationThreshold = sdk.ZeroDec()
+				return p
+			},
+		},
+		{
+			desc: "negative threshold",
+			params: func() types.Params {
+				p := types.DefaultParams()
+				p.KeyAggregationThreshold = sdk.NewDecWithPrec(-1, 1)
+				return p
+			},
+		},
+		{
+			desc: "nil threshold",
+			params: func() types.Params {
+				p := types.DefaultParams()
+				p.KeyAggregationThreshold = sdk.Dec{}
+				return p
+			},
+		},
+		{
+			desc: "pruning disabled",
+			params: func() types.Params {
+				p := types.DefaultParams()
+				p.KeyShareRetentionBlocks = 0
+				return p
+			},
+			valid: true,
+		},
+		{
+			desc: "retention within submission window",
+			params: func() types.Params {
+				p := types.DefaultParams()
+				p.KeyShareRetentionBlocks = p.KeyshareSubmissionWindow
+				return p
+			},
+		},
+		{
+			desc: "zero invalid keyshare window",
+			params: func() types.Params {
+				p := types.DefaultParams()
+				p.InvalidKeyshareWindow = 0
+				return p
+			},
+		},
+		{
+			desc: "zero invalid keyshare jail duration",
+			params: func() types.Params {
+				p := types.DefaultParams()
+				p.InvalidKeyshareJailDuration = 0
+				return p
+			},
+		},
+		{
+			desc: "zero keyshare liveness window",
+			params: func() types.Params {
+				p := types.DefaultParams()
+				p.KeyshareLivenessWindow = 0
+				return p
+			},
+		},
+		{
+			desc: "min submitted per window above one",
+			params: func() types.Params {
+				p := types.DefaultParams()
+				p.MinSubmittedPerWindow = sdk.NewDecWithPrec(11, 1)
+				return p
+			},
+		},
+		{
+			desc: "zero downtime jail duration",
+			params: func() types.Params {
+				p := types.DefaultParams()
+				p.DowntimeJailDuration = 0
+				return p
+			},
+		},
+		{
+			desc: "zero max keyshare pause duration",
+			params: func() types.Params {
+				p := types.DefaultParams()
+				p.MaxKeysharePauseDuration = 0
+				return p
+			},
+		},
+		{
+			desc: "zero max authorized addresses",
+			params: func() types.Params {
+				p := types.DefaultParams()
+				p.MaxAuthorizedAddresses = 0
+				return p
+			},
+		},
+		{
+			desc: "threshold exceeds one",
+			params: func() types.Params {
+				p := types.DefaultParams()
+				p.KeyAggregationThreshold = sdk.NewDecWithPrec(101, 2)
+				return p
+			},
+		},
+		{
+			desc: "free general key requests",
+			params: func() types.Params {
+				p := types.DefaultParams()
+				p.GeneralKeyRequestFee = sdk.NewCoin("ufairy", sdk.ZeroInt())
+				return p
+			},
+			valid: true,
+		},
+		{
+			desc: "invalid general key request fee denom",
+			params: func() types.Params {
+				p := types.DefaultParams()
+				p.GeneralKeyRequestFee = sdk.Coin{Denom: "1", Amount: sdk.NewInt(1)}
+				return p
+			},
+		},
+		{
+			desc: "zero reward epoch blocks",
+			params: func() types.Params {
+				p := types.DefaultParams()
+				p.RewardEpochBlocks = 0
+				return p
+			},
+		},
+		{
+			desc: "encrypted tx fee reward share above one",
+			params: func() types.Params {
+				p := types.DefaultParams()
+				p.EncryptedTxFeeRewardShare = sdk.NewDecWithPrec(11, 1)
+				return p
+			},
+		},
+		{
+			desc: "no request fee reward share",
+			params: func() types.Params {
+				p := types.DefaultParams()
+				p.RequestFeeRewardShare = sdk.ZeroDec()
+				return p
+			},
+			valid: true,
+		},
+		{
+			desc: "zero aggregated key packet timeout",
+			params: func() types.Params {
+				p := types.DefaultParams()
+				p.AggrKeysharePacketTimeout = 0
+				return p
+			},
+		},
+		{
+			desc: "aggregated key retry backoff overflow",
+			params: func() types.Params {
+				p := types.DefaultParams()
+				p.AggrKeyshareRetryBackoffBlocks = math.MaxUint64 >> types.MaxAggrKeyshareRetryBackoffShift
+				return p
+			},
+		},
+		{
+			desc: "no aggregated key retry backoff",
+			params: func() types.Params {
+				p := types.DefaultParams()
+				p.AggrKeyshareRetryBackoffBlocks = 0
+				return p
+			},
+			valid: true,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.params().Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestParams_AggregationThreshold(t *testing.T) {
+	p := types.DefaultParams()
+	for n, expected := range map[uint64]uint64{1: 1, 2: 2, 3: 2, 4: 3, 6: 4, 10: 7} {
+		require.Equal(t, expected, p.AggregationThreshold(n), "n: %d", n)
+	}
+
+	p.KeyAggregationThreshold = sdk.NewDecWithPrec(5, 1)
+	for n, expected := range map[uint64]uint64{1: 1, 2: 1, 3: 2, 4: 2, 5: 3} {
+		require.Equal(t, expected, p.AggregationThreshold(n), "n: %d", n)
+	}
+}
+
+func TestParams_AggrKeyshareRetryDelay(t *testing.T) {
+	p := types.DefaultParams()
+	p.AggrKeyshareRetryBackoffBlocks = 5
+	for retry, expected := range map[uint64]uint64{0: 0, 1: 5, 2: 10, 3: 20, 4: 40} {
+		require.Equal(t, expected, p.AggrKeyshareRetryDelay(retry), "retry: %d", retry)
+	}
+	require.Equal(t, p.AggrKeyshareRetryDelay(types.MaxAggrKeyshareRetryBackoffShift+1), p.AggrKeyshareRetryDelay(100))
+
+	p.AggrKeyshareRetryBackoffBlocks = 0
+	require.Equal(t, uint64(0), p.AggrKeyshareRetryDelay(3))
+}
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -23,9 +24,11 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type ActivePubKey struct {
-	PublicKey string `protobuf:"bytes,1,opt,name=publicKey,proto3" json:"publicKey,omitempty"`
-	Creator   string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
-	Expiry    uint64 `protobuf:"varint,3,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	PublicKey   string               `protobuf:"bytes,1,opt,name=publicKey,proto3" json:"publicKey,omitempty"`
+	Creator     string               `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	Expiry      uint64               `protobuf:"varint,3,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	Threshold   uint64               `protobuf:"varint,4,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	Assignments []KeyShareAssignment `protobuf:"bytes,5,rep,name=assignments,proto3" json:"assignments"`
 }
 
 func (m *ActivePubKey) Reset()         { *m = ActivePubKey{} }
@@ -82,10 +85,26 @@ func (m *ActivePubKey) GetExpiry() uint64 {
 	return 0
 }
 
+func (m *ActivePubKey) GetThreshold() uint64 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *ActivePubKey) GetAssignments() []KeyShareAssignment {
+	if m != nil {
+		return m.Assignments
+	}
+	return nil
+}
+
 type QueuedPubKey struct {
-	PublicKey string `protobuf:"bytes,1,opt,name=publicKey,proto3" json:"publicKey,omitempty"`
-	Creator   string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
-	Expiry    uint64 `protobuf:"varint,3,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	PublicKey   string               `protobuf:"bytes,1,opt,name=publicKey,proto3" json:"publicKey,omitempty"`
+	Creator     string               `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	Expiry      uint64               `protobuf:"varint,3,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	Threshold   uint64               `protobuf:"varint,4,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	Assignments []KeyShareAssignment `protobuf:"bytes,5,rep,name=assignments,proto3" json:"assignments"`
 }
 
 func (m *QueuedPubKey) Reset()         { *m = QueuedPubKey{} }
@@ -142,28 +161,167 @@ func (m *QueuedPubKey) GetExpiry() uint64 {
 	return 0
 }
 
+func (m *QueuedPubKey) GetThreshold() uint64 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *QueuedPubKey) GetAssignments() []KeyShareAssignment {
+	if m != nil {
+		return m.Assignments
+	}
+	return nil
+}
+
+type KeyShareAssignment struct {
+	Validator    string   `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	ShareIndices []uint64 `protobuf:"varint,2,rep,packed,name=shareIndices,proto3" json:"shareIndices,omitempty"`
+	Weight       uint64   `protobuf:"varint,3,opt,name=weight,proto3" json:"weight,omitempty"`
+}
+
+func (m *KeyShareAssignment) Reset()         { *m = KeyShareAssignment{} }
+func (m *KeyShareAssignment) String() string { return proto.CompactTextString(m) }
+func (*KeyShareAssignment) ProtoMessage()    {}
+func (*KeyShareAssignment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c1c9675c7c2f3c4, []int{2}
+}
+func (m *KeyShareAssignment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KeyShareAssignment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_KeyShareAssignment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *KeyShareAssignment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KeyShareAssignment.Merge(m, src)
+}
+func (m *KeyShareAssignment) XXX_Size() int {
+	return m.Size()
+}
+func (m *KeyShareAssignment) XXX_DiscardUnknown() {
+	xxx_messageInfo_KeyShareAssignment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KeyShareAssignment proto.InternalMessageInfo
+
+func (m *KeyShareAssignment) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+func (m *KeyShareAssignment) GetShareIndices() []uint64 {
+	if m != nil {
+		return m.ShareIndices
+	}
+	return nil
+}
+
+func (m *KeyShareAssignment) GetWeight() uint64 {
+	if m != nil {
+		return m.Weight
+	}
+	return 0
+}
+
+// KeyShareIndexOwner records the validator a key share index of the active key is assigned to.
+// It is kept when the validator leaves the validator set, so the index stays bound to it.
+type KeyShareIndexOwner struct {
+	Index     uint64 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Validator string `protobuf:"bytes,2,opt,name=validator,proto3" json:"validator,omitempty"`
+}
+
+func (m *KeyShareIndexOwner) Reset()         { *m = KeyShareIndexOwner{} }
+func (m *KeyShareIndexOwner) String() string { return proto.CompactTextString(m) }
+func (*KeyShareIndexOwner) ProtoMessage()    {}
+func (*KeyShareIndexOwner) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c1c9675c7c2f3c4, []int{3}
+}
+func (m *KeyShareIndexOwner) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KeyShareIndexOwner) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_KeyShareIndexOwner.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *KeyShareIndexOwner) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KeyShareIndexOwner.Merge(m, src)
+}
+func (m *KeyShareIndexOwner) XXX_Size() int {
+	return m.Size()
+}
+func (m *KeyShareIndexOwner) XXX_DiscardUnknown() {
+	xxx_messageInfo_KeyShareIndexOwner.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KeyShareIndexOwner proto.InternalMessageInfo
+
+func (m *KeyShareIndexOwner) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *KeyShareIndexOwner) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*ActivePubKey)(nil), "fairyring.keyshare.ActivePubKey")
 	proto.RegisterType((*QueuedPubKey)(nil), "fairyring.keyshare.QueuedPubKey")
+	proto.RegisterType((*KeyShareAssignment)(nil), "fairyring.keyshare.KeyShareAssignment")
+	proto.RegisterType((*KeyShareIndexOwner)(nil), "fairyring.keyshare.KeyShareIndexOwner")
 }
 
 func init() { proto.RegisterFile("fairyring/keyshare/pub_key.proto", fileDescriptor_2c1c9675c7c2f3c4) }
 
 var fileDescriptor_2c1c9675c7c2f3c4 = []byte{
-	// 195 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x48, 0x4b, 0xcc, 0x2c,
-	0xaa, 0x2c, 0xca, 0xcc, 0x4b, 0xd7, 0xcf, 0x4e, 0xad, 0x2c, 0xce, 0x48, 0x2c, 0x4a, 0xd5, 0x2f,
-	0x28, 0x4d, 0x8a, 0xcf, 0x4e, 0xad, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x82, 0xab,
-	0xd0, 0x83, 0xa9, 0x50, 0x8a, 0xe3, 0xe2, 0x71, 0x4c, 0x2e, 0xc9, 0x2c, 0x4b, 0x0d, 0x28, 0x4d,
-	0xf2, 0x4e, 0xad, 0x14, 0x92, 0xe1, 0xe2, 0x2c, 0x28, 0x4d, 0xca, 0xc9, 0x4c, 0xf6, 0x4e, 0xad,
-	0x94, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x0c, 0x42, 0x08, 0x08, 0x49, 0x70, 0xb1, 0x27, 0x17, 0xa5,
-	0x26, 0x96, 0xe4, 0x17, 0x49, 0x30, 0x81, 0xe5, 0x60, 0x5c, 0x21, 0x31, 0x2e, 0xb6, 0xd4, 0x8a,
-	0x82, 0xcc, 0xa2, 0x4a, 0x09, 0x66, 0x05, 0x46, 0x0d, 0x96, 0x20, 0x28, 0x0f, 0x64, 0x7e, 0x60,
-	0x69, 0x6a, 0x69, 0x6a, 0x0a, 0x6d, 0xcc, 0x77, 0x32, 0x39, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23,
-	0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6,
-	0x63, 0x39, 0x86, 0x28, 0x29, 0x44, 0x78, 0x54, 0x20, 0x42, 0xa4, 0xa4, 0xb2, 0x20, 0xb5, 0x38,
-	0x89, 0x0d, 0x1c, 0x20, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0xee, 0xe6, 0x0d, 0x31, 0x34,
-	0x01, 0x00, 0x00,
+	// 346 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x52, 0xbb, 0x6e, 0xe2, 0x40,
+	0x14, 0xb5, 0xc1, 0xb0, 0x62, 0xa0, 0x1a, 0xa1, 0xd5, 0x08, 0xad, 0xbc, 0x16, 0xc5, 0xca, 0x95,
+	0x91, 0x36, 0xf9, 0x01, 0xa8, 0x82, 0x90, 0xf2, 0x70, 0xba, 0x34, 0x91, 0x1f, 0x37, 0xf6, 0x08,
+	0x32, 0xb6, 0x66, 0xc6, 0xc0, 0xfc, 0x45, 0x3e, 0x8b, 0x22, 0x05, 0x65, 0xaa, 0x28, 0x82, 0x1f,
+	0x89, 0x3c, 0x3c, 0x1c, 0xc2, 0x1f, 0xa4, 0xbb, 0xe7, 0xdc, 0xa3, 0x7b, 0xcf, 0x91, 0x0e, 0x72,
+	0x9e, 0x02, 0xca, 0x15, 0xa7, 0x2c, 0x19, 0x4c, 0x41, 0x89, 0x34, 0xe0, 0x30, 0xc8, 0x8b, 0xf0,
+	0x71, 0x0a, 0xca, 0xcb, 0x79, 0x26, 0x33, 0x8c, 0x8f, 0x0a, 0xef, 0xa0, 0xe8, 0x75, 0x93, 0x2c,
+	0xc9, 0xf4, 0x7a, 0x50, 0x4e, 0x3b, 0x65, 0xff, 0xd5, 0x44, 0x9d, 0x61, 0x24, 0xe9, 0x1c, 0x6e,
+	0x8b, 0x70, 0x02, 0x0a, 0xff, 0x41, 0xad, 0xbc, 0x08, 0x67, 0x34, 0x9a, 0x80, 0x22, 0xa6, 0x63,
+	0xba, 0x2d, 0xbf, 0x22, 0x30, 0x41, 0xbf, 0x22, 0x0e, 0x81, 0xcc, 0x38, 0xa9, 0xe9, 0xdd, 0x01,
+	0xe2, 0xdf, 0xa8, 0x09, 0xcb, 0x9c, 0x72, 0x45, 0xea, 0x8e, 0xe9, 0x5a, 0xfe, 0x1e, 0x95, 0xf7,
+	0x64, 0xca, 0x41, 0xa4, 0xd9, 0x2c, 0x26, 0x96, 0x5e, 0x55, 0x04, 0xbe, 0x46, 0xed, 0x40, 0x08,
+	0x9a, 0xb0, 0x67, 0x60, 0x52, 0x90, 0x86, 0x53, 0x77, 0xdb, 0xff, 0xff, 0x79, 0xe7, 0xf6, 0xbd,
+	0x09, 0xa8, 0xfb, 0x72, 0x18, 0x1e, 0xe5, 0x23, 0x6b, 0xf5, 0xfe, 0xd7, 0xf0, 0xbf, 0x1e, 0xd0,
+	0x71, 0xee, 0x0a, 0x28, 0x20, 0xfe, 0x11, 0x71, 0x18, 0xc2, 0xe7, 0xc2, 0xd2, 0xc3, 0x3c, 0x98,
+	0xd1, 0x58, 0xfb, 0xde, 0x67, 0x3a, 0x12, 0xb8, 0x8f, 0x3a, 0xfa, 0xc5, 0x98, 0xc5, 0x34, 0x02,
+	0x41, 0x6a, 0x4e, 0xdd, 0xb5, 0xfc, 0x13, 0xae, 0x4c, 0xb7, 0x00, 0x9a, 0xa4, 0xf2, 0x90, 0x6e,
+	0x87, 0xfa, 0x57, 0xd5, 0xbf, 0x31, 0x8b, 0x61, 0x79, 0xb3, 0x60, 0xc0, 0x71, 0x17, 0x35, 0x68,
+	0x89, 0xf4, 0x2f, 0xcb, 0xdf, 0x81, 0x53, 0x17, 0xb5, 0x6f, 0x2e, 0x46, 0x97, 0xab, 0x8d, 0x6d,
+	0xae, 0x37, 0xb6, 0xf9, 0xb1, 0xb1, 0xcd, 0x97, 0xad, 0x6d, 0xac, 0xb7, 0xb6, 0xf1, 0xb6, 0xb5,
+	0x8d, 0x87, 0x5e, 0xd5, 0xde, 0x65, 0xd5, 0x5f, 0xa9, 0x72, 0x10, 0x61, 0x53, 0x97, 0xf2, 0xe2,
+	0x73, 0x00, 0xa1, 0x46, 0xea, 0x23, 0xe2, 0x02, 0x00, 0x00,
 }
 
 func (m *ActivePubKey) Marshal() (dAtA []byte, err error) {
@@ -186,6 +344,25 @@ func (m *ActivePubKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Assignments) > 0 {
+		for iNdEx := len(m.Assignments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Assignments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPubKey(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Threshold != 0 {
+		i = encodeVarintPubKey(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.Expiry != 0 {
 		i = encodeVarintPubKey(dAtA, i, uint64(m.Expiry))
 		i--
@@ -228,6 +405,25 @@ func (m *QueuedPubKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Assignments) > 0 {
+		for iNdEx := len(m.Assignments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Assignments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPubKey(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Threshold != 0 {
+		i = encodeVarintPubKey(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.Expiry != 0 {
 		i = encodeVarintPubKey(dAtA, i, uint64(m.Expiry))
 		i--
@@ -250,6 +446,94 @@ func (m *QueuedPubKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *KeyShareAssignment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KeyShareAssignment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KeyShareAssignment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Weight != 0 {
+		i = encodeVarintPubKey(dAtA, i, uint64(m.Weight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ShareIndices) > 0 {
+		dAtA2 := make([]byte, len(m.ShareIndices)*10)
+		var j1 int
+		for _, num := range m.ShareIndices {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintPubKey(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintPubKey(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *KeyShareIndexOwner) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KeyShareIndexOwner) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KeyShareIndexOwner) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintPubKey(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Index != 0 {
+		i = encodeVarintPubKey(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintPubKey(dAtA []byte, offset int, v uint64) int {
 	offset -= sovPubKey(v)
 	base := offset
@@ -278,6 +562,15 @@ func (m *ActivePubKey) Size() (n int) {
 	if m.Expiry != 0 {
 		n += 1 + sovPubKey(uint64(m.Expiry))
 	}
+	if m.Threshold != 0 {
+		n += 1 + sovPubKey(uint64(m.Threshold))
+	}
+	if len(m.Assignments) > 0 {
+		for _, e := range m.Assignments {
+			l = e.Size()
+			n += 1 + l + sovPubKey(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -298,6 +591,54 @@ func (m *QueuedPubKey) Size() (n int) {
 	if m.Expiry != 0 {
 		n += 1 + sovPubKey(uint64(m.Expiry))
 	}
+	if m.Threshold != 0 {
+		n += 1 + sovPubKey(uint64(m.Threshold))
+	}
+	if len(m.Assignments) > 0 {
+		for _, e := range m.Assignments {
+			l = e.Size()
+			n += 1 + l + sovPubKey(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *KeyShareAssignment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovPubKey(uint64(l))
+	}
+	if len(m.ShareIndices) > 0 {
+		l = 0
+		for _, e := range m.ShareIndices {
+			l += sovPubKey(uint64(e))
+		}
+		n += 1 + sovPubKey(uint64(l)) + l
+	}
+	if m.Weight != 0 {
+		n += 1 + sovPubKey(uint64(m.Weight))
+	}
+	return n
+}
+
+func (m *KeyShareIndexOwner) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovPubKey(uint64(m.Index))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovPubKey(uint64(l))
+	}
 	return n
 }
 
@@ -419,6 +760,59 @@ func (m *ActivePubKey) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPubKey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Assignments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPubKey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPubKey
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPubKey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Assignments = append(m.Assignments, KeyShareAssignment{})
+			if err := m.Assignments[len(m.Assignments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPubKey(dAtA[iNdEx:])
@@ -552,6 +946,337 @@ func (m *QueuedPubKey) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPubKey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Assignments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPubKey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPubKey
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPubKey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Assignments = append(m.Assignments, KeyShareAssignment{})
+			if err := m.Assignments[len(m.Assignments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPubKey(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPubKey
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KeyShareAssignment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPubKey
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KeyShareAssignment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KeyShareAssignment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPubKey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPubKey
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPubKey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPubKey
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ShareIndices = append(m.ShareIndices, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPubKey
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPubKey
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPubKey
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ShareIndices) == 0 {
+					m.ShareIndices = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPubKey
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ShareIndices = append(m.ShareIndices, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareIndices", wireType)
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			m.Weight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPubKey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Weight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPubKey(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPubKey
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KeyShareIndexOwner) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPubKey
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KeyShareIndexOwner: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KeyShareIndexOwner: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPubKey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPubKey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPubKey
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPubKey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPubKey(dAtA[iNdEx:])
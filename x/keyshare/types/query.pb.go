@@ -6,6 +6,8 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -289,6 +291,52 @@ func (m *QueryGetValidatorSetResponse) GetValidatorSet() ValidatorSet {
 	return ValidatorSet{}
 }
 
+type QueryGetValidatorSetByShareIndexRequest struct {
+	ShareIndex uint64 `protobuf:"varint,1,opt,name=shareIndex,proto3" json:"shareIndex,omitempty"`
+}
+
+func (m *QueryGetValidatorSetByShareIndexRequest) Reset() {
+	*m = QueryGetValidatorSetByShareIndexRequest{}
+}
+func (m *QueryGetValidatorSetByShareIndexRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetValidatorSetByShareIndexRequest) ProtoMessage()    {}
+func (*QueryGetValidatorSetByShareIndexRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_572603c2d521bf14, []int{6}
+}
+func (m *QueryGetValidatorSetByShareIndexRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetValidatorSetByShareIndexRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetValidatorSetByShareIndexRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetValidatorSetByShareIndexRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetValidatorSetByShareIndexRequest.Merge(m, src)
+}
+func (m *QueryGetValidatorSetByShareIndexRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetValidatorSetByShareIndexRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetValidatorSetByShareIndexRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetValidatorSetByShareIndexRequest proto.InternalMessageInfo
+
+func (m *QueryGetValidatorSetByShareIndexRequest) GetShareIndex() uint64 {
+	if m != nil {
+		return m.ShareIndex
+	}
+	return 0
+}
+
 type QueryAllValidatorSetRequest struct {
 	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
@@ -297,7 +345,7 @@ func (m *QueryAllValidatorSetRequest) Reset()         { *m = QueryAllValidatorSe
 func (m *QueryAllValidatorSetRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllValidatorSetRequest) ProtoMessage()    {}
 func (*QueryAllValidatorSetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_572603c2d521bf14, []int{6}
+	return fileDescriptor_572603c2d521bf14, []int{7}
 }
 func (m *QueryAllValidatorSetRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -342,7 +390,7 @@ func (m *QueryAllValidatorSetResponse) Reset()         { *m = QueryAllValidatorS
 func (m *QueryAllValidatorSetResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAllValidatorSetResponse) ProtoMessage()    {}
 func (*QueryAllValidatorSetResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_572603c2d521bf14, []int{7}
+	return fileDescriptor_572603c2d521bf14, []int{8}
 }
 func (m *QueryAllValidatorSetResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -394,7 +442,7 @@ func (m *QueryGetKeyShareRequest) Reset()         { *m = QueryGetKeyShareRequest
 func (m *QueryGetKeyShareRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetKeyShareRequest) ProtoMessage()    {}
 func (*QueryGetKeyShareRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_572603c2d521bf14, []int{8}
+	return fileDescriptor_572603c2d521bf14, []int{9}
 }
 func (m *QueryGetKeyShareRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -445,7 +493,7 @@ func (m *QueryGetKeyShareResponse) Reset()         { *m = QueryGetKeyShareRespon
 func (m *QueryGetKeyShareResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetKeyShareResponse) ProtoMessage()    {}
 func (*QueryGetKeyShareResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_572603c2d521bf14, []int{9}
+	return fileDescriptor_572603c2d521bf14, []int{10}
 }
 func (m *QueryGetKeyShareResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -489,7 +537,7 @@ func (m *QueryAllKeyShareRequest) Reset()         { *m = QueryAllKeyShareRequest
 func (m *QueryAllKeyShareRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllKeyShareRequest) ProtoMessage()    {}
 func (*QueryAllKeyShareRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_572603c2d521bf14, []int{10}
+	return fileDescriptor_572603c2d521bf14, []int{11}
 }
 func (m *QueryAllKeyShareRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -534,7 +582,7 @@ func (m *QueryAllKeyShareResponse) Reset()         { *m = QueryAllKeyShareRespon
 func (m *QueryAllKeyShareResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAllKeyShareResponse) ProtoMessage()    {}
 func (*QueryAllKeyShareResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_572603c2d521bf14, []int{11}
+	return fileDescriptor_572603c2d521bf14, []int{12}
 }
 func (m *QueryAllKeyShareResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -586,7 +634,7 @@ func (m *QueryGetAggregatedKeyShareRequest) Reset()         { *m = QueryGetAggre
 func (m *QueryGetAggregatedKeyShareRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetAggregatedKeyShareRequest) ProtoMessage()    {}
 func (*QueryGetAggregatedKeyShareRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_572603c2d521bf14, []int{12}
+	return fileDescriptor_572603c2d521bf14, []int{13}
 }
 func (m *QueryGetAggregatedKeyShareRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -630,7 +678,7 @@ func (m *QueryGetAggregatedKeyShareResponse) Reset()         { *m = QueryGetAggr
 func (m *QueryGetAggregatedKeyShareResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetAggregatedKeyShareResponse) ProtoMessage()    {}
 func (*QueryGetAggregatedKeyShareResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_572603c2d521bf14, []int{13}
+	return fileDescriptor_572603c2d521bf14, []int{14}
 }
 func (m *QueryGetAggregatedKeyShareResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -674,7 +722,7 @@ func (m *QueryAllAggregatedKeyShareRequest) Reset()         { *m = QueryAllAggre
 func (m *QueryAllAggregatedKeyShareRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllAggregatedKeyShareRequest) ProtoMessage()    {}
 func (*QueryAllAggregatedKeyShareRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_572603c2d521bf14, []int{14}
+	return fileDescriptor_572603c2d521bf14, []int{15}
 }
 func (m *QueryAllAggregatedKeyShareRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -719,7 +767,7 @@ func (m *QueryAllAggregatedKeyShareResponse) Reset()         { *m = QueryAllAggr
 func (m *QueryAllAggregatedKeyShareResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAllAggregatedKeyShareResponse) ProtoMessage()    {}
 func (*QueryAllAggregatedKeyShareResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_572603c2d521bf14, []int{15}
+	return fileDescriptor_572603c2d521bf14, []int{16}
 }
 func (m *QueryAllAggregatedKeyShareResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -769,7 +817,7 @@ func (m *QueryPubKeyRequest) Reset()         { *m = QueryPubKeyRequest{} }
 func (m *QueryPubKeyRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryPubKeyRequest) ProtoMessage()    {}
 func (*QueryPubKeyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_572603c2d521bf14, []int{16}
+	return fileDescriptor_572603c2d521bf14, []int{17}
 }
 func (m *QueryPubKeyRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -807,7 +855,7 @@ func (m *QueryPubKeyResponse) Reset()         { *m = QueryPubKeyResponse{} }
 func (m *QueryPubKeyResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryPubKeyResponse) ProtoMessage()    {}
 func (*QueryPubKeyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_572603c2d521bf14, []int{17}
+	return fileDescriptor_572603c2d521bf14, []int{18}
 }
 func (m *QueryPubKeyResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -858,7 +906,7 @@ func (m *QueryGetAuthorizedAddressRequest) Reset()         { *m = QueryGetAuthor
 func (m *QueryGetAuthorizedAddressRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetAuthorizedAddressRequest) ProtoMessage()    {}
 func (*QueryGetAuthorizedAddressRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_572603c2d521bf14, []int{18}
+	return fileDescriptor_572603c2d521bf14, []int{19}
 }
 func (m *QueryGetAuthorizedAddressRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -902,7 +950,7 @@ func (m *QueryGetAuthorizedAddressResponse) Reset()         { *m = QueryGetAutho
 func (m *QueryGetAuthorizedAddressResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetAuthorizedAddressResponse) ProtoMessage()    {}
 func (*QueryGetAuthorizedAddressResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_572603c2d521bf14, []int{19}
+	return fileDescriptor_572603c2d521bf14, []int{20}
 }
 func (m *QueryGetAuthorizedAddressResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -946,7 +994,7 @@ func (m *QueryAllAuthorizedAddressRequest) Reset()         { *m = QueryAllAuthor
 func (m *QueryAllAuthorizedAddressRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllAuthorizedAddressRequest) ProtoMessage()    {}
 func (*QueryAllAuthorizedAddressRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_572603c2d521bf14, []int{20}
+	return fileDescriptor_572603c2d521bf14, []int{21}
 }
 func (m *QueryAllAuthorizedAddressRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -991,7 +1039,7 @@ func (m *QueryAllAuthorizedAddressResponse) Reset()         { *m = QueryAllAutho
 func (m *QueryAllAuthorizedAddressResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAllAuthorizedAddressResponse) ProtoMessage()    {}
 func (*QueryAllAuthorizedAddressResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_572603c2d521bf14, []int{21}
+	return fileDescriptor_572603c2d521bf14, []int{22}
 }
 func (m *QueryAllAuthorizedAddressResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1044,7 +1092,7 @@ func (m *QueryGetGeneralKeyShareRequest) Reset()         { *m = QueryGetGeneralK
 func (m *QueryGetGeneralKeyShareRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetGeneralKeyShareRequest) ProtoMessage()    {}
 func (*QueryGetGeneralKeyShareRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_572603c2d521bf14, []int{22}
+	return fileDescriptor_572603c2d521bf14, []int{23}
 }
 func (m *QueryGetGeneralKeyShareRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1102,7 +1150,7 @@ func (m *QueryGetGeneralKeyShareResponse) Reset()         { *m = QueryGetGeneral
 func (m *QueryGetGeneralKeyShareResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetGeneralKeyShareResponse) ProtoMessage()    {}
 func (*QueryGetGeneralKeyShareResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_572603c2d521bf14, []int{23}
+	return fileDescriptor_572603c2d521bf14, []int{24}
 }
 func (m *QueryGetGeneralKeyShareResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1146,7 +1194,7 @@ func (m *QueryAllGeneralKeyShareRequest) Reset()         { *m = QueryAllGeneralK
 func (m *QueryAllGeneralKeyShareRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllGeneralKeyShareRequest) ProtoMessage()    {}
 func (*QueryAllGeneralKeyShareRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_572603c2d521bf14, []int{24}
+	return fileDescriptor_572603c2d521bf14, []int{25}
 }
 func (m *QueryAllGeneralKeyShareRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1191,7 +1239,7 @@ func (m *QueryAllGeneralKeyShareResponse) Reset()         { *m = QueryAllGeneral
 func (m *QueryAllGeneralKeyShareResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAllGeneralKeyShareResponse) ProtoMessage()    {}
 func (*QueryAllGeneralKeyShareResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_572603c2d521bf14, []int{25}
+	return fileDescriptor_572603c2d521bf14, []int{26}
 }
 func (m *QueryAllGeneralKeyShareResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1234,1973 +1282,6402 @@ func (m *QueryAllGeneralKeyShareResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryCommitmentsRequest)(nil), "fairyring.keyshare.QueryCommitmentsRequest")
-	proto.RegisterType((*QueryCommitmentsResponse)(nil), "fairyring.keyshare.QueryCommitmentsResponse")
-	proto.RegisterType((*QueryParamsRequest)(nil), "fairyring.keyshare.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "fairyring.keyshare.QueryParamsResponse")
-	proto.RegisterType((*QueryGetValidatorSetRequest)(nil), "fairyring.keyshare.QueryGetValidatorSetRequest")
-	proto.RegisterType((*QueryGetValidatorSetResponse)(nil), "fairyring.keyshare.QueryGetValidatorSetResponse")
-	proto.RegisterType((*QueryAllValidatorSetRequest)(nil), "fairyring.keyshare.QueryAllValidatorSetRequest")
-	proto.RegisterType((*QueryAllValidatorSetResponse)(nil), "fairyring.keyshare.QueryAllValidatorSetResponse")
-	proto.RegisterType((*QueryGetKeyShareRequest)(nil), "fairyring.keyshare.QueryGetKeyShareRequest")
-	proto.RegisterType((*QueryGetKeyShareResponse)(nil), "fairyring.keyshare.QueryGetKeyShareResponse")
-	proto.RegisterType((*QueryAllKeyShareRequest)(nil), "fairyring.keyshare.QueryAllKeyShareRequest")
-	proto.RegisterType((*QueryAllKeyShareResponse)(nil), "fairyring.keyshare.QueryAllKeyShareResponse")
-	proto.RegisterType((*QueryGetAggregatedKeyShareRequest)(nil), "fairyring.keyshare.QueryGetAggregatedKeyShareRequest")
-	proto.RegisterType((*QueryGetAggregatedKeyShareResponse)(nil), "fairyring.keyshare.QueryGetAggregatedKeyShareResponse")
-	proto.RegisterType((*QueryAllAggregatedKeyShareRequest)(nil), "fairyring.keyshare.QueryAllAggregatedKeyShareRequest")
-	proto.RegisterType((*QueryAllAggregatedKeyShareResponse)(nil), "fairyring.keyshare.QueryAllAggregatedKeyShareResponse")
-	proto.RegisterType((*QueryPubKeyRequest)(nil), "fairyring.keyshare.QueryPubKeyRequest")
-	proto.RegisterType((*QueryPubKeyResponse)(nil), "fairyring.keyshare.QueryPubKeyResponse")
-	proto.RegisterType((*QueryGetAuthorizedAddressRequest)(nil), "fairyring.keyshare.QueryGetAuthorizedAddressRequest")
-	proto.RegisterType((*QueryGetAuthorizedAddressResponse)(nil), "fairyring.keyshare.QueryGetAuthorizedAddressResponse")
-	proto.RegisterType((*QueryAllAuthorizedAddressRequest)(nil), "fairyring.keyshare.QueryAllAuthorizedAddressRequest")
-	proto.RegisterType((*QueryAllAuthorizedAddressResponse)(nil), "fairyring.keyshare.QueryAllAuthorizedAddressResponse")
-	proto.RegisterType((*QueryGetGeneralKeyShareRequest)(nil), "fairyring.keyshare.QueryGetGeneralKeyShareRequest")
-	proto.RegisterType((*QueryGetGeneralKeyShareResponse)(nil), "fairyring.keyshare.QueryGetGeneralKeyShareResponse")
-	proto.RegisterType((*QueryAllGeneralKeyShareRequest)(nil), "fairyring.keyshare.QueryAllGeneralKeyShareRequest")
-	proto.RegisterType((*QueryAllGeneralKeyShareResponse)(nil), "fairyring.keyshare.QueryAllGeneralKeyShareResponse")
+type QueryGetDkgRoundRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func init() { proto.RegisterFile("fairyring/keyshare/query.proto", fileDescriptor_572603c2d521bf14) }
-
-var fileDescriptor_572603c2d521bf14 = []byte{
-	// 1306 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x4d, 0x6f, 0x1b, 0x45,
-	0x18, 0xce, 0xd4, 0xad, 0x69, 0x26, 0x95, 0xa2, 0x0c, 0xa1, 0x84, 0x6d, 0x6a, 0x27, 0x13, 0xf2,
-	0xd1, 0x86, 0x7a, 0x9a, 0x2f, 0x4a, 0x41, 0x02, 0xb9, 0xa0, 0x06, 0x51, 0x10, 0xad, 0x83, 0x2a,
-	0xb5, 0x42, 0x8a, 0xc6, 0xf1, 0xb0, 0xde, 0x66, 0xe3, 0x75, 0x76, 0xd7, 0x51, 0x4d, 0xe4, 0x1e,
-	0x7a, 0xe0, 0x0a, 0x88, 0x1f, 0xc0, 0x01, 0xc4, 0x09, 0x4e, 0xbd, 0x94, 0x0b, 0x1c, 0x89, 0xc4,
-	0xa5, 0x12, 0x17, 0x4e, 0x08, 0x25, 0xfc, 0x10, 0xb4, 0xb3, 0xef, 0xae, 0xd7, 0xde, 0xd9, 0xb5,
-	0xdd, 0xfa, 0xb6, 0x3b, 0xf3, 0x7e, 0x3c, 0xcf, 0x3b, 0xef, 0xcc, 0x3c, 0xbb, 0x38, 0xf7, 0x05,
-	0x37, 0xec, 0xa6, 0x6d, 0xd4, 0x74, 0xb6, 0x2b, 0x9a, 0x4e, 0x95, 0xdb, 0x82, 0xed, 0x37, 0x84,
-	0xdd, 0x2c, 0xd4, 0x6d, 0xcb, 0xb5, 0x08, 0x09, 0xe7, 0x0b, 0xc1, 0xbc, 0x36, 0xa9, 0x5b, 0xba,
-	0x25, 0xa7, 0x99, 0xf7, 0xe4, 0x5b, 0x6a, 0xd3, 0xba, 0x65, 0xe9, 0xa6, 0x60, 0xbc, 0x6e, 0x30,
-	0x5e, 0xab, 0x59, 0x2e, 0x77, 0x0d, 0xab, 0xe6, 0xc0, 0xec, 0xe5, 0x1d, 0xcb, 0xd9, 0xb3, 0x1c,
-	0x56, 0xe6, 0x0e, 0x24, 0x60, 0x07, 0x2b, 0x65, 0xe1, 0xf2, 0x15, 0x56, 0xe7, 0xba, 0x51, 0x93,
-	0xc6, 0x60, 0x9b, 0x57, 0x60, 0xaa, 0x73, 0x9b, 0xef, 0x05, 0xc1, 0x16, 0x14, 0x06, 0x07, 0xdc,
-	0x34, 0x2a, 0xdc, 0xb5, 0xec, 0x6d, 0x47, 0xb8, 0x60, 0x47, 0x15, 0x76, 0xbb, 0xa2, 0xb9, 0x2d,
-	0x9f, 0xc0, 0xe6, 0x8a, 0xc2, 0x86, 0xeb, 0xba, 0x2d, 0x74, 0xee, 0x8a, 0xca, 0x76, 0xb7, 0xf9,
-	0x8c, 0x0a, 0x5b, 0xa3, 0xec, 0xd9, 0x81, 0xc5, 0xb2, 0x2a, 0x60, 0xc3, 0xad, 0x5a, 0xb6, 0xf1,
-	0xa5, 0xa8, 0x6c, 0xf3, 0x4a, 0xc5, 0x16, 0x4e, 0x58, 0x16, 0x85, 0xb1, 0x2e, 0x6a, 0xc2, 0xe6,
-	0x66, 0x2c, 0xf5, 0xeb, 0x0a, 0xdb, 0x1d, 0x6b, 0x6f, 0xcf, 0x70, 0xf7, 0x44, 0xcd, 0x85, 0x88,
-	0xf4, 0x35, 0xfc, 0xea, 0x1d, 0xaf, 0xbc, 0xef, 0xb7, 0x67, 0x4a, 0x62, 0xbf, 0x21, 0x1c, 0x97,
-	0x3e, 0x45, 0x78, 0x2a, 0x3e, 0xe7, 0xd4, 0xad, 0x9a, 0x23, 0xc8, 0x27, 0x78, 0x82, 0xef, 0xb8,
-	0xc6, 0x81, 0x88, 0x4c, 0x4e, 0xa1, 0x19, 0xb4, 0x34, 0xb6, 0x9a, 0x2f, 0xc4, 0x9b, 0xa0, 0x10,
-	0x8d, 0x11, 0xf7, 0xf4, 0xc2, 0xed, 0x37, 0x44, 0x43, 0x54, 0xa2, 0xe1, 0x4e, 0xf5, 0x19, 0x2e,
-	0xe6, 0x49, 0x27, 0x31, 0x91, 0xc8, 0x6f, 0xcb, 0x36, 0x08, 0x08, 0x7d, 0x8a, 0x5f, 0xee, 0x18,
-	0x05, 0x2a, 0x6f, 0xe1, 0xac, 0xdf, 0x2e, 0x80, 0x5f, 0x53, 0x25, 0xf4, 0x7d, 0x6e, 0x9c, 0x3e,
-	0xfa, 0x27, 0x3f, 0x52, 0x02, 0x7b, 0xba, 0x86, 0x2f, 0xc8, 0x80, 0x9b, 0xc2, 0xbd, 0x1b, 0xf4,
-	0xd3, 0x96, 0x70, 0x21, 0x1f, 0x99, 0xc4, 0x67, 0x8c, 0x5a, 0x45, 0x3c, 0x94, 0x71, 0x47, 0x4b,
-	0xfe, 0x0b, 0x7d, 0x80, 0xa7, 0xd5, 0x4e, 0x00, 0xe7, 0x23, 0x7c, 0xee, 0x20, 0x32, 0x0e, 0xa0,
-	0x66, 0x54, 0xa0, 0xa2, 0xfe, 0x00, 0xad, 0xc3, 0x97, 0x0a, 0x00, 0x58, 0x34, 0x4d, 0x15, 0xc0,
-	0x9b, 0x18, 0xb7, 0x77, 0x13, 0x24, 0x5a, 0x28, 0xf8, 0x5b, 0xaf, 0xe0, 0x6d, 0xbd, 0x82, 0xbf,
-	0xb7, 0x61, 0xeb, 0x15, 0x6e, 0x73, 0x5d, 0x80, 0x6f, 0x29, 0xe2, 0x49, 0x9f, 0x20, 0xe0, 0x14,
-	0xcb, 0x93, 0xc8, 0x29, 0xf3, 0xbc, 0x9c, 0xc8, 0x66, 0x07, 0x68, 0xbf, 0x47, 0x16, 0x7b, 0x82,
-	0xf6, 0x81, 0x74, 0xa0, 0xbe, 0x07, 0xad, 0xbf, 0x29, 0xdc, 0x5b, 0xa2, 0xb9, 0xe5, 0x65, 0x0f,
-	0x0a, 0x33, 0x8d, 0x47, 0xc3, 0x9c, 0xb0, 0x7a, 0xed, 0x01, 0x32, 0x83, 0xc7, 0xca, 0xa6, 0xb5,
-	0xb3, 0xfb, 0xa1, 0x30, 0xf4, 0xaa, 0x2b, 0x21, 0x9c, 0x2e, 0x45, 0x87, 0xe8, 0x7d, 0xd8, 0x39,
-	0x1d, 0xa1, 0xa1, 0x16, 0xef, 0xe2, 0xb3, 0xbb, 0x30, 0x06, 0x25, 0x9f, 0x56, 0xd5, 0x21, 0xf0,
-	0x83, 0x1a, 0x84, 0x3e, 0x94, 0x03, 0xec, 0xa2, 0x69, 0x76, 0xc3, 0x1e, 0xd6, 0x7a, 0xfe, 0x10,
-	0xec, 0xfc, 0x8e, 0x1c, 0x4a, 0xfc, 0x99, 0x41, 0xf1, 0x0f, 0x6f, 0xfd, 0xde, 0xc1, 0xb3, 0x41,
-	0x91, 0x8b, 0xe1, 0x09, 0xdc, 0x5d, 0x92, 0xf3, 0x38, 0x5b, 0xf5, 0x97, 0x09, 0xc9, 0x65, 0x82,
-	0x37, 0xfa, 0x18, 0x61, 0x9a, 0xe6, 0x0d, 0x64, 0x3f, 0xc7, 0x84, 0xc7, 0x66, 0xc3, 0xca, 0x2a,
-	0x68, 0xc7, 0x63, 0x41, 0x01, 0x14, 0x71, 0xe8, 0x2e, 0x30, 0x28, 0x9a, 0x66, 0x32, 0x83, 0x61,
-	0x2d, 0xea, 0x9f, 0x01, 0xe3, 0x84, 0x6c, 0x3d, 0x18, 0x67, 0x86, 0xc1, 0x78, 0x78, 0x8b, 0x1f,
-	0x9e, 0xf0, 0x8d, 0xf2, 0x2d, 0xd1, 0x0c, 0x4e, 0xf8, 0x5f, 0x50, 0x70, 0xc4, 0xc3, 0x70, 0xfb,
-	0xfc, 0xf1, 0xef, 0x1c, 0x7f, 0x3c, 0xed, 0x4c, 0x2d, 0x46, 0xec, 0x82, 0xf3, 0x27, 0xea, 0xeb,
-	0xc5, 0xf2, 0x2f, 0x1c, 0x88, 0x75, 0x2a, 0x39, 0xd6, 0x9d, 0x88, 0x5d, 0x10, 0x2b, 0xea, 0x4b,
-	0xdf, 0xc6, 0x33, 0x61, 0x13, 0x86, 0x77, 0x7e, 0xd1, 0xbf, 0xf2, 0x23, 0x1d, 0xec, 0x72, 0x5b,
-	0x87, 0x9b, 0x60, 0xb4, 0x04, 0x6f, 0xf4, 0x51, 0xa4, 0xfd, 0xe3, 0xbe, 0x40, 0xfc, 0x1e, 0x9e,
-	0xe0, 0xdd, 0x93, 0xc0, 0x7e, 0x5e, 0xc9, 0xbe, 0xdb, 0x18, 0x60, 0xc7, 0xa3, 0xd0, 0x07, 0x80,
-	0xdd, 0x6b, 0xa7, 0x24, 0xec, 0xc3, 0xea, 0xdd, 0x3f, 0x50, 0x64, 0xa7, 0x0c, 0x4a, 0x36, 0xf3,
-	0xe2, 0x64, 0x87, 0xd7, 0xb7, 0x75, 0x9c, 0x0b, 0x56, 0x6d, 0xd3, 0x17, 0x6e, 0x83, 0xdd, 0x3d,
-	0xe7, 0x71, 0xd6, 0xa8, 0x7c, 0xd6, 0xac, 0x0b, 0x09, 0x62, 0xb4, 0x04, 0x6f, 0x64, 0x0a, 0xbf,
-	0x64, 0x54, 0xee, 0x72, 0xb3, 0x21, 0xa6, 0x32, 0x72, 0x22, 0x78, 0xa5, 0x07, 0x38, 0x9f, 0x98,
-	0x11, 0x0a, 0xb7, 0x85, 0xc7, 0xf5, 0xce, 0x29, 0x58, 0xab, 0x39, 0x55, 0xd9, 0xba, 0xa2, 0x40,
-	0xd1, 0xba, 0x23, 0xd0, 0x2a, 0x30, 0x2d, 0x9a, 0x66, 0x02, 0xd3, 0x61, 0x75, 0xc7, 0xef, 0x08,
-	0x28, 0xaa, 0x52, 0xa5, 0x51, 0xcc, 0xbc, 0x18, 0xc5, 0xa1, 0x75, 0xc5, 0xea, 0x57, 0x13, 0xf8,
-	0x8c, 0x64, 0x40, 0xbe, 0x45, 0x78, 0x2c, 0x2a, 0x8c, 0x97, 0x13, 0xce, 0x15, 0x95, 0x62, 0xd7,
-	0xde, 0xe8, 0xcf, 0xd8, 0x07, 0x40, 0x17, 0x1f, 0xff, 0xf5, 0xdf, 0x77, 0xa7, 0x66, 0x49, 0x9e,
-	0xa5, 0x7f, 0x29, 0x90, 0x16, 0xce, 0xfa, 0xf2, 0x97, 0x2c, 0x24, 0x26, 0xe8, 0x50, 0xda, 0xda,
-	0x62, 0x4f, 0x3b, 0xc0, 0x40, 0x25, 0x86, 0x69, 0xa2, 0xb1, 0xc4, 0x8f, 0x38, 0xf2, 0x23, 0xc2,
-	0xe7, 0xa2, 0xaa, 0x90, 0xb0, 0xc4, 0xe8, 0x6a, 0x21, 0xae, 0x5d, 0xed, 0xdf, 0x01, 0x70, 0xad,
-	0x48, 0x5c, 0xcb, 0xe4, 0x12, 0xeb, 0xf5, 0xed, 0xc8, 0x0e, 0xa5, 0xac, 0x6f, 0x91, 0xef, 0x11,
-	0x1e, 0x8f, 0xc6, 0x2a, 0x9a, 0x66, 0x0a, 0x52, 0xb5, 0x22, 0x4f, 0x41, 0x9a, 0x20, 0xad, 0xe9,
-	0x25, 0x89, 0x74, 0x8e, 0xcc, 0xf6, 0x44, 0x4a, 0x7e, 0x42, 0xf8, 0x6c, 0xd8, 0xbb, 0xcb, 0x69,
-	0x35, 0xe9, 0xda, 0xa9, 0x29, 0x8d, 0xa5, 0x50, 0xb8, 0xf4, 0x3d, 0x09, 0xe9, 0x3a, 0xb9, 0xc6,
-	0xd2, 0x3e, 0xa8, 0xd9, 0x61, 0x88, 0xae, 0xc5, 0x0e, 0x23, 0xea, 0xb9, 0x45, 0xbe, 0x46, 0x78,
-	0x2c, 0x88, 0xea, 0x95, 0x71, 0x39, 0xad, 0x2a, 0xfd, 0x63, 0x55, 0xa8, 0x59, 0x3a, 0x2f, 0xb1,
-	0xe6, 0xc9, 0xc5, 0x54, 0xac, 0xe4, 0x37, 0x84, 0x49, 0x5c, 0xe8, 0x90, 0x8d, 0xb4, 0xba, 0x24,
-	0x4a, 0x3a, 0xed, 0xcd, 0x41, 0xdd, 0x00, 0xec, 0x75, 0x09, 0x76, 0x8d, 0xac, 0xb0, 0x3e, 0xff,
-	0x42, 0xb0, 0xc3, 0x2a, 0x94, 0xf4, 0x29, 0xc2, 0xaf, 0xc4, 0x23, 0x7b, 0xc5, 0xdd, 0x48, 0xab,
-	0xd7, 0xf3, 0x70, 0x48, 0xd5, 0x97, 0xf4, 0xaa, 0xe4, 0x70, 0x99, 0x2c, 0xf5, 0xcb, 0x81, 0x3c,
-	0xc2, 0x59, 0x90, 0x5e, 0x29, 0xc7, 0x4f, 0x54, 0x06, 0xa6, 0x1d, 0x3f, 0x1d, 0xba, 0x90, 0xce,
-	0x49, 0x30, 0x17, 0xc9, 0x05, 0x96, 0xfc, 0x9f, 0x86, 0xfc, 0x8a, 0xf0, 0x44, 0x4c, 0x2a, 0x90,
-	0xf5, 0xd4, 0x35, 0x4c, 0x10, 0x44, 0xda, 0xc6, 0x80, 0x5e, 0x80, 0xf3, 0x9a, 0xc4, 0xb9, 0x42,
-	0x18, 0xeb, 0xeb, 0x6f, 0x11, 0x3b, 0xf4, 0x35, 0x62, 0x8b, 0x3c, 0x41, 0x78, 0x32, 0x16, 0xd6,
-	0x5b, 0xf5, 0xf5, 0xd4, 0xe5, 0x1b, 0x1c, 0x7e, 0x9a, 0x30, 0xa3, 0x05, 0x09, 0x7f, 0x89, 0x2c,
-	0xf4, 0x07, 0x9f, 0x1c, 0x21, 0x3c, 0xde, 0x75, 0x05, 0x93, 0xd5, 0xb4, 0xca, 0xa9, 0x05, 0x86,
-	0xb6, 0x36, 0x90, 0x0f, 0x80, 0xfd, 0x58, 0x82, 0xbd, 0x49, 0x3e, 0x60, 0xfd, 0xfc, 0x6c, 0xeb,
-	0x3c, 0xc5, 0x7c, 0x31, 0x26, 0x1f, 0xa4, 0xf8, 0x6a, 0x91, 0x9f, 0x11, 0x26, 0x5d, 0x99, 0xbc,
-	0xf2, 0xaf, 0xa6, 0x15, 0x72, 0x60, 0x36, 0xc9, 0xba, 0x87, 0x5e, 0x91, 0x6c, 0x16, 0xc9, 0x7c,
-	0x5f, 0x6c, 0x6e, 0xac, 0x1f, 0x1d, 0xe7, 0xd0, 0xb3, 0xe3, 0x1c, 0xfa, 0xf7, 0x38, 0x87, 0xbe,
-	0x39, 0xc9, 0x8d, 0x3c, 0x3b, 0xc9, 0x8d, 0xfc, 0x7d, 0x92, 0x1b, 0xb9, 0xaf, 0xb5, 0xfd, 0x1f,
-	0xb6, 0x23, 0xb8, 0xcd, 0xba, 0x70, 0xca, 0x59, 0xf9, 0x2f, 0x71, 0xed, 0xff, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x9b, 0x70, 0x22, 0xd3, 0x1e, 0x16, 0x00, 0x00,
+func (m *QueryGetDkgRoundRequest) Reset()         { *m = QueryGetDkgRoundRequest{} }
+func (m *QueryGetDkgRoundRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetDkgRoundRequest) ProtoMessage()    {}
+func (*QueryGetDkgRoundRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_572603c2d521bf14, []int{27}
+}
+func (m *QueryGetDkgRoundRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetDkgRoundRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetDkgRoundRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetDkgRoundRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetDkgRoundRequest.Merge(m, src)
+}
+func (m *QueryGetDkgRoundRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetDkgRoundRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetDkgRoundRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryGetDkgRoundRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	Commitments(ctx context.Context, in *QueryCommitmentsRequest, opts ...grpc.CallOption) (*QueryCommitmentsResponse, error)
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Queries a ValidatorSet by index.
-	ValidatorSet(ctx context.Context, in *QueryGetValidatorSetRequest, opts ...grpc.CallOption) (*QueryGetValidatorSetResponse, error)
-	// Queries a list of ValidatorSet items.
-	ValidatorSetAll(ctx context.Context, in *QueryAllValidatorSetRequest, opts ...grpc.CallOption) (*QueryAllValidatorSetResponse, error)
-	// Queries a KeyShare by index.
-	KeyShare(ctx context.Context, in *QueryGetKeyShareRequest, opts ...grpc.CallOption) (*QueryGetKeyShareResponse, error)
-	// Queries a list of KeyShare items.
-	KeyShareAll(ctx context.Context, in *QueryAllKeyShareRequest, opts ...grpc.CallOption) (*QueryAllKeyShareResponse, error)
-	// Queries a list of AggregatedKeyShare items.
-	AggregatedKeyShare(ctx context.Context, in *QueryGetAggregatedKeyShareRequest, opts ...grpc.CallOption) (*QueryGetAggregatedKeyShareResponse, error)
-	AggregatedKeyShareAll(ctx context.Context, in *QueryAllAggregatedKeyShareRequest, opts ...grpc.CallOption) (*QueryAllAggregatedKeyShareResponse, error)
-	// Queries the public keys
-	PubKey(ctx context.Context, in *QueryPubKeyRequest, opts ...grpc.CallOption) (*QueryPubKeyResponse, error)
-	// Queries a list of AuthorizedAddress items.
-	AuthorizedAddress(ctx context.Context, in *QueryGetAuthorizedAddressRequest, opts ...grpc.CallOption) (*QueryGetAuthorizedAddressResponse, error)
-	AuthorizedAddressAll(ctx context.Context, in *QueryAllAuthorizedAddressRequest, opts ...grpc.CallOption) (*QueryAllAuthorizedAddressResponse, error)
-	// Queries a list of GeneralKeyShare items.
-	GeneralKeyShare(ctx context.Context, in *QueryGetGeneralKeyShareRequest, opts ...grpc.CallOption) (*QueryGetGeneralKeyShareResponse, error)
-	GeneralKeyShareAll(ctx context.Context, in *QueryAllGeneralKeyShareRequest, opts ...grpc.CallOption) (*QueryAllGeneralKeyShareResponse, error)
+func (m *QueryGetDkgRoundRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+type QueryGetDkgRoundResponse struct {
+	DkgRound   DkgRound       `protobuf:"bytes,1,opt,name=dkgRound,proto3" json:"dkgRound"`
+	Deals      []DkgDeal      `protobuf:"bytes,2,rep,name=deals,proto3" json:"deals"`
+	Complaints []DkgComplaint `protobuf:"bytes,3,rep,name=complaints,proto3" json:"complaints"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryGetDkgRoundResponse) Reset()         { *m = QueryGetDkgRoundResponse{} }
+func (m *QueryGetDkgRoundResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetDkgRoundResponse) ProtoMessage()    {}
+func (*QueryGetDkgRoundResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_572603c2d521bf14, []int{28}
 }
-
-func (c *queryClient) Commitments(ctx context.Context, in *QueryCommitmentsRequest, opts ...grpc.CallOption) (*QueryCommitmentsResponse, error) {
-	out := new(QueryCommitmentsResponse)
-	err := c.cc.Invoke(ctx, "/fairyring.keyshare.Query/Commitments", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryGetDkgRoundResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/fairyring.keyshare.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGetDkgRoundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetDkgRoundResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryGetDkgRoundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetDkgRoundResponse.Merge(m, src)
+}
+func (m *QueryGetDkgRoundResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetDkgRoundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetDkgRoundResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) ValidatorSet(ctx context.Context, in *QueryGetValidatorSetRequest, opts ...grpc.CallOption) (*QueryGetValidatorSetResponse, error) {
-	out := new(QueryGetValidatorSetResponse)
-	err := c.cc.Invoke(ctx, "/fairyring.keyshare.Query/ValidatorSet", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetDkgRoundResponse proto.InternalMessageInfo
+
+func (m *QueryGetDkgRoundResponse) GetDkgRound() DkgRound {
+	if m != nil {
+		return m.DkgRound
 	}
-	return out, nil
+	return DkgRound{}
 }
 
-func (c *queryClient) ValidatorSetAll(ctx context.Context, in *QueryAllValidatorSetRequest, opts ...grpc.CallOption) (*QueryAllValidatorSetResponse, error) {
-	out := new(QueryAllValidatorSetResponse)
-	err := c.cc.Invoke(ctx, "/fairyring.keyshare.Query/ValidatorSetAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGetDkgRoundResponse) GetDeals() []DkgDeal {
+	if m != nil {
+		return m.Deals
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) KeyShare(ctx context.Context, in *QueryGetKeyShareRequest, opts ...grpc.CallOption) (*QueryGetKeyShareResponse, error) {
-	out := new(QueryGetKeyShareResponse)
-	err := c.cc.Invoke(ctx, "/fairyring.keyshare.Query/KeyShare", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGetDkgRoundResponse) GetComplaints() []DkgComplaint {
+	if m != nil {
+		return m.Complaints
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) KeyShareAll(ctx context.Context, in *QueryAllKeyShareRequest, opts ...grpc.CallOption) (*QueryAllKeyShareResponse, error) {
-	out := new(QueryAllKeyShareResponse)
-	err := c.cc.Invoke(ctx, "/fairyring.keyshare.Query/KeyShareAll", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryGetKeyshareLivenessRequest struct {
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
 }
 
-func (c *queryClient) AggregatedKeyShare(ctx context.Context, in *QueryGetAggregatedKeyShareRequest, opts ...grpc.CallOption) (*QueryGetAggregatedKeyShareResponse, error) {
-	out := new(QueryGetAggregatedKeyShareResponse)
-	err := c.cc.Invoke(ctx, "/fairyring.keyshare.Query/AggregatedKeyShare", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGetKeyshareLivenessRequest) Reset()         { *m = QueryGetKeyshareLivenessRequest{} }
+func (m *QueryGetKeyshareLivenessRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetKeyshareLivenessRequest) ProtoMessage()    {}
+func (*QueryGetKeyshareLivenessRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_572603c2d521bf14, []int{29}
+}
+func (m *QueryGetKeyshareLivenessRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetKeyshareLivenessRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetKeyshareLivenessRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryGetKeyshareLivenessRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetKeyshareLivenessRequest.Merge(m, src)
+}
+func (m *QueryGetKeyshareLivenessRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetKeyshareLivenessRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetKeyshareLivenessRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) AggregatedKeyShareAll(ctx context.Context, in *QueryAllAggregatedKeyShareRequest, opts ...grpc.CallOption) (*QueryAllAggregatedKeyShareResponse, error) {
-	out := new(QueryAllAggregatedKeyShareResponse)
-	err := c.cc.Invoke(ctx, "/fairyring.keyshare.Query/AggregatedKeyShareAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetKeyshareLivenessRequest proto.InternalMessageInfo
+
+func (m *QueryGetKeyshareLivenessRequest) GetValidator() string {
+	if m != nil {
+		return m.Validator
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) PubKey(ctx context.Context, in *QueryPubKeyRequest, opts ...grpc.CallOption) (*QueryPubKeyResponse, error) {
-	out := new(QueryPubKeyResponse)
-	err := c.cc.Invoke(ctx, "/fairyring.keyshare.Query/PubKey", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryGetKeyshareLivenessResponse struct {
+	KeyshareLivenessInfo KeyshareLivenessInfo `protobuf:"bytes,1,opt,name=keyshareLivenessInfo,proto3" json:"keyshareLivenessInfo"`
 }
 
-func (c *queryClient) AuthorizedAddress(ctx context.Context, in *QueryGetAuthorizedAddressRequest, opts ...grpc.CallOption) (*QueryGetAuthorizedAddressResponse, error) {
-	out := new(QueryGetAuthorizedAddressResponse)
-	err := c.cc.Invoke(ctx, "/fairyring.keyshare.Query/AuthorizedAddress", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryGetKeyshareLivenessResponse) Reset()         { *m = QueryGetKeyshareLivenessResponse{} }
+func (m *QueryGetKeyshareLivenessResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetKeyshareLivenessResponse) ProtoMessage()    {}
+func (*QueryGetKeyshareLivenessResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_572603c2d521bf14, []int{30}
 }
-
-func (c *queryClient) AuthorizedAddressAll(ctx context.Context, in *QueryAllAuthorizedAddressRequest, opts ...grpc.CallOption) (*QueryAllAuthorizedAddressResponse, error) {
-	out := new(QueryAllAuthorizedAddressResponse)
-	err := c.cc.Invoke(ctx, "/fairyring.keyshare.Query/AuthorizedAddressAll", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryGetKeyshareLivenessResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) GeneralKeyShare(ctx context.Context, in *QueryGetGeneralKeyShareRequest, opts ...grpc.CallOption) (*QueryGetGeneralKeyShareResponse, error) {
-	out := new(QueryGetGeneralKeyShareResponse)
-	err := c.cc.Invoke(ctx, "/fairyring.keyshare.Query/GeneralKeyShare", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGetKeyshareLivenessResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetKeyshareLivenessResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryGetKeyshareLivenessResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetKeyshareLivenessResponse.Merge(m, src)
+}
+func (m *QueryGetKeyshareLivenessResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetKeyshareLivenessResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetKeyshareLivenessResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) GeneralKeyShareAll(ctx context.Context, in *QueryAllGeneralKeyShareRequest, opts ...grpc.CallOption) (*QueryAllGeneralKeyShareResponse, error) {
-	out := new(QueryAllGeneralKeyShareResponse)
-	err := c.cc.Invoke(ctx, "/fairyring.keyshare.Query/GeneralKeyShareAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetKeyshareLivenessResponse proto.InternalMessageInfo
+
+func (m *QueryGetKeyshareLivenessResponse) GetKeyshareLivenessInfo() KeyshareLivenessInfo {
+	if m != nil {
+		return m.KeyshareLivenessInfo
 	}
-	return out, nil
+	return KeyshareLivenessInfo{}
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	Commitments(context.Context, *QueryCommitmentsRequest) (*QueryCommitmentsResponse, error)
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Queries a ValidatorSet by index.
-	ValidatorSet(context.Context, *QueryGetValidatorSetRequest) (*QueryGetValidatorSetResponse, error)
-	// Queries a list of ValidatorSet items.
-	ValidatorSetAll(context.Context, *QueryAllValidatorSetRequest) (*QueryAllValidatorSetResponse, error)
-	// Queries a KeyShare by index.
-	KeyShare(context.Context, *QueryGetKeyShareRequest) (*QueryGetKeyShareResponse, error)
-	// Queries a list of KeyShare items.
-	KeyShareAll(context.Context, *QueryAllKeyShareRequest) (*QueryAllKeyShareResponse, error)
-	// Queries a list of AggregatedKeyShare items.
-	AggregatedKeyShare(context.Context, *QueryGetAggregatedKeyShareRequest) (*QueryGetAggregatedKeyShareResponse, error)
-	AggregatedKeyShareAll(context.Context, *QueryAllAggregatedKeyShareRequest) (*QueryAllAggregatedKeyShareResponse, error)
-	// Queries the public keys
-	PubKey(context.Context, *QueryPubKeyRequest) (*QueryPubKeyResponse, error)
-	// Queries a list of AuthorizedAddress items.
-	AuthorizedAddress(context.Context, *QueryGetAuthorizedAddressRequest) (*QueryGetAuthorizedAddressResponse, error)
-	AuthorizedAddressAll(context.Context, *QueryAllAuthorizedAddressRequest) (*QueryAllAuthorizedAddressResponse, error)
-	// Queries a list of GeneralKeyShare items.
-	GeneralKeyShare(context.Context, *QueryGetGeneralKeyShareRequest) (*QueryGetGeneralKeyShareResponse, error)
-	GeneralKeyShareAll(context.Context, *QueryAllGeneralKeyShareRequest) (*QueryAllGeneralKeyShareResponse, error)
+type QueryAllKeyshareLivenessRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryAllKeyshareLivenessRequest) Reset()         { *m = QueryAllKeyshareLivenessRequest{} }
+func (m *QueryAllKeyshareLivenessRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllKeyshareLivenessRequest) ProtoMessage()    {}
+func (*QueryAllKeyshareLivenessRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_572603c2d521bf14, []int{31}
 }
-
-func (*UnimplementedQueryServer) Commitments(ctx context.Context, req *QueryCommitmentsRequest) (*QueryCommitmentsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Commitments not implemented")
+func (m *QueryAllKeyshareLivenessRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryAllKeyshareLivenessRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllKeyshareLivenessRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) ValidatorSet(ctx context.Context, req *QueryGetValidatorSetRequest) (*QueryGetValidatorSetResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ValidatorSet not implemented")
+func (m *QueryAllKeyshareLivenessRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllKeyshareLivenessRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) ValidatorSetAll(ctx context.Context, req *QueryAllValidatorSetRequest) (*QueryAllValidatorSetResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ValidatorSetAll not implemented")
+func (m *QueryAllKeyshareLivenessRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) KeyShare(ctx context.Context, req *QueryGetKeyShareRequest) (*QueryGetKeyShareResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method KeyShare not implemented")
+func (m *QueryAllKeyshareLivenessRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllKeyshareLivenessRequest.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) KeyShareAll(ctx context.Context, req *QueryAllKeyShareRequest) (*QueryAllKeyShareResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method KeyShareAll not implemented")
+
+var xxx_messageInfo_QueryAllKeyshareLivenessRequest proto.InternalMessageInfo
+
+func (m *QueryAllKeyshareLivenessRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) AggregatedKeyShare(ctx context.Context, req *QueryGetAggregatedKeyShareRequest) (*QueryGetAggregatedKeyShareResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AggregatedKeyShare not implemented")
+
+type QueryAllKeyshareLivenessResponse struct {
+	KeyshareLivenessInfo []KeyshareLivenessInfo `protobuf:"bytes,1,rep,name=keyshareLivenessInfo,proto3" json:"keyshareLivenessInfo"`
+	Pagination           *query.PageResponse    `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
-func (*UnimplementedQueryServer) AggregatedKeyShareAll(ctx context.Context, req *QueryAllAggregatedKeyShareRequest) (*QueryAllAggregatedKeyShareResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AggregatedKeyShareAll not implemented")
+
+func (m *QueryAllKeyshareLivenessResponse) Reset()         { *m = QueryAllKeyshareLivenessResponse{} }
+func (m *QueryAllKeyshareLivenessResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllKeyshareLivenessResponse) ProtoMessage()    {}
+func (*QueryAllKeyshareLivenessResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_572603c2d521bf14, []int{32}
 }
-func (*UnimplementedQueryServer) PubKey(ctx context.Context, req *QueryPubKeyRequest) (*QueryPubKeyResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PubKey not implemented")
+func (m *QueryAllKeyshareLivenessResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) AuthorizedAddress(ctx context.Context, req *QueryGetAuthorizedAddressRequest) (*QueryGetAuthorizedAddressResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AuthorizedAddress not implemented")
+func (m *QueryAllKeyshareLivenessResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllKeyshareLivenessResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) AuthorizedAddressAll(ctx context.Context, req *QueryAllAuthorizedAddressRequest) (*QueryAllAuthorizedAddressResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AuthorizedAddressAll not implemented")
+func (m *QueryAllKeyshareLivenessResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllKeyshareLivenessResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) GeneralKeyShare(ctx context.Context, req *QueryGetGeneralKeyShareRequest) (*QueryGetGeneralKeyShareResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GeneralKeyShare not implemented")
+func (m *QueryAllKeyshareLivenessResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) GeneralKeyShareAll(ctx context.Context, req *QueryAllGeneralKeyShareRequest) (*QueryAllGeneralKeyShareResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GeneralKeyShareAll not implemented")
+func (m *QueryAllKeyshareLivenessResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllKeyshareLivenessResponse.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
-}
+var xxx_messageInfo_QueryAllKeyshareLivenessResponse proto.InternalMessageInfo
 
-func _Query_Commitments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryCommitmentsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Commitments(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/fairyring.keyshare.Query/Commitments",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Commitments(ctx, req.(*QueryCommitmentsRequest))
+func (m *QueryAllKeyshareLivenessResponse) GetKeyshareLivenessInfo() []KeyshareLivenessInfo {
+	if m != nil {
+		return m.KeyshareLivenessInfo
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/fairyring.keyshare.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *QueryAllKeyshareLivenessResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_ValidatorSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetValidatorSetRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ValidatorSet(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/fairyring.keyshare.Query/ValidatorSet",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ValidatorSet(ctx, req.(*QueryGetValidatorSetRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryGetGeneralKeyRequestRequest struct {
+	Identity string `protobuf:"bytes,1,opt,name=identity,proto3" json:"identity,omitempty"`
 }
 
-func _Query_ValidatorSetAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllValidatorSetRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ValidatorSetAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/fairyring.keyshare.Query/ValidatorSetAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ValidatorSetAll(ctx, req.(*QueryAllValidatorSetRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryGetGeneralKeyRequestRequest) Reset()         { *m = QueryGetGeneralKeyRequestRequest{} }
+func (m *QueryGetGeneralKeyRequestRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetGeneralKeyRequestRequest) ProtoMessage()    {}
+func (*QueryGetGeneralKeyRequestRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_572603c2d521bf14, []int{33}
 }
-
-func _Query_KeyShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetKeyShareRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).KeyShare(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/fairyring.keyshare.Query/KeyShare",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).KeyShare(ctx, req.(*QueryGetKeyShareRequest))
+func (m *QueryGetGeneralKeyRequestRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetGeneralKeyRequestRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetGeneralKeyRequestRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryGetGeneralKeyRequestRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetGeneralKeyRequestRequest.Merge(m, src)
+}
+func (m *QueryGetGeneralKeyRequestRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetGeneralKeyRequestRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetGeneralKeyRequestRequest.DiscardUnknown(m)
 }
 
-func _Query_KeyShareAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllKeyShareRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).KeyShareAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/fairyring.keyshare.Query/KeyShareAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).KeyShareAll(ctx, req.(*QueryAllKeyShareRequest))
+var xxx_messageInfo_QueryGetGeneralKeyRequestRequest proto.InternalMessageInfo
+
+func (m *QueryGetGeneralKeyRequestRequest) GetIdentity() string {
+	if m != nil {
+		return m.Identity
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_AggregatedKeyShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetAggregatedKeyShareRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AggregatedKeyShare(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/fairyring.keyshare.Query/AggregatedKeyShare",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AggregatedKeyShare(ctx, req.(*QueryGetAggregatedKeyShareRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryGetGeneralKeyRequestResponse struct {
+	GeneralKeyRequest GeneralKeyRequest `protobuf:"bytes,1,opt,name=generalKeyRequest,proto3" json:"generalKeyRequest"`
 }
 
-func _Query_AggregatedKeyShareAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllAggregatedKeyShareRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AggregatedKeyShareAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/fairyring.keyshare.Query/AggregatedKeyShareAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AggregatedKeyShareAll(ctx, req.(*QueryAllAggregatedKeyShareRequest))
+func (m *QueryGetGeneralKeyRequestResponse) Reset()         { *m = QueryGetGeneralKeyRequestResponse{} }
+func (m *QueryGetGeneralKeyRequestResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetGeneralKeyRequestResponse) ProtoMessage()    {}
+func (*QueryGetGeneralKeyRequestResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_572603c2d521bf14, []int{34}
+}
+func (m *QueryGetGeneralKeyRequestResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetGeneralKeyRequestResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetGeneralKeyRequestResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryGetGeneralKeyRequestResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetGeneralKeyRequestResponse.Merge(m, src)
+}
+func (m *QueryGetGeneralKeyRequestResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetGeneralKeyRequestResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetGeneralKeyRequestResponse.DiscardUnknown(m)
 }
 
-func _Query_PubKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPubKeyRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).PubKey(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/fairyring.keyshare.Query/PubKey",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PubKey(ctx, req.(*QueryPubKeyRequest))
+var xxx_messageInfo_QueryGetGeneralKeyRequestResponse proto.InternalMessageInfo
+
+func (m *QueryGetGeneralKeyRequestResponse) GetGeneralKeyRequest() GeneralKeyRequest {
+	if m != nil {
+		return m.GeneralKeyRequest
 	}
-	return interceptor(ctx, in, info, handler)
+	return GeneralKeyRequest{}
 }
 
-func _Query_AuthorizedAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetAuthorizedAddressRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AuthorizedAddress(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/fairyring.keyshare.Query/AuthorizedAddress",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AuthorizedAddress(ctx, req.(*QueryGetAuthorizedAddressRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryAllGeneralKeyRequestRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_AuthorizedAddressAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllAuthorizedAddressRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AuthorizedAddressAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/fairyring.keyshare.Query/AuthorizedAddressAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AuthorizedAddressAll(ctx, req.(*QueryAllAuthorizedAddressRequest))
+func (m *QueryAllGeneralKeyRequestRequest) Reset()         { *m = QueryAllGeneralKeyRequestRequest{} }
+func (m *QueryAllGeneralKeyRequestRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllGeneralKeyRequestRequest) ProtoMessage()    {}
+func (*QueryAllGeneralKeyRequestRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_572603c2d521bf14, []int{35}
+}
+func (m *QueryAllGeneralKeyRequestRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllGeneralKeyRequestRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllGeneralKeyRequestRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryAllGeneralKeyRequestRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllGeneralKeyRequestRequest.Merge(m, src)
+}
+func (m *QueryAllGeneralKeyRequestRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllGeneralKeyRequestRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllGeneralKeyRequestRequest.DiscardUnknown(m)
 }
 
-func _Query_GeneralKeyShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetGeneralKeyShareRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GeneralKeyShare(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/fairyring.keyshare.Query/GeneralKeyShare",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GeneralKeyShare(ctx, req.(*QueryGetGeneralKeyShareRequest))
+var xxx_messageInfo_QueryAllGeneralKeyRequestRequest proto.InternalMessageInfo
+
+func (m *QueryAllGeneralKeyRequestRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_GeneralKeyShareAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllGeneralKeyShareRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GeneralKeyShareAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/fairyring.keyshare.Query/GeneralKeyShareAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GeneralKeyShareAll(ctx, req.(*QueryAllGeneralKeyShareRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryAllGeneralKeyRequestResponse struct {
+	GeneralKeyRequest []GeneralKeyRequest `protobuf:"bytes,1,rep,name=generalKeyRequest,proto3" json:"generalKeyRequest"`
+	Pagination        *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "fairyring.keyshare.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Commitments",
-			Handler:    _Query_Commitments_Handler,
-		},
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "ValidatorSet",
-			Handler:    _Query_ValidatorSet_Handler,
-		},
-		{
-			MethodName: "ValidatorSetAll",
-			Handler:    _Query_ValidatorSetAll_Handler,
-		},
-		{
-			MethodName: "KeyShare",
-			Handler:    _Query_KeyShare_Handler,
-		},
-		{
-			MethodName: "KeyShareAll",
-			Handler:    _Query_KeyShareAll_Handler,
-		},
-		{
-			MethodName: "AggregatedKeyShare",
-			Handler:    _Query_AggregatedKeyShare_Handler,
-		},
-		{
-			MethodName: "AggregatedKeyShareAll",
-			Handler:    _Query_AggregatedKeyShareAll_Handler,
-		},
-		{
-			MethodName: "PubKey",
-			Handler:    _Query_PubKey_Handler,
-		},
-		{
-			MethodName: "AuthorizedAddress",
-			Handler:    _Query_AuthorizedAddress_Handler,
-		},
-		{
-			MethodName: "AuthorizedAddressAll",
-			Handler:    _Query_AuthorizedAddressAll_Handler,
-		},
-		{
-			MethodName: "GeneralKeyShare",
-			Handler:    _Query_GeneralKeyShare_Handler,
-		},
-		{
-			MethodName: "GeneralKeyShareAll",
-			Handler:    _Query_GeneralKeyShareAll_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "fairyring/keyshare/query.proto",
+func (m *QueryAllGeneralKeyRequestResponse) Reset()         { *m = QueryAllGeneralKeyRequestResponse{} }
+func (m *QueryAllGeneralKeyRequestResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllGeneralKeyRequestResponse) ProtoMessage()    {}
+func (*QueryAllGeneralKeyRequestResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_572603c2d521bf14, []int{36}
+}
+func (m *QueryAllGeneralKeyRequestResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllGeneralKeyRequestResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllGeneralKeyRequestResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllGeneralKeyRequestResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllGeneralKeyRequestResponse.Merge(m, src)
+}
+func (m *QueryAllGeneralKeyRequestResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllGeneralKeyRequestResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllGeneralKeyRequestResponse.DiscardUnknown(m)
 }
 
-func (m *QueryCommitmentsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAllGeneralKeyRequestResponse proto.InternalMessageInfo
+
+func (m *QueryAllGeneralKeyRequestResponse) GetGeneralKeyRequest() []GeneralKeyRequest {
+	if m != nil {
+		return m.GeneralKeyRequest
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryCommitmentsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAllGeneralKeyRequestResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryCommitmentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type QueryRewardPoolRequest struct {
 }
 
-func (m *QueryCommitmentsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryRewardPoolRequest) Reset()         { *m = QueryRewardPoolRequest{} }
+func (m *QueryRewardPoolRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRewardPoolRequest) ProtoMessage()    {}
+func (*QueryRewardPoolRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_572603c2d521bf14, []int{37}
+}
+func (m *QueryRewardPoolRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRewardPoolRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRewardPoolRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryRewardPoolRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRewardPoolRequest.Merge(m, src)
+}
+func (m *QueryRewardPoolRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRewardPoolRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRewardPoolRequest.DiscardUnknown(m)
 }
 
-func (m *QueryCommitmentsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryRewardPoolRequest proto.InternalMessageInfo
+
+type QueryRewardPoolResponse struct {
+	RewardPool             RewardPool `protobuf:"bytes,1,opt,name=rewardPool,proto3" json:"rewardPool"`
+	NextDistributionHeight uint64     `protobuf:"varint,2,opt,name=nextDistributionHeight,proto3" json:"nextDistributionHeight,omitempty"`
 }
 
-func (m *QueryCommitmentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.QueuedCommitments != nil {
-		{
-			size, err := m.QueuedCommitments.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryRewardPoolResponse) Reset()         { *m = QueryRewardPoolResponse{} }
+func (m *QueryRewardPoolResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRewardPoolResponse) ProtoMessage()    {}
+func (*QueryRewardPoolResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_572603c2d521bf14, []int{38}
+}
+func (m *QueryRewardPoolResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRewardPoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRewardPoolResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if m.ActiveCommitments != nil {
-		{
-			size, err := m.ActiveCommitments.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+}
+func (m *QueryRewardPoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRewardPoolResponse.Merge(m, src)
+}
+func (m *QueryRewardPoolResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRewardPoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRewardPoolResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRewardPoolResponse proto.InternalMessageInfo
+
+func (m *QueryRewardPoolResponse) GetRewardPool() RewardPool {
+	if m != nil {
+		return m.RewardPool
 	}
-	return len(dAtA) - i, nil
+	return RewardPool{}
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryRewardPoolResponse) GetNextDistributionHeight() uint64 {
+	if m != nil {
+		return m.NextDistributionHeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryPendingRewardsRequest struct {
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryPendingRewardsRequest) Reset()         { *m = QueryPendingRewardsRequest{} }
+func (m *QueryPendingRewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingRewardsRequest) ProtoMessage()    {}
+func (*QueryPendingRewardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_572603c2d521bf14, []int{39}
+}
+func (m *QueryPendingRewardsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingRewardsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingRewardsRequest.Merge(m, src)
+}
+func (m *QueryPendingRewardsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingRewardsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPendingRewardsRequest proto.InternalMessageInfo
+
+func (m *QueryPendingRewardsRequest) GetValidator() string {
+	if m != nil {
+		return m.Validator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryPendingRewardsResponse struct {
+	AcceptedShares uint64                                   `protobuf:"varint,1,opt,name=acceptedShares,proto3" json:"acceptedShares,omitempty"`
+	PendingRewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=pendingRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"pendingRewards"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryPendingRewardsResponse) Reset()         { *m = QueryPendingRewardsResponse{} }
+func (m *QueryPendingRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingRewardsResponse) ProtoMessage()    {}
+func (*QueryPendingRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_572603c2d521bf14, []int{40}
+}
+func (m *QueryPendingRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryGetValidatorSetRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryPendingRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingRewardsResponse.Merge(m, src)
 }
-
-func (m *QueryGetValidatorSetRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryPendingRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingRewardsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetValidatorSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Index) > 0 {
-		i -= len(m.Index)
-		copy(dAtA[i:], m.Index)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Index)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryPendingRewardsResponse proto.InternalMessageInfo
+
+func (m *QueryPendingRewardsResponse) GetAcceptedShares() uint64 {
+	if m != nil {
+		return m.AcceptedShares
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryGetValidatorSetResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryPendingRewardsResponse) GetPendingRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.PendingRewards
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryGetValidatorSetResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryKeyShareRequestsByStatusRequest struct {
+	Status     KeyShareRequestStatus `protobuf:"varint,1,opt,name=status,proto3,enum=fairyring.keyshare.KeyShareRequestStatus" json:"status,omitempty"`
+	Pagination *query.PageRequest    `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetValidatorSetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.ValidatorSet.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryKeyShareRequestsByStatusRequest) Reset()         { *m = QueryKeyShareRequestsByStatusRequest{} }
+func (m *QueryKeyShareRequestsByStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryKeyShareRequestsByStatusRequest) ProtoMessage()    {}
+func (*QueryKeyShareRequestsByStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_572603c2d521bf14, []int{41}
+}
+func (m *QueryKeyShareRequestsByStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryKeyShareRequestsByStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryKeyShareRequestsByStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryKeyShareRequestsByStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryKeyShareRequestsByStatusRequest.Merge(m, src)
+}
+func (m *QueryKeyShareRequestsByStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryKeyShareRequestsByStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryKeyShareRequestsByStatusRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllValidatorSetRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryKeyShareRequestsByStatusRequest proto.InternalMessageInfo
+
+func (m *QueryKeyShareRequestsByStatusRequest) GetStatus() KeyShareRequestStatus {
+	if m != nil {
+		return m.Status
 	}
-	return dAtA[:n], nil
+	return KeyShareRequestStatus_KEY_SHARE_REQUEST_STATUS_PENDING
 }
 
-func (m *QueryAllValidatorSetRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryKeyShareRequestsByStatusRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryAllValidatorSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+type QueryKeyShareRequestsByStatusResponse struct {
+	KeyShareRequests []KeyShareRequest   `protobuf:"bytes,1,rep,name=keyShareRequests,proto3" json:"keyShareRequests"`
+	Pagination       *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryKeyShareRequestsByStatusResponse) Reset()         { *m = QueryKeyShareRequestsByStatusResponse{} }
+func (m *QueryKeyShareRequestsByStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryKeyShareRequestsByStatusResponse) ProtoMessage()    {}
+func (*QueryKeyShareRequestsByStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_572603c2d521bf14, []int{42}
+}
+func (m *QueryKeyShareRequestsByStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryKeyShareRequestsByStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryKeyShareRequestsByStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryKeyShareRequestsByStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryKeyShareRequestsByStatusResponse.Merge(m, src)
+}
+func (m *QueryKeyShareRequestsByStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryKeyShareRequestsByStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryKeyShareRequestsByStatusResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllValidatorSetResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryKeyShareRequestsByStatusResponse proto.InternalMessageInfo
+
+func (m *QueryKeyShareRequestsByStatusResponse) GetKeyShareRequests() []KeyShareRequest {
+	if m != nil {
+		return m.KeyShareRequests
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAllValidatorSetResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryKeyShareRequestsByStatusResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryAllValidatorSetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ValidatorSet) > 0 {
-		for iNdEx := len(m.ValidatorSet) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ValidatorSet[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+type QueryGetKeyShareRequestRequest struct {
+	Identity string `protobuf:"bytes,1,opt,name=identity,proto3" json:"identity,omitempty"`
 }
 
-func (m *QueryGetKeyShareRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryGetKeyShareRequestRequest) Reset()         { *m = QueryGetKeyShareRequestRequest{} }
+func (m *QueryGetKeyShareRequestRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetKeyShareRequestRequest) ProtoMessage()    {}
+func (*QueryGetKeyShareRequestRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_572603c2d521bf14, []int{43}
 }
-
-func (m *QueryGetKeyShareRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryGetKeyShareRequestRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryGetKeyShareRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BlockHeight != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BlockHeight))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Validator) > 0 {
-		i -= len(m.Validator)
-		copy(dAtA[i:], m.Validator)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Validator)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryGetKeyShareRequestRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetKeyShareRequestRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryGetKeyShareRequestRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetKeyShareRequestRequest.Merge(m, src)
+}
+func (m *QueryGetKeyShareRequestRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetKeyShareRequestRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetKeyShareRequestRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetKeyShareResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetKeyShareRequestRequest proto.InternalMessageInfo
+
+func (m *QueryGetKeyShareRequestRequest) GetIdentity() string {
+	if m != nil {
+		return m.Identity
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryGetKeyShareResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryGetKeyShareRequestResponse struct {
+	KeyShareRequest KeyShareRequest `protobuf:"bytes,1,opt,name=keyShareRequest,proto3" json:"keyShareRequest"`
 }
 
-func (m *QueryGetKeyShareResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.KeyShare.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryGetKeyShareRequestResponse) Reset()         { *m = QueryGetKeyShareRequestResponse{} }
+func (m *QueryGetKeyShareRequestResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetKeyShareRequestResponse) ProtoMessage()    {}
+func (*QueryGetKeyShareRequestResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_572603c2d521bf14, []int{44}
+}
+func (m *QueryGetKeyShareRequestResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetKeyShareRequestResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetKeyShareRequestResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryGetKeyShareRequestResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetKeyShareRequestResponse.Merge(m, src)
+}
+func (m *QueryGetKeyShareRequestResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetKeyShareRequestResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetKeyShareRequestResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllKeyShareRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetKeyShareRequestResponse proto.InternalMessageInfo
+
+func (m *QueryGetKeyShareRequestResponse) GetKeyShareRequest() KeyShareRequest {
+	if m != nil {
+		return m.KeyShareRequest
 	}
-	return dAtA[:n], nil
+	return KeyShareRequest{}
 }
 
-func (m *QueryAllKeyShareRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryAllKeyShareRequestRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// only return the requests received from this counterparty channel when set
+	CounterpartyChannel string `protobuf:"bytes,2,opt,name=counterpartyChannel,proto3" json:"counterpartyChannel,omitempty"`
+	// only return the requests of this proposal id when set
+	ProposalId string `protobuf:"bytes,3,opt,name=proposalId,proto3" json:"proposalId,omitempty"`
+	// only return the requests whose sent status equals sent when set
+	FilterBySent bool `protobuf:"varint,4,opt,name=filterBySent,proto3" json:"filterBySent,omitempty"`
+	Sent         bool `protobuf:"varint,5,opt,name=sent,proto3" json:"sent,omitempty"`
+}
+
+func (m *QueryAllKeyShareRequestRequest) Reset()         { *m = QueryAllKeyShareRequestRequest{} }
+func (m *QueryAllKeyShareRequestRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllKeyShareRequestRequest) ProtoMessage()    {}
+func (*QueryAllKeyShareRequestRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_572603c2d521bf14, []int{45}
+}
+func (m *QueryAllKeyShareRequestRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryAllKeyShareRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryAllKeyShareRequestRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllKeyShareRequestRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryAllKeyShareResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryAllKeyShareRequestRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllKeyShareRequestRequest.Merge(m, src)
 }
-
-func (m *QueryAllKeyShareResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAllKeyShareRequestRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllKeyShareRequestRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllKeyShareRequestRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllKeyShareResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.KeyShare) > 0 {
-		for iNdEx := len(m.KeyShare) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.KeyShare[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+var xxx_messageInfo_QueryAllKeyShareRequestRequest proto.InternalMessageInfo
+
+func (m *QueryAllKeyShareRequestRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryGetAggregatedKeyShareRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAllKeyShareRequestRequest) GetCounterpartyChannel() string {
+	if m != nil {
+		return m.CounterpartyChannel
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryGetAggregatedKeyShareRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAllKeyShareRequestRequest) GetProposalId() string {
+	if m != nil {
+		return m.ProposalId
+	}
+	return ""
 }
 
-func (m *QueryGetAggregatedKeyShareRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Height != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x8
+func (m *QueryAllKeyShareRequestRequest) GetFilterBySent() bool {
+	if m != nil {
+		return m.FilterBySent
 	}
-	return len(dAtA) - i, nil
+	return false
 }
 
-func (m *QueryGetAggregatedKeyShareResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAllKeyShareRequestRequest) GetSent() bool {
+	if m != nil {
+		return m.Sent
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *QueryGetAggregatedKeyShareResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryAllKeyShareRequestResponse struct {
+	KeyShareRequest []KeyShareRequest   `protobuf:"bytes,1,rep,name=keyShareRequest,proto3" json:"keyShareRequest"`
+	Pagination      *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetAggregatedKeyShareResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.AggregatedKeyShare.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryAllKeyShareRequestResponse) Reset()         { *m = QueryAllKeyShareRequestResponse{} }
+func (m *QueryAllKeyShareRequestResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllKeyShareRequestResponse) ProtoMessage()    {}
+func (*QueryAllKeyShareRequestResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_572603c2d521bf14, []int{46}
+}
+func (m *QueryAllKeyShareRequestResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllKeyShareRequestResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllKeyShareRequestResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryAllAggregatedKeyShareRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryAllKeyShareRequestResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllKeyShareRequestResponse.Merge(m, src)
 }
-
-func (m *QueryAllAggregatedKeyShareRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAllKeyShareRequestResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllKeyShareRequestResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllKeyShareRequestResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllAggregatedKeyShareRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryAllKeyShareRequestResponse proto.InternalMessageInfo
+
+func (m *QueryAllKeyShareRequestResponse) GetKeyShareRequest() []KeyShareRequest {
+	if m != nil {
+		return m.KeyShareRequest
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryAllAggregatedKeyShareResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAllKeyShareRequestResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAllAggregatedKeyShareResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterType((*QueryCommitmentsRequest)(nil), "fairyring.keyshare.QueryCommitmentsRequest")
+	proto.RegisterType((*QueryCommitmentsResponse)(nil), "fairyring.keyshare.QueryCommitmentsResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "fairyring.keyshare.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "fairyring.keyshare.QueryParamsResponse")
+	proto.RegisterType((*QueryGetValidatorSetRequest)(nil), "fairyring.keyshare.QueryGetValidatorSetRequest")
+	proto.RegisterType((*QueryGetValidatorSetResponse)(nil), "fairyring.keyshare.QueryGetValidatorSetResponse")
+	proto.RegisterType((*QueryGetValidatorSetByShareIndexRequest)(nil), "fairyring.keyshare.QueryGetValidatorSetByShareIndexRequest")
+	proto.RegisterType((*QueryAllValidatorSetRequest)(nil), "fairyring.keyshare.QueryAllValidatorSetRequest")
+	proto.RegisterType((*QueryAllValidatorSetResponse)(nil), "fairyring.keyshare.QueryAllValidatorSetResponse")
+	proto.RegisterType((*QueryGetKeyShareRequest)(nil), "fairyring.keyshare.QueryGetKeyShareRequest")
+	proto.RegisterType((*QueryGetKeyShareResponse)(nil), "fairyring.keyshare.QueryGetKeyShareResponse")
+	proto.RegisterType((*QueryAllKeyShareRequest)(nil), "fairyring.keyshare.QueryAllKeyShareRequest")
+	proto.RegisterType((*QueryAllKeyShareResponse)(nil), "fairyring.keyshare.QueryAllKeyShareResponse")
+	proto.RegisterType((*QueryGetAggregatedKeyShareRequest)(nil), "fairyring.keyshare.QueryGetAggregatedKeyShareRequest")
+	proto.RegisterType((*QueryGetAggregatedKeyShareResponse)(nil), "fairyring.keyshare.QueryGetAggregatedKeyShareResponse")
+	proto.RegisterType((*QueryAllAggregatedKeyShareRequest)(nil), "fairyring.keyshare.QueryAllAggregatedKeyShareRequest")
+	proto.RegisterType((*QueryAllAggregatedKeyShareResponse)(nil), "fairyring.keyshare.QueryAllAggregatedKeyShareResponse")
+	proto.RegisterType((*QueryPubKeyRequest)(nil), "fairyring.keyshare.QueryPubKeyRequest")
+	proto.RegisterType((*QueryPubKeyResponse)(nil), "fairyring.keyshare.QueryPubKeyResponse")
+	proto.RegisterType((*QueryGetAuthorizedAddressRequest)(nil), "fairyring.keyshare.QueryGetAuthorizedAddressRequest")
+	proto.RegisterType((*QueryGetAuthorizedAddressResponse)(nil), "fairyring.keyshare.QueryGetAuthorizedAddressResponse")
+	proto.RegisterType((*QueryAllAuthorizedAddressRequest)(nil), "fairyring.keyshare.QueryAllAuthorizedAddressRequest")
+	proto.RegisterType((*QueryAllAuthorizedAddressResponse)(nil), "fairyring.keyshare.QueryAllAuthorizedAddressResponse")
+	proto.RegisterType((*QueryGetGeneralKeyShareRequest)(nil), "fairyring.keyshare.QueryGetGeneralKeyShareRequest")
+	proto.RegisterType((*QueryGetGeneralKeyShareResponse)(nil), "fairyring.keyshare.QueryGetGeneralKeyShareResponse")
+	proto.RegisterType((*QueryAllGeneralKeyShareRequest)(nil), "fairyring.keyshare.QueryAllGeneralKeyShareRequest")
+	proto.RegisterType((*QueryAllGeneralKeyShareResponse)(nil), "fairyring.keyshare.QueryAllGeneralKeyShareResponse")
+	proto.RegisterType((*QueryGetDkgRoundRequest)(nil), "fairyring.keyshare.QueryGetDkgRoundRequest")
+	proto.RegisterType((*QueryGetDkgRoundResponse)(nil), "fairyring.keyshare.QueryGetDkgRoundResponse")
+	proto.RegisterType((*QueryGetKeyshareLivenessRequest)(nil), "fairyring.keyshare.QueryGetKeyshareLivenessRequest")
+	proto.RegisterType((*QueryGetKeyshareLivenessResponse)(nil), "fairyring.keyshare.QueryGetKeyshareLivenessResponse")
+	proto.RegisterType((*QueryAllKeyshareLivenessRequest)(nil), "fairyring.keyshare.QueryAllKeyshareLivenessRequest")
+	proto.RegisterType((*QueryAllKeyshareLivenessResponse)(nil), "fairyring.keyshare.QueryAllKeyshareLivenessResponse")
+	proto.RegisterType((*QueryGetGeneralKeyRequestRequest)(nil), "fairyring.keyshare.QueryGetGeneralKeyRequestRequest")
+	proto.RegisterType((*QueryGetGeneralKeyRequestResponse)(nil), "fairyring.keyshare.QueryGetGeneralKeyRequestResponse")
+	proto.RegisterType((*QueryAllGeneralKeyRequestRequest)(nil), "fairyring.keyshare.QueryAllGeneralKeyRequestRequest")
+	proto.RegisterType((*QueryAllGeneralKeyRequestResponse)(nil), "fairyring.keyshare.QueryAllGeneralKeyRequestResponse")
+	proto.RegisterType((*QueryRewardPoolRequest)(nil), "fairyring.keyshare.QueryRewardPoolRequest")
+	proto.RegisterType((*QueryRewardPoolResponse)(nil), "fairyring.keyshare.QueryRewardPoolResponse")
+	proto.RegisterType((*QueryPendingRewardsRequest)(nil), "fairyring.keyshare.QueryPendingRewardsRequest")
+	proto.RegisterType((*QueryPendingRewardsResponse)(nil), "fairyring.keyshare.QueryPendingRewardsResponse")
+	proto.RegisterType((*QueryKeyShareRequestsByStatusRequest)(nil), "fairyring.keyshare.QueryKeyShareRequestsByStatusRequest")
+	proto.RegisterType((*QueryKeyShareRequestsByStatusResponse)(nil), "fairyring.keyshare.QueryKeyShareRequestsByStatusResponse")
+	proto.RegisterType((*QueryGetKeyShareRequestRequest)(nil), "fairyring.keyshare.QueryGetKeyShareRequestRequest")
+	proto.RegisterType((*QueryGetKeyShareRequestResponse)(nil), "fairyring.keyshare.QueryGetKeyShareRequestResponse")
+	proto.RegisterType((*QueryAllKeyShareRequestRequest)(nil), "fairyring.keyshare.QueryAllKeyShareRequestRequest")
+	proto.RegisterType((*QueryAllKeyShareRequestResponse)(nil), "fairyring.keyshare.QueryAllKeyShareRequestResponse")
 }
 
-func (m *QueryAllAggregatedKeyShareResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.AggregatedKeyShare) > 0 {
-		for iNdEx := len(m.AggregatedKeyShare) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.AggregatedKeyShare[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+func init() { proto.RegisterFile("fairyring/keyshare/query.proto", fileDescriptor_572603c2d521bf14) }
+
+var fileDescriptor_572603c2d521bf14 = []byte{
+	// 2252 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x5a, 0xcd, 0x8f, 0xdc, 0x48,
+	0x15, 0x4f, 0x4d, 0x4f, 0x86, 0xc9, 0x4b, 0x34, 0xd9, 0x54, 0x86, 0x6c, 0xaf, 0x33, 0xdb, 0xd3,
+	0xe3, 0x24, 0xf3, 0x99, 0xb4, 0xe7, 0x23, 0xb3, 0xd9, 0x6c, 0xc8, 0x46, 0x3d, 0x19, 0x36, 0x84,
+	0x2c, 0x22, 0xdb, 0x03, 0x2b, 0xed, 0x0a, 0x69, 0xe4, 0x6e, 0x57, 0x3c, 0xde, 0xf6, 0xd8, 0x1d,
+	0xdb, 0x3d, 0xa4, 0x19, 0xf5, 0x1e, 0xf6, 0xc0, 0x91, 0x0f, 0x71, 0xe0, 0xc8, 0x01, 0xc4, 0x61,
+	0x05, 0x42, 0x68, 0x91, 0x58, 0x38, 0xb0, 0x47, 0x22, 0x71, 0x48, 0x04, 0x17, 0x24, 0x24, 0x40,
+	0x09, 0x7f, 0x01, 0x7f, 0x01, 0x72, 0xf9, 0xb9, 0xed, 0xf6, 0x57, 0xbb, 0x67, 0x9a, 0x53, 0xb7,
+	0xab, 0xde, 0x7b, 0xf5, 0x7b, 0xaf, 0x5e, 0xd5, 0xab, 0xfa, 0xd9, 0x50, 0x7a, 0x24, 0x6b, 0x56,
+	0xc7, 0xd2, 0x0c, 0x55, 0x6a, 0xb2, 0x8e, 0xbd, 0x27, 0x5b, 0x4c, 0x7a, 0xdc, 0x66, 0x56, 0xa7,
+	0xd2, 0xb2, 0x4c, 0xc7, 0xa4, 0xb4, 0xd7, 0x5f, 0xf1, 0xfb, 0x85, 0x69, 0xd5, 0x54, 0x4d, 0xde,
+	0x2d, 0xb9, 0xff, 0x3c, 0x49, 0x61, 0x46, 0x35, 0x4d, 0x55, 0x67, 0x92, 0xdc, 0xd2, 0x24, 0xd9,
+	0x30, 0x4c, 0x47, 0x76, 0x34, 0xd3, 0xb0, 0xb1, 0x77, 0xb9, 0x61, 0xda, 0xfb, 0xa6, 0x2d, 0xd5,
+	0x65, 0x1b, 0x07, 0x90, 0x0e, 0xd6, 0xea, 0xcc, 0x91, 0xd7, 0xa4, 0x96, 0xac, 0x6a, 0x06, 0x17,
+	0x46, 0xd9, 0xd9, 0x04, 0x4c, 0x2d, 0xd9, 0x92, 0xf7, 0x7d, 0x63, 0xf3, 0x09, 0x02, 0x07, 0xb2,
+	0xae, 0x29, 0xb2, 0x63, 0x5a, 0xbb, 0x36, 0x73, 0x50, 0x4e, 0x4c, 0x90, 0x6b, 0xb2, 0xce, 0x2e,
+	0xff, 0x87, 0x32, 0xd7, 0x12, 0x64, 0x64, 0x55, 0xb5, 0x98, 0x2a, 0x3b, 0x4c, 0xd9, 0x8d, 0x8a,
+	0x97, 0x93, 0xb0, 0xb5, 0xeb, 0xae, 0x1c, 0x4a, 0xac, 0x24, 0x19, 0x6c, 0x3b, 0x7b, 0xa6, 0xa5,
+	0x7d, 0x8f, 0x29, 0xbb, 0xb2, 0xa2, 0x58, 0xcc, 0xee, 0x85, 0x25, 0x41, 0x58, 0x65, 0x06, 0xb3,
+	0x64, 0x3d, 0x36, 0xf4, 0xe5, 0x04, 0xd9, 0x86, 0xb9, 0xbf, 0xaf, 0x39, 0xfb, 0xcc, 0x70, 0x7c,
+	0x8b, 0x33, 0x09, 0x52, 0x4a, 0x53, 0xcd, 0x18, 0xcf, 0xff, 0xb3, 0xab, 0x6b, 0x07, 0xcc, 0x08,
+	0xb0, 0x5d, 0x1d, 0x80, 0xcd, 0x62, 0x8f, 0xdb, 0xcc, 0xf6, 0x63, 0xbd, 0x98, 0x65, 0xd9, 0x62,
+	0xdf, 0x95, 0x2d, 0x25, 0x23, 0x40, 0x68, 0xcb, 0x0b, 0x78, 0xd8, 0xe9, 0x52, 0x38, 0x6f, 0xfc,
+	0x8c, 0x69, 0x98, 0x1a, 0xe6, 0x8a, 0xf8, 0x1a, 0xbc, 0xfa, 0x9e, 0x9b, 0x4d, 0x77, 0x83, 0x40,
+	0xd4, 0x3c, 0x5b, 0xe2, 0xe7, 0x04, 0x8a, 0xf1, 0x3e, 0xbb, 0x65, 0x1a, 0x36, 0xa3, 0xdf, 0x80,
+	0x73, 0x72, 0xc3, 0xd1, 0x0e, 0x58, 0xa8, 0xb3, 0x48, 0xca, 0x64, 0xf1, 0xf4, 0xfa, 0x6c, 0x25,
+	0x9e, 0xf3, 0x95, 0xb0, 0x8d, 0xb8, 0xa6, 0x6b, 0xee, 0x71, 0x9b, 0xb5, 0x99, 0x12, 0x36, 0x37,
+	0x96, 0xd3, 0x5c, 0x4c, 0x53, 0x9c, 0x06, 0xca, 0x91, 0x3f, 0xe4, 0x59, 0xef, 0x3b, 0xf4, 0x4d,
+	0x38, 0xdf, 0xd7, 0x8a, 0xae, 0xbc, 0x09, 0x13, 0xde, 0xea, 0x40, 0xfc, 0x42, 0xd2, 0x80, 0x9e,
+	0xce, 0xd6, 0xf8, 0xd3, 0x7f, 0xce, 0x9e, 0xa8, 0xa1, 0xbc, 0xb8, 0x01, 0x17, 0xb9, 0xc1, 0x7b,
+	0xcc, 0x79, 0xdf, 0x5f, 0x3e, 0x3b, 0xcc, 0xc1, 0xf1, 0xe8, 0x34, 0x9c, 0xd4, 0x0c, 0x85, 0x3d,
+	0xe1, 0x76, 0x4f, 0xd5, 0xbc, 0x07, 0xf1, 0x23, 0x98, 0x49, 0x56, 0x42, 0x38, 0x5f, 0x87, 0x33,
+	0x07, 0xa1, 0x76, 0x04, 0x55, 0x4e, 0x02, 0x15, 0xd6, 0x47, 0x68, 0x7d, 0xba, 0xe2, 0x7d, 0x58,
+	0x48, 0x1a, 0x6b, 0xab, 0xb3, 0xe3, 0xea, 0xdf, 0x77, 0xf1, 0xf8, 0x60, 0x4b, 0x00, 0x76, 0xaf,
+	0x91, 0x0f, 0x3a, 0x5e, 0x0b, 0xb5, 0x88, 0x0c, 0x7d, 0xad, 0xea, 0x7a, 0x92, 0xaf, 0xef, 0x00,
+	0x04, 0xfb, 0x10, 0x62, 0x9e, 0xaf, 0x78, 0xc9, 0x57, 0x71, 0x93, 0xaf, 0xe2, 0xed, 0x8a, 0x98,
+	0x82, 0x95, 0x87, 0xb2, 0xca, 0x50, 0xb7, 0x16, 0xd2, 0x14, 0x3f, 0x23, 0x30, 0x93, 0x3c, 0x4e,
+	0x6a, 0x78, 0x0a, 0x47, 0x0d, 0x0f, 0xbd, 0xd7, 0x07, 0xda, 0x4b, 0xb7, 0x85, 0x81, 0xa0, 0x3d,
+	0x20, 0x7d, 0xa8, 0x3f, 0xc0, 0x55, 0x74, 0x8f, 0x39, 0x0f, 0x98, 0x17, 0x5c, 0x3f, 0x30, 0x33,
+	0x70, 0xaa, 0x37, 0x26, 0x26, 0x42, 0xd0, 0x40, 0xcb, 0x70, 0xba, 0xae, 0x9b, 0x8d, 0xe6, 0xd7,
+	0x98, 0xa6, 0xee, 0x39, 0x1c, 0xc2, 0x78, 0x2d, 0xdc, 0x24, 0x7e, 0x08, 0xc5, 0xb8, 0x69, 0x8c,
+	0xc5, 0xdb, 0x30, 0xd9, 0xc4, 0x36, 0x0c, 0xf9, 0x4c, 0x52, 0x1c, 0x7c, 0x3d, 0x8c, 0x41, 0x4f,
+	0x47, 0x94, 0x11, 0x76, 0x55, 0xd7, 0xa3, 0xb0, 0x47, 0x35, 0x9f, 0x3f, 0xf7, 0x37, 0x91, 0xbe,
+	0x31, 0x12, 0xf1, 0x17, 0x86, 0xc5, 0x3f, 0xba, 0xf9, 0xbb, 0x05, 0x73, 0x7e, 0x90, 0xab, 0xbd,
+	0xda, 0x15, 0x0d, 0xc9, 0x05, 0x98, 0xd8, 0xf3, 0xa6, 0xc9, 0x5b, 0x1d, 0xf8, 0x24, 0x7e, 0x42,
+	0x40, 0xcc, 0xd2, 0x46, 0x67, 0xbf, 0x03, 0x54, 0x8e, 0xf5, 0xf6, 0x22, 0x9b, 0xe0, 0x76, 0xdc,
+	0x16, 0x06, 0x20, 0xc1, 0x8e, 0xd8, 0x44, 0x0f, 0xaa, 0xba, 0x9e, 0xee, 0xc1, 0xa8, 0x26, 0xf5,
+	0x2f, 0xbe, 0xc7, 0x29, 0xa3, 0x0d, 0xf0, 0xb8, 0x30, 0x0a, 0x8f, 0x47, 0x37, 0xf9, 0xbd, 0x62,
+	0xd1, 0xae, 0x3f, 0x60, 0x1d, 0xbf, 0x58, 0xfc, 0x9a, 0xc0, 0xf9, 0xbe, 0xe6, 0x60, 0xff, 0xf1,
+	0xca, 0x97, 0xd7, 0x9e, 0xb5, 0x3d, 0x57, 0x43, 0x72, 0xfe, 0xfe, 0x13, 0xd6, 0x75, 0x6d, 0x79,
+	0xb5, 0x0b, 0x6d, 0x8d, 0xa5, 0xdb, 0x7a, 0x2f, 0x24, 0xe7, 0xdb, 0x0a, 0xeb, 0x8a, 0x6f, 0x41,
+	0xb9, 0x97, 0x84, 0xbd, 0xd3, 0x52, 0xd5, 0x3b, 0x2c, 0x85, 0x32, 0xd8, 0x91, 0x2d, 0x15, 0x8b,
+	0xca, 0xa9, 0x1a, 0x3e, 0x89, 0x1f, 0xc3, 0x5c, 0x86, 0x2e, 0x3a, 0xfe, 0x01, 0x9c, 0x93, 0xa3,
+	0x9d, 0xe8, 0xfd, 0x95, 0x44, 0xef, 0xa3, 0xc2, 0x08, 0x3b, 0x6e, 0x45, 0xfc, 0x08, 0xca, 0xbd,
+	0x74, 0x4a, 0xc3, 0x3e, 0xaa, 0xdc, 0xfd, 0x33, 0x81, 0xb9, 0x8c, 0xc1, 0xb2, 0x9d, 0x2d, 0x1c,
+	0xdf, 0xd9, 0xd1, 0xe5, 0x6d, 0x0b, 0x4a, 0xfe, 0xac, 0xdd, 0xf3, 0x8e, 0x95, 0xc3, 0xd5, 0x9e,
+	0x0b, 0x30, 0xa1, 0x29, 0xdf, 0xea, 0xb4, 0x18, 0x07, 0x71, 0xaa, 0x86, 0x4f, 0xb4, 0x08, 0x5f,
+	0xd2, 0x94, 0xf7, 0x65, 0xbd, 0xcd, 0x8a, 0x05, 0xde, 0xe1, 0x3f, 0x8a, 0x07, 0x30, 0x9b, 0x3a,
+	0x22, 0x06, 0x6e, 0x07, 0xce, 0xaa, 0xfd, 0x5d, 0x38, 0x57, 0x97, 0x92, 0xc2, 0x16, 0xb1, 0x82,
+	0x41, 0x8b, 0x5a, 0x10, 0xf7, 0xd0, 0xd3, 0xaa, 0xae, 0xa7, 0x78, 0x3a, 0xaa, 0xec, 0xf8, 0x82,
+	0xc0, 0x6c, 0xea, 0x50, 0x59, 0x2e, 0x16, 0x8e, 0xe7, 0xe2, 0xe8, 0xb2, 0x62, 0x29, 0x38, 0x8a,
+	0x6c, 0x37, 0xd5, 0x9a, 0xd9, 0x36, 0x14, 0x3f, 0x48, 0x53, 0x30, 0xa6, 0x29, 0x58, 0xbc, 0xc6,
+	0x34, 0x45, 0xfc, 0x07, 0x81, 0x62, 0x5c, 0x36, 0xa8, 0xcd, 0x0a, 0xb6, 0x65, 0x9d, 0x2d, 0x7c,
+	0x3d, 0xbf, 0x36, 0xfb, 0x3a, 0xf4, 0x06, 0x9c, 0x54, 0x98, 0xac, 0xbb, 0xa7, 0x78, 0x37, 0x36,
+	0x17, 0x53, 0x94, 0xb7, 0x99, 0xac, 0xa3, 0xae, 0x27, 0xef, 0x4e, 0x65, 0xc3, 0xdc, 0x6f, 0xe9,
+	0xb2, 0xe6, 0xde, 0x01, 0x0a, 0xe9, 0xc7, 0xbb, 0xed, 0xa6, 0x7a, 0xd7, 0x17, 0x44, 0x13, 0x21,
+	0x4d, 0xf1, 0x4e, 0x90, 0xac, 0x0f, 0x50, 0xe5, 0x5d, 0xbc, 0xa0, 0xe5, 0x5a, 0x1f, 0xe2, 0xf7,
+	0x09, 0x94, 0xd3, 0x2d, 0x60, 0x98, 0xea, 0x30, 0xdd, 0x8c, 0xf4, 0xdd, 0x37, 0x1e, 0x99, 0x18,
+	0xb2, 0xc5, 0x94, 0xe3, 0x4c, 0x4c, 0x1e, 0xf1, 0x27, 0xda, 0x12, 0xb5, 0x20, 0x27, 0xd3, 0x3c,
+	0x19, 0x55, 0xfe, 0x3f, 0x23, 0x50, 0x4e, 0x1f, 0x6b, 0xa0, 0xcf, 0x85, 0x51, 0xf9, 0x3c, 0xba,
+	0xf5, 0xf0, 0x76, 0x30, 0x89, 0xc1, 0x52, 0xf4, 0x5d, 0xf7, 0x7e, 0xa8, 0x00, 0x93, 0x9a, 0xc2,
+	0x0c, 0x47, 0x73, 0x3a, 0x98, 0x06, 0xbd, 0xe7, 0x70, 0x6d, 0x4c, 0xd0, 0x0f, 0xca, 0x85, 0x1a,
+	0xed, 0xcc, 0xaa, 0x8d, 0x31, 0x4b, 0x7e, 0xb9, 0x88, 0x59, 0x09, 0xd7, 0xc6, 0x54, 0xfc, 0xff,
+	0x8f, 0xda, 0x38, 0xb4, 0xb3, 0x85, 0xe3, 0x3b, 0x3b, 0xba, 0x59, 0x2f, 0xc2, 0x05, 0xee, 0x48,
+	0x8d, 0x13, 0x27, 0x0f, 0x4d, 0x53, 0xf7, 0xe3, 0xf9, 0x53, 0x02, 0xaf, 0xc6, 0xba, 0xd0, 0xb3,
+	0x6d, 0x00, 0xab, 0xd7, 0x8a, 0x71, 0x2c, 0x25, 0xb9, 0x14, 0xe8, 0xfa, 0x1b, 0x4f, 0xa0, 0x47,
+	0xdf, 0x80, 0x0b, 0x06, 0x7b, 0xe2, 0x6c, 0x6b, 0xb6, 0x63, 0x69, 0xf5, 0xb6, 0x8b, 0xa7, 0xef,
+	0x7a, 0x97, 0xd2, 0x2b, 0xbe, 0x05, 0x82, 0x77, 0xe0, 0x64, 0x86, 0xa2, 0x19, 0xaa, 0x37, 0x46,
+	0xce, 0xbd, 0xea, 0x8f, 0x04, 0x2e, 0x26, 0x2a, 0xa3, 0x67, 0xf3, 0x30, 0x25, 0x37, 0x1a, 0xac,
+	0xe5, 0x30, 0x85, 0xd7, 0x1b, 0x1b, 0xcb, 0x40, 0xa4, 0x95, 0xda, 0x30, 0xd5, 0xea, 0xb3, 0x80,
+	0xdb, 0xf7, 0x6b, 0x7d, 0x93, 0xe0, 0x87, 0xff, 0xae, 0xa9, 0x19, 0x5b, 0xab, 0x6e, 0x00, 0x3e,
+	0xfd, 0xd7, 0xec, 0xa2, 0xaa, 0x39, 0x7b, 0xed, 0x7a, 0xa5, 0x61, 0xee, 0x4b, 0x9e, 0x30, 0xfe,
+	0x5c, 0xb3, 0x95, 0xa6, 0xe4, 0x74, 0x5a, 0xcc, 0xe6, 0x0a, 0x76, 0x2d, 0x32, 0x84, 0xf8, 0x5b,
+	0x02, 0x97, 0x39, 0xf8, 0x48, 0x55, 0xb7, 0xb7, 0x3a, 0x3b, 0x8e, 0xec, 0xb4, 0x7b, 0x31, 0xa8,
+	0xc2, 0x84, 0xcd, 0x1b, 0x38, 0xfa, 0xa9, 0xf5, 0xa5, 0xac, 0xdb, 0x22, 0x2a, 0xa1, 0x05, 0x54,
+	0x8c, 0x2c, 0x95, 0xb1, 0xe3, 0x2c, 0x95, 0x2b, 0x03, 0x30, 0x63, 0xe8, 0xbf, 0x0d, 0xaf, 0x34,
+	0x23, 0x32, 0x59, 0xe7, 0x85, 0x88, 0x3d, 0xcc, 0xaf, 0x98, 0x89, 0xd1, 0x2d, 0x95, 0xaf, 0x04,
+	0xc7, 0xc8, 0xc8, 0xd8, 0x79, 0xb6, 0xc7, 0x03, 0x98, 0x4d, 0xd5, 0x0e, 0xce, 0x4b, 0x11, 0xf4,
+	0x59, 0x47, 0xc2, 0x64, 0xff, 0xa3, 0x16, 0xc4, 0xff, 0x92, 0xe0, 0x4c, 0x98, 0x02, 0x7b, 0x44,
+	0xbb, 0x22, 0x5d, 0x85, 0xf3, 0x0d, 0xb3, 0x6d, 0x38, 0xcc, 0x6a, 0xc9, 0x96, 0xd3, 0xb9, 0xbb,
+	0x27, 0x1b, 0x06, 0xd3, 0xf1, 0xd0, 0x9c, 0xd4, 0xe5, 0x72, 0x69, 0x2d, 0xcb, 0x6c, 0x99, 0xb6,
+	0xac, 0xdf, 0x57, 0xf0, 0x10, 0x1d, 0x6a, 0xa1, 0x22, 0x9c, 0x79, 0xa4, 0xe9, 0x0e, 0xb3, 0xb6,
+	0x3a, 0x3b, 0xcc, 0x70, 0x8a, 0xe3, 0x65, 0xb2, 0x38, 0x59, 0xeb, 0x6b, 0xa3, 0x14, 0xc6, 0x6d,
+	0xb7, 0xef, 0x24, 0xef, 0xe3, 0xff, 0xfb, 0x4e, 0xa7, 0x43, 0x45, 0xbb, 0x70, 0xbc, 0x68, 0x8f,
+	0x2c, 0xd9, 0xd6, 0x9f, 0x95, 0xe1, 0x24, 0xf7, 0x80, 0xfe, 0x98, 0xc0, 0xe9, 0x30, 0x03, 0xbc,
+	0x92, 0x72, 0xeb, 0x4d, 0xa2, 0xa6, 0x85, 0xab, 0xf9, 0x84, 0x3d, 0x00, 0xe2, 0xc2, 0x27, 0x7f,
+	0xfb, 0xcf, 0x4f, 0xc6, 0xe6, 0xe8, 0xac, 0x94, 0xfd, 0x06, 0x80, 0x76, 0x61, 0xc2, 0xe3, 0x79,
+	0xe9, 0x7c, 0xea, 0x00, 0x7d, 0x94, 0xb2, 0xb0, 0x30, 0x50, 0x0e, 0x31, 0x88, 0x1c, 0xc3, 0x0c,
+	0x15, 0xa4, 0xd4, 0x97, 0x33, 0xf4, 0x17, 0x04, 0xce, 0x84, 0x39, 0x4b, 0x2a, 0xa5, 0x5a, 0x4f,
+	0x66, 0x9c, 0x85, 0xd5, 0xfc, 0x0a, 0x88, 0x6b, 0x8d, 0xe3, 0x5a, 0xa1, 0x4b, 0xd2, 0xa0, 0x77,
+	0x42, 0xd2, 0x21, 0xe7, 0xaf, 0xbb, 0xf4, 0x67, 0x04, 0xce, 0x86, 0x6d, 0x55, 0x75, 0x3d, 0x03,
+	0x69, 0x32, 0x5f, 0x2c, 0xac, 0xe6, 0x57, 0x40, 0xa4, 0x4b, 0x1c, 0xe9, 0x25, 0x3a, 0x37, 0x10,
+	0x29, 0x7d, 0x4e, 0xa0, 0x98, 0xc6, 0x77, 0xd3, 0x5b, 0x79, 0x63, 0x94, 0xc0, 0x92, 0x1f, 0x21,
+	0xc0, 0x5f, 0xe5, 0xb0, 0xef, 0xd0, 0xdb, 0x03, 0x61, 0xef, 0xd6, 0xf1, 0x7d, 0xd5, 0x2e, 0x0f,
+	0xb4, 0x74, 0x18, 0xb0, 0xef, 0x5d, 0xfa, 0x4b, 0x02, 0x93, 0xbd, 0xcb, 0xe2, 0x4a, 0x16, 0x8a,
+	0xc8, 0x92, 0x16, 0xae, 0xe6, 0x13, 0x46, 0xb8, 0x77, 0x38, 0xdc, 0x9b, 0xf4, 0x86, 0x94, 0xf5,
+	0xee, 0x4f, 0x3a, 0xec, 0x21, 0xef, 0x4a, 0x87, 0x21, 0xba, 0xba, 0x4b, 0x7f, 0x48, 0xe0, 0xb4,
+	0x6f, 0xd5, 0xcd, 0x8c, 0x95, 0xac, 0x89, 0xce, 0x8f, 0x35, 0x81, 0x3e, 0x16, 0xaf, 0x70, 0xac,
+	0xb3, 0xf4, 0xf5, 0x4c, 0xac, 0xf4, 0x4f, 0x04, 0x68, 0x9c, 0x59, 0xa4, 0x9b, 0x59, 0x71, 0x49,
+	0xe5, 0x50, 0x85, 0x37, 0x86, 0x55, 0x43, 0xb0, 0x37, 0x39, 0xd8, 0x0d, 0xba, 0x26, 0xe5, 0x7c,
+	0x61, 0x2a, 0x1d, 0xee, 0x61, 0x48, 0x3f, 0x27, 0xf0, 0xe5, 0xb8, 0x65, 0x37, 0xb8, 0x9b, 0x59,
+	0xf1, 0x3a, 0x8a, 0x0f, 0x99, 0x84, 0xae, 0xb8, 0xca, 0x7d, 0x58, 0xa6, 0x8b, 0x79, 0x7d, 0xa0,
+	0x1f, 0xc3, 0x04, 0x72, 0x9d, 0x19, 0x3b, 0x6a, 0x98, 0x77, 0x15, 0x16, 0x06, 0xca, 0x21, 0x98,
+	0x4b, 0x1c, 0xcc, 0xeb, 0xf4, 0xa2, 0x94, 0xfe, 0x4a, 0x99, 0xfe, 0x81, 0xc0, 0xb9, 0x18, 0x37,
+	0x47, 0xaf, 0x67, 0xce, 0x61, 0x0a, 0x03, 0x29, 0x6c, 0x0e, 0xa9, 0x85, 0x38, 0x6f, 0x70, 0x9c,
+	0x6b, 0x54, 0x92, 0x72, 0xbd, 0xd8, 0x96, 0x0e, 0x3d, 0x52, 0xb6, 0x4b, 0x3f, 0x23, 0x30, 0x1d,
+	0x33, 0xeb, 0xce, 0xfa, 0xf5, 0xcc, 0xe9, 0x1b, 0x1e, 0x7e, 0x16, 0x13, 0x2a, 0x56, 0x38, 0xfc,
+	0x45, 0x3a, 0x9f, 0x0f, 0x3e, 0x7d, 0x4a, 0xe0, 0x6c, 0x84, 0xf3, 0xa2, 0xeb, 0x59, 0x91, 0x4b,
+	0x66, 0xf4, 0x84, 0x8d, 0xa1, 0x74, 0x10, 0xec, 0xbb, 0x1c, 0xec, 0x3b, 0x74, 0x5b, 0xca, 0xf3,
+	0x5d, 0x40, 0xff, 0x2e, 0xe6, 0xb1, 0x9f, 0xfc, 0x0f, 0x67, 0x3b, 0xbb, 0xf4, 0x57, 0x04, 0x68,
+	0x64, 0x24, 0x37, 0xfc, 0xeb, 0x59, 0x81, 0x1c, 0xda, 0x9b, 0x74, 0xa2, 0x51, 0xbc, 0xc6, 0xbd,
+	0x59, 0xa0, 0x57, 0x72, 0x79, 0xe3, 0x9e, 0xa8, 0x26, 0x7d, 0x3a, 0x2e, 0xbb, 0x44, 0x44, 0x88,
+	0x41, 0xe1, 0x6a, 0x3e, 0x61, 0x84, 0xb5, 0xcc, 0x61, 0x5d, 0xa6, 0xa2, 0x94, 0xfc, 0xa9, 0xc4,
+	0xae, 0xe5, 0x8a, 0xbb, 0x71, 0xe4, 0x5b, 0xd7, 0x2b, 0x51, 0xee, 0x87, 0x6e, 0x0c, 0xa8, 0x48,
+	0x49, 0x0c, 0x97, 0x70, 0x7d, 0x38, 0xa5, 0x3c, 0xbb, 0x6e, 0xec, 0xc3, 0x8d, 0x70, 0x42, 0xd0,
+	0xdf, 0x10, 0x38, 0x1f, 0xb5, 0xeb, 0x4e, 0xff, 0xc6, 0x80, 0x1a, 0x35, 0x24, 0xfa, 0x0c, 0xa2,
+	0x2d, 0x3b, 0x01, 0x62, 0xe8, 0xe9, 0x17, 0x04, 0xce, 0xc5, 0xc8, 0x96, 0xec, 0xcd, 0x2e, 0x8d,
+	0x52, 0x12, 0x36, 0x87, 0xd4, 0x42, 0xc4, 0xb7, 0x39, 0xe2, 0x1b, 0x74, 0x53, 0xca, 0xf7, 0xf1,
+	0x8b, 0x74, 0xe8, 0xdf, 0x24, 0x6f, 0x2f, 0x2f, 0x77, 0xe9, 0xef, 0x08, 0x4c, 0xc7, 0x8c, 0x0f,
+	0xdc, 0xf2, 0x8e, 0xe0, 0x44, 0x16, 0xc1, 0x25, 0x4a, 0xdc, 0x89, 0x25, 0xba, 0x90, 0xd3, 0x09,
+	0xfa, 0x03, 0x02, 0x10, 0x50, 0x42, 0x74, 0x39, 0x75, 0xd8, 0x18, 0x1d, 0x25, 0xac, 0xe4, 0x92,
+	0xcd, 0x73, 0x91, 0xf1, 0x18, 0xa8, 0xdd, 0x96, 0x8b, 0xe0, 0x53, 0x02, 0x53, 0xfd, 0x4c, 0x10,
+	0xad, 0xa4, 0xd7, 0xd5, 0x24, 0xbe, 0x49, 0x90, 0x72, 0xcb, 0xe7, 0xa9, 0x73, 0xc8, 0xf8, 0xe0,
+	0x87, 0x4c, 0xfd, 0x0b, 0xed, 0xf7, 0x04, 0xce, 0x46, 0xdf, 0xe7, 0xac, 0x0f, 0x71, 0xc2, 0xcd,
+	0x55, 0x31, 0x52, 0xae, 0xcb, 0xe2, 0x2d, 0x8e, 0x7a, 0x93, 0x6e, 0x64, 0x9e, 0x21, 0x53, 0xd2,
+	0xd5, 0x2d, 0x10, 0x11, 0xc3, 0x03, 0x0b, 0xc4, 0xd0, 0xe0, 0xd3, 0xef, 0xfa, 0x03, 0xf7, 0x87,
+	0x7e, 0xf0, 0xf4, 0xaf, 0x04, 0x8a, 0x69, 0x74, 0x15, 0x7d, 0x33, 0x15, 0xc0, 0x00, 0x56, 0x4e,
+	0xb8, 0x79, 0x04, 0x4d, 0x74, 0xa0, 0xca, 0x1d, 0xb8, 0x45, 0x6f, 0xe6, 0x72, 0xc0, 0xe6, 0x37,
+	0x24, 0x6e, 0x41, 0x3a, 0xf4, 0x7e, 0xbb, 0x5b, 0xd7, 0x9f, 0xbe, 0x28, 0x91, 0xe7, 0x2f, 0x4a,
+	0xe4, 0xdf, 0x2f, 0x4a, 0xe4, 0x47, 0x2f, 0x4b, 0x27, 0x9e, 0xbf, 0x2c, 0x9d, 0xf8, 0xfb, 0xcb,
+	0xd2, 0x89, 0x0f, 0x85, 0xc0, 0xe6, 0x93, 0xc0, 0x2a, 0x27, 0x22, 0xeb, 0x13, 0xfc, 0xeb, 0xb7,
+	0x8d, 0xff, 0x0d, 0x00, 0x16, 0x57, 0xbd, 0x97, 0xbf, 0x29, 0x00, 0x00,
 }
 
-func (m *QueryPubKeyRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	Commitments(ctx context.Context, in *QueryCommitmentsRequest, opts ...grpc.CallOption) (*QueryCommitmentsResponse, error)
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Queries a ValidatorSet by index.
+	ValidatorSet(ctx context.Context, in *QueryGetValidatorSetRequest, opts ...grpc.CallOption) (*QueryGetValidatorSetResponse, error)
+	// Queries a list of ValidatorSet items.
+	ValidatorSetAll(ctx context.Context, in *QueryAllValidatorSetRequest, opts ...grpc.CallOption) (*QueryAllValidatorSetResponse, error)
+	// Queries the ValidatorSet the key share index is assigned to.
+	ValidatorSetByShareIndex(ctx context.Context, in *QueryGetValidatorSetByShareIndexRequest, opts ...grpc.CallOption) (*QueryGetValidatorSetResponse, error)
+	// Queries a KeyShare by index.
+	KeyShare(ctx context.Context, in *QueryGetKeyShareRequest, opts ...grpc.CallOption) (*QueryGetKeyShareResponse, error)
+	// Queries a list of KeyShare items.
+	KeyShareAll(ctx context.Context, in *QueryAllKeyShareRequest, opts ...grpc.CallOption) (*QueryAllKeyShareResponse, error)
+	// Queries a list of AggregatedKeyShare items.
+	AggregatedKeyShare(ctx context.Context, in *QueryGetAggregatedKeyShareRequest, opts ...grpc.CallOption) (*QueryGetAggregatedKeyShareResponse, error)
+	AggregatedKeyShareAll(ctx context.Context, in *QueryAllAggregatedKeyShareRequest, opts ...grpc.CallOption) (*QueryAllAggregatedKeyShareResponse, error)
+	// Queries the public keys
+	PubKey(ctx context.Context, in *QueryPubKeyRequest, opts ...grpc.CallOption) (*QueryPubKeyResponse, error)
+	// Queries a list of AuthorizedAddress items.
+	AuthorizedAddress(ctx context.Context, in *QueryGetAuthorizedAddressRequest, opts ...grpc.CallOption) (*QueryGetAuthorizedAddressResponse, error)
+	AuthorizedAddressAll(ctx context.Context, in *QueryAllAuthorizedAddressRequest, opts ...grpc.CallOption) (*QueryAllAuthorizedAddressResponse, error)
+	// Queries a list of GeneralKeyShare items.
+	GeneralKeyShare(ctx context.Context, in *QueryGetGeneralKeyShareRequest, opts ...grpc.CallOption) (*QueryGetGeneralKeyShareResponse, error)
+	GeneralKeyShareAll(ctx context.Context, in *QueryAllGeneralKeyShareRequest, opts ...grpc.CallOption) (*QueryAllGeneralKeyShareResponse, error)
+	// Queries a DKG round with its deals and complaints by id.
+	DkgRound(ctx context.Context, in *QueryGetDkgRoundRequest, opts ...grpc.CallOption) (*QueryGetDkgRoundResponse, error)
+	// Queries the keyshare liveness of a validator, including its missed counter.
+	KeyshareLiveness(ctx context.Context, in *QueryGetKeyshareLivenessRequest, opts ...grpc.CallOption) (*QueryGetKeyshareLivenessResponse, error)
+	KeyshareLivenessAll(ctx context.Context, in *QueryAllKeyshareLivenessRequest, opts ...grpc.CallOption) (*QueryAllKeyshareLivenessResponse, error)
+	// Queries a general key request made on fairyring and its aggregated key by identity.
+	GeneralKeyRequest(ctx context.Context, in *QueryGetGeneralKeyRequestRequest, opts ...grpc.CallOption) (*QueryGetGeneralKeyRequestResponse, error)
+	GeneralKeyRequestAll(ctx context.Context, in *QueryAllGeneralKeyRequestRequest, opts ...grpc.CallOption) (*QueryAllGeneralKeyRequestResponse, error)
+	// Queries the keyshare reward pool and the height it is next distributed at.
+	RewardPool(ctx context.Context, in *QueryRewardPoolRequest, opts ...grpc.CallOption) (*QueryRewardPoolResponse, error)
+	// Queries the key shares a validator got accepted during the current reward epoch and its pending rewards.
+	PendingRewards(ctx context.Context, in *QueryPendingRewardsRequest, opts ...grpc.CallOption) (*QueryPendingRewardsResponse, error)
+	// Queries a key share request received over IBC and its aggregated key by identity.
+	KeyShareRequest(ctx context.Context, in *QueryGetKeyShareRequestRequest, opts ...grpc.CallOption) (*QueryGetKeyShareRequestResponse, error)
+	// Queries a list of KeyShareRequest items, optionally filtered by counterparty channel, proposal id and sent status.
+	KeyShareRequestAll(ctx context.Context, in *QueryAllKeyShareRequestRequest, opts ...grpc.CallOption) (*QueryAllKeyShareRequestResponse, error)
+	// Queries a list of KeyShareRequest items with the given delivery status.
+	KeyShareRequestsByStatus(ctx context.Context, in *QueryKeyShareRequestsByStatusRequest, opts ...grpc.CallOption) (*QueryKeyShareRequestsByStatusResponse, error)
 }
 
-func (m *QueryPubKeyRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type queryClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *QueryPubKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
 }
 
-func (m *QueryPubKeyResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Commitments(ctx context.Context, in *QueryCommitmentsRequest, opts ...grpc.CallOption) (*QueryCommitmentsResponse, error) {
+	out := new(QueryCommitmentsResponse)
+	err := c.cc.Invoke(ctx, "/fairyring.keyshare.Query/Commitments", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryPubKeyResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/fairyring.keyshare.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryPubKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.QueuedPubKey.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.ActivePubKey.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) ValidatorSet(ctx context.Context, in *QueryGetValidatorSetRequest, opts ...grpc.CallOption) (*QueryGetValidatorSetResponse, error) {
+	out := new(QueryGetValidatorSetResponse)
+	err := c.cc.Invoke(ctx, "/fairyring.keyshare.Query/ValidatorSet", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryGetAuthorizedAddressRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ValidatorSetAll(ctx context.Context, in *QueryAllValidatorSetRequest, opts ...grpc.CallOption) (*QueryAllValidatorSetResponse, error) {
+	out := new(QueryAllValidatorSetResponse)
+	err := c.cc.Invoke(ctx, "/fairyring.keyshare.Query/ValidatorSetAll", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryGetAuthorizedAddressRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ValidatorSetByShareIndex(ctx context.Context, in *QueryGetValidatorSetByShareIndexRequest, opts ...grpc.CallOption) (*QueryGetValidatorSetResponse, error) {
+	out := new(QueryGetValidatorSetResponse)
+	err := c.cc.Invoke(ctx, "/fairyring.keyshare.Query/ValidatorSetByShareIndex", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryGetAuthorizedAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Target) > 0 {
-		i -= len(m.Target)
-		copy(dAtA[i:], m.Target)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Target)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) KeyShare(ctx context.Context, in *QueryGetKeyShareRequest, opts ...grpc.CallOption) (*QueryGetKeyShareResponse, error) {
+	out := new(QueryGetKeyShareResponse)
+	err := c.cc.Invoke(ctx, "/fairyring.keyshare.Query/KeyShare", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryGetAuthorizedAddressResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) KeyShareAll(ctx context.Context, in *QueryAllKeyShareRequest, opts ...grpc.CallOption) (*QueryAllKeyShareResponse, error) {
+	out := new(QueryAllKeyShareResponse)
+	err := c.cc.Invoke(ctx, "/fairyring.keyshare.Query/KeyShareAll", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryGetAuthorizedAddressResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryGetAuthorizedAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.AuthorizedAddress.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) AggregatedKeyShare(ctx context.Context, in *QueryGetAggregatedKeyShareRequest, opts ...grpc.CallOption) (*QueryGetAggregatedKeyShareResponse, error) {
+	out := new(QueryGetAggregatedKeyShareResponse)
+	err := c.cc.Invoke(ctx, "/fairyring.keyshare.Query/AggregatedKeyShare", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryAllAuthorizedAddressRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) AggregatedKeyShareAll(ctx context.Context, in *QueryAllAggregatedKeyShareRequest, opts ...grpc.CallOption) (*QueryAllAggregatedKeyShareResponse, error) {
+	out := new(QueryAllAggregatedKeyShareResponse)
+	err := c.cc.Invoke(ctx, "/fairyring.keyshare.Query/AggregatedKeyShareAll", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryAllAuthorizedAddressRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryAllAuthorizedAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) PubKey(ctx context.Context, in *QueryPubKeyRequest, opts ...grpc.CallOption) (*QueryPubKeyResponse, error) {
+	out := new(QueryPubKeyResponse)
+	err := c.cc.Invoke(ctx, "/fairyring.keyshare.Query/PubKey", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryAllAuthorizedAddressResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) AuthorizedAddress(ctx context.Context, in *QueryGetAuthorizedAddressRequest, opts ...grpc.CallOption) (*QueryGetAuthorizedAddressResponse, error) {
+	out := new(QueryGetAuthorizedAddressResponse)
+	err := c.cc.Invoke(ctx, "/fairyring.keyshare.Query/AuthorizedAddress", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryAllAuthorizedAddressResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) AuthorizedAddressAll(ctx context.Context, in *QueryAllAuthorizedAddressRequest, opts ...grpc.CallOption) (*QueryAllAuthorizedAddressResponse, error) {
+	out := new(QueryAllAuthorizedAddressResponse)
+	err := c.cc.Invoke(ctx, "/fairyring.keyshare.Query/AuthorizedAddressAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryAllAuthorizedAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.AuthorizedAddress) > 0 {
-		for iNdEx := len(m.AuthorizedAddress) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.AuthorizedAddress[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) GeneralKeyShare(ctx context.Context, in *QueryGetGeneralKeyShareRequest, opts ...grpc.CallOption) (*QueryGetGeneralKeyShareResponse, error) {
+	out := new(QueryGetGeneralKeyShareResponse)
+	err := c.cc.Invoke(ctx, "/fairyring.keyshare.Query/GeneralKeyShare", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryGetGeneralKeyShareRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GeneralKeyShareAll(ctx context.Context, in *QueryAllGeneralKeyShareRequest, opts ...grpc.CallOption) (*QueryAllGeneralKeyShareResponse, error) {
+	out := new(QueryAllGeneralKeyShareResponse)
+	err := c.cc.Invoke(ctx, "/fairyring.keyshare.Query/GeneralKeyShareAll", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryGetGeneralKeyShareRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) DkgRound(ctx context.Context, in *QueryGetDkgRoundRequest, opts ...grpc.CallOption) (*QueryGetDkgRoundResponse, error) {
+	out := new(QueryGetDkgRoundResponse)
+	err := c.cc.Invoke(ctx, "/fairyring.keyshare.Query/DkgRound", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryGetGeneralKeyShareRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.IdValue) > 0 {
-		i -= len(m.IdValue)
-		copy(dAtA[i:], m.IdValue)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.IdValue)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.IdType) > 0 {
-		i -= len(m.IdType)
-		copy(dAtA[i:], m.IdType)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.IdType)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Validator) > 0 {
-		i -= len(m.Validator)
-		copy(dAtA[i:], m.Validator)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Validator)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) KeyshareLiveness(ctx context.Context, in *QueryGetKeyshareLivenessRequest, opts ...grpc.CallOption) (*QueryGetKeyshareLivenessResponse, error) {
+	out := new(QueryGetKeyshareLivenessResponse)
+	err := c.cc.Invoke(ctx, "/fairyring.keyshare.Query/KeyshareLiveness", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryGetGeneralKeyShareResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) KeyshareLivenessAll(ctx context.Context, in *QueryAllKeyshareLivenessRequest, opts ...grpc.CallOption) (*QueryAllKeyshareLivenessResponse, error) {
+	out := new(QueryAllKeyshareLivenessResponse)
+	err := c.cc.Invoke(ctx, "/fairyring.keyshare.Query/KeyshareLivenessAll", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryGetGeneralKeyShareResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GeneralKeyRequest(ctx context.Context, in *QueryGetGeneralKeyRequestRequest, opts ...grpc.CallOption) (*QueryGetGeneralKeyRequestResponse, error) {
+	out := new(QueryGetGeneralKeyRequestResponse)
+	err := c.cc.Invoke(ctx, "/fairyring.keyshare.Query/GeneralKeyRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryGetGeneralKeyShareResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.GeneralKeyShare.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) GeneralKeyRequestAll(ctx context.Context, in *QueryAllGeneralKeyRequestRequest, opts ...grpc.CallOption) (*QueryAllGeneralKeyRequestResponse, error) {
+	out := new(QueryAllGeneralKeyRequestResponse)
+	err := c.cc.Invoke(ctx, "/fairyring.keyshare.Query/GeneralKeyRequestAll", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryAllGeneralKeyShareRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) RewardPool(ctx context.Context, in *QueryRewardPoolRequest, opts ...grpc.CallOption) (*QueryRewardPoolResponse, error) {
+	out := new(QueryRewardPoolResponse)
+	err := c.cc.Invoke(ctx, "/fairyring.keyshare.Query/RewardPool", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryAllGeneralKeyShareRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) PendingRewards(ctx context.Context, in *QueryPendingRewardsRequest, opts ...grpc.CallOption) (*QueryPendingRewardsResponse, error) {
+	out := new(QueryPendingRewardsResponse)
+	err := c.cc.Invoke(ctx, "/fairyring.keyshare.Query/PendingRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryAllGeneralKeyShareRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) KeyShareRequest(ctx context.Context, in *QueryGetKeyShareRequestRequest, opts ...grpc.CallOption) (*QueryGetKeyShareRequestResponse, error) {
+	out := new(QueryGetKeyShareRequestResponse)
+	err := c.cc.Invoke(ctx, "/fairyring.keyshare.Query/KeyShareRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryAllGeneralKeyShareResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) KeyShareRequestAll(ctx context.Context, in *QueryAllKeyShareRequestRequest, opts ...grpc.CallOption) (*QueryAllKeyShareRequestResponse, error) {
+	out := new(QueryAllKeyShareRequestResponse)
+	err := c.cc.Invoke(ctx, "/fairyring.keyshare.Query/KeyShareRequestAll", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryAllGeneralKeyShareResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) KeyShareRequestsByStatus(ctx context.Context, in *QueryKeyShareRequestsByStatusRequest, opts ...grpc.CallOption) (*QueryKeyShareRequestsByStatusResponse, error) {
+	out := new(QueryKeyShareRequestsByStatusResponse)
+	err := c.cc.Invoke(ctx, "/fairyring.keyshare.Query/KeyShareRequestsByStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryAllGeneralKeyShareResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.GeneralKeyShare) > 0 {
-		for iNdEx := len(m.GeneralKeyShare) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.GeneralKeyShare[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	Commitments(context.Context, *QueryCommitmentsRequest) (*QueryCommitmentsResponse, error)
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Queries a ValidatorSet by index.
+	ValidatorSet(context.Context, *QueryGetValidatorSetRequest) (*QueryGetValidatorSetResponse, error)
+	// Queries a list of ValidatorSet items.
+	ValidatorSetAll(context.Context, *QueryAllValidatorSetRequest) (*QueryAllValidatorSetResponse, error)
+	// Queries the ValidatorSet the key share index is assigned to.
+	ValidatorSetByShareIndex(context.Context, *QueryGetValidatorSetByShareIndexRequest) (*QueryGetValidatorSetResponse, error)
+	// Queries a KeyShare by index.
+	KeyShare(context.Context, *QueryGetKeyShareRequest) (*QueryGetKeyShareResponse, error)
+	// Queries a list of KeyShare items.
+	KeyShareAll(context.Context, *QueryAllKeyShareRequest) (*QueryAllKeyShareResponse, error)
+	// Queries a list of AggregatedKeyShare items.
+	AggregatedKeyShare(context.Context, *QueryGetAggregatedKeyShareRequest) (*QueryGetAggregatedKeyShareResponse, error)
+	AggregatedKeyShareAll(context.Context, *QueryAllAggregatedKeyShareRequest) (*QueryAllAggregatedKeyShareResponse, error)
+	// Queries the public keys
+	PubKey(context.Context, *QueryPubKeyRequest) (*QueryPubKeyResponse, error)
+	// Queries a list of AuthorizedAddress items.
+	AuthorizedAddress(context.Context, *QueryGetAuthorizedAddressRequest) (*QueryGetAuthorizedAddressResponse, error)
+	AuthorizedAddressAll(context.Context, *QueryAllAuthorizedAddressRequest) (*QueryAllAuthorizedAddressResponse, error)
+	// Queries a list of GeneralKeyShare items.
+	GeneralKeyShare(context.Context, *QueryGetGeneralKeyShareRequest) (*QueryGetGeneralKeyShareResponse, error)
+	GeneralKeyShareAll(context.Context, *QueryAllGeneralKeyShareRequest) (*QueryAllGeneralKeyShareResponse, error)
+	// Queries a DKG round with its deals and complaints by id.
+	DkgRound(context.Context, *QueryGetDkgRoundRequest) (*QueryGetDkgRoundResponse, error)
+	// Queries the keyshare liveness of a validator, including its missed counter.
+	KeyshareLiveness(context.Context, *QueryGetKeyshareLivenessRequest) (*QueryGetKeyshareLivenessResponse, error)
+	KeyshareLivenessAll(context.Context, *QueryAllKeyshareLivenessRequest) (*QueryAllKeyshareLivenessResponse, error)
+	// Queries a general key request made on fairyring and its aggregated key by identity.
+	GeneralKeyRequest(context.Context, *QueryGetGeneralKeyRequestRequest) (*QueryGetGeneralKeyRequestResponse, error)
+	GeneralKeyRequestAll(context.Context, *QueryAllGeneralKeyRequestRequest) (*QueryAllGeneralKeyRequestResponse, error)
+	// Queries the keyshare reward pool and the height it is next distributed at.
+	RewardPool(context.Context, *QueryRewardPoolRequest) (*QueryRewardPoolResponse, error)
+	// Queries the key shares a validator got accepted during the current reward epoch and its pending rewards.
+	PendingRewards(context.Context, *QueryPendingRewardsRequest) (*QueryPendingRewardsResponse, error)
+	// Queries a key share request received over IBC and its aggregated key by identity.
+	KeyShareRequest(context.Context, *QueryGetKeyShareRequestRequest) (*QueryGetKeyShareRequestResponse, error)
+	// Queries a list of KeyShareRequest items, optionally filtered by counterparty channel, proposal id and sent status.
+	KeyShareRequestAll(context.Context, *QueryAllKeyShareRequestRequest) (*QueryAllKeyShareRequestResponse, error)
+	// Queries a list of KeyShareRequest items with the given delivery status.
+	KeyShareRequestsByStatus(context.Context, *QueryKeyShareRequestsByStatusRequest) (*QueryKeyShareRequestsByStatusResponse, error)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
-func (m *QueryCommitmentsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (*UnimplementedQueryServer) Commitments(ctx context.Context, req *QueryCommitmentsRequest) (*QueryCommitmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Commitments not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) ValidatorSet(ctx context.Context, req *QueryGetValidatorSetRequest) (*QueryGetValidatorSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorSet not implemented")
+}
+func (*UnimplementedQueryServer) ValidatorSetAll(ctx context.Context, req *QueryAllValidatorSetRequest) (*QueryAllValidatorSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorSetAll not implemented")
+}
+func (*UnimplementedQueryServer) ValidatorSetByShareIndex(ctx context.Context, req *QueryGetValidatorSetByShareIndexRequest) (*QueryGetValidatorSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorSetByShareIndex not implemented")
+}
+func (*UnimplementedQueryServer) KeyShare(ctx context.Context, req *QueryGetKeyShareRequest) (*QueryGetKeyShareResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KeyShare not implemented")
+}
+func (*UnimplementedQueryServer) KeyShareAll(ctx context.Context, req *QueryAllKeyShareRequest) (*QueryAllKeyShareResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KeyShareAll not implemented")
+}
+func (*UnimplementedQueryServer) AggregatedKeyShare(ctx context.Context, req *QueryGetAggregatedKeyShareRequest) (*QueryGetAggregatedKeyShareResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AggregatedKeyShare not implemented")
+}
+func (*UnimplementedQueryServer) AggregatedKeyShareAll(ctx context.Context, req *QueryAllAggregatedKeyShareRequest) (*QueryAllAggregatedKeyShareResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AggregatedKeyShareAll not implemented")
+}
+func (*UnimplementedQueryServer) PubKey(ctx context.Context, req *QueryPubKeyRequest) (*QueryPubKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PubKey not implemented")
+}
+func (*UnimplementedQueryServer) AuthorizedAddress(ctx context.Context, req *QueryGetAuthorizedAddressRequest) (*QueryGetAuthorizedAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthorizedAddress not implemented")
+}
+func (*UnimplementedQueryServer) AuthorizedAddressAll(ctx context.Context, req *QueryAllAuthorizedAddressRequest) (*QueryAllAuthorizedAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthorizedAddressAll not implemented")
+}
+func (*UnimplementedQueryServer) GeneralKeyShare(ctx context.Context, req *QueryGetGeneralKeyShareRequest) (*QueryGetGeneralKeyShareResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GeneralKeyShare not implemented")
+}
+func (*UnimplementedQueryServer) GeneralKeyShareAll(ctx context.Context, req *QueryAllGeneralKeyShareRequest) (*QueryAllGeneralKeyShareResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GeneralKeyShareAll not implemented")
+}
+func (*UnimplementedQueryServer) DkgRound(ctx context.Context, req *QueryGetDkgRoundRequest) (*QueryGetDkgRoundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DkgRound not implemented")
+}
+func (*UnimplementedQueryServer) KeyshareLiveness(ctx context.Context, req *QueryGetKeyshareLivenessRequest) (*QueryGetKeyshareLivenessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KeyshareLiveness not implemented")
+}
+func (*UnimplementedQueryServer) KeyshareLivenessAll(ctx context.Context, req *QueryAllKeyshareLivenessRequest) (*QueryAllKeyshareLivenessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KeyshareLivenessAll not implemented")
+}
+func (*UnimplementedQueryServer) GeneralKeyRequest(ctx context.Context, req *QueryGetGeneralKeyRequestRequest) (*QueryGetGeneralKeyRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GeneralKeyRequest not implemented")
+}
+func (*UnimplementedQueryServer) GeneralKeyRequestAll(ctx context.Context, req *QueryAllGeneralKeyRequestRequest) (*QueryAllGeneralKeyRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GeneralKeyRequestAll not implemented")
+}
+func (*UnimplementedQueryServer) RewardPool(ctx context.Context, req *QueryRewardPoolRequest) (*QueryRewardPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RewardPool not implemented")
+}
+func (*UnimplementedQueryServer) PendingRewards(ctx context.Context, req *QueryPendingRewardsRequest) (*QueryPendingRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingRewards not implemented")
+}
+func (*UnimplementedQueryServer) KeyShareRequest(ctx context.Context, req *QueryGetKeyShareRequestRequest) (*QueryGetKeyShareRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KeyShareRequest not implemented")
+}
+func (*UnimplementedQueryServer) KeyShareRequestAll(ctx context.Context, req *QueryAllKeyShareRequestRequest) (*QueryAllKeyShareRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KeyShareRequestAll not implemented")
+}
+func (*UnimplementedQueryServer) KeyShareRequestsByStatus(ctx context.Context, req *QueryKeyShareRequestsByStatusRequest) (*QueryKeyShareRequestsByStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KeyShareRequestsByStatus not implemented")
 }
 
-func (m *QueryCommitmentsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Commitments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCommitmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.ActiveCommitments != nil {
-		l = m.ActiveCommitments.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).Commitments(ctx, in)
 	}
-	if m.QueuedCommitments != nil {
-		l = m.QueuedCommitments.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fairyring.keyshare.Query/Commitments",
 	}
-	return n
-}
-
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Commitments(ctx, req.(*QueryCommitmentsRequest))
 	}
-	var l int
-	_ = l
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func (m *QueryGetValidatorSetRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
 	}
-	var l int
-	_ = l
-	l = len(m.Index)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fairyring.keyshare.Query/Params",
 	}
-	return n
-}
-
-func (m *QueryGetValidatorSetResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
 	}
-	var l int
-	_ = l
-	l = m.ValidatorSet.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllValidatorSetRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_ValidatorSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetValidatorSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorSet(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fairyring.keyshare.Query/ValidatorSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorSet(ctx, req.(*QueryGetValidatorSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllValidatorSetResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_ValidatorSetAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllValidatorSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.ValidatorSet) > 0 {
-		for _, e := range m.ValidatorSet {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorSetAll(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fairyring.keyshare.Query/ValidatorSetAll",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorSetAll(ctx, req.(*QueryAllValidatorSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetKeyShareRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_ValidatorSetByShareIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetValidatorSetByShareIndexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Validator)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorSetByShareIndex(ctx, in)
 	}
-	if m.BlockHeight != 0 {
-		n += 1 + sovQuery(uint64(m.BlockHeight))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fairyring.keyshare.Query/ValidatorSetByShareIndex",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorSetByShareIndex(ctx, req.(*QueryGetValidatorSetByShareIndexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetKeyShareResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_KeyShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetKeyShareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.KeyShare.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func (m *QueryAllKeyShareRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(QueryServer).KeyShare(ctx, in)
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fairyring.keyshare.Query/KeyShare",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).KeyShare(ctx, req.(*QueryGetKeyShareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllKeyShareResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.KeyShare) > 0 {
-		for _, e := range m.KeyShare {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+func _Query_KeyShareAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllKeyShareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).KeyShareAll(ctx, in)
 	}
-	return n
-}
-
-func (m *QueryGetAggregatedKeyShareRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fairyring.keyshare.Query/KeyShareAll",
 	}
-	var l int
-	_ = l
-	if m.Height != 0 {
-		n += 1 + sovQuery(uint64(m.Height))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).KeyShareAll(ctx, req.(*QueryAllKeyShareRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetAggregatedKeyShareResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_AggregatedKeyShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetAggregatedKeyShareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.AggregatedKeyShare.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func (m *QueryAllAggregatedKeyShareRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(QueryServer).AggregatedKeyShare(ctx, in)
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fairyring.keyshare.Query/AggregatedKeyShare",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AggregatedKeyShare(ctx, req.(*QueryGetAggregatedKeyShareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllAggregatedKeyShareResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_AggregatedKeyShareAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllAggregatedKeyShareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.AggregatedKeyShare) > 0 {
-		for _, e := range m.AggregatedKeyShare {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).AggregatedKeyShareAll(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fairyring.keyshare.Query/AggregatedKeyShareAll",
 	}
-	return n
-}
-
-func (m *QueryPubKeyRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AggregatedKeyShareAll(ctx, req.(*QueryAllAggregatedKeyShareRequest))
 	}
-	var l int
-	_ = l
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPubKeyResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_PubKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPubKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.ActivePubKey.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.QueuedPubKey.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func (m *QueryGetAuthorizedAddressRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(QueryServer).PubKey(ctx, in)
 	}
-	var l int
-	_ = l
-	l = len(m.Target)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fairyring.keyshare.Query/PubKey",
 	}
-	return n
-}
-
-func (m *QueryGetAuthorizedAddressResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PubKey(ctx, req.(*QueryPubKeyRequest))
 	}
-	var l int
-	_ = l
-	l = m.AuthorizedAddress.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllAuthorizedAddressRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_AuthorizedAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetAuthorizedAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).AuthorizedAddress(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fairyring.keyshare.Query/AuthorizedAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AuthorizedAddress(ctx, req.(*QueryGetAuthorizedAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllAuthorizedAddressResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_AuthorizedAddressAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllAuthorizedAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.AuthorizedAddress) > 0 {
-		for _, e := range m.AuthorizedAddress {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).AuthorizedAddressAll(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fairyring.keyshare.Query/AuthorizedAddressAll",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AuthorizedAddressAll(ctx, req.(*QueryAllAuthorizedAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetGeneralKeyShareRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_GeneralKeyShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetGeneralKeyShareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Validator)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).GeneralKeyShare(ctx, in)
 	}
-	l = len(m.IdType)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fairyring.keyshare.Query/GeneralKeyShare",
 	}
-	l = len(m.IdValue)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GeneralKeyShare(ctx, req.(*QueryGetGeneralKeyShareRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetGeneralKeyShareResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_GeneralKeyShareAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllGeneralKeyShareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.GeneralKeyShare.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).GeneralKeyShareAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fairyring.keyshare.Query/GeneralKeyShareAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GeneralKeyShareAll(ctx, req.(*QueryAllGeneralKeyShareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllGeneralKeyShareRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_DkgRound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetDkgRoundRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).DkgRound(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fairyring.keyshare.Query/DkgRound",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DkgRound(ctx, req.(*QueryGetDkgRoundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllGeneralKeyShareResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_KeyshareLiveness_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetKeyshareLivenessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.GeneralKeyShare) > 0 {
-		for _, e := range m.GeneralKeyShare {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).KeyshareLiveness(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fairyring.keyshare.Query/KeyshareLiveness",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).KeyshareLiveness(ctx, req.(*QueryGetKeyshareLivenessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_KeyshareLivenessAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllKeyshareLivenessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).KeyshareLivenessAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fairyring.keyshare.Query/KeyshareLivenessAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).KeyshareLivenessAll(ctx, req.(*QueryAllKeyshareLivenessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GeneralKeyRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetGeneralKeyRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GeneralKeyRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fairyring.keyshare.Query/GeneralKeyRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GeneralKeyRequest(ctx, req.(*QueryGetGeneralKeyRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GeneralKeyRequestAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllGeneralKeyRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GeneralKeyRequestAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fairyring.keyshare.Query/GeneralKeyRequestAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GeneralKeyRequestAll(ctx, req.(*QueryAllGeneralKeyRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RewardPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRewardPoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RewardPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fairyring.keyshare.Query/RewardPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RewardPool(ctx, req.(*QueryRewardPoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fairyring.keyshare.Query/PendingRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingRewards(ctx, req.(*QueryPendingRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_KeyShareRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetKeyShareRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).KeyShareRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fairyring.keyshare.Query/KeyShareRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).KeyShareRequest(ctx, req.(*QueryGetKeyShareRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_KeyShareRequestAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllKeyShareRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).KeyShareRequestAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fairyring.keyshare.Query/KeyShareRequestAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).KeyShareRequestAll(ctx, req.(*QueryAllKeyShareRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_KeyShareRequestsByStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryKeyShareRequestsByStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).KeyShareRequestsByStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fairyring.keyshare.Query/KeyShareRequestsByStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).KeyShareRequestsByStatus(ctx, req.(*QueryKeyShareRequestsByStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "fairyring.keyshare.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Commitments",
+			Handler:    _Query_Commitments_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "ValidatorSet",
+			Handler:    _Query_ValidatorSet_Handler,
+		},
+		{
+			MethodName: "ValidatorSetAll",
+			Handler:    _Query_ValidatorSetAll_Handler,
+		},
+		{
+			MethodName: "ValidatorSetByShareIndex",
+			Handler:    _Query_ValidatorSetByShareIndex_Handler,
+		},
+		{
+			MethodName: "KeyShare",
+			Handler:    _Query_KeyShare_Handler,
+		},
+		{
+			MethodName: "KeyShareAll",
+			Handler:    _Query_KeyShareAll_Handler,
+		},
+		{
+			MethodName: "AggregatedKeyShare",
+			Handler:    _Query_AggregatedKeyShare_Handler,
+		},
+		{
+			MethodName: "AggregatedKeyShareAll",
+			Handler:    _Query_AggregatedKeyShareAll_Handler,
+		},
+		{
+			MethodName: "PubKey",
+			Handler:    _Query_PubKey_Handler,
+		},
+		{
+			MethodName: "AuthorizedAddress",
+			Handler:    _Query_AuthorizedAddress_Handler,
+		},
+		{
+			MethodName: "AuthorizedAddressAll",
+			Handler:    _Query_AuthorizedAddressAll_Handler,
+		},
+		{
+			MethodName: "GeneralKeyShare",
+			Handler:    _Query_GeneralKeyShare_Handler,
+		},
+		{
+			MethodName: "GeneralKeyShareAll",
+			Handler:    _Query_GeneralKeyShareAll_Handler,
+		},
+		{
+			MethodName: "DkgRound",
+			Handler:    _Query_DkgRound_Handler,
+		},
+		{
+			MethodName: "KeyshareLiveness",
+			Handler:    _Query_KeyshareLiveness_Handler,
+		},
+		{
+			MethodName: "KeyshareLivenessAll",
+			Handler:    _Query_KeyshareLivenessAll_Handler,
+		},
+		{
+			MethodName: "GeneralKeyRequest",
+			Handler:    _Query_GeneralKeyRequest_Handler,
+		},
+		{
+			MethodName: "GeneralKeyRequestAll",
+			Handler:    _Query_GeneralKeyRequestAll_Handler,
+		},
+		{
+			MethodName: "RewardPool",
+			Handler:    _Query_RewardPool_Handler,
+		},
+		{
+			MethodName: "PendingRewards",
+			Handler:    _Query_PendingRewards_Handler,
+		},
+		{
+			MethodName: "KeyShareRequest",
+			Handler:    _Query_KeyShareRequest_Handler,
+		},
+		{
+			MethodName: "KeyShareRequestAll",
+			Handler:    _Query_KeyShareRequestAll_Handler,
+		},
+		{
+			MethodName: "KeyShareRequestsByStatus",
+			Handler:    _Query_KeyShareRequestsByStatus_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "fairyring/keyshare/query.proto",
+}
+
+func (m *QueryCommitmentsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCommitmentsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCommitmentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCommitmentsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCommitmentsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCommitmentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.QueuedCommitments != nil {
+		{
+			size, err := m.QueuedCommitments.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ActiveCommitments != nil {
+		{
+			size, err := m.ActiveCommitments.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetValidatorSetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetValidatorSetRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetValidatorSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetValidatorSetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetValidatorSetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetValidatorSetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ValidatorSet.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetValidatorSetByShareIndexRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetValidatorSetByShareIndexRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetValidatorSetByShareIndexRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ShareIndex != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ShareIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllValidatorSetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllValidatorSetRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllValidatorSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllValidatorSetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllValidatorSetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllValidatorSetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorSet) > 0 {
+		for iNdEx := len(m.ValidatorSet) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorSet[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetKeyShareRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetKeyShareRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetKeyShareRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetKeyShareResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetKeyShareResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetKeyShareResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.KeyShare.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllKeyShareRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllKeyShareRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllKeyShareRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllKeyShareResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllKeyShareResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllKeyShareResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.KeyShare) > 0 {
+		for iNdEx := len(m.KeyShare) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.KeyShare[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetAggregatedKeyShareRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetAggregatedKeyShareRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetAggregatedKeyShareRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetAggregatedKeyShareResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetAggregatedKeyShareResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetAggregatedKeyShareResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.AggregatedKeyShare.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllAggregatedKeyShareRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllAggregatedKeyShareRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllAggregatedKeyShareRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllAggregatedKeyShareResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllAggregatedKeyShareResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllAggregatedKeyShareResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AggregatedKeyShare) > 0 {
+		for iNdEx := len(m.AggregatedKeyShare) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AggregatedKeyShare[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPubKeyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPubKeyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPubKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPubKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPubKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPubKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.QueuedPubKey.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.ActivePubKey.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetAuthorizedAddressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetAuthorizedAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetAuthorizedAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Target) > 0 {
+		i -= len(m.Target)
+		copy(dAtA[i:], m.Target)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Target)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetAuthorizedAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetAuthorizedAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetAuthorizedAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.AuthorizedAddress.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllAuthorizedAddressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllAuthorizedAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllAuthorizedAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllAuthorizedAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllAuthorizedAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllAuthorizedAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AuthorizedAddress) > 0 {
+		for iNdEx := len(m.AuthorizedAddress) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AuthorizedAddress[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetGeneralKeyShareRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetGeneralKeyShareRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetGeneralKeyShareRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.IdValue) > 0 {
+		i -= len(m.IdValue)
+		copy(dAtA[i:], m.IdValue)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.IdValue)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.IdType) > 0 {
+		i -= len(m.IdType)
+		copy(dAtA[i:], m.IdType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.IdType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetGeneralKeyShareResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetGeneralKeyShareResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetGeneralKeyShareResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.GeneralKeyShare.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllGeneralKeyShareRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllGeneralKeyShareRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllGeneralKeyShareRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllGeneralKeyShareResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllGeneralKeyShareResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllGeneralKeyShareResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.GeneralKeyShare) > 0 {
+		for iNdEx := len(m.GeneralKeyShare) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GeneralKeyShare[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetDkgRoundRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetDkgRoundRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetDkgRoundRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetDkgRoundResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetDkgRoundResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetDkgRoundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Complaints) > 0 {
+		for iNdEx := len(m.Complaints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Complaints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Deals) > 0 {
+		for iNdEx := len(m.Deals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.DkgRound.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetKeyshareLivenessRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetKeyshareLivenessRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetKeyshareLivenessRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetKeyshareLivenessResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetKeyshareLivenessResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetKeyshareLivenessResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.KeyshareLivenessInfo.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllKeyshareLivenessRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllKeyshareLivenessRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllKeyshareLivenessRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllKeyshareLivenessResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllKeyshareLivenessResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllKeyshareLivenessResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.KeyshareLivenessInfo) > 0 {
+		for iNdEx := len(m.KeyshareLivenessInfo) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.KeyshareLivenessInfo[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetGeneralKeyRequestRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetGeneralKeyRequestRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetGeneralKeyRequestRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetGeneralKeyRequestResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetGeneralKeyRequestResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetGeneralKeyRequestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.GeneralKeyRequest.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllGeneralKeyRequestRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllGeneralKeyRequestRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllGeneralKeyRequestRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllGeneralKeyRequestResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllGeneralKeyRequestResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllGeneralKeyRequestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.GeneralKeyRequest) > 0 {
+		for iNdEx := len(m.GeneralKeyRequest) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GeneralKeyRequest[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardPoolRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardPoolRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NextDistributionHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NextDistributionHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.RewardPool.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PendingRewards) > 0 {
+		for iNdEx := len(m.PendingRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.AcceptedShares != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AcceptedShares))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryKeyShareRequestsByStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryKeyShareRequestsByStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryKeyShareRequestsByStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryKeyShareRequestsByStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryKeyShareRequestsByStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryKeyShareRequestsByStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.KeyShareRequests) > 0 {
+		for iNdEx := len(m.KeyShareRequests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.KeyShareRequests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetKeyShareRequestRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetKeyShareRequestRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetKeyShareRequestRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetKeyShareRequestResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetKeyShareRequestResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetKeyShareRequestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.KeyShareRequest.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllKeyShareRequestRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllKeyShareRequestRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllKeyShareRequestRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sent {
+		i--
+		if m.Sent {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.FilterBySent {
+		i--
+		if m.FilterBySent {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ProposalId) > 0 {
+		i -= len(m.ProposalId)
+		copy(dAtA[i:], m.ProposalId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProposalId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CounterpartyChannel) > 0 {
+		i -= len(m.CounterpartyChannel)
+		copy(dAtA[i:], m.CounterpartyChannel)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CounterpartyChannel)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllKeyShareRequestResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllKeyShareRequestResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllKeyShareRequestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.KeyShareRequest) > 0 {
+		for iNdEx := len(m.KeyShareRequest) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.KeyShareRequest[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryCommitmentsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryCommitmentsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ActiveCommitments != nil {
+		l = m.ActiveCommitments.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.QueuedCommitments != nil {
+		l = m.QueuedCommitments.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetValidatorSetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetValidatorSetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ValidatorSet.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetValidatorSetByShareIndexRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ShareIndex != 0 {
+		n += 1 + sovQuery(uint64(m.ShareIndex))
+	}
+	return n
+}
+
+func (m *QueryAllValidatorSetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllValidatorSetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ValidatorSet) > 0 {
+		for _, e := range m.ValidatorSet {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetKeyShareRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovQuery(uint64(m.BlockHeight))
+	}
+	return n
+}
+
+func (m *QueryGetKeyShareResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.KeyShare.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllKeyShareRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllKeyShareResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.KeyShare) > 0 {
+		for _, e := range m.KeyShare {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetAggregatedKeyShareRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryGetAggregatedKeyShareResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AggregatedKeyShare.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllAggregatedKeyShareRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllAggregatedKeyShareResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AggregatedKeyShare) > 0 {
+		for _, e := range m.AggregatedKeyShare {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPubKeyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPubKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ActivePubKey.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.QueuedPubKey.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetAuthorizedAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Target)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetAuthorizedAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AuthorizedAddress.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllAuthorizedAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllAuthorizedAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AuthorizedAddress) > 0 {
+		for _, e := range m.AuthorizedAddress {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetGeneralKeyShareRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.IdType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.IdValue)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetGeneralKeyShareResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.GeneralKeyShare.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllGeneralKeyShareRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllGeneralKeyShareResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.GeneralKeyShare) > 0 {
+		for _, e := range m.GeneralKeyShare {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetDkgRoundRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryGetDkgRoundResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.DkgRound.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Deals) > 0 {
+		for _, e := range m.Deals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Complaints) > 0 {
+		for _, e := range m.Complaints {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGetKeyshareLivenessRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetKeyshareLivenessResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.KeyshareLivenessInfo.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllKeyshareLivenessRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllKeyshareLivenessResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.KeyshareLivenessInfo) > 0 {
+		for _, e := range m.KeyshareLivenessInfo {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetGeneralKeyRequestRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetGeneralKeyRequestResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.GeneralKeyRequest.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllGeneralKeyRequestRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllGeneralKeyRequestResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.GeneralKeyRequest) > 0 {
+		for _, e := range m.GeneralKeyRequest {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRewardPoolRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryRewardPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RewardPool.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.NextDistributionHeight != 0 {
+		n += 1 + sovQuery(uint64(m.NextDistributionHeight))
+	}
+	return n
+}
+
+func (m *QueryPendingRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AcceptedShares != 0 {
+		n += 1 + sovQuery(uint64(m.AcceptedShares))
+	}
+	if len(m.PendingRewards) > 0 {
+		for _, e := range m.PendingRewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryKeyShareRequestsByStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryKeyShareRequestsByStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.KeyShareRequests) > 0 {
+		for _, e := range m.KeyShareRequests {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetKeyShareRequestRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetKeyShareRequestResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.KeyShareRequest.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllKeyShareRequestRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.CounterpartyChannel)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ProposalId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.FilterBySent {
+		n += 2
+	}
+	if m.Sent {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryAllKeyShareRequestResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.KeyShareRequest) > 0 {
+		for _, e := range m.KeyShareRequest {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryCommitmentsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCommitmentsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCommitmentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCommitmentsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCommitmentsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCommitmentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveCommitments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ActiveCommitments == nil {
+				m.ActiveCommitments = &Commitments{}
+			}
+			if err := m.ActiveCommitments.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedCommitments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.QueuedCommitments == nil {
+				m.QueuedCommitments = &Commitments{}
+			}
+			if err := m.QueuedCommitments.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetValidatorSetRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetValidatorSetRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetValidatorSetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetValidatorSetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetValidatorSetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetValidatorSetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidatorSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetValidatorSetByShareIndexRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetValidatorSetByShareIndexRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetValidatorSetByShareIndexRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareIndex", wireType)
+			}
+			m.ShareIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShareIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllValidatorSetRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllValidatorSetRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllValidatorSetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllValidatorSetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllValidatorSetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllValidatorSetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorSet = append(m.ValidatorSet, ValidatorSet{})
+			if err := m.ValidatorSet[len(m.ValidatorSet)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetKeyShareRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetKeyShareRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetKeyShareRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetKeyShareResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetKeyShareResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetKeyShareResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyShare", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.KeyShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllKeyShareRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllKeyShareRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllKeyShareRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllKeyShareResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllKeyShareResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllKeyShareResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyShare", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyShare = append(m.KeyShare, KeyShare{})
+			if err := m.KeyShare[len(m.KeyShare)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetAggregatedKeyShareRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetAggregatedKeyShareRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetAggregatedKeyShareRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetAggregatedKeyShareResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetAggregatedKeyShareResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetAggregatedKeyShareResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregatedKeyShare", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AggregatedKeyShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllAggregatedKeyShareRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllAggregatedKeyShareRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllAggregatedKeyShareRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllAggregatedKeyShareResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllAggregatedKeyShareResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllAggregatedKeyShareResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregatedKeyShare", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AggregatedKeyShare = append(m.AggregatedKeyShare, AggregatedKeyShare{})
+			if err := m.AggregatedKeyShare[len(m.AggregatedKeyShare)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPubKeyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPubKeyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPubKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPubKeyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPubKeyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPubKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivePubKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ActivePubKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedPubKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.QueuedPubKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetAuthorizedAddressRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetAuthorizedAddressRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetAuthorizedAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Target = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
+func (m *QueryGetAuthorizedAddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetAuthorizedAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetAuthorizedAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthorizedAddress", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AuthorizedAddress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryCommitmentsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllAuthorizedAddressRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3223,12 +7700,48 @@ func (m *QueryCommitmentsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCommitmentsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllAuthorizedAddressRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCommitmentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllAuthorizedAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3250,7 +7763,7 @@ func (m *QueryCommitmentsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCommitmentsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllAuthorizedAddressResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3273,15 +7786,15 @@ func (m *QueryCommitmentsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCommitmentsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllAuthorizedAddressResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCommitmentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllAuthorizedAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActiveCommitments", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthorizedAddress", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3308,16 +7821,14 @@ func (m *QueryCommitmentsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ActiveCommitments == nil {
-				m.ActiveCommitments = &Commitments{}
-			}
-			if err := m.ActiveCommitments.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.AuthorizedAddress = append(m.AuthorizedAddress, AuthorizedAddress{})
+			if err := m.AuthorizedAddress[len(m.AuthorizedAddress)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QueuedCommitments", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3344,10 +7855,10 @@ func (m *QueryCommitmentsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.QueuedCommitments == nil {
-				m.QueuedCommitments = &Commitments{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-			if err := m.QueuedCommitments.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3372,7 +7883,7 @@ func (m *QueryCommitmentsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetGeneralKeyShareRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3382,25 +7893,121 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowQuery
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetGeneralKeyShareRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetGeneralKeyShareRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IdType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IdType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IdValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IdValue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3422,7 +8029,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetGeneralKeyShareResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3445,15 +8052,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetGeneralKeyShareResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetGeneralKeyShareResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GeneralKeyShare", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3480,7 +8087,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.GeneralKeyShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3505,7 +8112,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetValidatorSetRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllGeneralKeyShareRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3528,17 +8135,17 @@ func (m *QueryGetValidatorSetRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetValidatorSetRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllGeneralKeyShareRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetValidatorSetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllGeneralKeyShareRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3548,23 +8155,27 @@ func (m *QueryGetValidatorSetRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Index = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3587,7 +8198,7 @@ func (m *QueryGetValidatorSetRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetValidatorSetResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllGeneralKeyShareResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3610,15 +8221,15 @@ func (m *QueryGetValidatorSetResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetValidatorSetResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllGeneralKeyShareResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetValidatorSetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllGeneralKeyShareResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GeneralKeyShare", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3645,7 +8256,44 @@ func (m *QueryGetValidatorSetResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ValidatorSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.GeneralKeyShare = append(m.GeneralKeyShare, GeneralKeyShare{})
+			if err := m.GeneralKeyShare[len(m.GeneralKeyShare)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3670,7 +8318,7 @@ func (m *QueryGetValidatorSetResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllValidatorSetRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetDkgRoundRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3693,17 +8341,17 @@ func (m *QueryAllValidatorSetRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllValidatorSetRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetDkgRoundRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllValidatorSetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetDkgRoundRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3713,28 +8361,11 @@ func (m *QueryAllValidatorSetRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3756,7 +8387,7 @@ func (m *QueryAllValidatorSetRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllValidatorSetResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetDkgRoundResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3779,15 +8410,15 @@ func (m *QueryAllValidatorSetResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllValidatorSetResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetDkgRoundResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllValidatorSetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetDkgRoundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DkgRound", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3814,14 +8445,13 @@ func (m *QueryAllValidatorSetResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorSet = append(m.ValidatorSet, ValidatorSet{})
-			if err := m.ValidatorSet[len(m.ValidatorSet)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DkgRound.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deals", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3848,10 +8478,42 @@ func (m *QueryAllValidatorSetResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			m.Deals = append(m.Deals, DkgDeal{})
+			if err := m.Deals[len(m.Deals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Complaints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Complaints = append(m.Complaints, DkgComplaint{})
+			if err := m.Complaints[len(m.Complaints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3876,7 +8538,7 @@ func (m *QueryAllValidatorSetResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetKeyShareRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetKeyshareLivenessRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3899,10 +8561,10 @@ func (m *QueryGetKeyShareRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetKeyShareRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetKeyshareLivenessRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetKeyShareRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetKeyshareLivenessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3937,25 +8599,6 @@ func (m *QueryGetKeyShareRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Validator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
-			}
-			m.BlockHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BlockHeight |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3977,7 +8620,7 @@ func (m *QueryGetKeyShareRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetKeyShareResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetKeyshareLivenessResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4000,15 +8643,15 @@ func (m *QueryGetKeyShareResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetKeyShareResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetKeyshareLivenessResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetKeyShareResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetKeyshareLivenessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyShare", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyshareLivenessInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4035,7 +8678,7 @@ func (m *QueryGetKeyShareResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.KeyShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.KeyshareLivenessInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4060,7 +8703,7 @@ func (m *QueryGetKeyShareResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllKeyShareRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllKeyshareLivenessRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4083,10 +8726,10 @@ func (m *QueryAllKeyShareRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllKeyShareRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllKeyshareLivenessRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllKeyShareRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllKeyshareLivenessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4146,7 +8789,7 @@ func (m *QueryAllKeyShareRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllKeyShareResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllKeyshareLivenessResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4169,15 +8812,15 @@ func (m *QueryAllKeyShareResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllKeyShareResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllKeyshareLivenessResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllKeyShareResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllKeyshareLivenessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyShare", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyshareLivenessInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4204,8 +8847,8 @@ func (m *QueryAllKeyShareResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KeyShare = append(m.KeyShare, KeyShare{})
-			if err := m.KeyShare[len(m.KeyShare)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.KeyshareLivenessInfo = append(m.KeyshareLivenessInfo, KeyshareLivenessInfo{})
+			if err := m.KeyshareLivenessInfo[len(m.KeyshareLivenessInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4266,7 +8909,7 @@ func (m *QueryAllKeyShareResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetAggregatedKeyShareRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetGeneralKeyRequestRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4289,17 +8932,17 @@ func (m *QueryGetAggregatedKeyShareRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetAggregatedKeyShareRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetGeneralKeyRequestRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetAggregatedKeyShareRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetGeneralKeyRequestRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
 			}
-			m.Height = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4309,11 +8952,24 @@ func (m *QueryGetAggregatedKeyShareRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4335,7 +8991,7 @@ func (m *QueryGetAggregatedKeyShareRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetAggregatedKeyShareResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetGeneralKeyRequestResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4358,15 +9014,15 @@ func (m *QueryGetAggregatedKeyShareResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetAggregatedKeyShareResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetGeneralKeyRequestResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetAggregatedKeyShareResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetGeneralKeyRequestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AggregatedKeyShare", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GeneralKeyRequest", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4393,7 +9049,7 @@ func (m *QueryGetAggregatedKeyShareResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AggregatedKeyShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.GeneralKeyRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4418,7 +9074,7 @@ func (m *QueryGetAggregatedKeyShareResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllAggregatedKeyShareRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllGeneralKeyRequestRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4441,10 +9097,10 @@ func (m *QueryAllAggregatedKeyShareRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllAggregatedKeyShareRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllGeneralKeyRequestRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllAggregatedKeyShareRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllGeneralKeyRequestRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4504,7 +9160,7 @@ func (m *QueryAllAggregatedKeyShareRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllAggregatedKeyShareResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllGeneralKeyRequestResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4527,15 +9183,15 @@ func (m *QueryAllAggregatedKeyShareResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllAggregatedKeyShareResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllGeneralKeyRequestResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllAggregatedKeyShareResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllGeneralKeyRequestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AggregatedKeyShare", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GeneralKeyRequest", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4561,9 +9217,9 @@ func (m *QueryAllAggregatedKeyShareResponse) Unmarshal(dAtA []byte) error {
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
-			}
-			m.AggregatedKeyShare = append(m.AggregatedKeyShare, AggregatedKeyShare{})
-			if err := m.AggregatedKeyShare[len(m.AggregatedKeyShare)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			}
+			m.GeneralKeyRequest = append(m.GeneralKeyRequest, GeneralKeyRequest{})
+			if err := m.GeneralKeyRequest[len(m.GeneralKeyRequest)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4624,7 +9280,7 @@ func (m *QueryAllAggregatedKeyShareResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPubKeyRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRewardPoolRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4647,10 +9303,10 @@ func (m *QueryPubKeyRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPubKeyRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRewardPoolRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPubKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRewardPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4674,7 +9330,7 @@ func (m *QueryPubKeyRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPubKeyResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRewardPoolResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4697,15 +9353,15 @@ func (m *QueryPubKeyResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPubKeyResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRewardPoolResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPubKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRewardPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActivePubKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPool", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4732,15 +9388,15 @@ func (m *QueryPubKeyResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ActivePubKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RewardPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QueuedPubKey", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextDistributionHeight", wireType)
 			}
-			var msglen int
+			m.NextDistributionHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4750,25 +9406,11 @@ func (m *QueryPubKeyResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.NextDistributionHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.QueuedPubKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4790,7 +9432,7 @@ func (m *QueryPubKeyResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetAuthorizedAddressRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPendingRewardsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4813,15 +9455,15 @@ func (m *QueryGetAuthorizedAddressRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetAuthorizedAddressRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPendingRewardsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetAuthorizedAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPendingRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4849,7 +9491,7 @@ func (m *QueryGetAuthorizedAddressRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Target = string(dAtA[iNdEx:postIndex])
+			m.Validator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4872,7 +9514,7 @@ func (m *QueryGetAuthorizedAddressRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetAuthorizedAddressResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPendingRewardsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4895,15 +9537,34 @@ func (m *QueryGetAuthorizedAddressResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetAuthorizedAddressResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPendingRewardsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetAuthorizedAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPendingRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcceptedShares", wireType)
+			}
+			m.AcceptedShares = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AcceptedShares |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuthorizedAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingRewards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4930,7 +9591,8 @@ func (m *QueryGetAuthorizedAddressResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AuthorizedAddress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PendingRewards = append(m.PendingRewards, types.Coin{})
+			if err := m.PendingRewards[len(m.PendingRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4955,7 +9617,7 @@ func (m *QueryGetAuthorizedAddressResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllAuthorizedAddressRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryKeyShareRequestsByStatusRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4978,13 +9640,32 @@ func (m *QueryAllAuthorizedAddressRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllAuthorizedAddressRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryKeyShareRequestsByStatusRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllAuthorizedAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryKeyShareRequestsByStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= KeyShareRequestStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -5041,7 +9722,7 @@ func (m *QueryAllAuthorizedAddressRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllAuthorizedAddressResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryKeyShareRequestsByStatusResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5064,15 +9745,15 @@ func (m *QueryAllAuthorizedAddressResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllAuthorizedAddressResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryKeyShareRequestsByStatusResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllAuthorizedAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryKeyShareRequestsByStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuthorizedAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyShareRequests", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5099,8 +9780,8 @@ func (m *QueryAllAuthorizedAddressResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AuthorizedAddress = append(m.AuthorizedAddress, AuthorizedAddress{})
-			if err := m.AuthorizedAddress[len(m.AuthorizedAddress)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.KeyShareRequests = append(m.KeyShareRequests, KeyShareRequest{})
+			if err := m.KeyShareRequests[len(m.KeyShareRequests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5161,7 +9842,7 @@ func (m *QueryAllAuthorizedAddressResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetGeneralKeyShareRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetKeyShareRequestRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5184,79 +9865,15 @@ func (m *QueryGetGeneralKeyShareRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetGeneralKeyShareRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetKeyShareRequestRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetGeneralKeyShareRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetKeyShareRequestRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Validator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IdType", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.IdType = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IdValue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5284,7 +9901,7 @@ func (m *QueryGetGeneralKeyShareRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IdValue = string(dAtA[iNdEx:postIndex])
+			m.Identity = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5307,7 +9924,7 @@ func (m *QueryGetGeneralKeyShareRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetGeneralKeyShareResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetKeyShareRequestResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5330,15 +9947,15 @@ func (m *QueryGetGeneralKeyShareResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetGeneralKeyShareResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetKeyShareRequestResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetGeneralKeyShareResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetKeyShareRequestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GeneralKeyShare", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyShareRequest", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5365,7 +9982,7 @@ func (m *QueryGetGeneralKeyShareResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.GeneralKeyShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.KeyShareRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5390,7 +10007,7 @@ func (m *QueryGetGeneralKeyShareResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllGeneralKeyShareRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllKeyShareRequestRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5413,10 +10030,10 @@ func (m *QueryAllGeneralKeyShareRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllGeneralKeyShareRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllKeyShareRequestRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllGeneralKeyShareRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllKeyShareRequestRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5455,6 +10072,110 @@ func (m *QueryAllGeneralKeyShareRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyChannel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CounterpartyChannel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposalId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FilterBySent", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FilterBySent = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sent", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Sent = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5476,7 +10197,7 @@ func (m *QueryAllGeneralKeyShareRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllGeneralKeyShareResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllKeyShareRequestResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5499,15 +10220,15 @@ func (m *QueryAllGeneralKeyShareResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllGeneralKeyShareResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllKeyShareRequestResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllGeneralKeyShareResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllKeyShareRequestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GeneralKeyShare", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyShareRequest", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5534,8 +10255,8 @@ func (m *QueryAllGeneralKeyShareResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GeneralKeyShare = append(m.GeneralKeyShare, GeneralKeyShare{})
-			if err := m.GeneralKeyShare[len(m.GeneralKeyShare)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.KeyShareRequest = append(m.KeyShareRequest, KeyShareRequest{})
+			if err := m.KeyShareRequest[len(m.KeyShareRequest)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -22,6 +22,42 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// KeyShareRequestStatus is the delivery status of a KeyShareRequest
+type KeyShareRequestStatus int32
+
+const (
+	// the aggregated key is not available yet
+	KeyShareRequestStatus_KEY_SHARE_REQUEST_STATUS_PENDING KeyShareRequestStatus = 0
+	// the aggregated key is available but not delivered yet
+	KeyShareRequestStatus_KEY_SHARE_REQUEST_STATUS_AGGREGATED KeyShareRequestStatus = 1
+	// the aggregated key was delivered to the counterparty
+	KeyShareRequestStatus_KEY_SHARE_REQUEST_STATUS_SENT KeyShareRequestStatus = 2
+	// the aggregated key could not be delivered before the deadline or the packet retries ran out
+	KeyShareRequestStatus_KEY_SHARE_REQUEST_STATUS_FAILED KeyShareRequestStatus = 3
+)
+
+var KeyShareRequestStatus_name = map[int32]string{
+	0: "KEY_SHARE_REQUEST_STATUS_PENDING",
+	1: "KEY_SHARE_REQUEST_STATUS_AGGREGATED",
+	2: "KEY_SHARE_REQUEST_STATUS_SENT",
+	3: "KEY_SHARE_REQUEST_STATUS_FAILED",
+}
+
+var KeyShareRequestStatus_value = map[string]int32{
+	"KEY_SHARE_REQUEST_STATUS_PENDING":    0,
+	"KEY_SHARE_REQUEST_STATUS_AGGREGATED": 1,
+	"KEY_SHARE_REQUEST_STATUS_SENT":       2,
+	"KEY_SHARE_REQUEST_STATUS_FAILED":     3,
+}
+
+func (x KeyShareRequestStatus) String() string {
+	return proto.EnumName(KeyShareRequestStatus_name, int32(x))
+}
+
+func (KeyShareRequestStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e8ed024b19ae59bd, []int{0}
+}
+
 type KeyShareRequest struct {
 	Identity     string               `protobuf:"bytes,1,opt,name=identity,proto3" json:"identity,omitempty"`
 	Pubkey       string               `protobuf:"bytes,2,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
@@ -30,6 +66,22 @@ type KeyShareRequest struct {
 	AggrKeyshare string               `protobuf:"bytes,5,opt,name=aggr_keyshare,json=aggrKeyshare,proto3" json:"aggr_keyshare,omitempty"`
 	ProposalId   string               `protobuf:"bytes,6,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
 	Sent         bool                 `protobuf:"varint,7,opt,name=sent,proto3" json:"sent,omitempty"`
+	// height the request was received at
+	CreatedHeight uint64 `protobuf:"varint,8,opt,name=created_height,json=createdHeight,proto3" json:"created_height,omitempty"`
+	// height the aggregated key has to be delivered by, the request is marked failed afterwards
+	Deadline uint64 `protobuf:"varint,9,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	// set when the aggregated key could not be delivered to the counterparty
+	Failed bool `protobuf:"varint,10,opt,name=failed,proto3" json:"failed,omitempty"`
+	// height the request was delivered or marked failed at
+	ClosedHeight uint64 `protobuf:"varint,11,opt,name=closed_height,json=closedHeight,proto3" json:"closed_height,omitempty"`
+	// height the key was aggregated at
+	AggrHeight uint64 `protobuf:"varint,12,opt,name=aggr_height,json=aggrHeight,proto3" json:"aggr_height,omitempty"`
+	// sequence of the aggregated key packet awaiting its acknowledgement or timeout, 0 if none is in flight
+	InflightSequence uint64 `protobuf:"varint,13,opt,name=inflight_sequence,json=inflightSequence,proto3" json:"inflight_sequence,omitempty"`
+	// height the aggregated key packet is queued to be sent again at, 0 if no retry is queued
+	RetryHeight uint64 `protobuf:"varint,14,opt,name=retry_height,json=retryHeight,proto3" json:"retry_height,omitempty"`
+	// height of the last MsgResendAggrKeyshare for the request
+	LastResendHeight uint64 `protobuf:"varint,15,opt,name=last_resend_height,json=lastResendHeight,proto3" json:"last_resend_height,omitempty"`
 }
 
 func (m *KeyShareRequest) Reset()         { *m = KeyShareRequest{} }
@@ -114,6 +166,62 @@ func (m *KeyShareRequest) GetSent() bool {
 	return false
 }
 
+func (m *KeyShareRequest) GetCreatedHeight() uint64 {
+	if m != nil {
+		return m.CreatedHeight
+	}
+	return 0
+}
+
+func (m *KeyShareRequest) GetDeadline() uint64 {
+	if m != nil {
+		return m.Deadline
+	}
+	return 0
+}
+
+func (m *KeyShareRequest) GetFailed() bool {
+	if m != nil {
+		return m.Failed
+	}
+	return false
+}
+
+func (m *KeyShareRequest) GetClosedHeight() uint64 {
+	if m != nil {
+		return m.ClosedHeight
+	}
+	return 0
+}
+
+func (m *KeyShareRequest) GetAggrHeight() uint64 {
+	if m != nil {
+		return m.AggrHeight
+	}
+	return 0
+}
+
+func (m *KeyShareRequest) GetInflightSequence() uint64 {
+	if m != nil {
+		return m.InflightSequence
+	}
+	return 0
+}
+
+func (m *KeyShareRequest) GetRetryHeight() uint64 {
+	if m != nil {
+		return m.RetryHeight
+	}
+	return 0
+}
+
+func (m *KeyShareRequest) GetLastResendHeight() uint64 {
+	if m != nil {
+		return m.LastResendHeight
+	}
+	return 0
+}
+
 type IBCInfo struct {
 	ClientID     string `protobuf:"bytes,1,opt,name=ClientID,proto3" json:"ClientID,omitempty"`
 	ConnectionID string `protobuf:"bytes,2,opt,name=ConnectionID,proto3" json:"ConnectionID,omitempty"`
@@ -251,6 +359,7 @@ func (m *CounterPartyIBCInfo) GetPortID() string {
 }
 
 func init() {
+	proto.RegisterEnum("fairyring.keyshare.KeyShareRequestStatus", KeyShareRequestStatus_name, KeyShareRequestStatus_value)
 	proto.RegisterType((*KeyShareRequest)(nil), "fairyring.keyshare.KeyShareRequest")
 	proto.RegisterType((*IBCInfo)(nil), "fairyring.keyshare.IBCInfo")
 	proto.RegisterType((*CounterPartyIBCInfo)(nil), "fairyring.keyshare.CounterPartyIBCInfo")
@@ -261,31 +370,45 @@ func init() {
 }
 
 var fileDescriptor_e8ed024b19ae59bd = []byte{
-	// 370 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x52, 0xbb, 0x4e, 0x02, 0x41,
-	0x14, 0x65, 0x10, 0x79, 0x5c, 0x30, 0x26, 0x63, 0x62, 0x36, 0x68, 0x56, 0xb2, 0x16, 0x92, 0x98,
-	0x2c, 0x89, 0x1a, 0x3f, 0x80, 0xa5, 0xd9, 0xd0, 0x90, 0xb5, 0xb3, 0x21, 0xfb, 0xb8, 0xc0, 0x04,
-	0x32, 0xb3, 0xce, 0x0e, 0x89, 0x5b, 0xf9, 0x01, 0x36, 0x7e, 0x84, 0x1f, 0x63, 0x49, 0x69, 0x69,
-	0xe0, 0x47, 0xcc, 0xbe, 0x20, 0x44, 0x7a, 0xbb, 0x39, 0xe7, 0x9e, 0x73, 0xef, 0x9d, 0x93, 0x0b,
-	0xb7, 0x13, 0x97, 0xc9, 0x58, 0x32, 0x3e, 0xed, 0xcd, 0x31, 0x8e, 0x66, 0xae, 0xc4, 0x9e, 0xc4,
-	0x97, 0x25, 0x46, 0x0a, 0x83, 0x71, 0x41, 0x99, 0xa1, 0x14, 0x4a, 0x50, 0xba, 0x15, 0x9b, 0x45,
-	0xc5, 0xf8, 0x2c, 0xc3, 0xe9, 0x10, 0xe3, 0xa7, 0x04, 0x38, 0x99, 0x91, 0xb6, 0xa1, 0xce, 0x02,
-	0xe4, 0x8a, 0xa9, 0x58, 0x23, 0x1d, 0xd2, 0x6d, 0x38, 0x5b, 0x4c, 0xcf, 0xa1, 0x1a, 0x2e, 0xbd,
-	0x39, 0xc6, 0x5a, 0x39, 0xad, 0xe4, 0x88, 0x3e, 0x42, 0x9d, 0x79, 0xfe, 0x98, 0xf1, 0x89, 0xd0,
-	0x8e, 0x3a, 0xa4, 0xdb, 0xbc, 0xbb, 0x30, 0xff, 0x8e, 0x33, 0xed, 0xbe, 0x65, 0xf3, 0x89, 0x70,
-	0x6a, 0xcc, 0xf3, 0x93, 0x07, 0x1d, 0x42, 0xcb, 0x17, 0x4b, 0xae, 0x50, 0x86, 0xae, 0x54, 0xb1,
-	0x56, 0x49, 0xbd, 0x37, 0x87, 0xbc, 0x56, 0xa6, 0x1b, 0x25, 0xba, 0xa2, 0xcf, 0x9e, 0x99, 0x5e,
-	0xc3, 0x89, 0x3b, 0x9d, 0xca, 0xed, 0xbf, 0xb5, 0xe3, 0x74, 0xc7, 0x56, 0x42, 0x0e, 0x73, 0x8e,
-	0x5e, 0x41, 0x33, 0x94, 0x22, 0x14, 0x91, 0xbb, 0x18, 0xb3, 0x40, 0xab, 0xa6, 0x12, 0x28, 0x28,
-	0x3b, 0xa0, 0x14, 0x2a, 0x11, 0x72, 0xa5, 0xd5, 0x3a, 0xa4, 0x5b, 0x77, 0xd2, 0xb7, 0xf1, 0x06,
-	0xb5, 0x7c, 0x64, 0x92, 0x8e, 0xb5, 0x60, 0xc8, 0x95, 0x3d, 0x28, 0xd2, 0x29, 0x30, 0x35, 0xa0,
-	0x65, 0x09, 0xce, 0xd1, 0x57, 0x4c, 0x70, 0x7b, 0x90, 0x67, 0xb4, 0xc7, 0xd1, 0x4b, 0x68, 0x58,
-	0x33, 0x97, 0x73, 0x5c, 0xd8, 0x83, 0x34, 0xaa, 0x86, 0xb3, 0x23, 0x92, 0x7c, 0x47, 0x42, 0x26,
-	0xbd, 0x2b, 0x59, 0xbe, 0x19, 0x32, 0xde, 0x09, 0x9c, 0x1d, 0x08, 0xe0, 0x7f, 0xb6, 0xe9, 0x3f,
-	0x7c, 0xad, 0x75, 0xb2, 0x5a, 0xeb, 0xe4, 0x67, 0xad, 0x93, 0x8f, 0x8d, 0x5e, 0x5a, 0x6d, 0xf4,
-	0xd2, 0xf7, 0x46, 0x2f, 0x3d, 0xb7, 0x77, 0x07, 0xf9, 0xba, 0x3b, 0x49, 0x15, 0x87, 0x18, 0x79,
-	0xd5, 0xf4, 0x0c, 0xef, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x0f, 0xa4, 0x3c, 0x49, 0xb5, 0x02,
-	0x00, 0x00,
+	// 595 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x94, 0xc1, 0x6e, 0xd3, 0x4c,
+	0x10, 0xc7, 0xe3, 0x36, 0x5f, 0x9b, 0x4c, 0xdc, 0x36, 0xdf, 0x22, 0x90, 0x55, 0xc0, 0x4d, 0x53,
+	0x50, 0x23, 0x8a, 0x52, 0x09, 0x10, 0xf7, 0x34, 0x36, 0xa9, 0x15, 0x14, 0x05, 0x3b, 0x3d, 0xc0,
+	0xc5, 0x72, 0xec, 0x4d, 0xb2, 0xaa, 0xb5, 0x36, 0xeb, 0x8d, 0x84, 0x4f, 0x3c, 0x00, 0x17, 0x9e,
+	0x84, 0x97, 0xe0, 0xc2, 0xb1, 0x47, 0x8e, 0x28, 0x79, 0x11, 0xb4, 0x6b, 0x3b, 0x51, 0xa1, 0x39,
+	0x73, 0xdb, 0xf9, 0xcf, 0x6f, 0xfe, 0x19, 0xcf, 0x4e, 0x16, 0xce, 0x26, 0x1e, 0x61, 0x29, 0x23,
+	0x74, 0x7a, 0x7e, 0x8d, 0xd3, 0x64, 0xe6, 0x31, 0x7c, 0xce, 0xf0, 0xc7, 0x39, 0x4e, 0x38, 0x0e,
+	0xdc, 0x42, 0x6a, 0xc7, 0x2c, 0xe2, 0x11, 0x42, 0x2b, 0xb8, 0x5d, 0x64, 0x9a, 0xdf, 0xcb, 0x70,
+	0xd0, 0xc7, 0xa9, 0x23, 0x02, 0x3b, 0x2b, 0x44, 0x87, 0x50, 0x21, 0x01, 0xa6, 0x9c, 0xf0, 0x54,
+	0x53, 0x1a, 0x4a, 0xab, 0x6a, 0xaf, 0x62, 0xf4, 0x00, 0x76, 0xe2, 0xf9, 0xf8, 0x1a, 0xa7, 0xda,
+	0x96, 0xcc, 0xe4, 0x11, 0x7a, 0x0d, 0x15, 0x32, 0xf6, 0x5d, 0x42, 0x27, 0x91, 0xb6, 0xdd, 0x50,
+	0x5a, 0xb5, 0x17, 0x0f, 0xdb, 0x7f, 0xff, 0x5c, 0xdb, 0xba, 0xe8, 0x5a, 0x74, 0x12, 0xd9, 0xbb,
+	0x64, 0xec, 0x8b, 0x03, 0xea, 0x83, 0xea, 0x47, 0x73, 0xca, 0x31, 0x8b, 0x3d, 0xc6, 0x53, 0xad,
+	0x2c, 0x6b, 0x4f, 0xef, 0xaa, 0xed, 0x66, 0xdc, 0x50, 0x70, 0x85, 0xcf, 0xad, 0x62, 0x74, 0x02,
+	0x7b, 0xde, 0x74, 0xca, 0x56, 0xdf, 0xad, 0xfd, 0x27, 0x7b, 0x54, 0x85, 0xd8, 0xcf, 0x35, 0x74,
+	0x04, 0xb5, 0x98, 0x45, 0x71, 0x94, 0x78, 0xa1, 0x4b, 0x02, 0x6d, 0x47, 0x22, 0x50, 0x48, 0x56,
+	0x80, 0x10, 0x94, 0x13, 0x4c, 0xb9, 0xb6, 0xdb, 0x50, 0x5a, 0x15, 0x5b, 0x9e, 0xd1, 0x53, 0xd8,
+	0xf7, 0x19, 0xf6, 0xc4, 0x50, 0x67, 0x98, 0x4c, 0x67, 0x5c, 0xab, 0x34, 0x94, 0x56, 0xd9, 0xde,
+	0xcb, 0xd5, 0x4b, 0x29, 0x8a, 0xc9, 0x05, 0xd8, 0x0b, 0x42, 0x42, 0xb1, 0x56, 0x95, 0xc0, 0x2a,
+	0x16, 0x93, 0x9b, 0x78, 0x24, 0xc4, 0x81, 0x06, 0xd2, 0x38, 0x8f, 0x44, 0xd3, 0x7e, 0x18, 0x25,
+	0x6b, 0xe7, 0x9a, 0x2c, 0x54, 0x33, 0x31, 0x37, 0x3e, 0x82, 0x9a, 0xfc, 0xb2, 0x1c, 0x51, 0x25,
+	0x02, 0x42, 0xca, 0x81, 0x33, 0xf8, 0x9f, 0xd0, 0x49, 0x28, 0xce, 0x6e, 0x22, 0xee, 0x91, 0xfa,
+	0x58, 0xdb, 0x93, 0x58, 0xbd, 0x48, 0x38, 0xb9, 0x8e, 0x8e, 0x41, 0x65, 0x98, 0xb3, 0xb4, 0xb0,
+	0xdb, 0x97, 0x5c, 0x4d, 0x6a, 0xb9, 0xdf, 0x73, 0x40, 0xa1, 0x97, 0x70, 0x97, 0xe1, 0x04, 0xd3,
+	0x55, 0x6b, 0x07, 0x99, 0xa1, 0xc8, 0xd8, 0x32, 0x91, 0xd1, 0xcd, 0xcf, 0xb0, 0x9b, 0xdf, 0x88,
+	0x18, 0x41, 0x37, 0x24, 0x98, 0x72, 0xcb, 0x28, 0x96, 0xa7, 0x88, 0x51, 0x13, 0xd4, 0x6e, 0x44,
+	0x29, 0xf6, 0x39, 0x89, 0xa8, 0x65, 0xe4, 0x2b, 0x74, 0x4b, 0x43, 0x8f, 0xa0, 0xda, 0x9d, 0x79,
+	0x94, 0xe2, 0xd0, 0x32, 0xe4, 0x26, 0x55, 0xed, 0xb5, 0x20, 0x86, 0x38, 0x8c, 0x98, 0xf0, 0x2e,
+	0x67, 0xeb, 0x97, 0x45, 0xcd, 0x2f, 0x0a, 0xdc, 0xbb, 0x63, 0x3f, 0xfe, 0x4d, 0x37, 0xcf, 0xbe,
+	0x29, 0x70, 0xff, 0x8f, 0x3f, 0x95, 0xc3, 0x3d, 0x3e, 0x4f, 0xd0, 0x13, 0x68, 0xf4, 0xcd, 0xf7,
+	0xae, 0x73, 0xd9, 0xb1, 0x4d, 0xd7, 0x36, 0xdf, 0x5d, 0x99, 0xce, 0xc8, 0x75, 0x46, 0x9d, 0xd1,
+	0x95, 0xe3, 0x0e, 0xcd, 0x81, 0x61, 0x0d, 0x7a, 0xf5, 0x12, 0x3a, 0x85, 0x93, 0x8d, 0x54, 0xa7,
+	0xd7, 0xb3, 0xcd, 0x5e, 0x67, 0x64, 0x1a, 0x75, 0x05, 0x1d, 0xc3, 0xe3, 0x8d, 0xa0, 0x63, 0x0e,
+	0x46, 0xf5, 0x2d, 0x74, 0x02, 0x47, 0x1b, 0x91, 0x37, 0x1d, 0xeb, 0xad, 0x69, 0xd4, 0xb7, 0x2f,
+	0x5e, 0xfd, 0x58, 0xe8, 0xca, 0xcd, 0x42, 0x57, 0x7e, 0x2d, 0x74, 0xe5, 0xeb, 0x52, 0x2f, 0xdd,
+	0x2c, 0xf5, 0xd2, 0xcf, 0xa5, 0x5e, 0xfa, 0x70, 0xb8, 0x7e, 0x60, 0x3e, 0xad, 0x9f, 0x18, 0x9e,
+	0xc6, 0x38, 0x19, 0xef, 0xc8, 0x67, 0xe5, 0xe5, 0xef, 0x01, 0x00, 0x0b, 0x62, 0x86, 0xd6, 0x85,
+	0x04, 0x00, 0x00,
 }
 
 func (m *KeyShareRequest) Marshal() (dAtA []byte, err error) {
@@ -308,6 +431,51 @@ func (m *KeyShareRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.LastResendHeight != 0 {
+		i = encodeVarintRequestedKeyshare(dAtA, i, uint64(m.LastResendHeight))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.RetryHeight != 0 {
+		i = encodeVarintRequestedKeyshare(dAtA, i, uint64(m.RetryHeight))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.InflightSequence != 0 {
+		i = encodeVarintRequestedKeyshare(dAtA, i, uint64(m.InflightSequence))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.AggrHeight != 0 {
+		i = encodeVarintRequestedKeyshare(dAtA, i, uint64(m.AggrHeight))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.ClosedHeight != 0 {
+		i = encodeVarintRequestedKeyshare(dAtA, i, uint64(m.ClosedHeight))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.Failed {
+		i--
+		if m.Failed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Deadline != 0 {
+		i = encodeVarintRequestedKeyshare(dAtA, i, uint64(m.Deadline))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.CreatedHeight != 0 {
+		i = encodeVarintRequestedKeyshare(dAtA, i, uint64(m.CreatedHeight))
+		i--
+		dAtA[i] = 0x40
+	}
 	if m.Sent {
 		i--
 		if m.Sent {
@@ -519,6 +687,30 @@ func (m *KeyShareRequest) Size() (n int) {
 	if m.Sent {
 		n += 2
 	}
+	if m.CreatedHeight != 0 {
+		n += 1 + sovRequestedKeyshare(uint64(m.CreatedHeight))
+	}
+	if m.Deadline != 0 {
+		n += 1 + sovRequestedKeyshare(uint64(m.Deadline))
+	}
+	if m.Failed {
+		n += 2
+	}
+	if m.ClosedHeight != 0 {
+		n += 1 + sovRequestedKeyshare(uint64(m.ClosedHeight))
+	}
+	if m.AggrHeight != 0 {
+		n += 1 + sovRequestedKeyshare(uint64(m.AggrHeight))
+	}
+	if m.InflightSequence != 0 {
+		n += 1 + sovRequestedKeyshare(uint64(m.InflightSequence))
+	}
+	if m.RetryHeight != 0 {
+		n += 1 + sovRequestedKeyshare(uint64(m.RetryHeight))
+	}
+	if m.LastResendHeight != 0 {
+		n += 1 + sovRequestedKeyshare(uint64(m.LastResendHeight))
+	}
 	return n
 }
 
@@ -827,6 +1019,159 @@ func (m *KeyShareRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Sent = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedHeight", wireType)
+			}
+			m.CreatedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestedKeyshare
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			m.Deadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestedKeyshare
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Deadline |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestedKeyshare
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Failed = bool(v != 0)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClosedHeight", wireType)
+			}
+			m.ClosedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestedKeyshare
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClosedHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggrHeight", wireType)
+			}
+			m.AggrHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestedKeyshare
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AggrHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflightSequence", wireType)
+			}
+			m.InflightSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestedKeyshare
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InflightSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryHeight", wireType)
+			}
+			m.RetryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestedKeyshare
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetryHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastResendHeight", wireType)
+			}
+			m.LastResendHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestedKeyshare
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastResendHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestedKeyshare(dAtA[iNdEx:])
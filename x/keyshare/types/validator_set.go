@@ -0,0 +1,8 @@
+package types
+
+import "time"
+
+// IsPauseOver returns true if the validator paused its keyshare duties and the pause is over at the given time
+func (v ValidatorSet) IsPauseOver(now time.Time) bool {
+	return !v.IsActive && !now.Before(v.PausedUntil)
+}
@@ -5,16 +5,21 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "github.com/gogo/protobuf/types"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -23,10 +28,11 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type ValidatorSet struct {
-	Index     string `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
-	Validator string `protobuf:"bytes,2,opt,name=validator,proto3" json:"validator,omitempty"`
-	ConsAddr  string `protobuf:"bytes,3,opt,name=consAddr,proto3" json:"consAddr,omitempty"`
-	IsActive  bool   `protobuf:"varint,4,opt,name=isActive,proto3" json:"isActive,omitempty"`
+	Index       string    `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+	Validator   string    `protobuf:"bytes,2,opt,name=validator,proto3" json:"validator,omitempty"`
+	ConsAddr    string    `protobuf:"bytes,3,opt,name=consAddr,proto3" json:"consAddr,omitempty"`
+	IsActive    bool      `protobuf:"varint,4,opt,name=isActive,proto3" json:"isActive,omitempty"`
+	PausedUntil time.Time `protobuf:"bytes,7,opt,name=pausedUntil,proto3,stdtime" json:"pausedUntil"`
 }
 
 func (m *ValidatorSet) Reset()         { *m = ValidatorSet{} }
@@ -90,6 +96,13 @@ func (m *ValidatorSet) GetIsActive() bool {
 	return false
 }
 
+func (m *ValidatorSet) GetPausedUntil() time.Time {
+	if m != nil {
+		return m.PausedUntil
+	}
+	return time.Time{}
+}
+
 func init() {
 	proto.RegisterType((*ValidatorSet)(nil), "fairyring.keyshare.ValidatorSet")
 }
@@ -99,19 +112,25 @@ func init() {
 }
 
 var fileDescriptor_092022802a527ced = []byte{
-	// 192 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4b, 0x4b, 0xcc, 0x2c,
-	0xaa, 0x2c, 0xca, 0xcc, 0x4b, 0xd7, 0xcf, 0x4e, 0xad, 0x2c, 0xce, 0x48, 0x2c, 0x4a, 0xd5, 0x2f,
-	0x4b, 0xcc, 0xc9, 0x4c, 0x49, 0x2c, 0xc9, 0x2f, 0x8a, 0x2f, 0x4e, 0x2d, 0xd1, 0x2b, 0x28, 0xca,
-	0x2f, 0xc9, 0x17, 0x12, 0x82, 0xab, 0xd3, 0x83, 0xa9, 0x53, 0xaa, 0xe2, 0xe2, 0x09, 0x83, 0x29,
-	0x0d, 0x4e, 0x2d, 0x11, 0x12, 0xe1, 0x62, 0xcd, 0xcc, 0x4b, 0x49, 0xad, 0x90, 0x60, 0x54, 0x60,
-	0xd4, 0xe0, 0x0c, 0x82, 0x70, 0x84, 0x64, 0xb8, 0x38, 0xe1, 0x06, 0x4a, 0x30, 0x81, 0x65, 0x10,
-	0x02, 0x42, 0x52, 0x5c, 0x1c, 0xc9, 0xf9, 0x79, 0xc5, 0x8e, 0x29, 0x29, 0x45, 0x12, 0xcc, 0x60,
-	0x49, 0x38, 0x1f, 0x24, 0x97, 0x59, 0xec, 0x98, 0x5c, 0x92, 0x59, 0x96, 0x2a, 0xc1, 0xa2, 0xc0,
-	0xa8, 0xc1, 0x11, 0x04, 0xe7, 0x3b, 0x99, 0x9c, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3,
-	0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c,
-	0x43, 0x94, 0x14, 0xc2, 0x47, 0x15, 0x08, 0x3f, 0x95, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81,
-	0x3d, 0x63, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0x42, 0x9f, 0x40, 0x4e, 0xf6, 0x00, 0x00, 0x00,
+	// 288 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x90, 0xbd, 0x6e, 0xb3, 0x30,
+	0x14, 0x86, 0xf1, 0xf7, 0x11, 0x42, 0x9c, 0x0e, 0x91, 0x95, 0x01, 0xa1, 0xca, 0xa0, 0x0e, 0x15,
+	0x13, 0x48, 0x6d, 0x6f, 0x20, 0x19, 0x3a, 0x64, 0xa4, 0x3f, 0x43, 0x97, 0xca, 0x09, 0x0e, 0xb5,
+	0x4a, 0x30, 0xb2, 0x9d, 0x28, 0xdc, 0x45, 0x2e, 0x2b, 0x53, 0x95, 0xb1, 0x53, 0x5b, 0xc1, 0x8d,
+	0x54, 0x31, 0x02, 0xba, 0xf9, 0xf5, 0xfb, 0x1c, 0x9d, 0x47, 0x07, 0x5e, 0xaf, 0x09, 0x13, 0xa5,
+	0x60, 0x79, 0x1a, 0xbd, 0xd3, 0x52, 0xbe, 0x11, 0x41, 0xa3, 0x1d, 0xc9, 0x58, 0x42, 0x14, 0x17,
+	0xaf, 0x92, 0xaa, 0xb0, 0x10, 0x5c, 0x71, 0x84, 0x3a, 0x2e, 0x6c, 0x39, 0x77, 0x9a, 0xf2, 0x94,
+	0xeb, 0x3a, 0x3a, 0xbf, 0x1a, 0xd2, 0xf5, 0x52, 0xce, 0xd3, 0x8c, 0x46, 0x3a, 0x2d, 0xb7, 0xeb,
+	0x48, 0xb1, 0x0d, 0x95, 0x8a, 0x6c, 0x8a, 0x06, 0xb8, 0xfa, 0x00, 0xf0, 0xe2, 0xb9, 0x5d, 0xf1,
+	0x40, 0x15, 0x9a, 0xc2, 0x01, 0xcb, 0x13, 0xba, 0x77, 0x80, 0x0f, 0x82, 0x51, 0xdc, 0x04, 0x74,
+	0x09, 0x47, 0x9d, 0x88, 0xf3, 0x4f, 0x37, 0xfd, 0x07, 0x72, 0xa1, 0xbd, 0xe2, 0xb9, 0x9c, 0x25,
+	0x89, 0x70, 0xfe, 0xeb, 0xb2, 0xcb, 0xe7, 0x8e, 0xc9, 0xd9, 0x4a, 0xb1, 0x1d, 0x75, 0x4c, 0x1f,
+	0x04, 0x76, 0xdc, 0x65, 0x74, 0x0f, 0xc7, 0x05, 0xd9, 0x4a, 0x9a, 0x3c, 0xe5, 0x8a, 0x65, 0xce,
+	0xd0, 0x07, 0xc1, 0xf8, 0xc6, 0x0d, 0x1b, 0xe7, 0xb0, 0x75, 0x0e, 0x1f, 0x5b, 0xe7, 0xb9, 0x7d,
+	0xfc, 0xf2, 0x8c, 0xc3, 0xb7, 0x07, 0xe2, 0xbf, 0x83, 0x0b, 0xd3, 0x1e, 0x4c, 0xac, 0x85, 0x69,
+	0x5b, 0x93, 0xe1, 0xfc, 0xee, 0x58, 0x61, 0x70, 0xaa, 0x30, 0xf8, 0xa9, 0x30, 0x38, 0xd4, 0xd8,
+	0x38, 0xd5, 0xd8, 0xf8, 0xac, 0xb1, 0xf1, 0xe2, 0xf6, 0xd7, 0xdd, 0xf7, 0xf7, 0x55, 0x65, 0x41,
+	0xe5, 0xd2, 0xd2, 0xcb, 0x6e, 0x7f, 0x07, 0x00, 0xd4, 0x46, 0x31, 0xc8, 0x82, 0x01, 0x00, 0x00,
 }
 
 func (m *ValidatorSet) Marshal() (dAtA []byte, err error) {
@@ -134,6 +153,14 @@ func (m *ValidatorSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PausedUntil, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PausedUntil):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintValidatorSet(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x3a
 	if m.IsActive {
 		i--
 		if m.IsActive {
@@ -200,6 +227,8 @@ func (m *ValidatorSet) Size() (n int) {
 	if m.IsActive {
 		n += 2
 	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PausedUntil)
+	n += 1 + l + sovValidatorSet(uint64(l))
 	return n
 }
 
@@ -354,6 +383,39 @@ func (m *ValidatorSet) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.IsActive = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedUntil", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorSet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthValidatorSet
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidatorSet
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.PausedUntil, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipValidatorSet(dAtA[iNdEx:])
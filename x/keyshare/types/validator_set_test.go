@@ -0,0 +1,43 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"fairyring/x/keyshare/types"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidatorSet_IsPauseOver(t *testing.T) {
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	for _, tc := range []struct {
+		desc string
+		val  types.ValidatorSet
+		over bool
+	}{
+		{
+			desc: "active validator",
+			val:  types.ValidatorSet{IsActive: true},
+		},
+		{
+			desc: "paused",
+			val:  types.ValidatorSet{PausedUntil: now.Add(time.Second)},
+		},
+		{
+			desc: "pause ends now",
+			val:  types.ValidatorSet{PausedUntil: now},
+			over: true,
+		},
+		{
+			desc: "pause ended",
+			val:  types.ValidatorSet{PausedUntil: now.Add(-time.Second)},
+			over: true,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			require.Equal(t, tc.over, tc.val.IsPauseOver(now))
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package ante
+
+import (
+	"math"
+
+	"fairyring/x/pep/keeper"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+var _ sdk.AnteDecorator = PepNonceVerificationDecorator{}
+
+// PepNonceVerificationDecorator is an AnteDecorator that verifies the signatures of a decrypted
+// transaction against the pep nonces of its signers instead of their account sequences, then moves
+// the pep nonce of every signer past its signed sequence. It replaces both the SigVerificationDecorator
+// and the IncrementSequenceDecorator of the SDK, so the account sequences are left untouched.
+// The SetPubKeyDecorator must be run before it.
+type PepNonceVerificationDecorator struct {
+	pepKeeper       keeper.Keeper
+	ak              ante.AccountKeeper
+	signModeHandler authsigning.SignModeHandler
+}
+
+func NewPepNonceVerificationDecorator(pk keeper.Keeper, ak ante.AccountKeeper, signModeHandler authsigning.SignModeHandler) PepNonceVerificationDecorator {
+	return PepNonceVerificationDecorator{
+		pepKeeper:       pk,
+		ak:              ak,
+		signModeHandler: signModeHandler,
+	}
+}
+
+// AnteHandle verifies the signature of every signer, multisig public keys included
+func (pd PepNonceVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	sigTx, ok := tx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return ctx, sdkerrors.ErrTxDecode.Wrap("invalid transaction type")
+	}
+
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return ctx, err
+	}
+
+	signerAddrs := sigTx.GetSigners()
+	if len(sigs) != len(signerAddrs) {
+		return ctx, sdkerrors.ErrUnauthorized.Wrapf("invalid number of signer; expected: %d, got %d", len(signerAddrs), len(sigs))
+	}
+
+	for i, sig := range sigs {
+		acc, err := ante.GetSignerAcc(ctx, pd.ak, signerAddrs[i])
+		if err != nil {
+			return ctx, err
+		}
+
+		pubKey := acc.GetPubKey()
+		if pubKey == nil {
+			return ctx, sdkerrors.ErrInvalidPubKey.Wrap("pubkey on account is not set")
+		}
+
+		pepNonce, _ := pd.pepKeeper.GetPepNonce(ctx, acc.GetAddress().String())
+		if pepNonce.Nonce == math.MaxUint64 {
+			return ctx, sdkerrors.ErrInvalidSequence.Wrapf("invalid pep nonce for signer %s", acc.GetAddress().String())
+		}
+
+		if sig.Sequence < pepNonce.Nonce {
+			return ctx, sdkerrors.ErrWrongSequence.Wrapf(
+				"pep nonce mismatch, expected at least %d, got %d", pepNonce.Nonce, sig.Sequence,
+			)
+		}
+
+		signerData := authsigning.SignerData{
+			Address:       acc.GetAddress().String(),
+			ChainID:       ctx.ChainID(),
+			AccountNumber: acc.GetAccountNumber(),
+			Sequence:      sig.Sequence,
+			PubKey:        pubKey,
+		}
+
+		if err := authsigning.VerifySignature(pubKey, signerData, sig.Data, pd.signModeHandler, tx); err != nil {
+			return ctx, sdkerrors.ErrUnauthorized.Wrapf(
+				"signature verification failed; please verify account number (%d), pep nonce (%d) and chain-id (%s)",
+				acc.GetAccountNumber(), sig.Sequence, ctx.ChainID(),
+			)
+		}
+	}
+
+	for i, sig := range sigs {
+		pd.pepKeeper.UpdatePepNonce(ctx, signerAddrs[i].String(), sig.Sequence)
+	}
+
+	return next(ctx, tx, simulate)
+}
@@ -29,6 +29,7 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdListEncryptedTxFromBlock())
 	cmd.AddCommand(CmdShowEncryptedTx())
 	cmd.AddCommand(CmdLatestHeight())
+	cmd.AddCommand(CmdEncryptedTxBacklog())
 
 	cmd.AddCommand(CmdListPepNonce())
 	cmd.AddCommand(CmdShowPepNonce())
@@ -0,0 +1,38 @@
+package cli
+
+import (
+	"fairyring/x/pep/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+)
+
+func CmdEncryptedTxBacklog() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "encrypted-tx-backlog",
+		Short: "Query the number of encrypted txs waiting to be executed",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryEncryptedTxBacklogRequest{}
+
+			res, err := queryClient.EncryptedTxBacklog(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
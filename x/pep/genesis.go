@@ -28,6 +28,14 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	k.SetActivePubKey(ctx, genState.ActivePubKey)
 	// Set queued public key
 	k.SetQueuedPubKey(ctx, genState.QueuedPubKey)
+	// Set all the pending execution heights
+	for _, elem := range genState.PendingExecutionHeightList {
+		k.SetPendingExecutionHeight(ctx, elem)
+	}
+	// Set the execution queue
+	for _, elem := range genState.ExecutionQueueList {
+		k.SetExecutionQueueEntry(ctx, elem)
+	}
 	// this line is used by starport scaffolding # genesis/module/init
 
 	var portID string
@@ -58,6 +66,8 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis.EncryptedTxArray = k.GetAllEncryptedArray(ctx)
 	genesis.PepNonceList = k.GetAllPepNonce(ctx)
 	genesis.AggregatedKeyShareList = k.GetAllAggregatedKeyShare(ctx)
+	genesis.PendingExecutionHeightList = k.GetAllPendingExecutionHeight(ctx)
+	genesis.ExecutionQueueList = k.GetAllExecutionQueueEntry(ctx)
 	// this line is used by starport scaffolding # genesis/module/export
 	akey, found := k.GetActivePubKey(ctx)
 	if found {
@@ -56,6 +56,15 @@ func TestGenesis(t *testing.T) {
 				Height: 1,
 			},
 		},
+		ExecutionQueueList: []types.EncryptedTxExecutionQueueEntry{
+			{
+				Height: 0,
+			},
+			{
+				Height:    1,
+				NextIndex: 1,
+			},
+		},
 		// this line is used by starport scaffolding # genesis/test/state
 	}
 
@@ -72,5 +81,6 @@ func TestGenesis(t *testing.T) {
 	require.ElementsMatch(t, genesisState.EncryptedTxArray, got.EncryptedTxArray)
 	require.ElementsMatch(t, genesisState.PepNonceList, got.PepNonceList)
 	require.ElementsMatch(t, genesisState.AggregatedKeyShareList, got.AggregatedKeyShareList)
+	require.ElementsMatch(t, genesisState.ExecutionQueueList, got.ExecutionQueueList)
 	// this line is used by starport scaffolding # genesis/test/assert
 }
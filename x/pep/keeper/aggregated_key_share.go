@@ -1,10 +1,17 @@
 package keeper
 
 import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+
 	"fairyring/x/pep/types"
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/drand/kyber"
+	bls "github.com/drand/kyber-bls12381"
 )
 
 // SetAggregatedKeyShare set a specific aggregatedKeyShare in the store from its index
@@ -62,3 +69,83 @@ func (k Keeper) GetAllAggregatedKeyShare(ctx sdk.Context) (list []types.Aggregat
 
 	return
 }
+
+// VerifyAggregatedKeyShare checks the aggregated key is the private key of the height identity under the active public key,
+// with the pairing check e(P, H(height)) == e(G1, key), and returns that public key. A keyshare verification event is emitted when it is not
+func (k Keeper) VerifyAggregatedKeyShare(ctx sdk.Context, creator string, height uint64, data string) (string, error) {
+	pubKey, err := k.verifyAggregatedKeyShare(ctx, height, data)
+	if err != nil {
+		k.Logger(ctx).Error(fmt.Sprintf("Error while verifying aggregated keyshare of height %d: %s", height, err.Error()))
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(types.KeyShareVerificationType,
+				sdk.NewAttribute(types.KeyShareVerificationCreator, creator),
+				sdk.NewAttribute(types.KeyShareVerificationHeight, strconv.FormatUint(height, 10)),
+				sdk.NewAttribute(types.KeyShareVerificationReason, err.Error()),
+			),
+		)
+		return "", err
+	}
+
+	return pubKey, nil
+}
+
+func (k Keeper) verifyAggregatedKeyShare(ctx sdk.Context, height uint64, data string) (string, error) {
+	ak, found := k.GetActivePubKey(ctx)
+	if !found {
+		return "", types.ErrActivePubKeyNotFound
+	}
+
+	// Heights after the expiry of the active public key are encrypted with the next public key
+	if height > ak.Expiry {
+		return "", types.ErrAggregatedKeyShareOtherPubKey.Wrapf("height %d is after the active public key expiry %d", height, ak.Expiry)
+	}
+
+	suite := bls.NewBLS12381Suite()
+
+	publicKeyByte, err := hex.DecodeString(ak.PublicKey)
+	if err != nil {
+		return "", err
+	}
+	publicKeyPoint := suite.G1().Point()
+	if err := publicKeyPoint.UnmarshalBinary(publicKeyByte); err != nil {
+		return "", err
+	}
+
+	keyByte, err := hex.DecodeString(data)
+	if err != nil {
+		return "", types.ErrInvalidAggregatedKeyShare.Wrap(err.Error())
+	}
+	skPoint := suite.G2().Point()
+	if err := skPoint.UnmarshalBinary(keyByte); err != nil {
+		return "", types.ErrInvalidAggregatedKeyShare.Wrap(err.Error())
+	}
+
+	hG2, ok := suite.G2().Point().(kyber.HashablePoint)
+	if !ok {
+		return "", errors.New("invalid point")
+	}
+	qid := hG2.Hash([]byte(strconv.FormatUint(height, 10)))
+
+	// A key of a height under another public key fails the pairing check as well
+	if !suite.Pair(publicKeyPoint, qid).Equal(suite.Pair(suite.G1().Point().Base(), skPoint)) {
+		return "", types.ErrInvalidAggregatedKeyShare.Wrapf("key does not match the active public key for height %d", height)
+	}
+
+	return ak.PublicKey, nil
+}
+
+// AddAggregatedKeyShare saves a verified aggregatedKeyShare and moves the latest height up to its height
+func (k Keeper) AddAggregatedKeyShare(ctx sdk.Context, aggregatedKeyShare types.AggregatedKeyShare) {
+	k.SetAggregatedKeyShare(ctx, aggregatedKeyShare)
+
+	latestHeight, err := strconv.ParseUint(k.GetLatestHeight(ctx), 10, 64)
+	if err != nil {
+		latestHeight = 0
+	}
+
+	if latestHeight < aggregatedKeyShare.Height {
+		k.SetLatestHeight(ctx, strconv.FormatUint(aggregatedKeyShare.Height, 10))
+	}
+
+	k.Logger(ctx).Info(fmt.Sprintf("[ProcessUnconfirmedTxs] Aggregated Key Added, height: %d", aggregatedKeyShare.Height))
+}
@@ -3,7 +3,6 @@ package keeper
 import (
 	"errors"
 	cosmoserror "github.com/cosmos/cosmos-sdk/types/errors"
-	"time"
 
 	"fairyring/x/pep/types"
 
@@ -14,25 +13,6 @@ import (
 	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
 )
 
-func (k Keeper) QueryFairyringCurrentKeys(ctx sdk.Context) error {
-	srcPort := k.GetPort(ctx)
-	params := k.GetParams(ctx)
-	srcChannel := params.ChannelId
-
-	timeoutTimestamp := ctx.BlockTime().Add(time.Second * 20).UnixNano()
-	var packet types.CurrentKeysPacketData
-
-	// Transmit the packet
-	return k.TransmitCurrentKeysPacket(
-		ctx,
-		packet,
-		srcPort,
-		srcChannel,
-		clienttypes.ZeroHeight(),
-		uint64(timeoutTimestamp),
-	)
-}
-
 // TransmitCurrentKeysPacket transmits the packet over IBC with the specified source port and source channel
 func (k Keeper) TransmitCurrentKeysPacket(
 	ctx sdk.Context,
@@ -43,15 +23,33 @@ func (k Keeper) TransmitCurrentKeysPacket(
 	timeoutTimestamp uint64,
 ) error {
 
+	packetBytes, err := packetData.GetBytes()
+	if err != nil {
+		return sdkerrors.Wrap(cosmoserror.ErrJSONMarshal, "cannot marshal the packet: "+err.Error())
+	}
+
+	_, err = k.transmitPacket(ctx, packetBytes, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp)
+	return err
+}
+
+// transmitPacket sends the packet bytes over IBC with the specified source port and source channel
+func (k Keeper) transmitPacket(
+	ctx sdk.Context,
+	packetBytes []byte,
+	sourcePort,
+	sourceChannel string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+) (uint64, error) {
 	_, found := k.ChannelKeeper.GetChannel(ctx, sourcePort, sourceChannel)
 	if !found {
-		return sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", sourcePort, sourceChannel)
+		return 0, sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", sourcePort, sourceChannel)
 	}
 
 	// get the next sequence
 	_, found = k.ChannelKeeper.GetNextSequenceSend(ctx, sourcePort, sourceChannel)
 	if !found {
-		return sdkerrors.Wrapf(
+		return 0, sdkerrors.Wrapf(
 			channeltypes.ErrSequenceSendNotFound,
 			"source port: %s, source channel: %s", sourcePort, sourceChannel,
 		)
@@ -59,19 +57,10 @@ func (k Keeper) TransmitCurrentKeysPacket(
 
 	channelCap, ok := k.ScopedKeeper.GetCapability(ctx, host.ChannelCapabilityPath(sourcePort, sourceChannel))
 	if !ok {
-		return sdkerrors.Wrap(channeltypes.ErrChannelCapabilityNotFound, "module does not own channel capability")
+		return 0, sdkerrors.Wrap(channeltypes.ErrChannelCapabilityNotFound, "module does not own channel capability")
 	}
 
-	packetBytes, err := packetData.GetBytes()
-	if err != nil {
-		return sdkerrors.Wrap(cosmoserror.ErrJSONMarshal, "cannot marshal the packet: "+err.Error())
-	}
-
-	if _, err := k.ChannelKeeper.SendPacket(ctx, channelCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, packetBytes); err != nil {
-		return err
-	}
-
-	return nil
+	return k.ChannelKeeper.SendPacket(ctx, channelCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, packetBytes)
 }
 
 // OnRecvCurrentKeysPacket processes packet reception
@@ -113,28 +102,8 @@ func (k Keeper) OnAcknowledgementCurrentKeysPacket(ctx sdk.Context, packet chann
 		k.Logger(ctx).Error(dispatchedAck.Error)
 		return errors.New(dispatchedAck.Error)
 	case *channeltypes.Acknowledgement_Result:
-		channel, found := k.ChannelKeeper.GetChannel(ctx, packet.SourcePort, packet.SourceChannel)
-		if !found {
-			return errors.New("channel info not found")
-		}
-
-		// Retrieve the connection associated with the channel
-		connection, found := k.connectionKeeper.GetConnection(ctx, channel.ConnectionHops[0])
-		if !found {
-			return errors.New("connection info not found")
-		}
-
-		params := k.GetParams(ctx)
-
-		trusted := verifyCounterparty(
-			connection.Counterparty.ClientId,
-			connection.Counterparty.ConnectionId,
-			channel.Counterparty.GetChannelID(),
-			params.TrustedCounterParties,
-		)
-
-		if !trusted {
-			return errors.New("counterparty is not trusted")
+		if err := k.VerifyTrustedChannel(ctx, packet.SourcePort, packet.SourceChannel); err != nil {
+			return err
 		}
 
 		// Decode the packet acknowledgment
@@ -148,33 +117,7 @@ func (k Keeper) OnAcknowledgementCurrentKeysPacket(ctx sdk.Context, packet chann
 		k.Logger(ctx).Info("Got ack result")
 		k.Logger(ctx).Info(packetAck.String())
 
-		if packetAck.ActiveKey == nil {
-			k.Logger(ctx).Info("active key is nil in packet ack")
-			return nil
-		}
-
-		ak, found := k.GetActivePubKey(ctx)
-		if !found {
-			k.SetActivePubKey(ctx, *packetAck.ActiveKey)
-		} else {
-			if ak.Expiry <= packetAck.ActiveKey.Expiry {
-				k.SetActivePubKey(ctx, *packetAck.ActiveKey)
-			}
-		}
-
-		if packetAck.QueuedKey == nil {
-			k.Logger(ctx).Info("queued key is nil in packet ack")
-			return nil
-		}
-
-		qk, found := k.GetQueuedPubKey(ctx)
-		if !found {
-			k.SetQueuedPubKey(ctx, *packetAck.QueuedKey)
-		} else {
-			if qk.Expiry <= packetAck.QueuedKey.Expiry {
-				k.SetQueuedPubKey(ctx, *packetAck.QueuedKey)
-			}
-		}
+		k.UpdatePubKeys(ctx, packetAck.ActiveKey, packetAck.QueuedKey)
 
 		return nil
 	default:
@@ -190,6 +133,57 @@ func (k Keeper) OnTimeoutCurrentKeysPacket(ctx sdk.Context, packet channeltypes.
 	return nil
 }
 
+// VerifyTrustedChannel checks the counterparty of the channel is one of the TrustedCounterParties
+func (k Keeper) VerifyTrustedChannel(ctx sdk.Context, portID, channelID string) error {
+	channel, found := k.ChannelKeeper.GetChannel(ctx, portID, channelID)
+	if !found {
+		return errors.New("channel info not found")
+	}
+
+	// Retrieve the connection associated with the channel
+	connection, found := k.connectionKeeper.GetConnection(ctx, channel.ConnectionHops[0])
+	if !found {
+		return errors.New("connection info not found")
+	}
+
+	params := k.GetParams(ctx)
+
+	trusted := verifyCounterparty(
+		connection.Counterparty.ClientId,
+		connection.Counterparty.ConnectionId,
+		channel.Counterparty.GetChannelID(),
+		params.TrustedCounterParties,
+	)
+
+	if !trusted {
+		return types.ErrUntrustedCounterparty
+	}
+
+	return nil
+}
+
+// UpdatePubKeys saves the received active and queued public keys, unless the ones already saved expire later
+func (k Keeper) UpdatePubKeys(ctx sdk.Context, activeKey *types.ActivePubKey, queuedKey *types.QueuedPubKey) {
+	if activeKey == nil {
+		k.Logger(ctx).Info("active key is nil")
+	} else {
+		ak, found := k.GetActivePubKey(ctx)
+		if !found || ak.Expiry <= activeKey.Expiry {
+			k.SetActivePubKey(ctx, *activeKey)
+		}
+	}
+
+	if queuedKey == nil {
+		k.Logger(ctx).Info("queued key is nil")
+		return
+	}
+
+	qk, found := k.GetQueuedPubKey(ctx)
+	if !found || qk.Expiry <= queuedKey.Expiry {
+		k.SetQueuedPubKey(ctx, *queuedKey)
+	}
+}
+
 func verifyCounterparty(clientID string, connectionID string, channelId string, trustedChannels []*types.TrustedCounterParty) bool {
 	for _, channelInfo := range trustedChannels {
 		if channelInfo.ClientId == clientID && channelInfo.ConnectionId == connectionID && channelInfo.ChannelId == channelId {
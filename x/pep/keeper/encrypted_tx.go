@@ -1,7 +1,9 @@
 package keeper
 
 import (
+	"encoding/binary"
 	"fairyring/x/pep/types"
+	"math"
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -92,6 +94,30 @@ func (k Keeper) GetEncryptedTxAllFromHeight(
 	return arr
 }
 
+// GetNextEncryptedTxHeight returns the lowest height from fromHeight to toHeight holding encrypted txs,
+// the heights without encrypted txs are skipped without being read
+func (k Keeper) GetNextEncryptedTxHeight(ctx sdk.Context, fromHeight, toHeight uint64) (uint64, bool) {
+	if fromHeight > toHeight {
+		return 0, false
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.EncryptedTxKeyPrefix))
+
+	var end []byte
+	if toHeight < math.MaxUint64 {
+		end = types.EncryptedTxAllFromHeightKey(toHeight + 1)
+	}
+	iterator := store.Iterator(types.EncryptedTxAllFromHeightKey(fromHeight), end)
+
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return 0, false
+	}
+
+	return binary.BigEndian.Uint64(iterator.Key()[:8]), true
+}
+
 // GetAllEncryptedArray returns the list of all encrypted txs
 func (k Keeper) GetAllEncryptedArray(ctx sdk.Context) (arr []types.EncryptedTxArray) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.EncryptedTxKeyPrefix))
@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"fmt"
+	"math"
 	"strconv"
 	"testing"
 
@@ -76,3 +77,28 @@ func TestEncryptedTxGetAll(t *testing.T) {
 		nullify.Fill(keeper.GetAllEncryptedArray(ctx)),
 	)
 }
+
+func TestNextEncryptedTxHeight(t *testing.T) {
+	keeper, ctx := keepertest.PepKeeper(t)
+	for _, h := range []uint64{3, 7, 300} {
+		keeper.AppendEncryptedTx(ctx, types.EncryptedTx{TargetHeight: h})
+	}
+
+	for _, tc := range []struct {
+		from, to uint64
+		height   uint64
+		found    bool
+	}{
+		{from: 1, to: 10, height: 3, found: true},
+		{from: 3, to: 10, height: 3, found: true},
+		{from: 4, to: 10, height: 7, found: true},
+		{from: 8, to: 299, found: false},
+		{from: 8, to: 300, height: 300, found: true},
+		{from: 301, to: math.MaxUint64, found: false},
+		{from: 10, to: 1, found: false},
+	} {
+		height, found := keeper.GetNextEncryptedTxHeight(ctx, tc.from, tc.to)
+		require.Equal(t, tc.found, found)
+		require.Equal(t, tc.height, height)
+	}
+}
@@ -0,0 +1,87 @@
+package keeper
+
+import (
+	"fairyring/x/pep/types"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetExecutionQueueEntry queues a height whose encrypted txs are ready to be executed
+func (k Keeper) SetExecutionQueueEntry(ctx sdk.Context, entry types.EncryptedTxExecutionQueueEntry) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ExecutionQueueKeyPrefix))
+	b := k.cdc.MustMarshal(&entry)
+	store.Set(types.ExecutionQueueKey(entry.Height), b)
+}
+
+// GetExecutionQueueEntry returns the execution queue entry of a height
+func (k Keeper) GetExecutionQueueEntry(ctx sdk.Context, height uint64) (val types.EncryptedTxExecutionQueueEntry, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ExecutionQueueKeyPrefix))
+
+	b := store.Get(types.ExecutionQueueKey(height))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveExecutionQueueEntry removes a height from the execution queue
+func (k Keeper) RemoveExecutionQueueEntry(ctx sdk.Context, height uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ExecutionQueueKeyPrefix))
+	store.Delete(types.ExecutionQueueKey(height))
+}
+
+// GetAllExecutionQueueEntry returns the execution queue in ascending order of height
+func (k Keeper) GetAllExecutionQueueEntry(ctx sdk.Context) (list []types.EncryptedTxExecutionQueueEntry) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ExecutionQueueKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.EncryptedTxExecutionQueueEntry
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetExecutionQueueEntries returns at most limit entries of the execution queue in ascending order of height,
+// the iteration stops once the limit is reached
+func (k Keeper) GetExecutionQueueEntries(ctx sdk.Context, limit uint64) (list []types.EncryptedTxExecutionQueueEntry) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ExecutionQueueKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid() && uint64(len(list)) < limit; iterator.Next() {
+		var val types.EncryptedTxExecutionQueueEntry
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetEncryptedTxBacklog returns the number of heights and encrypted txs queued for execution,
+// and the number of heights and encrypted txs still waiting for their decryption key
+func (k Keeper) GetEncryptedTxBacklog(ctx sdk.Context) (backlog types.QueryEncryptedTxBacklogResponse) {
+	for _, entry := range k.GetAllExecutionQueueEntry(ctx) {
+		arr := k.GetEncryptedTxAllFromHeight(ctx, entry.Height)
+		backlog.QueuedHeights++
+		if total := uint64(len(arr.EncryptedTx)); total > entry.NextIndex {
+			backlog.QueuedTxs += total - entry.NextIndex
+		}
+	}
+
+	for _, height := range k.GetAllPendingExecutionHeight(ctx) {
+		arr := k.GetEncryptedTxAllFromHeight(ctx, height)
+		backlog.PendingHeights++
+		backlog.PendingTxs += uint64(len(arr.EncryptedTx))
+	}
+
+	return
+}
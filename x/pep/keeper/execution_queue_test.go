@@ -0,0 +1,95 @@
+package keeper_test
+
+import (
+	"testing"
+
+	keepertest "fairyring/testutil/keeper"
+	"fairyring/testutil/nullify"
+	"fairyring/x/pep/keeper"
+	"fairyring/x/pep/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func createNExecutionQueueEntry(keeper *keeper.Keeper, ctx sdk.Context, n int) []types.EncryptedTxExecutionQueueEntry {
+	items := make([]types.EncryptedTxExecutionQueueEntry, n)
+	for i := range items {
+		// Set in descending order to check the queue is sorted by height
+		items[i].Height = uint64(n - i)
+		items[i].NextIndex = uint64(i)
+
+		keeper.SetExecutionQueueEntry(ctx, items[i])
+	}
+	return items
+}
+
+func TestExecutionQueueEntryGet(t *testing.T) {
+	keeper, ctx := keepertest.PepKeeper(t)
+	items := createNExecutionQueueEntry(keeper, ctx, 10)
+	for _, item := range items {
+		rst, found := keeper.GetExecutionQueueEntry(ctx, item.Height)
+		require.True(t, found)
+		require.Equal(t,
+			nullify.Fill(&item),
+			nullify.Fill(&rst),
+		)
+	}
+}
+
+func TestExecutionQueueEntryRemove(t *testing.T) {
+	keeper, ctx := keepertest.PepKeeper(t)
+	items := createNExecutionQueueEntry(keeper, ctx, 10)
+	for _, item := range items {
+		keeper.RemoveExecutionQueueEntry(ctx, item.Height)
+		_, found := keeper.GetExecutionQueueEntry(ctx, item.Height)
+		require.False(t, found)
+	}
+}
+
+func TestExecutionQueueEntryGetAll(t *testing.T) {
+	keeper, ctx := keepertest.PepKeeper(t)
+	createNExecutionQueueEntry(keeper, ctx, 10)
+
+	all := keeper.GetAllExecutionQueueEntry(ctx)
+	require.Len(t, all, 10)
+	for i, entry := range all {
+		require.Equal(t, uint64(i+1), entry.Height)
+	}
+}
+
+func TestExecutionQueueEntriesLimit(t *testing.T) {
+	keeper, ctx := keepertest.PepKeeper(t)
+	createNExecutionQueueEntry(keeper, ctx, 10)
+
+	entries := keeper.GetExecutionQueueEntries(ctx, 3)
+	require.Len(t, entries, 3)
+	for i, entry := range entries {
+		require.Equal(t, uint64(i+1), entry.Height)
+	}
+	require.Len(t, keeper.GetExecutionQueueEntries(ctx, 20), 10)
+}
+
+func TestGetEncryptedTxBacklog(t *testing.T) {
+	keeper, ctx := keepertest.PepKeeper(t)
+
+	for h := uint64(1); h <= 3; h++ {
+		for i := uint64(0); i < 4; i++ {
+			keeper.AppendEncryptedTx(ctx, types.EncryptedTx{TargetHeight: h})
+		}
+	}
+
+	keeper.SetExecutionQueueEntry(ctx, types.EncryptedTxExecutionQueueEntry{Height: 1, NextIndex: 3})
+	keeper.SetExecutionQueueEntry(ctx, types.EncryptedTxExecutionQueueEntry{Height: 2})
+	keeper.SetPendingExecutionHeight(ctx, 3)
+
+	backlog := keeper.GetEncryptedTxBacklog(ctx)
+	require.Equal(t, uint64(2), backlog.QueuedHeights)
+	require.Equal(t, uint64(5), backlog.QueuedTxs)
+	require.Equal(t, uint64(1), backlog.PendingHeights)
+	require.Equal(t, uint64(4), backlog.PendingTxs)
+
+	resp, err := keeper.EncryptedTxBacklog(sdk.WrapSDKContext(ctx), &types.QueryEncryptedTxBacklogRequest{})
+	require.NoError(t, err)
+	require.Equal(t, &backlog, resp)
+}
@@ -0,0 +1,24 @@
+package keeper
+
+import (
+	"context"
+
+	"fairyring/x/pep/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// EncryptedTxBacklog returns the number of encrypted txs waiting to be executed
+func (k Keeper) EncryptedTxBacklog(goCtx context.Context, req *types.QueryEncryptedTxBacklogRequest) (*types.QueryEncryptedTxBacklogResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	backlog := k.GetEncryptedTxBacklog(ctx)
+
+	return &backlog, nil
+}
@@ -1,13 +1,17 @@
 package keeper_test
 
 import (
+	"encoding/hex"
 	"strconv"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/drand/kyber"
+	bls "github.com/drand/kyber-bls12381"
 	"github.com/stretchr/testify/require"
 
 	keepertest "fairyring/testutil/keeper"
+	"fairyring/testutil/sample"
 	"fairyring/x/pep/keeper"
 	"fairyring/x/pep/types"
 )
@@ -15,14 +19,39 @@ import (
 // Prevent strconv unused error
 var _ = strconv.IntSize
 
+// setupAggregatedKeys sets a random active public key expiring at the expiry height,
+// and returns a function extracting the aggregated key of a height under it
+func setupAggregatedKeys(t *testing.T, k *keeper.Keeper, ctx sdk.Context, expiry uint64) func(height uint64) string {
+	suite := bls.NewBLS12381Suite()
+	secret := suite.G1().Scalar().Pick(suite.RandomStream())
+
+	publicKey, err := suite.G1().Point().Mul(secret, nil).MarshalBinary()
+	require.NoError(t, err)
+	k.SetActivePubKey(ctx, types.ActivePubKey{PublicKey: hex.EncodeToString(publicKey), Expiry: expiry})
+
+	return func(height uint64) string {
+		qid := suite.G2().Point().(kyber.HashablePoint).Hash([]byte(strconv.FormatUint(height, 10)))
+		key, err := suite.G2().Point().Mul(secret, qid).MarshalBinary()
+		require.NoError(t, err)
+		return hex.EncodeToString(key)
+	}
+}
+
 func TestAggregatedKeyShareMsgServerCreate(t *testing.T) {
 	k, ctx := keepertest.PepKeeper(t)
+	params := types.DefaultParams()
+	params.PermissionlessAggregatedKeys = true
+	k.SetParams(ctx, params)
+	extract := setupAggregatedKeys(t, k, ctx, 100)
+	ak, _ := k.GetActivePubKey(ctx)
+
 	srv := keeper.NewMsgServerImpl(*k)
 	wctx := sdk.WrapSDKContext(ctx)
-	creator := "A"
-	for i := 0; i < 5; i++ {
+	creator := sample.AccAddress()
+	for i := 1; i < 5; i++ {
 		expected := &types.MsgCreateAggregatedKeyShare{Creator: creator,
 			Height: uint64(i),
+			Data:   extract(uint64(i)),
 		}
 		_, err := srv.CreateAggregatedKeyShare(wctx, expected)
 		require.NoError(t, err)
@@ -31,5 +60,57 @@ func TestAggregatedKeyShareMsgServerCreate(t *testing.T) {
 		)
 		require.True(t, found)
 		require.Equal(t, expected.Creator, rst.Creator)
+		require.Equal(t, ak.PublicKey, rst.PublicKey)
+	}
+	require.Equal(t, "4", k.GetLatestHeight(ctx))
+}
+
+func TestAggregatedKeyShareMsgServerCreateInvalid(t *testing.T) {
+	k, ctx := keepertest.PepKeeper(t)
+	trusted := sample.AccAddress()
+	params := types.DefaultParams()
+	params.TrustedAddresses = []string{trusted}
+	k.SetParams(ctx, params)
+	extract := setupAggregatedKeys(t, k, ctx, 100)
+
+	srv := keeper.NewMsgServerImpl(*k)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	for _, tc := range []struct {
+		desc string
+		msg  *types.MsgCreateAggregatedKeyShare
+		err  error
+	}{
+		{
+			desc: "untrusted creator",
+			msg:  &types.MsgCreateAggregatedKeyShare{Creator: sample.AccAddress(), Height: 10, Data: extract(10)},
+			err:  types.ErrUntrustedMsgCreator,
+		},
+		{
+			desc: "key of another height",
+			msg:  &types.MsgCreateAggregatedKeyShare{Creator: trusted, Height: 10, Data: extract(11)},
+			err:  types.ErrInvalidAggregatedKeyShare,
+		},
+		{
+			desc: "malformed key",
+			msg:  &types.MsgCreateAggregatedKeyShare{Creator: trusted, Height: 10, Data: "key"},
+			err:  types.ErrInvalidAggregatedKeyShare,
+		},
+		{
+			desc: "height after the active public key expiry",
+			msg:  &types.MsgCreateAggregatedKeyShare{Creator: trusted, Height: 101, Data: extract(101)},
+			err:  types.ErrAggregatedKeyShareOtherPubKey,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := srv.CreateAggregatedKeyShare(wctx, tc.msg)
+			require.ErrorIs(t, err, tc.err)
+			_, found := k.GetAggregatedKeyShare(ctx, tc.msg.Height)
+			require.False(t, found)
+		})
 	}
+
+	// Trusted addresses can still submit keys
+	_, err := srv.CreateAggregatedKeyShare(wctx, &types.MsgCreateAggregatedKeyShare{Creator: trusted, Height: 10, Data: extract(10)})
+	require.NoError(t, err)
 }
@@ -14,6 +14,12 @@ func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 		k.TrustedCounterParties(ctx),
 		k.ChannelID(ctx),
 		&coin,
+		k.LateDecryptionWindow(ctx),
+		k.PermissionlessAggregatedKeys(ctx),
+		k.MaxEncryptedTxsPerBlock(ctx),
+		k.MaxEncryptedTxGasPerBlock(ctx),
+		k.SubscriberConnectionIds(ctx),
+		k.MaxSubscribedChannels(ctx),
 	)
 }
 
@@ -44,3 +50,39 @@ func (k Keeper) MinGasPrice(ctx sdk.Context) (res sdk.Coin) {
 	k.paramstore.Get(ctx, types.KeyMinGasPrice, &res)
 	return
 }
+
+// LateDecryptionWindow returns the LateDecryptionWindow param
+func (k Keeper) LateDecryptionWindow(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyLateDecryptionWindow, &res)
+	return
+}
+
+// PermissionlessAggregatedKeys returns the PermissionlessAggregatedKeys param
+func (k Keeper) PermissionlessAggregatedKeys(ctx sdk.Context) (res bool) {
+	k.paramstore.Get(ctx, types.KeyPermissionlessAggregatedKeys, &res)
+	return
+}
+
+// MaxEncryptedTxsPerBlock returns the MaxEncryptedTxsPerBlock param
+func (k Keeper) MaxEncryptedTxsPerBlock(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyMaxEncryptedTxsPerBlock, &res)
+	return
+}
+
+// MaxEncryptedTxGasPerBlock returns the MaxEncryptedTxGasPerBlock param
+func (k Keeper) MaxEncryptedTxGasPerBlock(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyMaxEncryptedTxGasPerBlock, &res)
+	return
+}
+
+// SubscriberConnectionIds returns the SubscriberConnectionIds param
+func (k Keeper) SubscriberConnectionIds(ctx sdk.Context) (res []string) {
+	k.paramstore.Get(ctx, types.KeySubscriberConnectionIds, &res)
+	return
+}
+
+// MaxSubscribedChannels returns the MaxSubscribedChannels param
+func (k Keeper) MaxSubscribedChannels(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyMaxSubscribedChannels, &res)
+	return
+}
@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"fairyring/x/pep/types"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetPendingExecutionHeight marks a height whose encrypted txs are waiting for their decryption key
+func (k Keeper) SetPendingExecutionHeight(ctx sdk.Context, height uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PendingExecutionHeightKeyPrefix))
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, height)
+	store.Set(types.PendingExecutionHeightKey(height), b)
+}
+
+// RemovePendingExecutionHeight removes a pending execution height from the store
+func (k Keeper) RemovePendingExecutionHeight(ctx sdk.Context, height uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PendingExecutionHeightKeyPrefix))
+	store.Delete(types.PendingExecutionHeightKey(height))
+}
+
+// GetAllPendingExecutionHeight returns all pending execution heights in ascending order
+func (k Keeper) GetAllPendingExecutionHeight(ctx sdk.Context) (list []uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PendingExecutionHeightKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		list = append(list, binary.BigEndian.Uint64(iterator.Value()))
+	}
+
+	return
+}
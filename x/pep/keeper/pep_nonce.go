@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"math"
+
 	"fairyring/x/pep/types"
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
@@ -46,6 +48,27 @@ func (k Keeper) IncreasePepNonce(
 	return newNonce
 }
 
+// UpdatePepNonce moves the pepNonce of the address past the provided sequence,
+// the nonce is never decreased and saturates at math.MaxUint64
+func (k Keeper) UpdatePepNonce(
+	ctx sdk.Context,
+	address string,
+	sequence uint64,
+) uint64 {
+	nonce, _ := k.GetPepNonce(ctx, address)
+	if sequence < nonce.Nonce {
+		return nonce.Nonce
+	}
+
+	nonce.Nonce = sequence + 1
+	if sequence == math.MaxUint64 {
+		nonce.Nonce = math.MaxUint64
+	}
+	k.SetPepNonce(ctx, nonce)
+
+	return nonce.Nonce
+}
+
 // GetPepNonce returns a pepNonce from its index
 func (k Keeper) GetPepNonce(
 	ctx sdk.Context,
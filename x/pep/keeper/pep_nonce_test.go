@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"math"
 	"strconv"
 	"testing"
 
@@ -62,3 +63,19 @@ func TestPepNonceGetAll(t *testing.T) {
 		nullify.Fill(keeper.GetAllPepNonce(ctx)),
 	)
 }
+
+func TestUpdatePepNonce(t *testing.T) {
+	keeper, ctx := keepertest.PepKeeper(t)
+	address := "update-pep-nonce"
+
+	require.Equal(t, uint64(1), keeper.UpdatePepNonce(ctx, address, 0))
+	require.Equal(t, uint64(2), keeper.UpdatePepNonce(ctx, address, 1))
+	require.Equal(t, uint64(6), keeper.UpdatePepNonce(ctx, address, 5))
+	// Lower sequences never decrease the nonce
+	require.Equal(t, uint64(6), keeper.UpdatePepNonce(ctx, address, 3))
+	require.Equal(t, uint64(math.MaxUint64), keeper.UpdatePepNonce(ctx, address, math.MaxUint64))
+
+	nonce, found := keeper.GetPepNonce(ctx, address)
+	require.True(t, found)
+	require.Equal(t, uint64(math.MaxUint64), nonce.Nonce)
+}
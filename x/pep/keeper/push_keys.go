@@ -0,0 +1,329 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"fairyring/x/pep/types"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserror "github.com/cosmos/cosmos-sdk/types/errors"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+)
+
+// SubscribeChannel starts pushing the keys to a channel opened on the pep port,
+// the current public keys are sent to the channel at the end of the block.
+// Only channels over a connection in SubscriberConnectionIds are subscribed, up to MaxSubscribedChannels
+func (k Keeper) SubscribeChannel(ctx sdk.Context, channelID string) error {
+	if err := k.VerifySubscriberChannel(ctx, k.GetPort(ctx), channelID); err != nil {
+		return err
+	}
+
+	maxChannels := k.MaxSubscribedChannels(ctx)
+	if uint64(len(k.GetAllSubscribedChannels(ctx))) >= maxChannels {
+		return types.ErrMaxSubscribedChannels.Wrapf("max: %d", maxChannels)
+	}
+
+	k.SetSubscribedChannel(ctx, channelID)
+	k.SetPendingKeySync(ctx, channelID)
+	return nil
+}
+
+// VerifySubscriberChannel checks that a channel is opened over a connection allowed to subscribe to the keys
+func (k Keeper) VerifySubscriberChannel(ctx sdk.Context, portID, channelID string) error {
+	channel, found := k.ChannelKeeper.GetChannel(ctx, portID, channelID)
+	if !found || len(channel.ConnectionHops) == 0 {
+		return sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	for _, connectionID := range k.SubscriberConnectionIds(ctx) {
+		if connectionID == channel.ConnectionHops[0] {
+			return nil
+		}
+	}
+
+	return types.ErrUnallowedSubscriber.Wrapf("connection: %s", channel.ConnectionHops[0])
+}
+
+// UnsubscribeChannel stops pushing the keys to a channel
+func (k Keeper) UnsubscribeChannel(ctx sdk.Context, channelID string) {
+	k.RemoveSubscribedChannel(ctx, channelID)
+	k.RemovePendingKeySync(ctx, channelID)
+}
+
+// SyncSubscribedChannels sends the current public keys to the subscribed channels waiting for them.
+// A channel to a trusted counterparty leads to the chain the keys come from, so it is unsubscribed instead,
+// and so are the channels whose connection is no longer allowed to subscribe
+func (k Keeper) SyncSubscribedChannels(ctx sdk.Context) {
+	port := k.GetPort(ctx)
+
+	for _, channelID := range k.GetAllPendingKeySync(ctx) {
+		k.RemovePendingKeySync(ctx, channelID)
+
+		if err := k.VerifyTrustedChannel(ctx, port, channelID); err == nil {
+			k.RemoveSubscribedChannel(ctx, channelID)
+			continue
+		}
+
+		if err := k.VerifySubscriberChannel(ctx, port, channelID); err != nil {
+			k.RemoveSubscribedChannel(ctx, channelID)
+			continue
+		}
+
+		var packet types.PubKeysPacketData
+
+		ak, found := k.GetActivePubKey(ctx)
+		if found {
+			packet.ActiveKey = &ak
+		}
+
+		qk, found := k.GetQueuedPubKey(ctx)
+		if found {
+			packet.QueuedKey = &qk
+		}
+
+		// New keys are pushed to the channel as soon as they are queued
+		if packet.ActiveKey == nil && packet.QueuedKey == nil {
+			continue
+		}
+
+		k.pushPubKeys(ctx, port, channelID, packet)
+	}
+}
+
+// PushQueuedPubKey marks all the subscribed channels as waiting for the current public keys,
+// so that the new queued public key is sent to them at the end of the block
+func (k Keeper) PushQueuedPubKey(ctx sdk.Context, _ types.QueuedPubKey) {
+	for _, channelID := range k.GetAllSubscribedChannels(ctx) {
+		k.SetPendingKeySync(ctx, channelID)
+	}
+}
+
+// PushAggregatedKeyShare queues the aggregated key of a height to be sent to all the subscribed channels
+// at the end of the block, so that the transaction aggregating the key does not pay for the packets
+func (k Keeper) PushAggregatedKeyShare(ctx sdk.Context, height uint64, data string) {
+	if len(k.GetAllSubscribedChannels(ctx)) == 0 {
+		return
+	}
+
+	k.SetPendingAggregatedKeyPush(ctx, types.AggregatedKeyShare{
+		Height: height,
+		Data:   data,
+	})
+}
+
+// PushPendingAggregatedKeyShares sends the aggregated keys queued during the block to all the subscribed channels.
+// Errors are logged and skipped, so a failing channel never stops the block
+func (k Keeper) PushPendingAggregatedKeyShares(ctx sdk.Context) {
+	pending := k.GetAllPendingAggregatedKeyPush(ctx)
+	if len(pending) == 0 {
+		return
+	}
+
+	port := k.GetPort(ctx)
+	maxChannels := k.MaxSubscribedChannels(ctx)
+
+	// The subscribed channels are checked again, the allowed connections and the cap may have changed
+	var allowed []string
+	for _, channelID := range k.GetAllSubscribedChannels(ctx) {
+		if uint64(len(allowed)) >= maxChannels {
+			break
+		}
+
+		if err := k.VerifySubscriberChannel(ctx, port, channelID); err != nil {
+			k.RemoveSubscribedChannel(ctx, channelID)
+			continue
+		}
+
+		allowed = append(allowed, channelID)
+	}
+
+	for _, aggrKey := range pending {
+		k.RemovePendingAggregatedKeyPush(ctx, aggrKey.Height)
+
+		for _, channelID := range allowed {
+			k.pushAggregatedKeyShare(ctx, port, channelID, aggrKey.Height, aggrKey.Data)
+		}
+	}
+}
+
+func (k Keeper) pushAggregatedKeyShare(ctx sdk.Context, port, channelID string, height uint64, data string) {
+	packet := types.AggregatedKeySharePacketData{
+		Height: height,
+		Data:   data,
+	}
+
+	timeoutTimestamp := ctx.BlockTime().Add(types.KeyPacketTimeout).UnixNano()
+
+	sequence, err := k.TransmitAggregatedKeySharePacket(
+		ctx,
+		packet,
+		port,
+		channelID,
+		clienttypes.ZeroHeight(),
+		uint64(timeoutTimestamp),
+	)
+	if err != nil {
+		k.Logger(ctx).Error(fmt.Sprintf("Error while pushing aggregated keyshare of height %d to channel %s: %s", height, channelID, err.Error()))
+		return
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.KeysPushedEventType,
+			sdk.NewAttribute(types.KeysPushedEventChannel, channelID),
+			sdk.NewAttribute(types.KeysPushedEventSequence, strconv.FormatUint(sequence, 10)),
+			sdk.NewAttribute(types.KeysPushedEventPacketType, types.KeysPushedPacketTypeAggregatedKeyShare),
+			sdk.NewAttribute(types.KeysPushedEventHeight, strconv.FormatUint(height, 10)),
+		),
+	)
+}
+
+func (k Keeper) pushPubKeys(ctx sdk.Context, port, channelID string, packet types.PubKeysPacketData) {
+	timeoutTimestamp := ctx.BlockTime().Add(types.KeyPacketTimeout).UnixNano()
+
+	sequence, err := k.TransmitPubKeysPacket(
+		ctx,
+		packet,
+		port,
+		channelID,
+		clienttypes.ZeroHeight(),
+		uint64(timeoutTimestamp),
+	)
+	if err != nil {
+		k.Logger(ctx).Error(fmt.Sprintf("Error while pushing public keys to channel %s: %s", channelID, err.Error()))
+		return
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.KeysPushedEventType,
+			sdk.NewAttribute(types.KeysPushedEventChannel, channelID),
+			sdk.NewAttribute(types.KeysPushedEventSequence, strconv.FormatUint(sequence, 10)),
+			sdk.NewAttribute(types.KeysPushedEventPacketType, types.KeysPushedPacketTypePubKeys),
+		),
+	)
+}
+
+// TransmitPubKeysPacket transmits the packet over IBC with the specified source port and source channel
+func (k Keeper) TransmitPubKeysPacket(
+	ctx sdk.Context,
+	packetData types.PubKeysPacketData,
+	sourcePort,
+	sourceChannel string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+) (uint64, error) {
+	packetBytes, err := packetData.GetBytes()
+	if err != nil {
+		return 0, sdkerrors.Wrap(cosmoserror.ErrJSONMarshal, "cannot marshal the packet: "+err.Error())
+	}
+
+	return k.transmitPacket(ctx, packetBytes, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp)
+}
+
+// OnRecvPubKeysPacket saves the public keys pushed by a trusted counterparty
+func (k Keeper) OnRecvPubKeysPacket(ctx sdk.Context, packet channeltypes.Packet, data types.PubKeysPacketData) (packetAck types.PubKeysPacketAck, err error) {
+	// validate packet data upon receiving
+	if err := data.ValidateBasic(); err != nil {
+		return packetAck, err
+	}
+
+	if err := k.VerifyTrustedChannel(ctx, packet.DestinationPort, packet.DestinationChannel); err != nil {
+		return packetAck, err
+	}
+
+	k.UpdatePubKeys(ctx, data.ActiveKey, data.QueuedKey)
+
+	return packetAck, nil
+}
+
+// OnAcknowledgementPubKeysPacket responds to the success or failure of a packet
+// acknowledgement written on the receiving chain.
+func (k Keeper) OnAcknowledgementPubKeysPacket(ctx sdk.Context, packet channeltypes.Packet, data types.PubKeysPacketData, ack channeltypes.Acknowledgement) error {
+	switch dispatchedAck := ack.Response.(type) {
+	case *channeltypes.Acknowledgement_Error:
+		k.Logger(ctx).Error(fmt.Sprintf("Public keys rejected by channel %s: %s", packet.SourceChannel, dispatchedAck.Error))
+		return nil
+	case *channeltypes.Acknowledgement_Result:
+		return nil
+	default:
+		// The counter-party module doesn't implement the correct acknowledgment format
+		return errors.New("invalid acknowledgment format")
+	}
+}
+
+// OnTimeoutPubKeysPacket responds to the case where a packet has not been transmitted because of a timeout
+func (k Keeper) OnTimeoutPubKeysPacket(ctx sdk.Context, packet channeltypes.Packet, data types.PubKeysPacketData) error {
+	k.Logger(ctx).Info(fmt.Sprintf("Public keys packet to channel %s timed out", packet.SourceChannel))
+	return nil
+}
+
+// TransmitAggregatedKeySharePacket transmits the packet over IBC with the specified source port and source channel
+func (k Keeper) TransmitAggregatedKeySharePacket(
+	ctx sdk.Context,
+	packetData types.AggregatedKeySharePacketData,
+	sourcePort,
+	sourceChannel string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+) (uint64, error) {
+	packetBytes, err := packetData.GetBytes()
+	if err != nil {
+		return 0, sdkerrors.Wrap(cosmoserror.ErrJSONMarshal, "cannot marshal the packet: "+err.Error())
+	}
+
+	return k.transmitPacket(ctx, packetBytes, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp)
+}
+
+// OnRecvAggregatedKeySharePacket verifies and saves the aggregated key pushed by a trusted counterparty
+func (k Keeper) OnRecvAggregatedKeySharePacket(ctx sdk.Context, packet channeltypes.Packet, data types.AggregatedKeySharePacketData) (packetAck types.AggregatedKeySharePacketAck, err error) {
+	// validate packet data upon receiving
+	if err := data.ValidateBasic(); err != nil {
+		return packetAck, err
+	}
+
+	if err := k.VerifyTrustedChannel(ctx, packet.DestinationPort, packet.DestinationChannel); err != nil {
+		return packetAck, err
+	}
+
+	// The key may already be submitted with MsgCreateAggregatedKeyShare
+	if _, found := k.GetAggregatedKeyShare(ctx, data.Height); found {
+		return packetAck, nil
+	}
+
+	pubKey, err := k.VerifyAggregatedKeyShare(ctx, "", data.Height, data.Data)
+	if err != nil {
+		return packetAck, err
+	}
+
+	k.AddAggregatedKeyShare(ctx, types.AggregatedKeyShare{
+		Height:    data.Height,
+		Data:      data.Data,
+		PublicKey: pubKey,
+	})
+
+	return packetAck, nil
+}
+
+// OnAcknowledgementAggregatedKeySharePacket responds to the success or failure of a packet
+// acknowledgement written on the receiving chain.
+func (k Keeper) OnAcknowledgementAggregatedKeySharePacket(ctx sdk.Context, packet channeltypes.Packet, data types.AggregatedKeySharePacketData, ack channeltypes.Acknowledgement) error {
+	switch dispatchedAck := ack.Response.(type) {
+	case *channeltypes.Acknowledgement_Error:
+		k.Logger(ctx).Error(fmt.Sprintf("Aggregated keyshare of height %d rejected by channel %s: %s", data.Height, packet.SourceChannel, dispatchedAck.Error))
+		return nil
+	case *channeltypes.Acknowledgement_Result:
+		return nil
+	default:
+		// The counter-party module doesn't implement the correct acknowledgment format
+		return errors.New("invalid acknowledgment format")
+	}
+}
+
+// OnTimeoutAggregatedKeySharePacket responds to the case where a packet has not been transmitted because of a timeout
+func (k Keeper) OnTimeoutAggregatedKeySharePacket(ctx sdk.Context, packet channeltypes.Packet, data types.AggregatedKeySharePacketData) error {
+	k.Logger(ctx).Info(fmt.Sprintf("Aggregated keyshare packet of height %d to channel %s timed out", data.Height, packet.SourceChannel))
+	return nil
+}
@@ -0,0 +1,88 @@
+package keeper_test
+
+import (
+	"testing"
+
+	keepertest "fairyring/testutil/keeper"
+	"fairyring/x/pep/types"
+
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubscribeChannel(t *testing.T) {
+	keeper, ctx := keepertest.PepKeeper(t)
+
+	// Only the channels over an allowed connection are subscribed
+	require.ErrorIs(t, keeper.SubscribeChannel(ctx, "channel-0"), types.ErrUnallowedSubscriber)
+	require.Empty(t, keeper.GetAllSubscribedChannels(ctx))
+
+	params := keeper.GetParams(ctx)
+	params.SubscriberConnectionIds = []string{"connection-0"}
+	params.MaxSubscribedChannels = 2
+	keeper.SetParams(ctx, params)
+
+	require.NoError(t, keeper.SubscribeChannel(ctx, "channel-0"))
+	require.NoError(t, keeper.SubscribeChannel(ctx, "channel-1"))
+	require.ErrorIs(t, keeper.SubscribeChannel(ctx, "channel-2"), types.ErrMaxSubscribedChannels)
+	require.ElementsMatch(t, []string{"channel-0", "channel-1"}, keeper.GetAllSubscribedChannels(ctx))
+	require.ElementsMatch(t, []string{"channel-0", "channel-1"}, keeper.GetAllPendingKeySync(ctx))
+
+	keeper.UnsubscribeChannel(ctx, "channel-1")
+	require.Equal(t, []string{"channel-0"}, keeper.GetAllSubscribedChannels(ctx))
+
+	// Pending channels are synced once, even when the keys can't be sent
+	keeper.SetQueuedPubKey(ctx, types.QueuedPubKey{PublicKey: "pubkey", Expiry: 100})
+	keeper.SyncSubscribedChannels(ctx)
+	require.Empty(t, keeper.GetAllPendingKeySync(ctx))
+	require.Equal(t, []string{"channel-0"}, keeper.GetAllSubscribedChannels(ctx))
+}
+
+func TestOnRecvPushedKeysFromUntrustedChannel(t *testing.T) {
+	keeper, ctx := keepertest.PepKeeper(t)
+	packet := channeltypes.Packet{DestinationPort: types.PortID, DestinationChannel: "channel-0"}
+
+	_, err := keeper.OnRecvPubKeysPacket(ctx, packet, types.PubKeysPacketData{})
+	require.Error(t, err)
+
+	_, err = keeper.OnRecvPubKeysPacket(ctx, packet, types.PubKeysPacketData{
+		QueuedKey: &types.QueuedPubKey{PublicKey: "pubkey", Expiry: 100},
+	})
+	require.Error(t, err)
+	_, found := keeper.GetQueuedPubKey(ctx)
+	require.False(t, found)
+
+	_, err = keeper.OnRecvAggregatedKeySharePacket(ctx, packet, types.AggregatedKeySharePacketData{Height: 1, Data: "key"})
+	require.Error(t, err)
+	_, found = keeper.GetAggregatedKeyShare(ctx, 1)
+	require.False(t, found)
+}
+
+func TestPushAggregatedKeyShare(t *testing.T) {
+	keeper, ctx := keepertest.PepKeeper(t)
+
+	// Nothing is queued without subscribers
+	keeper.PushAggregatedKeyShare(ctx, 1, "key")
+	require.Empty(t, keeper.GetAllPendingAggregatedKeyPush(ctx))
+
+	params := keeper.GetParams(ctx)
+	params.SubscriberConnectionIds = []string{"connection-0"}
+	keeper.SetParams(ctx, params)
+	require.NoError(t, keeper.SubscribeChannel(ctx, "channel-0"))
+
+	keeper.PushAggregatedKeyShare(ctx, 1, "key")
+	keeper.PushAggregatedKeyShare(ctx, 2, "key")
+	require.Len(t, keeper.GetAllPendingAggregatedKeyPush(ctx), 2)
+
+	// The keys are pushed once at the end of the block, even when the packets can't be sent
+	keeper.PushPendingAggregatedKeyShares(ctx)
+	require.Empty(t, keeper.GetAllPendingAggregatedKeyPush(ctx))
+	require.Equal(t, []string{"channel-0"}, keeper.GetAllSubscribedChannels(ctx))
+
+	// Channels whose connection is no longer allowed are unsubscribed
+	params.SubscriberConnectionIds = nil
+	keeper.SetParams(ctx, params)
+	keeper.PushAggregatedKeyShare(ctx, 3, "key")
+	keeper.PushPendingAggregatedKeyShares(ctx)
+	require.Empty(t, keeper.GetAllSubscribedChannels(ctx))
+}
@@ -0,0 +1,89 @@
+package keeper
+
+import (
+	"fairyring/x/pep/types"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetSubscribedChannel saves a channel the keys are pushed to
+func (k Keeper) SetSubscribedChannel(ctx sdk.Context, channelID string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SubscribedChannelKeyPrefix))
+	store.Set(types.SubscribedChannelKey(channelID), []byte(channelID))
+}
+
+// RemoveSubscribedChannel stops pushing the keys to a channel
+func (k Keeper) RemoveSubscribedChannel(ctx sdk.Context, channelID string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SubscribedChannelKeyPrefix))
+	store.Delete(types.SubscribedChannelKey(channelID))
+}
+
+// GetAllSubscribedChannels returns all the channels the keys are pushed to
+func (k Keeper) GetAllSubscribedChannels(ctx sdk.Context) (list []string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SubscribedChannelKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		list = append(list, string(iterator.Value()))
+	}
+
+	return
+}
+
+// SetPendingKeySync marks a subscribed channel as waiting for the current keys
+func (k Keeper) SetPendingKeySync(ctx sdk.Context, channelID string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PendingKeySyncKeyPrefix))
+	store.Set(types.SubscribedChannelKey(channelID), []byte(channelID))
+}
+
+// RemovePendingKeySync removes a subscribed channel from the ones waiting for the current keys
+func (k Keeper) RemovePendingKeySync(ctx sdk.Context, channelID string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PendingKeySyncKeyPrefix))
+	store.Delete(types.SubscribedChannelKey(channelID))
+}
+
+// GetAllPendingKeySync returns all the subscribed channels waiting for the current keys
+func (k Keeper) GetAllPendingKeySync(ctx sdk.Context) (list []string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PendingKeySyncKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		list = append(list, string(iterator.Value()))
+	}
+
+	return
+}
+
+// SetPendingAggregatedKeyPush queues an aggregated key to be pushed to the subscribed channels
+func (k Keeper) SetPendingAggregatedKeyPush(ctx sdk.Context, aggregatedKeyShare types.AggregatedKeyShare) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PendingAggregatedKeyPushKeyPrefix))
+	b := k.cdc.MustMarshal(&aggregatedKeyShare)
+	store.Set(types.AggregatedKeyShareKey(aggregatedKeyShare.Height), b)
+}
+
+// RemovePendingAggregatedKeyPush removes the aggregated key of a height from the ones waiting to be pushed
+func (k Keeper) RemovePendingAggregatedKeyPush(ctx sdk.Context, height uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PendingAggregatedKeyPushKeyPrefix))
+	store.Delete(types.AggregatedKeyShareKey(height))
+}
+
+// GetAllPendingAggregatedKeyPush returns all the aggregated keys waiting to be pushed to the subscribed channels
+func (k Keeper) GetAllPendingAggregatedKeyPush(ctx sdk.Context) (list []types.AggregatedKeyShare) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PendingAggregatedKeyPushKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.AggregatedKeyShare
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
@@ -8,17 +8,18 @@ import (
 	"fmt"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 
+	sdkerrors "cosmossdk.io/errors"
 	cosmosmath "cosmossdk.io/math"
 
 	enc "github.com/FairBlock/DistributedIBE/encryption"
 
 	"math"
 	"strconv"
-	"strings"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/drand/kyber"
 	bls "github.com/drand/kyber-bls12381"
 
 	// this line is used by starport scaffolding # 1
@@ -36,6 +37,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserror "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/module"
 )
 
@@ -110,13 +112,14 @@ func (AppModuleBasic) GetQueryCmd() *cobra.Command {
 type AppModule struct {
 	AppModuleBasic
 
-	keeper        keeper.Keeper
-	accountKeeper types.AccountKeeper
-	bankKeeper    types.BankKeeper
+	keeper         keeper.Keeper
+	accountKeeper  types.AccountKeeper
+	bankKeeper     types.BankKeeper
+	keyshareKeeper types.KeyshareKeeper
 
 	msgServiceRouter *baseapp.MsgServiceRouter
 	txConfig         client.TxConfig
-	simCheck         func(txEncoder sdk.TxEncoder, tx sdk.Tx) (sdk.GasInfo, *sdk.Result, error)
+	anteHandler      sdk.AnteHandler
 }
 
 func NewAppModule(
@@ -124,18 +127,20 @@ func NewAppModule(
 	keeper keeper.Keeper,
 	accountKeeper types.AccountKeeper,
 	bankKeeper types.BankKeeper,
+	keyshareKeeper types.KeyshareKeeper,
 	msgServiceRouter *baseapp.MsgServiceRouter,
 	txConfig client.TxConfig,
-	simCheck func(txEncoder sdk.TxEncoder, tx sdk.Tx) (sdk.GasInfo, *sdk.Result, error),
+	anteHandler sdk.AnteHandler,
 ) AppModule {
 	return AppModule{
 		AppModuleBasic:   AppModuleBasic{cdc: cdc, cdcJson: cdc},
 		keeper:           keeper,
 		accountKeeper:    accountKeeper,
 		bankKeeper:       bankKeeper,
+		keyshareKeeper:   keyshareKeeper,
 		msgServiceRouter: msgServiceRouter,
 		txConfig:         txConfig,
-		simCheck:         simCheck,
+		anteHandler:      anteHandler,
 	}
 }
 
@@ -168,10 +173,53 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 // ConsensusVersion is a sequence number for state-breaking change of the module. It should be incremented on each consensus-breaking change introduced by the module. To avoid wrong/empty versions, the initial version should be set to 1
 func (AppModule) ConsensusVersion() uint64 { return 1 }
 
-func (am AppModule) handleGasConsumption(ctx sdk.Context, recipient sdk.AccAddress, gasUsed cosmosmath.Int, gasCharged *sdk.Coin) {
+// defaultEncryptedTxGasPrice is the price of the gas used by an encrypted tx whose decrypted tx does not pay
+// any fee, e.g. because it could not be decrypted. The charged gas is then counted at one unit per gas
+var defaultEncryptedTxGasPrice = sdk.OneDec()
+
+// decryptedTxGasPrice returns the gas price paid by a decrypted tx in the denom of the charged gas,
+// which is its fee divided by the gas it declares
+func decryptedTxGasPrice(tx sdk.Tx, denom string) (sdk.Dec, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok || feeTx.GetFee().Empty() || feeTx.GetGas() == 0 {
+		return defaultEncryptedTxGasPrice, nil
+	}
+
+	fee := feeTx.GetFee().AmountOf(denom)
+	if !fee.IsPositive() {
+		return sdk.Dec{}, fmt.Errorf(
+			"underlying tx gas denom does not match charged gas denom, got: %s, expect: %s",
+			feeTx.GetFee()[0].Denom, denom,
+		)
+	}
+
+	return sdk.NewDecFromInt(fee).QuoInt(cosmosmath.NewIntFromUint64(feeTx.GetGas())), nil
+}
+
+// chargedGasLimit returns the gas the charged gas pays for at the gas price
+func chargedGasLimit(gasCharged *sdk.Coin, gasPrice sdk.Dec) uint64 {
+	gas := sdk.NewDecFromInt(gasCharged.Amount).Quo(gasPrice).TruncateInt()
+	if !gas.IsUint64() {
+		return math.MaxUint64
+	}
+	return gas.Uint64()
+}
+
+// handleGasConsumption settles the gas charged when an encrypted tx was submitted against the gas used by its
+// decryption and execution, converted to the charged denom with the gas price: the creator is charged the cost above the
+// charged gas, or refunded the charged gas that was not used. It returns an error if the cost above the
+// charged gas can't be deducted from the creator, in which case the whole charged gas is kept
+func (am AppModule) handleGasConsumption(ctx sdk.Context, recipient sdk.AccAddress, gasUsed uint64, gasPrice sdk.Dec, gasCharged *sdk.Coin) error {
 	creatorAccount := am.accountKeeper.GetAccount(ctx, recipient)
+	cost := gasPrice.MulInt(cosmosmath.NewIntFromUint64(gasUsed)).Ceil().TruncateInt()
 
-	if gasUsed.GT(gasCharged.Amount) {
+	// The charged gas that is not refunded funds the keyshare reward pool
+	defer am.collectKeyshareRewards(ctx, sdk.NewCoin(gasCharged.Denom, cosmosmath.MinInt(cost, gasCharged.Amount)))
+
+	if cost.GT(gasCharged.Amount) {
+		if creatorAccount == nil {
+			return cosmoserror.ErrUnknownAddress.Wrapf("creator account %s does not exist", recipient)
+		}
 		deductFeeErr := ante.DeductFees(
 			am.bankKeeper,
 			ctx,
@@ -179,37 +227,51 @@ func (am AppModule) handleGasConsumption(ctx sdk.Context, recipient sdk.AccAddre
 			sdk.NewCoins(
 				sdk.NewCoin(
 					gasCharged.Denom,
-					gasUsed.Sub(gasCharged.Amount)),
+					cost.Sub(gasCharged.Amount)),
 			),
 		)
 		if deductFeeErr != nil {
-			am.keeper.Logger(ctx).Error("deduct failed tx fee error")
-			am.keeper.Logger(ctx).Error(deductFeeErr.Error())
-		} else {
-			am.keeper.Logger(ctx).Info("failed tx fee deducted without error")
+			return sdkerrors.Wrap(deductFeeErr, "error deducting the gas used above the charged gas")
 		}
+		am.keeper.Logger(ctx).Info("failed tx fee deducted without error")
+		return nil
+	}
+
+	amount := gasCharged.Amount.Sub(cost)
+	if amount.IsZero() {
+		am.keeper.Logger(ctx).Info("refund failed tx fee amount is zero, no need to refund...")
+		return nil
+	}
+	refundFeeErr := am.bankKeeper.SendCoinsFromModuleToAccount(
+		ctx,
+		types.ModuleName,
+		recipient,
+		sdk.NewCoins(sdk.NewCoin(gasCharged.Denom, amount)),
+	)
+	if refundFeeErr != nil {
+		am.keeper.Logger(ctx).Error("refund failed tx fee error")
+		am.keeper.Logger(ctx).Error(refundFeeErr.Error())
 	} else {
-		amount := gasCharged.Amount.Sub(gasUsed)
-		if amount.IsZero() {
-			am.keeper.Logger(ctx).Info("refund failed tx fee amount is zero, no need to refund...")
-			return
-		}
-		refundFeeErr := am.bankKeeper.SendCoinsFromModuleToAccount(
-			ctx,
-			types.ModuleName,
-			recipient,
-			sdk.NewCoins(sdk.NewCoin(gasCharged.Denom, amount)),
-		)
-		if refundFeeErr != nil {
-			am.keeper.Logger(ctx).Error("refund failed tx fee error")
-			am.keeper.Logger(ctx).Error(refundFeeErr.Error())
-		} else {
-			am.keeper.Logger(ctx).Info("failed tx fee refunded without error")
-		}
+		am.keeper.Logger(ctx).Info("failed tx fee refunded without error")
+	}
+	return nil
+}
+
+// collectKeyshareRewards sends the share of an encrypted tx fee kept by the module to the keyshare reward pool
+func (am AppModule) collectKeyshareRewards(ctx sdk.Context, retainedFee sdk.Coin) {
+	if !retainedFee.IsPositive() {
+		return
+	}
+
+	err := am.keyshareKeeper.CollectEncryptedTxFees(ctx, types.ModuleName, sdk.NewCoins(retainedFee))
+	if err != nil {
+		am.keeper.Logger(ctx).Error("collect keyshare rewards error")
+		am.keeper.Logger(ctx).Error(err.Error())
 	}
 }
 
-func (am AppModule) processFailedEncryptedTx(ctx sdk.Context, tx types.EncryptedTx, failReason string, startConsumedGas uint64) {
+// revertEncryptedTx emits the event of a reverted encrypted tx, it does not settle its charged gas
+func (am AppModule) revertEncryptedTx(ctx sdk.Context, tx types.EncryptedTx, failReason string) {
 	am.keeper.Logger(ctx).Error(fmt.Sprintf("failed to process encrypted tx: %s", failReason))
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(types.EncryptedTxRevertedEventType,
@@ -219,6 +281,13 @@ func (am AppModule) processFailedEncryptedTx(ctx sdk.Context, tx types.Encrypted
 			sdk.NewAttribute(types.EncryptedTxRevertedEventIndex, strconv.FormatUint(tx.Index, 10)),
 		),
 	)
+	telemetry.IncrCounter(1, types.KeyTotalFailedEncryptedTx)
+}
+
+// processFailedEncryptedTx reverts an encrypted tx, the charged gas is settled at the gas price against the gas
+// consumed on the block context since startConsumedGas and the gas used by the execution of its decrypted tx
+func (am AppModule) processFailedEncryptedTx(ctx sdk.Context, tx types.EncryptedTx, failReason string, startConsumedGas, executionGas uint64, gasPrice sdk.Dec) {
+	am.revertEncryptedTx(ctx, tx, failReason)
 
 	creatorAddr, err := sdk.AccAddressFromBech32(tx.Creator)
 	if err != nil {
@@ -231,8 +300,271 @@ func (am AppModule) processFailedEncryptedTx(ctx sdk.Context, tx types.Encrypted
 	if ctx.GasMeter().GasConsumed() > startConsumedGas {
 		actualGasConsumed = ctx.GasMeter().GasConsumed() - startConsumedGas
 	}
-	defer telemetry.IncrCounter(1, types.KeyTotalFailedEncryptedTx)
-	am.handleGasConsumption(ctx, creatorAddr, cosmosmath.NewIntFromUint64(actualGasConsumed), tx.ChargedGas)
+	if err := am.handleGasConsumption(ctx, creatorAddr, actualGasConsumed+executionGas, gasPrice, tx.ChargedGas); err != nil {
+		am.keeper.Logger(ctx).Error("settle failed tx charged gas error")
+		am.keeper.Logger(ctx).Error(err.Error())
+	}
+}
+
+// isEncryptedTxSigner checks that the creator of an encrypted tx is one of the signers of the decrypted tx
+func isEncryptedTxSigner(tx authsigning.SigVerifiableTx, creator sdk.AccAddress) bool {
+	for _, signer := range tx.GetSigners() {
+		if signer.Equals(creator) {
+			return true
+		}
+	}
+	return false
+}
+
+// executeEncryptedTx runs the ante handler and the messages of a decrypted tx with a gas meter capped at
+// the gas declared by the tx, like a regular tx, and at gasLimit, and returns the gas used by the tx.
+// The changes of the ante handler, such as the fee deduction and the pep nonces, are kept even if the
+// execution of the messages fails. The changes of the messages are only kept once writeMsgs is called.
+// Panics are recovered and returned as errors so a failing encrypted tx can not halt the chain
+func (am AppModule) executeEncryptedTx(ctx sdk.Context, tx sdk.Tx, txBytes []byte, gasLimit uint64) (txMsgData *sdk.TxMsgData, gasUsed uint64, writeMsgs func(), err error) {
+	// Replaced by the gas meter of the tx once the ante handler has set it up
+	gasMeter := sdk.NewInfiniteGasMeter()
+
+	defer func() {
+		if r := recover(); r != nil {
+			txMsgData = nil
+			writeMsgs = nil
+			gasUsed = gasMeter.GasConsumed()
+			switch rType := r.(type) {
+			case sdk.ErrorOutOfGas:
+				err = cosmoserror.ErrOutOfGas.Wrapf(
+					"out of gas in location: %v; gasWanted: %d, gasUsed: %d",
+					rType.Descriptor, gasMeter.Limit(), gasMeter.GasConsumed(),
+				)
+			default:
+				err = cosmoserror.ErrPanic.Wrapf("recovered panic when executing decrypted tx: %v", r)
+			}
+		}
+	}()
+
+	// The SetUpContextDecorator replaces the gas meter with one capped at the gas declared by the tx
+	anteCtx, writeAnte := ctx.WithTxBytes(txBytes).CacheContext()
+	newCtx, err := am.anteHandler(anteCtx, tx, false)
+	if newCtx.GasMeter() != nil {
+		gasMeter = newCtx.GasMeter()
+	}
+	if err != nil {
+		return nil, gasMeter.GasConsumed(), nil, sdkerrors.Wrap(err, "error when running ante handler on decrypted tx")
+	}
+	writeAnte()
+
+	// The tx can't use more gas than its charged gas pays for
+	if gasLimit < gasMeter.Limit() {
+		anteGas := gasMeter.GasConsumed()
+		gasMeter = sdk.NewGasMeter(gasLimit)
+		gasMeter.ConsumeGas(anteGas, "ante handler of decrypted tx")
+	}
+
+	txMsgData, writeMsgs, err = am.executeEncryptedTxMsgs(ctx.WithTxBytes(txBytes).WithGasMeter(gasMeter), tx.GetMsgs())
+	if err != nil {
+		return nil, gasMeter.GasConsumed(), nil, sdkerrors.Wrap(err, "error when handling tx message")
+	}
+
+	return txMsgData, gasMeter.GasConsumed(), writeMsgs, nil
+}
+
+// executeEncryptedTxMsgs executes all the messages of a decrypted tx in a cached context and returns the
+// function writing its state changes and events, which is only returned when every message succeeds, like in a regular tx
+func (am AppModule) executeEncryptedTxMsgs(ctx sdk.Context, msgs []sdk.Msg) (*sdk.TxMsgData, func(), error) {
+	for i, msg := range msgs {
+		if err := msg.ValidateBasic(); err != nil {
+			return nil, nil, sdkerrors.Wrapf(err, "invalid message; message index: %d", i)
+		}
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	msgResponses := make([]*cdctypes.Any, 0, len(msgs))
+
+	for i, msg := range msgs {
+		handler := am.msgServiceRouter.Handler(msg)
+		if handler == nil {
+			return nil, nil, cosmoserror.ErrUnknownRequest.Wrapf("can't route message %s; message index: %d", sdk.MsgTypeURL(msg), i)
+		}
+
+		msgResult, err := handler(cacheCtx, msg)
+		if err != nil {
+			return nil, nil, sdkerrors.Wrapf(err, "failed to execute message; message index: %d", i)
+		}
+
+		cacheCtx.EventManager().EmitEvent(
+			sdk.NewEvent(sdk.EventTypeMessage, sdk.NewAttribute(sdk.AttributeKeyAction, sdk.MsgTypeURL(msg))),
+		)
+		cacheCtx.EventManager().EmitEvents(msgResult.GetEvents())
+		msgResponses = append(msgResponses, msgResult.MsgResponses...)
+	}
+
+	return &sdk.TxMsgData{MsgResponses: msgResponses}, write, nil
+}
+
+// revertPendingEncryptedTxs reverts the encrypted txs of a height that can't be decrypted,
+// e.g. when its decryption key did not arrive within the late decryption window
+func (am AppModule) revertPendingEncryptedTxs(ctx sdk.Context, height uint64, reason string) {
+	arr := am.keeper.GetEncryptedTxAllFromHeight(ctx, height)
+	for _, eachTx := range arr.EncryptedTx {
+		am.processFailedEncryptedTx(ctx, eachTx, reason, ctx.GasMeter().GasConsumed(), 0, defaultEncryptedTxGasPrice)
+	}
+	am.keeper.RemoveAllEncryptedTxFromHeight(ctx, height)
+}
+
+// decodeDecryptionKeys decodes the hex encoded public key and aggregated key used to decrypt the encrypted txs of a height
+func decodeDecryptionKeys(pubKey, aggrKey string) (kyber.Point, kyber.Point, error) {
+	suite := bls.NewBLS12381Suite()
+
+	publicKeyByte, err := hex.DecodeString(pubKey)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error decoding public key: %w", err)
+	}
+	publicKeyPoint := suite.G1().Point()
+	if err := publicKeyPoint.UnmarshalBinary(publicKeyByte); err != nil {
+		return nil, nil, fmt.Errorf("error unmarshalling public key: %w", err)
+	}
+
+	keyByte, err := hex.DecodeString(aggrKey)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error decoding aggregated key: %w", err)
+	}
+	skPoint := suite.G2().Point()
+	if err := skPoint.UnmarshalBinary(keyByte); err != nil {
+		return nil, nil, fmt.Errorf("error unmarshalling aggregated key: %w", err)
+	}
+
+	return publicKeyPoint, skPoint, nil
+}
+
+// processEncryptedTx decrypts, verifies and executes an encrypted tx, it returns the gas used by the
+// execution of the decrypted tx, which is metered apart from the gas consumed on the block context
+func (am AppModule) processEncryptedTx(ctx sdk.Context, eachTx types.EncryptedTx, publicKeyPoint, skPoint kyber.Point) uint64 {
+	startConsumedGas := ctx.GasMeter().GasConsumed()
+	if currentNonce, found := am.keeper.GetPepNonce(ctx, eachTx.Creator); found && currentNonce.Nonce == math.MaxUint64 {
+		am.processFailedEncryptedTx(ctx, eachTx, "invalid pep nonce", startConsumedGas, 0, defaultEncryptedTxGasPrice)
+		return 0
+	}
+
+	creatorAddr, err := sdk.AccAddressFromBech32(eachTx.Creator)
+	if err != nil {
+		am.processFailedEncryptedTx(ctx, eachTx, fmt.Sprintf("error parsing creator address: %s", err.Error()), startConsumedGas, 0, defaultEncryptedTxGasPrice)
+		return 0
+	}
+
+	txBytes, err := hex.DecodeString(eachTx.Data)
+	if err != nil {
+		am.processFailedEncryptedTx(ctx, eachTx, fmt.Sprintf("error decoding tx data to bytes: %s", err.Error()), startConsumedGas, 0, defaultEncryptedTxGasPrice)
+		return 0
+	}
+
+	var decryptedTx bytes.Buffer
+	var txBuffer bytes.Buffer
+	_, err = txBuffer.Write(txBytes)
+	if err != nil {
+		am.processFailedEncryptedTx(ctx, eachTx, fmt.Sprintf("error while writing bytes to tx buffer: %s", err.Error()), startConsumedGas, 0, defaultEncryptedTxGasPrice)
+		return 0
+	}
+
+	err = enc.Decrypt(publicKeyPoint, skPoint, &decryptedTx, &txBuffer)
+	if err != nil {
+		am.processFailedEncryptedTx(ctx, eachTx, fmt.Sprintf("error decrypting tx data: %s", err.Error()), startConsumedGas, 0, defaultEncryptedTxGasPrice)
+		return 0
+	}
+
+	am.keeper.Logger(ctx).Info(fmt.Sprintf("Decrypt TX Successfully: %s", decryptedTx.String()))
+
+	txDecoderTx, err := am.txConfig.TxDecoder()(decryptedTx.Bytes())
+
+	if err != nil {
+		am.keeper.Logger(ctx).Error("Decoding Tx error in BeginBlock... Trying JSON Decoder")
+		am.keeper.Logger(ctx).Error(err.Error())
+
+		txDecoderTx, err = am.txConfig.TxJSONDecoder()(decryptedTx.Bytes())
+		if err != nil {
+			am.keeper.Logger(ctx).Error("JSON Decoding Tx error in BeginBlock")
+			am.keeper.Logger(ctx).Error(err.Error())
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(types.EncryptedTxRevertedEventType,
+					sdk.NewAttribute(types.EncryptedTxRevertedEventCreator, eachTx.Creator),
+					sdk.NewAttribute(types.EncryptedTxRevertedEventHeight, strconv.FormatUint(eachTx.TargetHeight, 10)),
+					sdk.NewAttribute(types.EncryptedTxRevertedEventReason, "Unable to decode tx data to Cosmos Tx"),
+					sdk.NewAttribute(types.EncryptedTxRevertedEventIndex, strconv.FormatUint(eachTx.Index, 10)),
+				),
+			)
+
+			am.processFailedEncryptedTx(ctx, eachTx, fmt.Sprintf("error trying to json decoding tx: %s", err.Error()), startConsumedGas, 0, defaultEncryptedTxGasPrice)
+			return 0
+		} else {
+			am.keeper.Logger(ctx).Error("TX Successfully Decode with JSON Decoder")
+		}
+	}
+
+	wrappedTx, err := am.txConfig.WrapTxBuilder(txDecoderTx)
+	if err != nil {
+		am.processFailedEncryptedTx(ctx, eachTx, fmt.Sprintf("error when trying to wrap decoded tx to tx builder: %s", err.Error()), startConsumedGas, 0, defaultEncryptedTxGasPrice)
+		return 0
+	}
+
+	txMsgs := wrappedTx.GetTx().GetMsgs()
+
+	if len(txMsgs) == 0 {
+		am.processFailedEncryptedTx(ctx, eachTx, "tx does not contain any message", startConsumedGas, 0, defaultEncryptedTxGasPrice)
+		return 0
+	}
+
+	if !isEncryptedTxSigner(wrappedTx.GetTx(), creatorAddr) {
+		am.processFailedEncryptedTx(ctx, eachTx, "tx sender is not one of the tx signers", startConsumedGas, 0, defaultEncryptedTxGasPrice)
+		return 0
+	}
+
+	gasPrice, err := decryptedTxGasPrice(wrappedTx.GetTx(), eachTx.ChargedGas.Denom)
+	if err != nil {
+		am.processFailedEncryptedTx(ctx, eachTx, err.Error(), startConsumedGas, 0, defaultEncryptedTxGasPrice)
+		return 0
+	}
+
+	decryptionConsumed := ctx.GasMeter().GasConsumed() - startConsumedGas
+
+	// The execution can only use the gas the charged gas pays for once the decryption is paid
+	var executionGasLimit uint64 = 0
+	if chargedGas := chargedGasLimit(eachTx.ChargedGas, gasPrice); chargedGas > decryptionConsumed {
+		executionGasLimit = chargedGas - decryptionConsumed
+	}
+
+	txMsgData, executionGas, writeMsgs, err := am.executeEncryptedTx(ctx, txDecoderTx, decryptedTx.Bytes(), executionGasLimit)
+	if err != nil {
+		am.processFailedEncryptedTx(ctx, eachTx, err.Error(), startConsumedGas, executionGas, gasPrice)
+		return executionGas
+	}
+
+	// The execution is reverted, by not writing the changes of its messages, if its cost can't be paid
+	if err := am.handleGasConsumption(ctx, creatorAddr, decryptionConsumed+executionGas, gasPrice, eachTx.ChargedGas); err != nil {
+		am.revertEncryptedTx(ctx, eachTx, err.Error())
+		return executionGas
+	}
+	writeMsgs()
+
+	msgResponses, err := am.cdcJson.MarshalJSON(txMsgData)
+	if err != nil {
+		am.keeper.Logger(ctx).Error("Error encoding encrypted tx message responses")
+		am.keeper.Logger(ctx).Error(err.Error())
+	}
+
+	am.keeper.Logger(ctx).Info("! Encrypted Tx Decrypted & Decoded & Executed successfully !")
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EncryptedTxExecutedEventType,
+			sdk.NewAttribute(types.EncryptedTxExecutedEventCreator, eachTx.Creator),
+			sdk.NewAttribute(types.EncryptedTxExecutedEventHeight, strconv.FormatUint(eachTx.TargetHeight, 10)),
+			sdk.NewAttribute(types.EncryptedTxExecutedEventData, eachTx.Data),
+			sdk.NewAttribute(types.EncryptedTxExecutedEventIndex, strconv.FormatUint(eachTx.Index, 10)),
+			sdk.NewAttribute(types.EncryptedTxExecutedEventMsgResponses, string(msgResponses)),
+		),
+	)
+
+	telemetry.IncrCounter(1, types.KeyTotalSuccessEncryptedTx)
+
+	return executionGas
 }
 
 // BeginBlock contains the logic that is automatically triggered at the beginning of each block
@@ -245,10 +577,6 @@ func (am AppModule) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
 		lastExecutedHeight = 0
 	}
 
-	allAggKey := am.keeper.GetAllAggregatedKeyShare(ctx)
-
-	am.keeper.Logger(ctx).Info(fmt.Sprintf("[PEP][AGGKEY] %v", allAggKey))
-
 	strHeight := am.keeper.GetLatestHeight(ctx)
 	height, err := strconv.ParseUint(strHeight, 10, 64)
 
@@ -271,290 +599,115 @@ func (am AppModule) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
 		return
 	}
 
-	// loop over all encrypted Txs from the last executed height to the current height
-	for h := lastExecutedHeight + 1; h <= height; h++ {
-		arr := am.keeper.GetEncryptedTxAllFromHeight(ctx, h)
-		am.keeper.SetLastExecutedHeight(ctx, strconv.FormatUint(h, 10))
+	lateDecryptionWindow := am.keeper.LateDecryptionWindow(ctx)
 
-		key, found := am.keeper.GetAggregatedKeyShare(ctx, h)
-		if !found {
-			am.keeper.Logger(ctx).Error(fmt.Sprintf("Decryption key not found for block height: %d", h))
+	// Heights whose decryption key arrived late are queued for execution,
+	// the ones still missing their key after the late decryption window are reverted
+	for _, h := range am.keeper.GetAllPendingExecutionHeight(ctx) {
+		if _, found := am.keeper.GetAggregatedKeyShare(ctx, h); found {
+			am.keeper.RemovePendingExecutionHeight(ctx, h)
+			am.keeper.SetExecutionQueueEntry(ctx, types.EncryptedTxExecutionQueueEntry{Height: h})
 			continue
 		}
-
-		publicKeyByte, err := hex.DecodeString(activePubkey.PublicKey)
-		if err != nil {
-			am.keeper.Logger(ctx).Error("Error decoding active public key")
-			am.keeper.Logger(ctx).Error(err.Error())
-			return
+		if h+lateDecryptionWindow < height {
+			am.keeper.RemovePendingExecutionHeight(ctx, h)
+			am.revertPendingEncryptedTxs(ctx, h, "decryption key not received within the late decryption window")
 		}
+	}
 
-		suite := bls.NewBLS12381Suite()
+	maxTxs := am.keeper.MaxEncryptedTxsPerBlock(ctx)
+	maxGas := am.keeper.MaxEncryptedTxGasPerBlock(ctx)
 
-		publicKeyPoint := suite.G1().Point()
-		err = publicKeyPoint.UnmarshalBinary(publicKeyByte)
-		if err != nil {
-			am.keeper.Logger(ctx).Error("Error unmarshalling public key")
-			am.keeper.Logger(ctx).Error(err.Error())
-			return
+	// Queue the encrypted txs from the last executed height to the current height. The heights without
+	// encrypted txs are skipped, and at most maxTxs heights holding encrypted txs are scanned per block
+	// since no more can be executed in it. The last executed height is the cursor the scan resumes from
+	scanHeight := lastExecutedHeight
+	for queued := uint64(0); queued < maxTxs; queued++ {
+		h, found := am.keeper.GetNextEncryptedTxHeight(ctx, scanHeight+1, height)
+		if !found {
+			scanHeight = height
+			break
 		}
+		scanHeight = h
 
-		am.keeper.Logger(ctx).Info("Unmarshal public key successfully")
-		am.keeper.Logger(ctx).Info(publicKeyPoint.String())
-
-		keyByte, err := hex.DecodeString(key.Data)
-		if err != nil {
-			am.keeper.Logger(ctx).Error("Error decoding aggregated key")
-			am.keeper.Logger(ctx).Error(err.Error())
+		arr := am.keeper.GetEncryptedTxAllFromHeight(ctx, h)
+		if len(arr.EncryptedTx) == 0 {
 			continue
 		}
 
-		skPoint := suite.G2().Point()
-		err = skPoint.UnmarshalBinary(keyByte)
-		if err != nil {
-			am.keeper.Logger(ctx).Error("Error unmarshalling aggregated key")
-			am.keeper.Logger(ctx).Error(err.Error())
+		if _, found := am.keeper.GetAggregatedKeyShare(ctx, h); !found {
+			am.keeper.Logger(ctx).Error(fmt.Sprintf("Decryption key not found for block height: %d", h))
+			// Keep the encrypted txs until the key arrives instead of skipping them
+			am.keeper.SetPendingExecutionHeight(ctx, h)
 			continue
 		}
 
-		am.keeper.Logger(ctx).Info("Unmarshal decryption key successfully")
-		am.keeper.Logger(ctx).Info(skPoint.String())
-
-		for _, eachTx := range arr.EncryptedTx {
-			startConsumedGas := ctx.GasMeter().GasConsumed()
-			if currentNonce, found := am.keeper.GetPepNonce(ctx, eachTx.Creator); found && currentNonce.Nonce == math.MaxUint64 {
-				am.processFailedEncryptedTx(ctx, eachTx, "invalid pep nonce", startConsumedGas)
-				continue
-			}
-
-			newExecutedNonce := am.keeper.IncreasePepNonce(ctx, eachTx.Creator)
-
-			creatorAddr, err := sdk.AccAddressFromBech32(eachTx.Creator)
-			if err != nil {
-				am.processFailedEncryptedTx(ctx, eachTx, fmt.Sprintf("error parsing creator address: %s", err.Error()), startConsumedGas)
-				continue
-			}
-
-			creatorAccount := am.accountKeeper.GetAccount(ctx, creatorAddr)
-
-			txBytes, err := hex.DecodeString(eachTx.Data)
-			if err != nil {
-				am.processFailedEncryptedTx(ctx, eachTx, fmt.Sprintf("error decoding tx data to bytes: %s", err.Error()), startConsumedGas)
-				continue
-			}
-
-			var decryptedTx bytes.Buffer
-			var txBuffer bytes.Buffer
-			_, err = txBuffer.Write(txBytes)
-			if err != nil {
-				am.processFailedEncryptedTx(ctx, eachTx, fmt.Sprintf("error while writing bytes to tx buffer: %s", err.Error()), startConsumedGas)
-				continue
-			}
-
-			err = enc.Decrypt(publicKeyPoint, skPoint, &decryptedTx, &txBuffer)
-			if err != nil {
-				am.processFailedEncryptedTx(ctx, eachTx, fmt.Sprintf("error decrypting tx data: %s", err.Error()), startConsumedGas)
-				continue
-			}
-
-			am.keeper.Logger(ctx).Info(fmt.Sprintf("Decrypt TX Successfully: %s", decryptedTx.String()))
-
-			txDecoderTx, err := am.txConfig.TxDecoder()(decryptedTx.Bytes())
-
-			if err != nil {
-				am.keeper.Logger(ctx).Error("Decoding Tx error in BeginBlock... Trying JSON Decoder")
-				am.keeper.Logger(ctx).Error(err.Error())
-
-				txDecoderTx, err = am.txConfig.TxJSONDecoder()(decryptedTx.Bytes())
-				if err != nil {
-					am.keeper.Logger(ctx).Error("JSON Decoding Tx error in BeginBlock")
-					am.keeper.Logger(ctx).Error(err.Error())
-					ctx.EventManager().EmitEvent(
-						sdk.NewEvent(types.EncryptedTxRevertedEventType,
-							sdk.NewAttribute(types.EncryptedTxRevertedEventCreator, eachTx.Creator),
-							sdk.NewAttribute(types.EncryptedTxRevertedEventHeight, strconv.FormatUint(eachTx.TargetHeight, 10)),
-							sdk.NewAttribute(types.EncryptedTxRevertedEventReason, "Unable to decode tx data to Cosmos Tx"),
-							sdk.NewAttribute(types.EncryptedTxRevertedEventIndex, strconv.FormatUint(eachTx.Index, 10)),
-						),
-					)
-
-					am.processFailedEncryptedTx(ctx, eachTx, fmt.Sprintf("error trying to json decoding tx: %s", err.Error()), startConsumedGas)
-					continue
-				} else {
-					am.keeper.Logger(ctx).Error("TX Successfully Decode with JSON Decoder")
-				}
-			}
-
-			wrappedTx, err := am.txConfig.WrapTxBuilder(txDecoderTx)
-			if err != nil {
-				am.processFailedEncryptedTx(ctx, eachTx, fmt.Sprintf("error when trying to wrap decoded tx to tx builder: %s", err.Error()), startConsumedGas)
-				continue
-			}
-
-			sigs, err := wrappedTx.GetTx().GetSignaturesV2()
-			if err != nil {
-				am.processFailedEncryptedTx(ctx, eachTx, fmt.Sprintf("error getting decoded tx signatures: %s", err.Error()), startConsumedGas)
-				continue
-			}
-
-			if len(sigs) != 1 {
-				am.processFailedEncryptedTx(ctx, eachTx, "number of provided signatures is more than one", startConsumedGas)
-				continue
-			}
-
-			txMsgs := wrappedTx.GetTx().GetMsgs()
-
-			if len(sigs) != len(txMsgs) {
-				am.processFailedEncryptedTx(ctx, eachTx, "number of provided signatures is not equals to number of tx messages", startConsumedGas)
-				continue
-			}
-
-			if !sigs[0].PubKey.Equals(creatorAccount.GetPubKey()) {
-				am.processFailedEncryptedTx(ctx, eachTx, "tx signer is not tx sender", startConsumedGas)
-				continue
-			}
-
-			expectingNonce := newExecutedNonce - 1
-
-			if sigs[0].Sequence < expectingNonce {
-				am.processFailedEncryptedTx(ctx, eachTx, fmt.Sprintf("Incorrect Nonce sequence, Provided: %d, Expecting: %d", sigs[0].Sequence, expectingNonce), startConsumedGas)
-				continue
-			}
-
-			if sigs[0].Sequence > expectingNonce {
-				am.keeper.SetPepNonce(ctx, types.PepNonce{
-					Address: eachTx.Creator,
-					Nonce:   sigs[0].Sequence,
-				})
-			}
-
-			verifiableTx := wrappedTx.GetTx().(authsigning.SigVerifiableTx)
-
-			signingData := authsigning.SignerData{
-				Address:       creatorAddr.String(),
-				ChainID:       ctx.ChainID(),
-				AccountNumber: creatorAccount.GetAccountNumber(),
-				Sequence:      sigs[0].Sequence,
-				PubKey:        creatorAccount.GetPubKey(),
-			}
+		am.keeper.SetExecutionQueueEntry(ctx, types.EncryptedTxExecutionQueueEntry{Height: h})
+	}
+	if scanHeight > lastExecutedHeight {
+		am.keeper.SetLastExecutedHeight(ctx, strconv.FormatUint(scanHeight, 10))
+	}
 
-			err = authsigning.VerifySignature(
-				creatorAccount.GetPubKey(),
-				signingData,
-				sigs[0].Data,
-				am.txConfig.SignModeHandler(),
-				verifiableTx,
-			)
+	var executedTxs, spentGas uint64
 
-			if err != nil {
-				am.processFailedEncryptedTx(ctx, eachTx, fmt.Sprintf("error when verifying signature: invalid signature: %s", err.Error()), startConsumedGas)
-				continue
-			}
+	// Execute the queued encrypted txs in the order of their target heights until the
+	// block limits are reached, the remaining ones are resumed in the next block. Every
+	// queued height holds at least one encrypted tx, so no more than maxTxs heights are read
+	for _, entry := range am.keeper.GetExecutionQueueEntries(ctx, maxTxs) {
+		if executedTxs >= maxTxs || spentGas >= maxGas {
+			break
+		}
 
-			decryptionConsumed := ctx.GasMeter().GasConsumed() - startConsumedGas
-			simCheckGas, _, err := am.simCheck(am.txConfig.TxEncoder(), txDecoderTx)
-			// We are using SimCheck() to only estimate gas for the underlying transaction
-			// Since user is supposed to sign the underlying transaction with Pep Nonce,
-			// is expected that we gets 'account sequence mismatch' error
-			// however, the underlying tx is not expected to get other errors
-			// such as insufficient fee, out of gas etc...
-			if err != nil && !strings.Contains(err.Error(), "account sequence mismatch") {
-				am.processFailedEncryptedTx(ctx, eachTx, fmt.Sprintf("error while performing check tx: %s", err.Error()), startConsumedGas)
-				continue
-			}
+		h := entry.Height
+		arr := am.keeper.GetEncryptedTxAllFromHeight(ctx, h)
 
-			txFee := wrappedTx.GetTx().GetFee()
-
-			// If it passes the CheckTx but Tx Fee is empty,
-			// that means the minimum-gas-prices for the validator is 0
-			// therefore, we are not charging for the tx execution
-			if !txFee.Empty() {
-				gasProvided := cosmosmath.NewIntFromUint64(wrappedTx.GetTx().GetGas())
-				// Underlying tx consumed gas + gas consumed on decrypting & decoding tx
-				am.keeper.Logger(ctx).Info(fmt.Sprintf("Underlying tx consumed: %d, decryption consumed: %d", simCheckGas.GasUsed, decryptionConsumed))
-				gasUsedInBig := cosmosmath.NewIntFromUint64(simCheckGas.GasUsed).Add(cosmosmath.NewIntFromUint64(decryptionConsumed))
-				newCoins := make([]sdk.Coin, len(txFee))
-				refundDenom := txFee[0].Denom
-				refundAmount := cosmosmath.NewIntFromUint64(0)
-
-				usedGasFee := sdk.NewCoin(
-					txFee[0].Denom,
-					// Tx Fee Amount Divide Provide Gas => provided gas price
-					// Provided Gas Price * Gas Used => Amount to deduct as gas fee
-					txFee[0].Amount.Quo(gasProvided).Mul(gasUsedInBig),
-				)
+		key, found := am.keeper.GetAggregatedKeyShare(ctx, h)
+		if !found {
+			am.keeper.Logger(ctx).Error(fmt.Sprintf("Decryption key not found for queued block height: %d", h))
+			am.keeper.RemoveExecutionQueueEntry(ctx, h)
+			am.keeper.SetPendingExecutionHeight(ctx, h)
+			continue
+		}
 
-				if usedGasFee.Denom != eachTx.ChargedGas.Denom {
-					am.processFailedEncryptedTx(ctx, eachTx, fmt.Sprintf("underlying tx gas denom does not match charged gas denom, got: %s, expect: %s", usedGasFee.Denom, eachTx.ChargedGas.Denom), startConsumedGas)
-					continue
-				}
-
-				if usedGasFee.Amount.GT(eachTx.ChargedGas.Amount) {
-					usedGasFee.Amount = usedGasFee.Amount.Sub(eachTx.ChargedGas.Amount)
-				} else { // less than or equals to
-					refundAmount = eachTx.ChargedGas.Amount.Sub(usedGasFee.Amount)
-					usedGasFee.Amount = cosmosmath.NewIntFromUint64(0)
-				}
-
-				am.keeper.Logger(ctx).Info(fmt.Sprintf("Deduct fee amount: %v | Refund amount: %v", newCoins, refundAmount))
-
-				if refundAmount.IsZero() {
-					deductFeeErr := ante.DeductFees(am.bankKeeper, ctx, creatorAccount, sdk.NewCoins(usedGasFee))
-					if deductFeeErr != nil {
-						am.keeper.Logger(ctx).Error("Deduct fee Err")
-						am.keeper.Logger(ctx).Error(deductFeeErr.Error())
-					} else {
-						am.keeper.Logger(ctx).Info("Fee deducted without error")
-					}
-				} else {
-					refundFeeErr := am.bankKeeper.SendCoinsFromModuleToAccount(
-						ctx,
-						types.ModuleName,
-						creatorAddr,
-						sdk.NewCoins(sdk.NewCoin(refundDenom, refundAmount)),
-					)
-					if refundFeeErr != nil {
-						am.keeper.Logger(ctx).Error("Refund fee Err")
-						am.keeper.Logger(ctx).Error(refundFeeErr.Error())
-					} else {
-						am.keeper.Logger(ctx).Info("Fee refunded without error")
-					}
-				}
-			}
+		// The encrypted txs of the height are decrypted with the public key its aggregated key was verified against,
+		// the active public key may have been rotated since. Keys saved before it was recorded fall back to the active one
+		pubKey := key.PublicKey
+		if pubKey == "" {
+			pubKey = activePubkey.PublicKey
+		}
 
-			handler := am.msgServiceRouter.Handler(txMsgs[0])
-			_, err = handler(ctx, txMsgs[0])
-			if err != nil {
-				am.processFailedEncryptedTx(ctx, eachTx, fmt.Sprintf("error when handling tx message: %s", err.Error()), startConsumedGas)
-				continue
-			}
+		publicKeyPoint, skPoint, err := decodeDecryptionKeys(pubKey, key.Data)
+		if err != nil {
+			am.keeper.Logger(ctx).Error(fmt.Sprintf("Error decoding decryption keys of block height %d: %s", h, err.Error()))
+			// The encrypted txs of the height can never be decrypted, they are reverted instead of left in the store
+			am.revertPendingEncryptedTxs(ctx, h, fmt.Sprintf("invalid decryption keys: %s", err.Error()))
+			am.keeper.RemoveExecutionQueueEntry(ctx, h)
+			continue
+		}
 
-			am.keeper.Logger(ctx).Info("! Encrypted Tx Decrypted & Decoded & Executed successfully !")
+		for entry.NextIndex < uint64(len(arr.EncryptedTx)) && executedTxs < maxTxs && spentGas < maxGas {
+			startConsumedGas := ctx.GasMeter().GasConsumed()
+			executionGas := am.processEncryptedTx(ctx, arr.EncryptedTx[entry.NextIndex], publicKeyPoint, skPoint)
 
-			ctx.EventManager().EmitEvent(
-				sdk.NewEvent(types.EncryptedTxExecutedEventType,
-					sdk.NewAttribute(types.EncryptedTxExecutedEventCreator, eachTx.Creator),
-					sdk.NewAttribute(types.EncryptedTxExecutedEventHeight, strconv.FormatUint(eachTx.TargetHeight, 10)),
-					sdk.NewAttribute(types.EncryptedTxExecutedEventData, eachTx.Data),
-					sdk.NewAttribute(types.EncryptedTxExecutedEventIndex, strconv.FormatUint(eachTx.Index, 10)),
-				),
-			)
+			entry.NextIndex++
+			executedTxs++
+			spentGas += ctx.GasMeter().GasConsumed() - startConsumedGas + executionGas
+		}
 
-			telemetry.IncrCounter(1, types.KeyTotalSuccessEncryptedTx)
+		if entry.NextIndex < uint64(len(arr.EncryptedTx)) {
+			am.keeper.SetExecutionQueueEntry(ctx, entry)
+			break
 		}
 
+		am.keeper.RemoveExecutionQueueEntry(ctx, h)
 		am.keeper.RemoveAllEncryptedTxFromHeight(ctx, h)
 	}
-
 }
 
 // EndBlock contains the logic that is automatically triggered at the end of each block
 func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
-	err := am.keeper.QueryFairyringCurrentKeys(ctx)
-	if err != nil {
-		am.keeper.Logger(ctx).Error("Endblocker get keys err", err)
-		am.keeper.Logger(ctx).Error(err.Error())
-	}
+	am.keeper.SyncSubscribedChannels(ctx)
+	am.keeper.PushPendingAggregatedKeyShares(ctx)
 
 	strHeight := am.keeper.GetLatestHeight(ctx)
 	height, err := strconv.ParseUint(strHeight, 10, 64)
@@ -103,6 +103,11 @@ func (im IBCModule) OnChanOpenAck(
 	if counterpartyVersion != types.Version {
 		return sdkerrors.Wrapf(types.ErrInvalidVersion, "invalid counterparty version: %s, expected %s", counterpartyVersion, types.Version)
 	}
+
+	// Channels that can't subscribe are still opened, the keys are just not pushed to them
+	if err := im.keeper.SubscribeChannel(ctx, channelID); err != nil {
+		im.keeper.Logger(ctx).Info(fmt.Sprintf("Channel %s not subscribed to the keys: %s", channelID, err.Error()))
+	}
 	return nil
 }
 
@@ -112,7 +117,10 @@ func (im IBCModule) OnChanOpenConfirm(
 	portID,
 	channelID string,
 ) error {
-	// im.keeper.SetChannel(ctx, channelID)
+	// Channels that can't subscribe are still opened, the keys are just not pushed to them
+	if err := im.keeper.SubscribeChannel(ctx, channelID); err != nil {
+		im.keeper.Logger(ctx).Info(fmt.Sprintf("Channel %s not subscribed to the keys: %s", channelID, err.Error()))
+	}
 	return nil
 }
 
@@ -132,6 +140,7 @@ func (im IBCModule) OnChanCloseConfirm(
 	portID,
 	channelID string,
 ) error {
+	im.keeper.UnsubscribeChannel(ctx, channelID)
 	return nil
 }
 
@@ -169,6 +178,44 @@ func (im IBCModule) OnRecvPacket(
 				sdk.NewAttribute(types.AttributeKeyAckSuccess, fmt.Sprintf("%t", err != nil)),
 			),
 		)
+	case *types.PepPacketData_PubKeysPacket:
+		packetAck, err := im.keeper.OnRecvPubKeysPacket(ctx, modulePacket, *packet.PubKeysPacket)
+		if err != nil {
+			ack = channeltypes.NewErrorAcknowledgement(err)
+		} else {
+			// Encode packet acknowledgment
+			packetAckBytes, err := types.ModuleCdc.MarshalJSON(&packetAck)
+			if err != nil {
+				return channeltypes.NewErrorAcknowledgement(sdkerrors.Wrap(cosmoserror.ErrJSONMarshal, err.Error()))
+			}
+			ack = channeltypes.NewResultAcknowledgement(sdk.MustSortJSON(packetAckBytes))
+		}
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypePubKeysPacket,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+				sdk.NewAttribute(types.AttributeKeyAckSuccess, fmt.Sprintf("%t", err == nil)),
+			),
+		)
+	case *types.PepPacketData_AggregatedKeySharePacket:
+		packetAck, err := im.keeper.OnRecvAggregatedKeySharePacket(ctx, modulePacket, *packet.AggregatedKeySharePacket)
+		if err != nil {
+			ack = channeltypes.NewErrorAcknowledgement(err)
+		} else {
+			// Encode packet acknowledgment
+			packetAckBytes, err := types.ModuleCdc.MarshalJSON(&packetAck)
+			if err != nil {
+				return channeltypes.NewErrorAcknowledgement(sdkerrors.Wrap(cosmoserror.ErrJSONMarshal, err.Error()))
+			}
+			ack = channeltypes.NewResultAcknowledgement(sdk.MustSortJSON(packetAckBytes))
+		}
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeAggregatedKeySharePacket,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+				sdk.NewAttribute(types.AttributeKeyAckSuccess, fmt.Sprintf("%t", err == nil)),
+			),
+		)
 		// this line is used by starport scaffolding # ibc/packet/module/recv
 	default:
 		err := fmt.Errorf("unrecognized %s packet type: %T", types.ModuleName, packet)
@@ -208,6 +255,18 @@ func (im IBCModule) OnAcknowledgementPacket(
 			return err
 		}
 		eventType = types.EventTypeCurrentKeysPacket
+	case *types.PepPacketData_PubKeysPacket:
+		err := im.keeper.OnAcknowledgementPubKeysPacket(ctx, modulePacket, *packet.PubKeysPacket, ack)
+		if err != nil {
+			return err
+		}
+		eventType = types.EventTypePubKeysPacket
+	case *types.PepPacketData_AggregatedKeySharePacket:
+		err := im.keeper.OnAcknowledgementAggregatedKeySharePacket(ctx, modulePacket, *packet.AggregatedKeySharePacket, ack)
+		if err != nil {
+			return err
+		}
+		eventType = types.EventTypeAggregatedKeySharePacket
 		// this line is used by starport scaffolding # ibc/packet/module/ack
 	default:
 		errMsg := fmt.Sprintf("unrecognized %s packet type: %T", types.ModuleName, packet)
@@ -249,7 +308,7 @@ func (im IBCModule) OnTimeoutPacket(
 	relayer sdk.AccAddress,
 ) error {
 	var modulePacketData types.PepPacketData
-	if err := modulePacketData.Unmarshal(modulePacket.GetData()); err != nil {
+	if err := types.ModuleCdc.UnmarshalJSON(modulePacket.GetData(), &modulePacketData); err != nil {
 		return sdkerrors.Wrapf(cosmoserror.ErrUnknownRequest, "cannot unmarshal packet data: %s", err.Error())
 	}
 
@@ -260,6 +319,16 @@ func (im IBCModule) OnTimeoutPacket(
 		if err != nil {
 			return err
 		}
+	case *types.PepPacketData_PubKeysPacket:
+		err := im.keeper.OnTimeoutPubKeysPacket(ctx, modulePacket, *packet.PubKeysPacket)
+		if err != nil {
+			return err
+		}
+	case *types.PepPacketData_AggregatedKeySharePacket:
+		err := im.keeper.OnTimeoutAggregatedKeySharePacket(ctx, modulePacket, *packet.AggregatedKeySharePacket)
+		if err != nil {
+			return err
+		}
 		// this line is used by starport scaffolding # ibc/packet/module/timeout
 	default:
 		errMsg := fmt.Sprintf("unrecognized %s packet type: %T", types.ModuleName, packet)
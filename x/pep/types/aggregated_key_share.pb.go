@@ -26,6 +26,8 @@ type AggregatedKeyShare struct {
 	Height  uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
 	Data    string `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
 	Creator string `protobuf:"bytes,3,opt,name=creator,proto3" json:"creator,omitempty"`
+	// public key the aggregated key was verified against, the encrypted txs of the height are decrypted with it
+	PublicKey string `protobuf:"bytes,4,opt,name=publicKey,proto3" json:"publicKey,omitempty"`
 }
 
 func (m *AggregatedKeyShare) Reset()         { *m = AggregatedKeyShare{} }
@@ -82,6 +84,13 @@ func (m *AggregatedKeyShare) GetCreator() string {
 	return ""
 }
 
+func (m *AggregatedKeyShare) GetPublicKey() string {
+	if m != nil {
+		return m.PublicKey
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*AggregatedKeyShare)(nil), "fairyring.pep.AggregatedKeyShare")
 }
@@ -91,19 +100,20 @@ func init() {
 }
 
 var fileDescriptor_95dc3bd78b9184ad = []byte{
-	// 184 bytes of a gzipped FileDescriptorProto
+	// 200 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x48, 0x4b, 0xcc, 0x2c,
 	0xaa, 0x2c, 0xca, 0xcc, 0x4b, 0xd7, 0x2f, 0x48, 0x2d, 0xd0, 0x4f, 0x4c, 0x4f, 0x2f, 0x4a, 0x4d,
 	0x4f, 0x2c, 0x49, 0x4d, 0x89, 0xcf, 0x4e, 0xad, 0x8c, 0x2f, 0xce, 0x48, 0x2c, 0x4a, 0xd5, 0x2b,
-	0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x85, 0xab, 0xd4, 0x2b, 0x48, 0x2d, 0x50, 0x8a, 0xe2, 0x12,
+	0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x85, 0xab, 0xd4, 0x2b, 0x48, 0x2d, 0x50, 0xaa, 0xe0, 0x12,
 	0x72, 0x84, 0x2b, 0xf6, 0x4e, 0xad, 0x0c, 0x06, 0x29, 0x15, 0x12, 0xe3, 0x62, 0xcb, 0x48, 0xcd,
 	0x4c, 0xcf, 0x28, 0x91, 0x60, 0x54, 0x60, 0xd4, 0x60, 0x09, 0x82, 0xf2, 0x84, 0x84, 0xb8, 0x58,
 	0x52, 0x12, 0x4b, 0x12, 0x25, 0x98, 0x14, 0x18, 0x35, 0x38, 0x83, 0xc0, 0x6c, 0x21, 0x09, 0x2e,
-	0xf6, 0xe4, 0xa2, 0xd4, 0xc4, 0x92, 0xfc, 0x22, 0x09, 0x66, 0xb0, 0x30, 0x8c, 0xeb, 0xa4, 0x7f,
-	0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7,
-	0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0xa2, 0x08, 0xe7, 0x56, 0x80, 0x1d,
-	0x5c, 0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0x76, 0xa2, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff,
-	0xb2, 0xca, 0xe1, 0x22, 0xce, 0x00, 0x00, 0x00,
+	0xf6, 0xe4, 0xa2, 0xd4, 0xc4, 0x92, 0xfc, 0x22, 0x09, 0x66, 0xb0, 0x30, 0x8c, 0x2b, 0x24, 0xc3,
+	0xc5, 0x59, 0x50, 0x9a, 0x94, 0x93, 0x99, 0xec, 0x9d, 0x5a, 0x29, 0xc1, 0x02, 0x96, 0x43, 0x08,
+	0x38, 0xe9, 0x9f, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13,
+	0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x28, 0xc2, 0x33,
+	0x15, 0x60, 0xef, 0x94, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0x3d, 0x60, 0x0c, 0x18, 0x00,
+	0x81, 0x1d, 0x34, 0xf8, 0xec, 0x00, 0x00, 0x00,
 }
 
 func (m *AggregatedKeyShare) Marshal() (dAtA []byte, err error) {
@@ -126,6 +136,13 @@ func (m *AggregatedKeyShare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintAggregatedKeyShare(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.Creator) > 0 {
 		i -= len(m.Creator)
 		copy(dAtA[i:], m.Creator)
@@ -176,6 +193,10 @@ func (m *AggregatedKeyShare) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovAggregatedKeyShare(uint64(l))
 	}
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovAggregatedKeyShare(uint64(l))
+	}
 	return n
 }
 
@@ -297,6 +318,38 @@ func (m *AggregatedKeyShare) Unmarshal(dAtA []byte) error {
 			}
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAggregatedKeyShare
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAggregatedKeyShare
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAggregatedKeyShare
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAggregatedKeyShare(dAtA[iNdEx:])
@@ -0,0 +1,31 @@
+package types
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ValidateBasic is used for validating the packet
+func (p AggregatedKeySharePacketData) ValidateBasic() error {
+	if p.Height == 0 {
+		return errors.New("height can not be zero")
+	}
+	if p.Data == "" {
+		return errors.New("aggregated key can not be empty")
+	}
+	return nil
+}
+
+// GetBytes is a helper for serialising
+func (p AggregatedKeySharePacketData) GetBytes() ([]byte, error) {
+	var modulePacket PepPacketData
+
+	modulePacket.Packet = &PepPacketData_AggregatedKeySharePacket{&p}
+
+	b, err := MustProtoMarshalJSON(&modulePacket)
+	if err != nil {
+		return nil, err
+	}
+	return sdk.MustSortJSON(b), nil
+}
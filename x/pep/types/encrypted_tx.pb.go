@@ -144,35 +144,92 @@ func (m *EncryptedTxArray) GetEncryptedTx() []EncryptedTx {
 	return nil
 }
 
+// EncryptedTxExecutionQueueEntry is a height whose encrypted txs are waiting to be executed,
+// next_index is the index of the next encrypted tx to execute
+type EncryptedTxExecutionQueueEntry struct {
+	Height    uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	NextIndex uint64 `protobuf:"varint,2,opt,name=next_index,json=nextIndex,proto3" json:"next_index,omitempty"`
+}
+
+func (m *EncryptedTxExecutionQueueEntry) Reset()         { *m = EncryptedTxExecutionQueueEntry{} }
+func (m *EncryptedTxExecutionQueueEntry) String() string { return proto.CompactTextString(m) }
+func (*EncryptedTxExecutionQueueEntry) ProtoMessage()    {}
+func (*EncryptedTxExecutionQueueEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7c124d687cde8326, []int{2}
+}
+func (m *EncryptedTxExecutionQueueEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EncryptedTxExecutionQueueEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EncryptedTxExecutionQueueEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EncryptedTxExecutionQueueEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EncryptedTxExecutionQueueEntry.Merge(m, src)
+}
+func (m *EncryptedTxExecutionQueueEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *EncryptedTxExecutionQueueEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_EncryptedTxExecutionQueueEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EncryptedTxExecutionQueueEntry proto.InternalMessageInfo
+
+func (m *EncryptedTxExecutionQueueEntry) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *EncryptedTxExecutionQueueEntry) GetNextIndex() uint64 {
+	if m != nil {
+		return m.NextIndex
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*EncryptedTx)(nil), "fairyring.pep.EncryptedTx")
 	proto.RegisterType((*EncryptedTxArray)(nil), "fairyring.pep.EncryptedTxArray")
+	proto.RegisterType((*EncryptedTxExecutionQueueEntry)(nil), "fairyring.pep.EncryptedTxExecutionQueueEntry")
 }
 
 func init() { proto.RegisterFile("fairyring/pep/encrypted_tx.proto", fileDescriptor_7c124d687cde8326) }
 
 var fileDescriptor_7c124d687cde8326 = []byte{
-	// 306 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x91, 0xb1, 0x4e, 0xc3, 0x30,
-	0x10, 0x86, 0x63, 0x9a, 0x82, 0x70, 0x40, 0x42, 0x56, 0x91, 0x4c, 0x07, 0x13, 0x75, 0xca, 0x64,
-	0xab, 0x65, 0x62, 0xa4, 0x08, 0xc1, 0x1c, 0x21, 0x06, 0x16, 0xe4, 0x26, 0x47, 0xea, 0x81, 0xd8,
-	0x72, 0x2c, 0x94, 0xbc, 0x05, 0x8f, 0xc2, 0x63, 0x74, 0xec, 0xc8, 0x84, 0x50, 0xfb, 0x22, 0xa8,
-	0x0e, 0xa5, 0xe9, 0x76, 0xf7, 0xff, 0xff, 0x9d, 0x3e, 0xdd, 0xe1, 0xf8, 0x55, 0x2a, 0xdb, 0x58,
-	0x55, 0x16, 0xc2, 0x80, 0x11, 0x50, 0x66, 0xb6, 0x31, 0x0e, 0xf2, 0x17, 0x57, 0x73, 0x63, 0xb5,
-	0xd3, 0xe4, 0xf4, 0x3f, 0xc1, 0x0d, 0x98, 0xe1, 0xa0, 0xd0, 0x85, 0xf6, 0x8e, 0xd8, 0x54, 0x6d,
-	0x68, 0xc8, 0x32, 0x5d, 0xbd, 0xe9, 0x4a, 0xcc, 0x64, 0x05, 0xe2, 0x7d, 0x3c, 0x03, 0x27, 0xc7,
-	0x22, 0xd3, 0xaa, 0x6c, 0xfd, 0xd1, 0x27, 0xc2, 0xd1, 0xdd, 0x76, 0xf7, 0x63, 0x4d, 0x46, 0xf8,
-	0xc4, 0x49, 0x5b, 0x80, 0x7b, 0x00, 0x55, 0xcc, 0x1d, 0x45, 0x31, 0x4a, 0xc2, 0x74, 0x4f, 0x23,
-	0x03, 0xdc, 0x57, 0x65, 0x0e, 0x35, 0x3d, 0xf0, 0x66, 0xdb, 0x10, 0x82, 0xc3, 0x5c, 0x3a, 0x49,
-	0x7b, 0x31, 0x4a, 0x8e, 0x53, 0x5f, 0x13, 0x8a, 0x8f, 0x32, 0x0b, 0xd2, 0x69, 0x4b, 0x43, 0x2f,
-	0x6f, 0x5b, 0x72, 0x8d, 0x71, 0x36, 0xdf, 0x2c, 0xcd, 0xef, 0x65, 0x45, 0xfb, 0x31, 0x4a, 0xa2,
-	0xc9, 0x05, 0x6f, 0x61, 0xf9, 0x06, 0x96, 0xff, 0xc1, 0xf2, 0x5b, 0xad, 0xca, 0xb4, 0x13, 0x1e,
-	0x3d, 0xe1, 0xb3, 0x0e, 0xf1, 0x8d, 0xb5, 0xb2, 0x21, 0x53, 0x1c, 0xc1, 0x4e, 0xa3, 0x28, 0xee,
-	0x25, 0xd1, 0x64, 0xc8, 0xf7, 0x2e, 0xc4, 0x3b, 0x53, 0xd3, 0x70, 0xf1, 0x7d, 0x19, 0xa4, 0xdd,
-	0xa1, 0xa9, 0x58, 0xac, 0x18, 0x5a, 0xae, 0x18, 0xfa, 0x59, 0x31, 0xf4, 0xb1, 0x66, 0xc1, 0x72,
-	0xcd, 0x82, 0xaf, 0x35, 0x0b, 0x9e, 0xcf, 0x77, 0xbf, 0xa8, 0xfd, 0x37, 0x5c, 0x63, 0xa0, 0x9a,
-	0x1d, 0xfa, 0x13, 0x5e, 0xfd, 0x06, 0x00, 0x00, 0xff, 0xff, 0x95, 0xcb, 0x8a, 0x8d, 0xab, 0x01,
-	0x00, 0x00,
+	// 344 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x51, 0xc1, 0x4a, 0xc3, 0x40,
+	0x14, 0xcc, 0xda, 0xb4, 0xd2, 0x8d, 0x82, 0x2c, 0x55, 0x62, 0xc1, 0x35, 0xe4, 0x94, 0xd3, 0x86,
+	0xd6, 0x93, 0x47, 0x2b, 0x45, 0x3d, 0x1a, 0x44, 0xc1, 0x4b, 0xd9, 0x26, 0xcf, 0x34, 0x07, 0x77,
+	0xc3, 0x66, 0x2b, 0xc9, 0x5f, 0xf8, 0x29, 0x7e, 0x46, 0x8f, 0x3d, 0x7a, 0x12, 0x69, 0x7f, 0x44,
+	0x92, 0xb4, 0x36, 0xbd, 0xbd, 0x37, 0x33, 0xef, 0x31, 0xcc, 0x60, 0xe7, 0x8d, 0x27, 0xaa, 0x50,
+	0x89, 0x88, 0xfd, 0x14, 0x52, 0x1f, 0x44, 0xa8, 0x8a, 0x54, 0x43, 0x34, 0xd1, 0x39, 0x4b, 0x95,
+	0xd4, 0x92, 0x1c, 0xff, 0x2b, 0x58, 0x0a, 0x69, 0xbf, 0x17, 0xcb, 0x58, 0x56, 0x8c, 0x5f, 0x4e,
+	0xb5, 0xa8, 0x4f, 0x43, 0x99, 0xbd, 0xcb, 0xcc, 0x9f, 0xf2, 0x0c, 0xfc, 0x8f, 0xc1, 0x14, 0x34,
+	0x1f, 0xf8, 0xa1, 0x4c, 0x44, 0xcd, 0xbb, 0x5f, 0x08, 0x5b, 0xe3, 0xed, 0xef, 0xa7, 0x9c, 0xb8,
+	0xf8, 0x48, 0x73, 0x15, 0x83, 0xbe, 0x87, 0x24, 0x9e, 0x69, 0x1b, 0x39, 0xc8, 0x33, 0x83, 0x3d,
+	0x8c, 0xf4, 0x70, 0x3b, 0x11, 0x11, 0xe4, 0xf6, 0x41, 0x45, 0xd6, 0x0b, 0x21, 0xd8, 0x8c, 0xb8,
+	0xe6, 0x76, 0xcb, 0x41, 0x5e, 0x37, 0xa8, 0x66, 0x62, 0xe3, 0xc3, 0x50, 0x01, 0xd7, 0x52, 0xd9,
+	0x66, 0x05, 0x6f, 0x57, 0x72, 0x8d, 0x71, 0x38, 0x2b, 0x9f, 0x46, 0x77, 0x3c, 0xb3, 0xdb, 0x0e,
+	0xf2, 0xac, 0xe1, 0x39, 0xab, 0xcd, 0xb2, 0xd2, 0x2c, 0xdb, 0x98, 0x65, 0xb7, 0x32, 0x11, 0x41,
+	0x43, 0xec, 0x3e, 0xe3, 0x93, 0x86, 0xe3, 0x1b, 0xa5, 0x78, 0x41, 0x46, 0xd8, 0x82, 0x1d, 0x66,
+	0x23, 0xa7, 0xe5, 0x59, 0xc3, 0x3e, 0xdb, 0x4b, 0x88, 0x35, 0xae, 0x46, 0xe6, 0xe2, 0xe7, 0xd2,
+	0x08, 0x9a, 0x47, 0xee, 0x0b, 0xa6, 0x0d, 0xc5, 0x38, 0x87, 0x70, 0xae, 0x13, 0x29, 0x1e, 0xe7,
+	0x30, 0x87, 0xb1, 0xd0, 0xaa, 0x20, 0x67, 0xb8, 0x33, 0x6b, 0xc6, 0xb2, 0xd9, 0xc8, 0x05, 0xc6,
+	0x02, 0x72, 0x3d, 0x69, 0xa6, 0xd2, 0x2d, 0x91, 0x87, 0x12, 0x18, 0xf9, 0x8b, 0x15, 0x45, 0xcb,
+	0x15, 0x45, 0xbf, 0x2b, 0x8a, 0x3e, 0xd7, 0xd4, 0x58, 0xae, 0xa9, 0xf1, 0xbd, 0xa6, 0xc6, 0xeb,
+	0xe9, 0xae, 0xe4, 0xbc, 0xaa, 0x59, 0x17, 0x29, 0x64, 0xd3, 0x4e, 0xd5, 0xcd, 0xd5, 0xdf, 0x00,
+	0xea, 0x72, 0x75, 0xef, 0x04, 0x02, 0x00, 0x00,
 }
 
 func (m *EncryptedTx) Marshal() (dAtA []byte, err error) {
@@ -271,6 +328,39 @@ func (m *EncryptedTxArray) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EncryptedTxExecutionQueueEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EncryptedTxExecutionQueueEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EncryptedTxExecutionQueueEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NextIndex != 0 {
+		i = encodeVarintEncryptedTx(dAtA, i, uint64(m.NextIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintEncryptedTx(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEncryptedTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEncryptedTx(v)
 	base := offset
@@ -324,6 +414,21 @@ func (m *EncryptedTxArray) Size() (n int) {
 	return n
 }
 
+func (m *EncryptedTxExecutionQueueEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovEncryptedTx(uint64(m.Height))
+	}
+	if m.NextIndex != 0 {
+		n += 1 + sovEncryptedTx(uint64(m.NextIndex))
+	}
+	return n
+}
+
 func sovEncryptedTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -602,6 +707,94 @@ func (m *EncryptedTxArray) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EncryptedTxExecutionQueueEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEncryptedTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EncryptedTxExecutionQueueEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EncryptedTxExecutionQueueEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryptedTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextIndex", wireType)
+			}
+			m.NextIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEncryptedTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEncryptedTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEncryptedTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEncryptedTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
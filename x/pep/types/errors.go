@@ -8,7 +8,14 @@ import (
 
 // x/pep module sentinel errors
 var (
-	ErrInvalidVersion           = sdkerrors.Register(ModuleName, 1501, "invalid version")
-	ErrInvalidTargetBlockHeight = sdkerrors.Register(ModuleName, 1600, "Invalid target block height")
-	ErrInvalidMsgCreator        = sdkerrors.Register(ModuleName, 1700, "Invalid msg creator address")
+	ErrInvalidVersion                = sdkerrors.Register(ModuleName, 1501, "invalid version")
+	ErrInvalidTargetBlockHeight      = sdkerrors.Register(ModuleName, 1600, "Invalid target block height")
+	ErrInvalidMsgCreator             = sdkerrors.Register(ModuleName, 1700, "Invalid msg creator address")
+	ErrUntrustedCounterparty         = sdkerrors.Register(ModuleName, 1800, "counterparty is not trusted")
+	ErrActivePubKeyNotFound          = sdkerrors.Register(ModuleName, 1801, "active public key not found")
+	ErrInvalidAggregatedKeyShare     = sdkerrors.Register(ModuleName, 1802, "invalid aggregated keyshare")
+	ErrAggregatedKeyShareOtherPubKey = sdkerrors.Register(ModuleName, 1803, "aggregated keyshare height does not belong to the active public key")
+	ErrUntrustedMsgCreator           = sdkerrors.Register(ModuleName, 1804, "msg not from trusted source")
+	ErrUnallowedSubscriber           = sdkerrors.Register(ModuleName, 1805, "channel connection is not allowed to subscribe to the keys")
+	ErrMaxSubscribedChannels         = sdkerrors.Register(ModuleName, 1806, "max subscribed channels reached")
 )
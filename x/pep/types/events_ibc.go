@@ -2,8 +2,10 @@ package types
 
 // IBC events
 const (
-	EventTypeTimeout           = "timeout"
-	EventTypeCurrentKeysPacket = "currentKeys_packet"
+	EventTypeTimeout                  = "timeout"
+	EventTypeCurrentKeysPacket        = "currentKeys_packet"
+	EventTypePubKeysPacket            = "pubKeys_packet"
+	EventTypeAggregatedKeySharePacket = "aggregatedKeyShare_packet"
 	// this line is used by starport scaffolding # ibc/packet/event
 
 	AttributeKeyAckSuccess = "success"
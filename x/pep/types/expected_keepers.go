@@ -18,6 +18,11 @@ type AccountKeeper interface {
 	// Methods imported from account should be defined here
 }
 
+// KeyshareKeeper defines the expected interface needed to fund the keyshare reward pool with encrypted tx fees
+type KeyshareKeeper interface {
+	CollectEncryptedTxFees(ctx sdk.Context, senderModule string, fees sdk.Coins) error
+}
+
 // BankKeeper defines the expected interface needed to retrieve account balances.
 type BankKeeper interface {
 	SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
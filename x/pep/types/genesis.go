@@ -50,6 +50,24 @@ func (gs GenesisState) Validate() error {
 		}
 		aggregatedKeyShareIndexMap[index] = struct{}{}
 	}
+	// Check for duplicated pending execution height
+	pendingExecutionHeightMap := make(map[uint64]struct{})
+
+	for _, elem := range gs.PendingExecutionHeightList {
+		if _, ok := pendingExecutionHeightMap[elem]; ok {
+			return fmt.Errorf("duplicated pending execution height")
+		}
+		pendingExecutionHeightMap[elem] = struct{}{}
+	}
+	// Check for duplicated height in the execution queue
+	executionQueueMap := make(map[uint64]struct{})
+
+	for _, elem := range gs.ExecutionQueueList {
+		if _, ok := executionQueueMap[elem.Height]; ok {
+			return fmt.Errorf("duplicated height in execution queue")
+		}
+		executionQueueMap[elem.Height] = struct{}{}
+	}
 	// this line is used by starport scaffolding # genesis/types/validate
 
 	// Check for valid addresses in NONCE
@@ -30,9 +30,11 @@ type GenesisState struct {
 	EncryptedTxArray []EncryptedTxArray `protobuf:"bytes,3,rep,name=encryptedTxArray,proto3" json:"encryptedTxArray"`
 	PepNonceList     []PepNonce         `protobuf:"bytes,4,rep,name=pepNonceList,proto3" json:"pepNonceList"`
 	// this line is used by starport scaffolding # genesis/proto/state
-	AggregatedKeyShareList []AggregatedKeyShare `protobuf:"bytes,6,rep,name=aggregatedKeyShareList,proto3" json:"aggregatedKeyShareList"`
-	ActivePubKey           ActivePubKey         `protobuf:"bytes,7,opt,name=activePubKey,proto3" json:"activePubKey"`
-	QueuedPubKey           QueuedPubKey         `protobuf:"bytes,8,opt,name=queuedPubKey,proto3" json:"queuedPubKey"`
+	AggregatedKeyShareList     []AggregatedKeyShare             `protobuf:"bytes,6,rep,name=aggregatedKeyShareList,proto3" json:"aggregatedKeyShareList"`
+	ActivePubKey               ActivePubKey                     `protobuf:"bytes,7,opt,name=activePubKey,proto3" json:"activePubKey"`
+	QueuedPubKey               QueuedPubKey                     `protobuf:"bytes,8,opt,name=queuedPubKey,proto3" json:"queuedPubKey"`
+	PendingExecutionHeightList []uint64                         `protobuf:"varint,9,rep,packed,name=pendingExecutionHeightList,proto3" json:"pendingExecutionHeightList,omitempty"`
+	ExecutionQueueList         []EncryptedTxExecutionQueueEntry `protobuf:"bytes,10,rep,name=executionQueueList,proto3" json:"executionQueueList"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -117,6 +119,20 @@ func (m *GenesisState) GetQueuedPubKey() QueuedPubKey {
 	return QueuedPubKey{}
 }
 
+func (m *GenesisState) GetPendingExecutionHeightList() []uint64 {
+	if m != nil {
+		return m.PendingExecutionHeightList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetExecutionQueueList() []EncryptedTxExecutionQueueEntry {
+	if m != nil {
+		return m.ExecutionQueueList
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "fairyring.pep.GenesisState")
 }
@@ -124,32 +140,35 @@ func init() {
 func init() { proto.RegisterFile("fairyring/pep/genesis.proto", fileDescriptor_c02ca82ac7a8fa8f) }
 
 var fileDescriptor_c02ca82ac7a8fa8f = []byte{
-	// 386 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xcf, 0x6e, 0xa2, 0x50,
-	0x14, 0x87, 0x61, 0x34, 0x38, 0x73, 0x75, 0x92, 0x09, 0x19, 0x47, 0x82, 0x19, 0x64, 0x66, 0xc5,
-	0x0a, 0x12, 0x7d, 0x02, 0x4d, 0xcc, 0x64, 0x62, 0xd3, 0xf8, 0xa7, 0xab, 0x6e, 0xc8, 0x55, 0x4e,
-	0x29, 0x69, 0x0a, 0xb7, 0x97, 0x4b, 0x23, 0x6f, 0xd1, 0xc7, 0x72, 0xe9, 0xb2, 0xab, 0xa6, 0xd5,
-	0x17, 0x69, 0xb8, 0x5c, 0x15, 0xb0, 0xdd, 0x41, 0x7e, 0xdf, 0xf9, 0xee, 0x39, 0x27, 0x07, 0x75,
-	0x6f, 0x70, 0x40, 0x53, 0x1a, 0x84, 0xbe, 0x43, 0x80, 0x38, 0x3e, 0x84, 0x10, 0x07, 0xb1, 0x4d,
-	0x68, 0xc4, 0x22, 0xf5, 0xfb, 0x31, 0xb4, 0x09, 0x10, 0xfd, 0xa7, 0x1f, 0xf9, 0x11, 0x4f, 0x9c,
-	0xec, 0x2b, 0x87, 0x74, 0xbd, 0x6c, 0x20, 0x98, 0xe2, 0x7b, 0x21, 0xd0, 0xcd, 0x72, 0x06, 0xe1,
-	0x8a, 0xa6, 0x84, 0x81, 0xe7, 0xb2, 0xb5, 0x20, 0x7e, 0x57, 0xaa, 0x81, 0xb8, 0x61, 0x14, 0xae,
-	0x40, 0xc4, 0x56, 0x39, 0xc6, 0xbe, 0x4f, 0xc1, 0xc7, 0x99, 0xe1, 0x0e, 0x52, 0x37, 0xbe, 0xc5,
-	0xf4, 0x40, 0x56, 0x06, 0x21, 0xc9, 0x32, 0x43, 0xf2, 0xf0, 0xef, 0x5b, 0x0d, 0xb5, 0xfe, 0xe5,
-	0xa3, 0x2d, 0x18, 0x66, 0xa0, 0x0e, 0x90, 0x92, 0x37, 0xaa, 0xc9, 0xa6, 0x6c, 0x35, 0xfb, 0x6d,
-	0xbb, 0x34, 0xaa, 0x3d, 0xe5, 0xe1, 0xa8, 0xbe, 0x79, 0xe9, 0x49, 0x73, 0x81, 0xaa, 0x1d, 0xd4,
-	0x20, 0x11, 0x65, 0x6e, 0xe0, 0x69, 0x5f, 0x4c, 0xd9, 0xfa, 0x36, 0x57, 0xb2, 0xdf, 0xff, 0x9e,
-	0x3a, 0x43, 0x3f, 0x8e, 0xa3, 0x5d, 0xad, 0x87, 0x94, 0xe2, 0x54, 0xab, 0x99, 0x35, 0xab, 0xd9,
-	0xef, 0x55, 0xbc, 0xe3, 0x0a, 0x26, 0x5e, 0x38, 0x2b, 0x57, 0x87, 0xa8, 0x45, 0x80, 0x5c, 0x66,
-	0xab, 0xb8, 0x08, 0x62, 0xa6, 0xd5, 0xb9, 0xae, 0x53, 0x6d, 0x53, 0x20, 0x42, 0x53, 0x2a, 0x51,
-	0x5d, 0xf4, 0xeb, 0xb4, 0xaf, 0x09, 0xa4, 0x8b, 0x6c, 0x5b, 0x5c, 0xa6, 0x70, 0xd9, 0x9f, 0x8a,
-	0x6c, 0x78, 0x06, 0x0b, 0xed, 0x27, 0x1a, 0x75, 0x8c, 0x5a, 0x78, 0xc5, 0x82, 0x47, 0x98, 0x26,
-	0xcb, 0x09, 0xa4, 0x5a, 0x83, 0xaf, 0xb2, 0x5b, 0xd5, 0x16, 0x90, 0x43, 0x9f, 0xc5, 0xb2, 0x4c,
-	0xf3, 0x90, 0x40, 0x02, 0x9e, 0xd0, 0x7c, 0xfd, 0x50, 0x33, 0x2b, 0x20, 0x07, 0x4d, 0xb1, 0x6c,
-	0xe4, 0x6c, 0x76, 0x86, 0xbc, 0xdd, 0x19, 0xf2, 0xeb, 0xce, 0x90, 0x9f, 0xf6, 0x86, 0xb4, 0xdd,
-	0x1b, 0xd2, 0xf3, 0xde, 0x90, 0xae, 0xdb, 0xa7, 0xd3, 0x58, 0xf3, 0xe3, 0x60, 0x29, 0x81, 0x78,
-	0xa9, 0xf0, 0xdb, 0x18, 0xbc, 0x07, 0x00, 0x00, 0xff, 0xff, 0x58, 0x19, 0xd7, 0x31, 0x03, 0x03,
-	0x00, 0x00,
+	// 439 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x4f, 0x6f, 0x94, 0x40,
+	0x18, 0xc6, 0x17, 0x77, 0xa5, 0x76, 0xba, 0x26, 0x66, 0x62, 0x2d, 0xa1, 0x91, 0xa2, 0x27, 0x2e,
+	0x42, 0xd2, 0xde, 0x4d, 0xb6, 0x09, 0x51, 0x53, 0x63, 0xda, 0xad, 0x27, 0x2f, 0x64, 0x16, 0x5e,
+	0xa7, 0x13, 0xe3, 0x30, 0x0e, 0x83, 0x81, 0x2f, 0xe0, 0xd9, 0x8f, 0xd5, 0x63, 0x8f, 0x9e, 0x8c,
+	0xd9, 0xfd, 0x22, 0x86, 0x61, 0x68, 0x81, 0xfe, 0xb9, 0x01, 0xcf, 0xf3, 0xfc, 0xe6, 0x7d, 0x1f,
+	0x06, 0xed, 0x7f, 0x25, 0x4c, 0xd6, 0x92, 0x71, 0x1a, 0x09, 0x10, 0x11, 0x05, 0x0e, 0x05, 0x2b,
+	0x42, 0x21, 0x73, 0x95, 0xe3, 0xa7, 0xd7, 0x62, 0x28, 0x40, 0xb8, 0xcf, 0x69, 0x4e, 0x73, 0xad,
+	0x44, 0xcd, 0x53, 0x6b, 0x72, 0xdd, 0x21, 0x41, 0x10, 0x49, 0xbe, 0x1b, 0x80, 0xeb, 0x0f, 0x35,
+	0xe0, 0xa9, 0xac, 0x85, 0x82, 0x2c, 0x51, 0x95, 0x71, 0xbc, 0x1c, 0xa5, 0x41, 0x24, 0x3c, 0xe7,
+	0x29, 0x18, 0x39, 0x18, 0xca, 0x84, 0x52, 0x09, 0x94, 0x34, 0x84, 0x6f, 0x50, 0x27, 0xc5, 0x05,
+	0x91, 0x9d, 0x73, 0xb4, 0x88, 0x28, 0x57, 0x8d, 0xa5, 0x15, 0x5f, 0xff, 0x7a, 0x8c, 0xe6, 0xef,
+	0xda, 0xd5, 0xce, 0x15, 0x51, 0x80, 0x8f, 0x90, 0xdd, 0x0e, 0xea, 0x58, 0xbe, 0x15, 0xec, 0x1c,
+	0xee, 0x86, 0x83, 0x55, 0xc3, 0x53, 0x2d, 0x1e, 0xcf, 0x2e, 0xff, 0x1e, 0x4c, 0x96, 0xc6, 0x8a,
+	0xf7, 0xd0, 0x96, 0xc8, 0xa5, 0x4a, 0x58, 0xe6, 0x3c, 0xf2, 0xad, 0x60, 0x7b, 0x69, 0x37, 0xaf,
+	0x1f, 0x32, 0x7c, 0x86, 0x9e, 0x5d, 0xaf, 0xf6, 0xb9, 0x5a, 0x48, 0x49, 0x6a, 0x67, 0xea, 0x4f,
+	0x83, 0x9d, 0xc3, 0x83, 0x11, 0x37, 0x1e, 0xd9, 0xcc, 0x09, 0xb7, 0xe2, 0x78, 0x81, 0xe6, 0x02,
+	0xc4, 0xa7, 0xa6, 0x8a, 0x8f, 0xac, 0x50, 0xce, 0x4c, 0xe3, 0xf6, 0xc6, 0x63, 0x1a, 0x8b, 0xc1,
+	0x0c, 0x22, 0x38, 0x41, 0x2f, 0x6e, 0xfa, 0x3a, 0x81, 0xfa, 0xbc, 0x69, 0x4b, 0xc3, 0x6c, 0x0d,
+	0x7b, 0x35, 0x82, 0x2d, 0x6e, 0x99, 0x0d, 0xf6, 0x1e, 0x0c, 0x8e, 0xd1, 0x9c, 0xa4, 0x8a, 0xfd,
+	0x84, 0xd3, 0x72, 0x75, 0x02, 0xb5, 0xb3, 0xa5, 0xab, 0xdc, 0x1f, 0x63, 0x7b, 0x96, 0x6e, 0xce,
+	0x7e, 0xac, 0xc1, 0xfc, 0x28, 0xa1, 0x84, 0xcc, 0x60, 0x9e, 0xdc, 0x89, 0x39, 0xeb, 0x59, 0x3a,
+	0x4c, 0x3f, 0x86, 0xdf, 0x22, 0x57, 0x00, 0xcf, 0x18, 0xa7, 0x71, 0x05, 0x69, 0xa9, 0x58, 0xce,
+	0xdf, 0x03, 0xa3, 0x17, 0x4a, 0xaf, 0xbc, 0xed, 0x4f, 0x83, 0xd9, 0xf2, 0x01, 0x07, 0x4e, 0x11,
+	0x86, 0xee, 0xb3, 0x3e, 0x4c, 0xe7, 0x90, 0xae, 0xea, 0xcd, 0xfd, 0xbf, 0x31, 0x1e, 0x64, 0x62,
+	0xae, 0x64, 0x37, 0xde, 0x1d, 0xb8, 0xe3, 0xe8, 0x72, 0xed, 0x59, 0x57, 0x6b, 0xcf, 0xfa, 0xb7,
+	0xf6, 0xac, 0xdf, 0x1b, 0x6f, 0x72, 0xb5, 0xf1, 0x26, 0x7f, 0x36, 0xde, 0xe4, 0xcb, 0xee, 0xcd,
+	0xfd, 0xad, 0xf4, 0x0d, 0x56, 0xb5, 0x80, 0x62, 0x65, 0xeb, 0x0b, 0x7c, 0xf4, 0x7f, 0x00, 0x15,
+	0x83, 0x49, 0xa6, 0xa8, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -172,6 +191,38 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ExecutionQueueList) > 0 {
+		for iNdEx := len(m.ExecutionQueueList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExecutionQueueList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.PendingExecutionHeightList) > 0 {
+		dAtA2 := make([]byte, len(m.PendingExecutionHeightList)*10)
+		var j1 int
+		for _, num := range m.PendingExecutionHeightList {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintGenesis(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x4a
+	}
 	{
 		size, err := m.QueuedPubKey.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -299,6 +350,19 @@ func (m *GenesisState) Size() (n int) {
 	n += 1 + l + sovGenesis(uint64(l))
 	l = m.QueuedPubKey.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.PendingExecutionHeightList) > 0 {
+		l = 0
+		for _, e := range m.PendingExecutionHeightList {
+			l += sovGenesis(uint64(e))
+		}
+		n += 1 + sovGenesis(uint64(l)) + l
+	}
+	if len(m.ExecutionQueueList) > 0 {
+		for _, e := range m.ExecutionQueueList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -570,6 +634,116 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PendingExecutionHeightList = append(m.PendingExecutionHeightList, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PendingExecutionHeightList) == 0 {
+					m.PendingExecutionHeightList = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PendingExecutionHeightList = append(m.PendingExecutionHeightList, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingExecutionHeightList", wireType)
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionQueueList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExecutionQueueList = append(m.ExecutionQueueList, EncryptedTxExecutionQueueEntry{})
+			if err := m.ExecutionQueueList[len(m.ExecutionQueueList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -128,6 +128,30 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "duplicated pendingExecutionHeight",
+			genState: &types.GenesisState{
+				PendingExecutionHeightList: []uint64{1, 1},
+				Params:                     types.DefaultParams(),
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated executionQueue height",
+			genState: &types.GenesisState{
+				ExecutionQueueList: []types.EncryptedTxExecutionQueueEntry{
+					{
+						Height: 1,
+					},
+					{
+						Height:    1,
+						NextIndex: 2,
+					},
+				},
+				Params: types.DefaultParams(),
+			},
+			valid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
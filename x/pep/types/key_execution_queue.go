@@ -0,0 +1,24 @@
+package types
+
+import "encoding/binary"
+
+var _ binary.ByteOrder
+
+const (
+	// ExecutionQueueKeyPrefix is the prefix to retrieve all EncryptedTxExecutionQueueEntry
+	ExecutionQueueKeyPrefix = "ExecutionQueue/value/"
+)
+
+// ExecutionQueueKey returns the store key to retrieve an EncryptedTxExecutionQueueEntry from the index fields
+func ExecutionQueueKey(
+	height uint64,
+) []byte {
+	var key []byte
+
+	heightBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(heightBytes, height)
+	key = append(key, heightBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
@@ -0,0 +1,24 @@
+package types
+
+import "encoding/binary"
+
+var _ binary.ByteOrder
+
+const (
+	// PendingExecutionHeightKeyPrefix is the prefix to retrieve all PendingExecutionHeight
+	PendingExecutionHeightKeyPrefix = "PendingExecutionHeight/value/"
+)
+
+// PendingExecutionHeightKey returns the store key to retrieve a PendingExecutionHeight from the index fields
+func PendingExecutionHeightKey(
+	height uint64,
+) []byte {
+	var key []byte
+
+	heightBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(heightBytes, height)
+	key = append(key, heightBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
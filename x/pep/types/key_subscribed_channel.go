@@ -0,0 +1,26 @@
+package types
+
+const (
+	// SubscribedChannelKeyPrefix is the prefix to retrieve all the channels the keys are pushed to
+	SubscribedChannelKeyPrefix = "SubscribedChannel/value/"
+
+	// PendingKeySyncKeyPrefix is the prefix to retrieve all the subscribed channels waiting for the current keys
+	PendingKeySyncKeyPrefix = "PendingKeySync/value/"
+
+	// PendingAggregatedKeyPushKeyPrefix is the prefix to retrieve all the aggregated keys waiting to be pushed
+	// to the subscribed channels at the end of the block
+	PendingAggregatedKeyPushKeyPrefix = "PendingAggregatedKeyPush/value/"
+)
+
+// SubscribedChannelKey returns the store key to retrieve a subscribed channel from its channel id
+func SubscribedChannelKey(
+	channelID string,
+) []byte {
+	var key []byte
+
+	channelIDBytes := []byte(channelID)
+	key = append(key, channelIDBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
@@ -1,5 +1,7 @@
 package types
 
+import "time"
+
 const (
 	// ModuleName defines the module name
 	ModuleName = "pep"
@@ -21,6 +23,9 @@ const (
 
 	// ChannelID is the default channel id that module will use to transmit IBC packets.
 	ChannelID = "channel-0"
+
+	// KeyPacketTimeout is the timeout of the packets pushing keys to the subscribed channels
+	KeyPacketTimeout = 20 * time.Second
 )
 
 var (
@@ -45,6 +50,8 @@ const (
 	EncryptedTxExecutedEventHeight  = "executed-encrypted-tx-target-height"
 	EncryptedTxExecutedEventIndex   = "executed-encrypted-tx-index"
 	EncryptedTxExecutedEventData    = "executed-encrypted-tx-data"
+
+	EncryptedTxExecutedEventMsgResponses = "executed-encrypted-tx-msg-responses"
 )
 
 const (
@@ -62,6 +69,19 @@ const (
 	KeyShareVerificationReason  = "keyshare-verification-reason"
 )
 
+const (
+	KeysPushedEventType       = "keys-pushed"
+	KeysPushedEventChannel    = "keys-pushed-channel"
+	KeysPushedEventSequence   = "keys-pushed-sequence"
+	KeysPushedEventPacketType = "keys-pushed-packet-type"
+	KeysPushedEventHeight     = "keys-pushed-height"
+)
+
+const (
+	KeysPushedPacketTypePubKeys            = "pub-keys"
+	KeysPushedPacketTypeAggregatedKeyShare = "aggregated-keyshare"
+)
+
 const (
 	KeyTotalEncryptedTxSubmitted = "total_encrypted_tx_submitted"
 	KeyTotalSuccessEncryptedTx   = "total_success_encrypted_tx"
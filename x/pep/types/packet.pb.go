@@ -26,6 +26,8 @@ type PepPacketData struct {
 	// Types that are valid to be assigned to Packet:
 	//	*PepPacketData_NoData
 	//	*PepPacketData_CurrentKeysPacket
+	//	*PepPacketData_PubKeysPacket
+	//	*PepPacketData_AggregatedKeySharePacket
 	Packet isPepPacketData_Packet `protobuf_oneof:"packet"`
 }
 
@@ -74,9 +76,17 @@ type PepPacketData_NoData struct {
 type PepPacketData_CurrentKeysPacket struct {
 	CurrentKeysPacket *CurrentKeysPacketData `protobuf:"bytes,2,opt,name=currentKeysPacket,proto3,oneof" json:"currentKeysPacket,omitempty"`
 }
+type PepPacketData_PubKeysPacket struct {
+	PubKeysPacket *PubKeysPacketData `protobuf:"bytes,3,opt,name=pubKeysPacket,proto3,oneof" json:"pubKeysPacket,omitempty"`
+}
+type PepPacketData_AggregatedKeySharePacket struct {
+	AggregatedKeySharePacket *AggregatedKeySharePacketData `protobuf:"bytes,4,opt,name=aggregatedKeySharePacket,proto3,oneof" json:"aggregatedKeySharePacket,omitempty"`
+}
 
-func (*PepPacketData_NoData) isPepPacketData_Packet()            {}
-func (*PepPacketData_CurrentKeysPacket) isPepPacketData_Packet() {}
+func (*PepPacketData_NoData) isPepPacketData_Packet()                   {}
+func (*PepPacketData_CurrentKeysPacket) isPepPacketData_Packet()        {}
+func (*PepPacketData_PubKeysPacket) isPepPacketData_Packet()            {}
+func (*PepPacketData_AggregatedKeySharePacket) isPepPacketData_Packet() {}
 
 func (m *PepPacketData) GetPacket() isPepPacketData_Packet {
 	if m != nil {
@@ -99,11 +109,27 @@ func (m *PepPacketData) GetCurrentKeysPacket() *CurrentKeysPacketData {
 	return nil
 }
 
+func (m *PepPacketData) GetPubKeysPacket() *PubKeysPacketData {
+	if x, ok := m.GetPacket().(*PepPacketData_PubKeysPacket); ok {
+		return x.PubKeysPacket
+	}
+	return nil
+}
+
+func (m *PepPacketData) GetAggregatedKeySharePacket() *AggregatedKeySharePacketData {
+	if x, ok := m.GetPacket().(*PepPacketData_AggregatedKeySharePacket); ok {
+		return x.AggregatedKeySharePacket
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*PepPacketData) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*PepPacketData_NoData)(nil),
 		(*PepPacketData_CurrentKeysPacket)(nil),
+		(*PepPacketData_PubKeysPacket)(nil),
+		(*PepPacketData_AggregatedKeySharePacket)(nil),
 	}
 }
 
@@ -233,34 +259,225 @@ func (m *CurrentKeysPacketAck) GetQueuedKey() *QueuedPubKey {
 	return nil
 }
 
+// PubKeysPacketData defines a struct for the packet payload pushing the public keys to a subscribed channel
+type PubKeysPacketData struct {
+	ActiveKey *ActivePubKey `protobuf:"bytes,1,opt,name=activeKey,proto3" json:"activeKey,omitempty"`
+	QueuedKey *QueuedPubKey `protobuf:"bytes,2,opt,name=queuedKey,proto3" json:"queuedKey,omitempty"`
+}
+
+func (m *PubKeysPacketData) Reset()         { *m = PubKeysPacketData{} }
+func (m *PubKeysPacketData) String() string { return proto.CompactTextString(m) }
+func (*PubKeysPacketData) ProtoMessage()    {}
+func (*PubKeysPacketData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_69dc34a7ea22bf8e, []int{4}
+}
+func (m *PubKeysPacketData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PubKeysPacketData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PubKeysPacketData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PubKeysPacketData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PubKeysPacketData.Merge(m, src)
+}
+func (m *PubKeysPacketData) XXX_Size() int {
+	return m.Size()
+}
+func (m *PubKeysPacketData) XXX_DiscardUnknown() {
+	xxx_messageInfo_PubKeysPacketData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PubKeysPacketData proto.InternalMessageInfo
+
+func (m *PubKeysPacketData) GetActiveKey() *ActivePubKey {
+	if m != nil {
+		return m.ActiveKey
+	}
+	return nil
+}
+
+func (m *PubKeysPacketData) GetQueuedKey() *QueuedPubKey {
+	if m != nil {
+		return m.QueuedKey
+	}
+	return nil
+}
+
+// PubKeysPacketAck defines a struct for the packet acknowledgment
+type PubKeysPacketAck struct {
+}
+
+func (m *PubKeysPacketAck) Reset()         { *m = PubKeysPacketAck{} }
+func (m *PubKeysPacketAck) String() string { return proto.CompactTextString(m) }
+func (*PubKeysPacketAck) ProtoMessage()    {}
+func (*PubKeysPacketAck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_69dc34a7ea22bf8e, []int{5}
+}
+func (m *PubKeysPacketAck) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PubKeysPacketAck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PubKeysPacketAck.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PubKeysPacketAck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PubKeysPacketAck.Merge(m, src)
+}
+func (m *PubKeysPacketAck) XXX_Size() int {
+	return m.Size()
+}
+func (m *PubKeysPacketAck) XXX_DiscardUnknown() {
+	xxx_messageInfo_PubKeysPacketAck.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PubKeysPacketAck proto.InternalMessageInfo
+
+// AggregatedKeySharePacketData defines a struct for the packet payload pushing the aggregated key of a height to a subscribed channel
+type AggregatedKeySharePacketData struct {
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Data   string `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *AggregatedKeySharePacketData) Reset()         { *m = AggregatedKeySharePacketData{} }
+func (m *AggregatedKeySharePacketData) String() string { return proto.CompactTextString(m) }
+func (*AggregatedKeySharePacketData) ProtoMessage()    {}
+func (*AggregatedKeySharePacketData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_69dc34a7ea22bf8e, []int{6}
+}
+func (m *AggregatedKeySharePacketData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AggregatedKeySharePacketData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AggregatedKeySharePacketData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AggregatedKeySharePacketData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AggregatedKeySharePacketData.Merge(m, src)
+}
+func (m *AggregatedKeySharePacketData) XXX_Size() int {
+	return m.Size()
+}
+func (m *AggregatedKeySharePacketData) XXX_DiscardUnknown() {
+	xxx_messageInfo_AggregatedKeySharePacketData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AggregatedKeySharePacketData proto.InternalMessageInfo
+
+func (m *AggregatedKeySharePacketData) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *AggregatedKeySharePacketData) GetData() string {
+	if m != nil {
+		return m.Data
+	}
+	return ""
+}
+
+// AggregatedKeySharePacketAck defines a struct for the packet acknowledgment
+type AggregatedKeySharePacketAck struct {
+}
+
+func (m *AggregatedKeySharePacketAck) Reset()         { *m = AggregatedKeySharePacketAck{} }
+func (m *AggregatedKeySharePacketAck) String() string { return proto.CompactTextString(m) }
+func (*AggregatedKeySharePacketAck) ProtoMessage()    {}
+func (*AggregatedKeySharePacketAck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_69dc34a7ea22bf8e, []int{7}
+}
+func (m *AggregatedKeySharePacketAck) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AggregatedKeySharePacketAck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AggregatedKeySharePacketAck.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AggregatedKeySharePacketAck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AggregatedKeySharePacketAck.Merge(m, src)
+}
+func (m *AggregatedKeySharePacketAck) XXX_Size() int {
+	return m.Size()
+}
+func (m *AggregatedKeySharePacketAck) XXX_DiscardUnknown() {
+	xxx_messageInfo_AggregatedKeySharePacketAck.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AggregatedKeySharePacketAck proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*PepPacketData)(nil), "fairyring.pep.PepPacketData")
 	proto.RegisterType((*NoData)(nil), "fairyring.pep.NoData")
 	proto.RegisterType((*CurrentKeysPacketData)(nil), "fairyring.pep.CurrentKeysPacketData")
 	proto.RegisterType((*CurrentKeysPacketAck)(nil), "fairyring.pep.CurrentKeysPacketAck")
+	proto.RegisterType((*PubKeysPacketData)(nil), "fairyring.pep.PubKeysPacketData")
+	proto.RegisterType((*PubKeysPacketAck)(nil), "fairyring.pep.PubKeysPacketAck")
+	proto.RegisterType((*AggregatedKeySharePacketData)(nil), "fairyring.pep.AggregatedKeySharePacketData")
+	proto.RegisterType((*AggregatedKeySharePacketAck)(nil), "fairyring.pep.AggregatedKeySharePacketAck")
 }
 
 func init() { proto.RegisterFile("fairyring/pep/packet.proto", fileDescriptor_69dc34a7ea22bf8e) }
 
 var fileDescriptor_69dc34a7ea22bf8e = []byte{
-	// 272 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4a, 0x4b, 0xcc, 0x2c,
-	0xaa, 0x2c, 0xca, 0xcc, 0x4b, 0xd7, 0x2f, 0x48, 0x2d, 0xd0, 0x2f, 0x48, 0x4c, 0xce, 0x4e, 0x2d,
-	0xd1, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x85, 0xcb, 0xe9, 0x15, 0xa4, 0x16, 0x48, 0x49,
-	0xa3, 0x29, 0x2d, 0x4d, 0x8a, 0xcf, 0x4e, 0xad, 0x84, 0xa8, 0x55, 0x5a, 0xc0, 0xc8, 0xc5, 0x1b,
-	0x90, 0x5a, 0x10, 0x00, 0xd6, 0xef, 0x92, 0x58, 0x92, 0x28, 0xa4, 0xcf, 0xc5, 0x96, 0x97, 0x0f,
-	0x62, 0x49, 0x30, 0x2a, 0x30, 0x6a, 0x70, 0x1b, 0x89, 0xea, 0xa1, 0x18, 0xa7, 0xe7, 0x07, 0x96,
-	0xf4, 0x60, 0x08, 0x82, 0x2a, 0x13, 0x0a, 0xe1, 0x12, 0x4c, 0x2e, 0x2d, 0x2a, 0x4a, 0xcd, 0x2b,
-	0xf1, 0x4e, 0xad, 0x2c, 0x86, 0x98, 0x24, 0xc1, 0x04, 0xd6, 0xab, 0x82, 0xa6, 0xd7, 0x19, 0x5d,
-	0x1d, 0xd4, 0x28, 0x4c, 0x03, 0x9c, 0x38, 0xb8, 0xd8, 0x20, 0x9e, 0x52, 0xe2, 0xe0, 0x62, 0x83,
-	0xd8, 0xa9, 0x24, 0xce, 0x25, 0x8a, 0xd5, 0x04, 0xa5, 0x1e, 0x46, 0x2e, 0x11, 0x0c, 0x19, 0xc7,
-	0xe4, 0x6c, 0x21, 0x4b, 0x2e, 0xce, 0xc4, 0xe4, 0x92, 0xcc, 0xb2, 0x54, 0xef, 0xd4, 0x4a, 0xa8,
-	0x7f, 0xa4, 0xd1, 0xdc, 0xe4, 0x08, 0x96, 0x0f, 0x28, 0x4d, 0xf2, 0x4e, 0xad, 0x0c, 0x42, 0xa8,
-	0x06, 0x69, 0x2d, 0x2c, 0x4d, 0x2d, 0x4d, 0x4d, 0x01, 0x69, 0x65, 0xc2, 0xaa, 0x35, 0x10, 0x2c,
-	0x0f, 0xd3, 0x0a, 0x57, 0xed, 0xa4, 0x7f, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f,
-	0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c,
-	0x51, 0xa2, 0x88, 0xb8, 0xa8, 0x00, 0xc7, 0x46, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0x38,
-	0x32, 0x8c, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0x8f, 0x7d, 0x51, 0x1e, 0xd6, 0x01, 0x00, 0x00,
+	// 382 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x93, 0xc1, 0x4a, 0xf3, 0x40,
+	0x14, 0x85, 0x93, 0xfe, 0x25, 0xb4, 0xf7, 0xa7, 0x60, 0x07, 0xab, 0xa1, 0xd5, 0x50, 0x82, 0x0b,
+	0x41, 0x48, 0x40, 0x57, 0x2e, 0x53, 0x5d, 0x14, 0x0b, 0x52, 0xa3, 0x2b, 0x37, 0x32, 0x49, 0xaf,
+	0x69, 0x08, 0xb4, 0x63, 0x3a, 0x11, 0xf3, 0x08, 0x82, 0x0b, 0x1f, 0xcb, 0x65, 0x37, 0x82, 0x4b,
+	0x69, 0x5f, 0x44, 0x32, 0x89, 0xad, 0x4d, 0x5a, 0xb7, 0xee, 0x26, 0x9c, 0x73, 0x3e, 0xce, 0xcc,
+	0xcd, 0x85, 0xe6, 0x3d, 0xf5, 0xc3, 0x38, 0xf4, 0x47, 0x9e, 0xc9, 0x90, 0x99, 0x8c, 0xba, 0x01,
+	0x72, 0x83, 0x85, 0x63, 0x3e, 0x26, 0xb5, 0x85, 0x66, 0x30, 0x64, 0xcd, 0x56, 0xce, 0x1a, 0x39,
+	0x77, 0x01, 0xc6, 0xa9, 0x57, 0x7f, 0x2f, 0x41, 0xad, 0x8f, 0xac, 0x2f, 0xf2, 0xe7, 0x94, 0x53,
+	0x62, 0x82, 0x32, 0x1a, 0x27, 0x27, 0x55, 0x6e, 0xcb, 0x87, 0xff, 0x8f, 0x1b, 0xc6, 0x0a, 0xce,
+	0xb8, 0x14, 0x62, 0x57, 0xb2, 0x33, 0x1b, 0xb9, 0x81, 0xba, 0x1b, 0x85, 0x21, 0x8e, 0x78, 0x0f,
+	0xe3, 0x49, 0x4a, 0x52, 0x4b, 0x22, 0x7b, 0x90, 0xcb, 0x9e, 0xe5, 0x7d, 0x19, 0xaa, 0x08, 0x20,
+	0x5d, 0xa8, 0xb1, 0xc8, 0xf9, 0x41, 0xfc, 0x27, 0x88, 0xed, 0x1c, 0xb1, 0x1f, 0x39, 0x05, 0xda,
+	0x6a, 0x90, 0xf8, 0xa0, 0x52, 0xcf, 0x0b, 0xd1, 0xa3, 0x1c, 0x07, 0x3d, 0x8c, 0xaf, 0x87, 0x34,
+	0xc4, 0x0c, 0x5a, 0x16, 0xd0, 0xa3, 0x1c, 0xd4, 0xda, 0x60, 0xcf, 0xf8, 0x1b, 0x71, 0x9d, 0x0a,
+	0x28, 0xe9, 0x24, 0xf4, 0x0a, 0x28, 0xe9, 0x43, 0xe9, 0xbb, 0xd0, 0x58, 0x7b, 0x6d, 0xfd, 0x45,
+	0x86, 0xed, 0x82, 0x62, 0xb9, 0x01, 0x39, 0x85, 0x2a, 0x75, 0xb9, 0xff, 0x88, 0x3d, 0x8c, 0xb3,
+	0x21, 0xb4, 0xf2, 0x0d, 0x85, 0x9e, 0x5e, 0xde, 0x5e, 0xba, 0x93, 0xe8, 0x43, 0x84, 0x91, 0x28,
+	0xa6, 0x96, 0xd6, 0x46, 0xaf, 0x84, 0xfe, 0x1d, 0x5d, 0xb8, 0xf5, 0x67, 0x19, 0xea, 0x85, 0xd7,
+	0xfc, 0xa3, 0x2e, 0x04, 0xb6, 0x56, 0xaa, 0x58, 0x6e, 0xa0, 0x5f, 0xc0, 0xde, 0x6f, 0x73, 0x21,
+	0x3b, 0xa0, 0x0c, 0xd1, 0xf7, 0x86, 0x5c, 0xd4, 0x2c, 0xdb, 0xd9, 0x17, 0x21, 0x50, 0x1e, 0x24,
+	0x7f, 0x73, 0xd2, 0xa0, 0x6a, 0x8b, 0xb3, 0xbe, 0x0f, 0xad, 0x4d, 0x2c, 0xcb, 0x0d, 0x3a, 0xe6,
+	0xdb, 0x4c, 0x93, 0xa7, 0x33, 0x4d, 0xfe, 0x9c, 0x69, 0xf2, 0xeb, 0x5c, 0x93, 0xa6, 0x73, 0x4d,
+	0xfa, 0x98, 0x6b, 0xd2, 0x6d, 0x63, 0xb9, 0x4b, 0x4f, 0x62, 0x9b, 0x78, 0xcc, 0x70, 0xe2, 0x28,
+	0x62, 0x99, 0x4e, 0xbe, 0x06, 0x00, 0xd7, 0x65, 0x6d, 0x29, 0x96, 0x03, 0x00, 0x00,
 }
 
 func (m *PepPacketData) Marshal() (dAtA []byte, err error) {
@@ -337,6 +554,48 @@ func (m *PepPacketData_CurrentKeysPacket) MarshalToSizedBuffer(dAtA []byte) (int
 	}
 	return len(dAtA) - i, nil
 }
+func (m *PepPacketData_PubKeysPacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PepPacketData_PubKeysPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.PubKeysPacket != nil {
+		{
+			size, err := m.PubKeysPacket.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *PepPacketData_AggregatedKeySharePacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PepPacketData_AggregatedKeySharePacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.AggregatedKeySharePacket != nil {
+		{
+			size, err := m.AggregatedKeySharePacket.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	return len(dAtA) - i, nil
+}
 func (m *NoData) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -430,78 +689,247 @@ func (m *CurrentKeysPacketAck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintPacket(dAtA []byte, offset int, v uint64) int {
-	offset -= sovPacket(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *PubKeysPacketData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *PepPacketData) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Packet != nil {
-		n += m.Packet.Size()
-	}
-	return n
+
+func (m *PubKeysPacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PepPacketData_NoData) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *PubKeysPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.NoData != nil {
-		l = m.NoData.Size()
-		n += 1 + l + sovPacket(uint64(l))
-	}
-	return n
-}
-func (m *PepPacketData_CurrentKeysPacket) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.QueuedKey != nil {
+		{
+			size, err := m.QueuedKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	var l int
-	_ = l
-	if m.CurrentKeysPacket != nil {
-		l = m.CurrentKeysPacket.Size()
-		n += 1 + l + sovPacket(uint64(l))
+	if m.ActiveKey != nil {
+		{
+			size, err := m.ActiveKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *NoData) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *PubKeysPacketAck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *CurrentKeysPacketData) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *PubKeysPacketAck) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PubKeysPacketAck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *CurrentKeysPacketAck) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AggregatedKeySharePacketData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.ActiveKey != nil {
+	return dAtA[:n], nil
+}
+
+func (m *AggregatedKeySharePacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AggregatedKeySharePacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AggregatedKeySharePacketAck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AggregatedKeySharePacketAck) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AggregatedKeySharePacketAck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPacket(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPacket(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PepPacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Packet != nil {
+		n += m.Packet.Size()
+	}
+	return n
+}
+
+func (m *PepPacketData_NoData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NoData != nil {
+		l = m.NoData.Size()
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+func (m *PepPacketData_CurrentKeysPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CurrentKeysPacket != nil {
+		l = m.CurrentKeysPacket.Size()
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+func (m *PepPacketData_PubKeysPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PubKeysPacket != nil {
+		l = m.PubKeysPacket.Size()
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+func (m *PepPacketData_AggregatedKeySharePacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AggregatedKeySharePacket != nil {
+		l = m.AggregatedKeySharePacket.Size()
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+func (m *NoData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *CurrentKeysPacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *CurrentKeysPacketAck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ActiveKey != nil {
+		l = m.ActiveKey.Size()
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	if m.QueuedKey != nil {
+		l = m.QueuedKey.Size()
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+
+func (m *PubKeysPacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ActiveKey != nil {
 		l = m.ActiveKey.Size()
 		n += 1 + l + sovPacket(uint64(l))
 	}
@@ -512,6 +940,40 @@ func (m *CurrentKeysPacketAck) Size() (n int) {
 	return n
 }
 
+func (m *PubKeysPacketAck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *AggregatedKeySharePacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovPacket(uint64(m.Height))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+
+func (m *AggregatedKeySharePacketAck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovPacket(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -617,6 +1079,76 @@ func (m *PepPacketData) Unmarshal(dAtA []byte) error {
 			}
 			m.Packet = &PepPacketData_CurrentKeysPacket{v}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKeysPacket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &PubKeysPacketData{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Packet = &PepPacketData_PubKeysPacket{v}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregatedKeySharePacket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &AggregatedKeySharePacketData{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Packet = &PepPacketData_AggregatedKeySharePacket{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPacket(dAtA[iNdEx:])
@@ -860,6 +1392,329 @@ func (m *CurrentKeysPacketAck) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PubKeysPacketData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PubKeysPacketData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PubKeysPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ActiveKey == nil {
+				m.ActiveKey = &ActivePubKey{}
+			}
+			if err := m.ActiveKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.QueuedKey == nil {
+				m.QueuedKey = &QueuedPubKey{}
+			}
+			if err := m.QueuedKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PubKeysPacketAck) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PubKeysPacketAck: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PubKeysPacketAck: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AggregatedKeySharePacketData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AggregatedKeySharePacketData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AggregatedKeySharePacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AggregatedKeySharePacketAck) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AggregatedKeySharePacketAck: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AggregatedKeySharePacketAck: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipPacket(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -6,6 +6,7 @@ import (
 	cosmosmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
 	"gopkg.in/yaml.v2"
 )
 
@@ -28,6 +29,30 @@ var (
 	DefaultMinGasPrice = sdk.NewCoin("ufairy", cosmosmath.NewInt(300000))
 )
 
+var (
+	KeyLateDecryptionWindow            = []byte("LateDecryptionWindow")
+	DefaultLateDecryptionWindow uint64 = 5
+)
+
+var (
+	KeyPermissionlessAggregatedKeys     = []byte("PermissionlessAggregatedKeys")
+	DefaultPermissionlessAggregatedKeys = false
+)
+
+var (
+	KeyMaxEncryptedTxsPerBlock              = []byte("MaxEncryptedTxsPerBlock")
+	DefaultMaxEncryptedTxsPerBlock   uint64 = 100
+	KeyMaxEncryptedTxGasPerBlock            = []byte("MaxEncryptedTxGasPerBlock")
+	DefaultMaxEncryptedTxGasPerBlock uint64 = 20_000_000
+)
+
+var (
+	KeySubscriberConnectionIds     = []byte("SubscriberConnectionIds")
+	DefaultSubscriberConnectionIds []string
+	KeyMaxSubscribedChannels              = []byte("MaxSubscribedChannels")
+	DefaultMaxSubscribedChannels   uint64 = 10
+)
+
 // ParamKeyTable the param key table for launch module
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
@@ -39,18 +64,41 @@ func NewParams(
 	trustedParties []*TrustedCounterParty,
 	channelID string,
 	minGasPrice *sdk.Coin,
+	lateDecryptionWindow uint64,
+	permissionlessAggregatedKeys bool,
+	maxEncryptedTxsPerBlock uint64,
+	maxEncryptedTxGasPerBlock uint64,
+	subscriberConnectionIds []string,
+	maxSubscribedChannels uint64,
 ) Params {
 	return Params{
-		TrustedAddresses:      trAddrs,
-		TrustedCounterParties: trustedParties,
-		ChannelId:             channelID,
-		MinGasPrice:           minGasPrice,
+		TrustedAddresses:             trAddrs,
+		TrustedCounterParties:        trustedParties,
+		ChannelId:                    channelID,
+		MinGasPrice:                  minGasPrice,
+		LateDecryptionWindow:         lateDecryptionWindow,
+		PermissionlessAggregatedKeys: permissionlessAggregatedKeys,
+		MaxEncryptedTxsPerBlock:      maxEncryptedTxsPerBlock,
+		MaxEncryptedTxGasPerBlock:    maxEncryptedTxGasPerBlock,
+		SubscriberConnectionIds:      subscriberConnectionIds,
+		MaxSubscribedChannels:        maxSubscribedChannels,
 	}
 }
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams(DefaultTrustedAddresses, DefaultTrustedCounterParties, DefaultChannelID, &DefaultMinGasPrice)
+	return NewParams(
+		DefaultTrustedAddresses,
+		DefaultTrustedCounterParties,
+		DefaultChannelID,
+		&DefaultMinGasPrice,
+		DefaultLateDecryptionWindow,
+		DefaultPermissionlessAggregatedKeys,
+		DefaultMaxEncryptedTxsPerBlock,
+		DefaultMaxEncryptedTxGasPerBlock,
+		DefaultSubscriberConnectionIds,
+		DefaultMaxSubscribedChannels,
+	)
 }
 
 // ParamSetPairs get the params.ParamSet
@@ -60,6 +108,12 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyTrustedCounterParties, &p.TrustedCounterParties, validateTrustedCounterParties),
 		paramtypes.NewParamSetPair(KeyChannelID, &p.ChannelId, validateChannelID),
 		paramtypes.NewParamSetPair(KeyMinGasPrice, &p.MinGasPrice, validateMinGasPrice),
+		paramtypes.NewParamSetPair(KeyLateDecryptionWindow, &p.LateDecryptionWindow, validateLateDecryptionWindow),
+		paramtypes.NewParamSetPair(KeyPermissionlessAggregatedKeys, &p.PermissionlessAggregatedKeys, validatePermissionlessAggregatedKeys),
+		paramtypes.NewParamSetPair(KeyMaxEncryptedTxsPerBlock, &p.MaxEncryptedTxsPerBlock, validateMaxEncryptedTxsPerBlock),
+		paramtypes.NewParamSetPair(KeyMaxEncryptedTxGasPerBlock, &p.MaxEncryptedTxGasPerBlock, validateMaxEncryptedTxGasPerBlock),
+		paramtypes.NewParamSetPair(KeySubscriberConnectionIds, &p.SubscriberConnectionIds, validateSubscriberConnectionIds),
+		paramtypes.NewParamSetPair(KeyMaxSubscribedChannels, &p.MaxSubscribedChannels, validateMaxSubscribedChannels),
 	}
 }
 
@@ -80,6 +134,30 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateLateDecryptionWindow(p.LateDecryptionWindow); err != nil {
+		return err
+	}
+
+	if err := validatePermissionlessAggregatedKeys(p.PermissionlessAggregatedKeys); err != nil {
+		return err
+	}
+
+	if err := validateMaxEncryptedTxsPerBlock(p.MaxEncryptedTxsPerBlock); err != nil {
+		return err
+	}
+
+	if err := validateMaxEncryptedTxGasPerBlock(p.MaxEncryptedTxGasPerBlock); err != nil {
+		return err
+	}
+
+	if err := validateSubscriberConnectionIds(p.SubscriberConnectionIds); err != nil {
+		return err
+	}
+
+	if err := validateMaxSubscribedChannels(p.MaxSubscribedChannels); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -118,6 +196,16 @@ func validateMinGasPrice(v interface{}) error {
 	return nil
 }
 
+// validateLateDecryptionWindow validates the LateDecryptionWindow param
+func validateLateDecryptionWindow(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
 // validateTrustedAddresses validates the TrustedAddresses param
 func validateTrustedAddresses(v interface{}) error {
 	trustedList, ok := v.([]string)
@@ -163,3 +251,66 @@ func validateTrustedCounterParties(v interface{}) error {
 
 	return nil
 }
+
+// validatePermissionlessAggregatedKeys validates the PermissionlessAggregatedKeys param
+func validatePermissionlessAggregatedKeys(v interface{}) error {
+	if _, ok := v.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+// validateMaxEncryptedTxsPerBlock validates the MaxEncryptedTxsPerBlock param
+func validateMaxEncryptedTxsPerBlock(v interface{}) error {
+	maxTxs, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if maxTxs == 0 {
+		return fmt.Errorf("max encrypted txs per block must be positive")
+	}
+
+	return nil
+}
+
+// validateMaxEncryptedTxGasPerBlock validates the MaxEncryptedTxGasPerBlock param
+func validateMaxEncryptedTxGasPerBlock(v interface{}) error {
+	maxGas, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if maxGas == 0 {
+		return fmt.Errorf("max encrypted tx gas per block must be positive")
+	}
+
+	return nil
+}
+
+// validateSubscriberConnectionIds validates the SubscriberConnectionIds param
+func validateSubscriberConnectionIds(v interface{}) error {
+	connectionIDs, ok := v.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	for i, element := range connectionIDs {
+		if err := host.ConnectionIdentifierValidator(element); err != nil {
+			return fmt.Errorf("connection ID at index %d is invalid: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+// validateMaxSubscribedChannels validates the MaxSubscribedChannels param
+func validateMaxSubscribedChannels(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
@@ -26,10 +26,17 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the module.
 type Params struct {
-	TrustedCounterParties []*TrustedCounterParty `protobuf:"bytes,1,rep,name=trusted_counter_parties,json=trustedCounterParties,proto3" json:"trusted_counter_parties,omitempty"`
-	TrustedAddresses      []string               `protobuf:"bytes,2,rep,name=trusted_addresses,json=trustedAddresses,proto3" json:"trusted_addresses,omitempty"`
-	ChannelId             string                 `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
-	MinGasPrice           *types.Coin            `protobuf:"bytes,4,opt,name=minGasPrice,proto3" json:"minGasPrice,omitempty"`
+	TrustedCounterParties        []*TrustedCounterParty `protobuf:"bytes,1,rep,name=trusted_counter_parties,json=trustedCounterParties,proto3" json:"trusted_counter_parties,omitempty"`
+	TrustedAddresses             []string               `protobuf:"bytes,2,rep,name=trusted_addresses,json=trustedAddresses,proto3" json:"trusted_addresses,omitempty"`
+	ChannelId                    string                 `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	MinGasPrice                  *types.Coin            `protobuf:"bytes,4,opt,name=minGasPrice,proto3" json:"minGasPrice,omitempty"`
+	LateDecryptionWindow         uint64                 `protobuf:"varint,5,opt,name=late_decryption_window,json=lateDecryptionWindow,proto3" json:"late_decryption_window,omitempty"`
+	PermissionlessAggregatedKeys bool                   `protobuf:"varint,6,opt,name=permissionless_aggregated_keys,json=permissionlessAggregatedKeys,proto3" json:"permissionless_aggregated_keys,omitempty"`
+	MaxEncryptedTxsPerBlock      uint64                 `protobuf:"varint,7,opt,name=max_encrypted_txs_per_block,json=maxEncryptedTxsPerBlock,proto3" json:"max_encrypted_txs_per_block,omitempty"`
+	MaxEncryptedTxGasPerBlock    uint64                 `protobuf:"varint,8,opt,name=max_encrypted_tx_gas_per_block,json=maxEncryptedTxGasPerBlock,proto3" json:"max_encrypted_tx_gas_per_block,omitempty"`
+	// connections whose channels on the pep port are pushed the keys
+	SubscriberConnectionIds []string `protobuf:"bytes,9,rep,name=subscriber_connection_ids,json=subscriberConnectionIds,proto3" json:"subscriber_connection_ids,omitempty"`
+	MaxSubscribedChannels   uint64   `protobuf:"varint,10,opt,name=max_subscribed_channels,json=maxSubscribedChannels,proto3" json:"max_subscribed_channels,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -92,6 +99,48 @@ func (m *Params) GetMinGasPrice() *types.Coin {
 	return nil
 }
 
+func (m *Params) GetLateDecryptionWindow() uint64 {
+	if m != nil {
+		return m.LateDecryptionWindow
+	}
+	return 0
+}
+
+func (m *Params) GetPermissionlessAggregatedKeys() bool {
+	if m != nil {
+		return m.PermissionlessAggregatedKeys
+	}
+	return false
+}
+
+func (m *Params) GetMaxEncryptedTxsPerBlock() uint64 {
+	if m != nil {
+		return m.MaxEncryptedTxsPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetMaxEncryptedTxGasPerBlock() uint64 {
+	if m != nil {
+		return m.MaxEncryptedTxGasPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetSubscriberConnectionIds() []string {
+	if m != nil {
+		return m.SubscriberConnectionIds
+	}
+	return nil
+}
+
+func (m *Params) GetMaxSubscribedChannels() uint64 {
+	if m != nil {
+		return m.MaxSubscribedChannels
+	}
+	return 0
+}
+
 type TrustedCounterParty struct {
 	ClientId     string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
 	ConnectionId string `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
@@ -160,30 +209,41 @@ func init() {
 func init() { proto.RegisterFile("fairyring/pep/params.proto", fileDescriptor_9a32cf7d58c7a431) }
 
 var fileDescriptor_9a32cf7d58c7a431 = []byte{
-	// 356 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x91, 0xc1, 0x4e, 0x2a, 0x31,
-	0x18, 0x85, 0xa7, 0x40, 0xc8, 0x9d, 0x72, 0x49, 0xee, 0x9d, 0x7b, 0x89, 0x23, 0xc6, 0x71, 0x82,
-	0x9b, 0x49, 0x4c, 0x3a, 0x01, 0x77, 0xba, 0x52, 0x16, 0x86, 0x1d, 0x99, 0xb8, 0x62, 0x43, 0x4a,
-	0xe7, 0x17, 0x9b, 0x40, 0xdb, 0xb4, 0x85, 0xc8, 0x5b, 0xb8, 0x74, 0xe9, 0xe3, 0xb8, 0x64, 0xe9,
-	0xd2, 0xc0, 0x23, 0xf8, 0x02, 0x66, 0x66, 0x40, 0x34, 0x9a, 0xb8, 0x6b, 0xce, 0xf9, 0xd2, 0xf3,
-	0x9f, 0x1c, 0xdc, 0xbc, 0xa1, 0x5c, 0x2f, 0x34, 0x17, 0xe3, 0x58, 0x81, 0x8a, 0x15, 0xd5, 0x74,
-	0x6a, 0x88, 0xd2, 0xd2, 0x4a, 0xaf, 0xfe, 0xee, 0x11, 0x05, 0xaa, 0xf9, 0x7f, 0x2c, 0xc7, 0x32,
-	0x77, 0xe2, 0xec, 0x55, 0x40, 0xcd, 0x80, 0x49, 0x33, 0x95, 0x26, 0x1e, 0x51, 0x03, 0xf1, 0xbc,
-	0x3d, 0x02, 0x4b, 0xdb, 0x31, 0x93, 0x5c, 0x14, 0x7e, 0xeb, 0x15, 0xe1, 0x6a, 0x3f, 0xff, 0xd5,
-	0x1b, 0xe0, 0x3d, 0xab, 0x67, 0xc6, 0x42, 0x3a, 0x64, 0x72, 0x26, 0x2c, 0xe8, 0xa1, 0xa2, 0xda,
-	0x72, 0x30, 0x3e, 0x0a, 0xcb, 0x51, 0xad, 0xd3, 0x22, 0x9f, 0x12, 0xc9, 0x75, 0x41, 0x77, 0x0b,
-	0xb8, 0x4f, 0xb5, 0x5d, 0x24, 0x0d, 0xfb, 0x45, 0xe4, 0x60, 0xbc, 0x13, 0xfc, 0x77, 0xfb, 0x37,
-	0x4d, 0x53, 0x0d, 0xc6, 0x80, 0xf1, 0x4b, 0x61, 0x39, 0x72, 0x93, 0x3f, 0x1b, 0xe3, 0x62, 0xab,
-	0x7b, 0x87, 0x18, 0xb3, 0x5b, 0x2a, 0x04, 0x4c, 0x86, 0x3c, 0xf5, 0xcb, 0x21, 0x8a, 0xdc, 0xc4,
-	0xdd, 0x28, 0xbd, 0xd4, 0x3b, 0xc7, 0xb5, 0x29, 0x17, 0x57, 0xd4, 0xf4, 0x35, 0x67, 0xe0, 0x57,
-	0x42, 0x14, 0xd5, 0x3a, 0xfb, 0xa4, 0x28, 0x4a, 0xb2, 0xa2, 0x64, 0x53, 0x94, 0x74, 0x25, 0x17,
-	0xc9, 0x47, 0xfa, 0xac, 0xf2, 0xf0, 0x78, 0xe4, 0xb4, 0xe6, 0xf8, 0xdf, 0x37, 0xc7, 0x7b, 0x07,
-	0xd8, 0x65, 0x13, 0x0e, 0xc2, 0x66, 0xb9, 0x28, 0xcf, 0xfd, 0x55, 0x08, 0xbd, 0xd4, 0x3b, 0xc6,
-	0x75, 0x26, 0x85, 0x00, 0x66, 0xb9, 0x14, 0x19, 0x50, 0xca, 0x81, 0xdf, 0x3b, 0xb1, 0x97, 0xfe,
-	0x70, 0xfa, 0x65, 0xfc, 0xb4, 0x0a, 0xd0, 0x72, 0x15, 0xa0, 0x97, 0x55, 0x80, 0xee, 0xd7, 0x81,
-	0xb3, 0x5c, 0x07, 0xce, 0xf3, 0x3a, 0x70, 0x06, 0x8d, 0xdd, 0xd0, 0x77, 0xf9, 0xd4, 0x76, 0xa1,
-	0xc0, 0x8c, 0xaa, 0xf9, 0x4a, 0xa7, 0x6f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x45, 0xbc, 0x8c, 0x68,
-	0x08, 0x02, 0x00, 0x00,
+	// 534 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0xc1, 0x4f, 0xd4, 0x4e,
+	0x14, 0xc7, 0xb7, 0xb0, 0x3f, 0x7e, 0xec, 0x20, 0x89, 0x8e, 0x20, 0x05, 0xb4, 0x6e, 0xf0, 0xb2,
+	0x89, 0x49, 0x1b, 0xd0, 0x78, 0x40, 0x2f, 0xb0, 0x18, 0x42, 0xbc, 0x6c, 0x56, 0x12, 0x13, 0x2e,
+	0x93, 0xe9, 0xcc, 0xb3, 0x4e, 0xd8, 0xce, 0x34, 0xf3, 0x06, 0x68, 0xff, 0x0b, 0x8f, 0x1e, 0xfd,
+	0x73, 0x3c, 0x72, 0xf4, 0x68, 0xc0, 0x3f, 0xc4, 0x74, 0xba, 0x14, 0x56, 0x4d, 0xbc, 0x35, 0xef,
+	0xf3, 0xed, 0x77, 0xde, 0x9b, 0xef, 0x3c, 0xb2, 0xf1, 0x91, 0x2b, 0x5b, 0x59, 0xa5, 0xb3, 0xa4,
+	0x80, 0x22, 0x29, 0xb8, 0xe5, 0x39, 0xc6, 0x85, 0x35, 0xce, 0xd0, 0xe5, 0x96, 0xc5, 0x05, 0x14,
+	0x1b, 0x2b, 0x99, 0xc9, 0x8c, 0x27, 0x49, 0xfd, 0xd5, 0x88, 0x36, 0x22, 0x61, 0x30, 0x37, 0x98,
+	0xa4, 0x1c, 0x21, 0x39, 0xdf, 0x4e, 0xc1, 0xf1, 0xed, 0x44, 0x18, 0xa5, 0x1b, 0xbe, 0xf5, 0xb3,
+	0x4b, 0x16, 0x46, 0xde, 0x95, 0x9e, 0x90, 0x35, 0x67, 0xcf, 0xd0, 0x81, 0x64, 0xc2, 0x9c, 0x69,
+	0x07, 0x96, 0x15, 0xdc, 0x3a, 0x05, 0x18, 0x06, 0xfd, 0xf9, 0xc1, 0xd2, 0xce, 0x56, 0x3c, 0x73,
+	0x62, 0x7c, 0xdc, 0xa8, 0x87, 0x8d, 0x78, 0xc4, 0xad, 0xab, 0xc6, 0xab, 0xee, 0x8f, 0xa2, 0x02,
+	0xa4, 0xcf, 0xc9, 0x83, 0x1b, 0x6f, 0x2e, 0xa5, 0x05, 0x44, 0xc0, 0x70, 0xae, 0x3f, 0x3f, 0xe8,
+	0x8d, 0xef, 0x4f, 0xc1, 0xde, 0x4d, 0x9d, 0x3e, 0x21, 0x44, 0x7c, 0xe2, 0x5a, 0xc3, 0x84, 0x29,
+	0x19, 0xce, 0xf7, 0x83, 0x41, 0x6f, 0xdc, 0x9b, 0x56, 0x8e, 0x24, 0x7d, 0x4d, 0x96, 0x72, 0xa5,
+	0x0f, 0x39, 0x8e, 0xac, 0x12, 0x10, 0x76, 0xfb, 0xc1, 0x60, 0x69, 0x67, 0x3d, 0x6e, 0x06, 0x8d,
+	0xeb, 0x41, 0xe3, 0xe9, 0xa0, 0xf1, 0xd0, 0x28, 0x3d, 0xbe, 0xab, 0xa6, 0x2f, 0xc9, 0xa3, 0x09,
+	0x77, 0xc0, 0x24, 0x08, 0x5b, 0x15, 0x4e, 0x19, 0xcd, 0x2e, 0x94, 0x96, 0xe6, 0x22, 0xfc, 0xaf,
+	0x1f, 0x0c, 0xba, 0xe3, 0x95, 0x9a, 0x1e, 0xb4, 0xf0, 0x83, 0x67, 0xf4, 0x80, 0x44, 0x05, 0xd8,
+	0x5c, 0x21, 0x2a, 0xa3, 0x27, 0x80, 0xc8, 0x78, 0x96, 0x59, 0xc8, 0x78, 0x3d, 0xd0, 0x29, 0x54,
+	0x18, 0x2e, 0xf4, 0x83, 0xc1, 0xe2, 0xf8, 0xf1, 0xac, 0x6a, 0xaf, 0x15, 0xbd, 0x83, 0x0a, 0xe9,
+	0x1b, 0xb2, 0x99, 0xf3, 0x92, 0x81, 0xf6, 0xee, 0x20, 0x99, 0x2b, 0x91, 0x15, 0x60, 0x59, 0x3a,
+	0x31, 0xe2, 0x34, 0xfc, 0xdf, 0x37, 0xb0, 0x96, 0xf3, 0xf2, 0xed, 0x8d, 0xe2, 0xb8, 0xc4, 0x11,
+	0xd8, 0xfd, 0x1a, 0xd3, 0x3d, 0x12, 0xfd, 0xfe, 0x37, 0xcb, 0xf8, 0x5d, 0x83, 0x45, 0x6f, 0xb0,
+	0x3e, 0x6b, 0x70, 0xc8, 0x6f, 0x2d, 0x76, 0xc9, 0x3a, 0x9e, 0xa5, 0x28, 0xac, 0x4a, 0xc1, 0x32,
+	0x61, 0xb4, 0x06, 0xe1, 0xaf, 0x40, 0x49, 0x0c, 0x7b, 0x3e, 0x8d, 0xb5, 0x5b, 0xc1, 0xb0, 0xe5,
+	0x47, 0x12, 0xe9, 0x2b, 0x52, 0x77, 0xc6, 0x5a, 0x2c, 0xd9, 0x34, 0x11, 0x0c, 0x89, 0x3f, 0x77,
+	0x35, 0xe7, 0xe5, 0xfb, 0x96, 0x0e, 0xa7, 0x70, 0xb7, 0xfb, 0xe5, 0xeb, 0xd3, 0xce, 0xd6, 0x39,
+	0x79, 0xf8, 0x97, 0xd7, 0x42, 0x37, 0x49, 0x4f, 0x4c, 0x14, 0x68, 0x57, 0x07, 0x1d, 0xf8, 0xa0,
+	0x17, 0x9b, 0xc2, 0x91, 0xa4, 0xcf, 0xc8, 0xf2, 0x4c, 0x8b, 0xe1, 0x9c, 0x17, 0xdc, 0x13, 0x77,
+	0xfa, 0xfa, 0xc7, 0x5b, 0xd9, 0x4f, 0xbe, 0x5d, 0x45, 0xc1, 0xe5, 0x55, 0x14, 0xfc, 0xb8, 0x8a,
+	0x82, 0xcf, 0xd7, 0x51, 0xe7, 0xf2, 0x3a, 0xea, 0x7c, 0xbf, 0x8e, 0x3a, 0x27, 0xab, 0xb7, 0x9b,
+	0x55, 0xfa, 0xdd, 0x72, 0x55, 0x01, 0x98, 0x2e, 0xf8, 0xb5, 0x78, 0xf1, 0x6b, 0x00, 0xc2, 0x03,
+	0xf4, 0x40, 0x79, 0x03, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -206,6 +266,45 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxSubscribedChannels != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxSubscribedChannels))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.SubscriberConnectionIds) > 0 {
+		for iNdEx := len(m.SubscriberConnectionIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SubscriberConnectionIds[iNdEx])
+			copy(dAtA[i:], m.SubscriberConnectionIds[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.SubscriberConnectionIds[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.MaxEncryptedTxGasPerBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxEncryptedTxGasPerBlock))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MaxEncryptedTxsPerBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxEncryptedTxsPerBlock))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.PermissionlessAggregatedKeys {
+		i--
+		if m.PermissionlessAggregatedKeys {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.LateDecryptionWindow != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.LateDecryptionWindow))
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.MinGasPrice != nil {
 		{
 			size, err := m.MinGasPrice.MarshalToSizedBuffer(dAtA[:i])
@@ -332,6 +431,27 @@ func (m *Params) Size() (n int) {
 		l = m.MinGasPrice.Size()
 		n += 1 + l + sovParams(uint64(l))
 	}
+	if m.LateDecryptionWindow != 0 {
+		n += 1 + sovParams(uint64(m.LateDecryptionWindow))
+	}
+	if m.PermissionlessAggregatedKeys {
+		n += 2
+	}
+	if m.MaxEncryptedTxsPerBlock != 0 {
+		n += 1 + sovParams(uint64(m.MaxEncryptedTxsPerBlock))
+	}
+	if m.MaxEncryptedTxGasPerBlock != 0 {
+		n += 1 + sovParams(uint64(m.MaxEncryptedTxGasPerBlock))
+	}
+	if len(m.SubscriberConnectionIds) > 0 {
+		for _, s := range m.SubscriberConnectionIds {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.MaxSubscribedChannels != 0 {
+		n += 1 + sovParams(uint64(m.MaxSubscribedChannels))
+	}
 	return n
 }
 
@@ -525,6 +645,134 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LateDecryptionWindow", wireType)
+			}
+			m.LateDecryptionWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LateDecryptionWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PermissionlessAggregatedKeys", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PermissionlessAggregatedKeys = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxEncryptedTxsPerBlock", wireType)
+			}
+			m.MaxEncryptedTxsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxEncryptedTxsPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxEncryptedTxGasPerBlock", wireType)
+			}
+			m.MaxEncryptedTxGasPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxEncryptedTxGasPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubscriberConnectionIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubscriberConnectionIds = append(m.SubscriberConnectionIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSubscribedChannels", wireType)
+			}
+			m.MaxSubscribedChannels = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSubscribedChannels |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
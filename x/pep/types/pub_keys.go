@@ -0,0 +1,34 @@
+package types
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ValidateBasic is used for validating the packet
+func (p PubKeysPacketData) ValidateBasic() error {
+	if p.ActiveKey == nil && p.QueuedKey == nil {
+		return errors.New("packet does not contain any public key")
+	}
+	if p.ActiveKey != nil && p.ActiveKey.PublicKey == "" {
+		return errors.New("active public key can not be empty")
+	}
+	if p.QueuedKey != nil && p.QueuedKey.PublicKey == "" {
+		return errors.New("queued public key can not be empty")
+	}
+	return nil
+}
+
+// GetBytes is a helper for serialising
+func (p PubKeysPacketData) GetBytes() ([]byte, error) {
+	var modulePacket PepPacketData
+
+	modulePacket.Packet = &PepPacketData_PubKeysPacket{&p}
+
+	b, err := MustProtoMarshalJSON(&modulePacket)
+	if err != nil {
+		return nil, err
+	}
+	return sdk.MustSortJSON(b), nil
+}
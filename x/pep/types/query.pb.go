@@ -473,6 +473,114 @@ func (m *QueryLatestHeightResponse) GetHeight() uint64 {
 	return 0
 }
 
+type QueryEncryptedTxBacklogRequest struct {
+}
+
+func (m *QueryEncryptedTxBacklogRequest) Reset()         { *m = QueryEncryptedTxBacklogRequest{} }
+func (m *QueryEncryptedTxBacklogRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEncryptedTxBacklogRequest) ProtoMessage()    {}
+func (*QueryEncryptedTxBacklogRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd36cf23112e8be0, []int{10}
+}
+func (m *QueryEncryptedTxBacklogRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEncryptedTxBacklogRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEncryptedTxBacklogRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEncryptedTxBacklogRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEncryptedTxBacklogRequest.Merge(m, src)
+}
+func (m *QueryEncryptedTxBacklogRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEncryptedTxBacklogRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEncryptedTxBacklogRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEncryptedTxBacklogRequest proto.InternalMessageInfo
+
+type QueryEncryptedTxBacklogResponse struct {
+	// queued_heights is the number of heights whose encrypted txs are queued for execution
+	QueuedHeights uint64 `protobuf:"varint,1,opt,name=queued_heights,json=queuedHeights,proto3" json:"queued_heights,omitempty"`
+	// queued_txs is the number of encrypted txs queued for execution
+	QueuedTxs uint64 `protobuf:"varint,2,opt,name=queued_txs,json=queuedTxs,proto3" json:"queued_txs,omitempty"`
+	// pending_heights is the number of heights whose encrypted txs are waiting for their decryption key
+	PendingHeights uint64 `protobuf:"varint,3,opt,name=pending_heights,json=pendingHeights,proto3" json:"pending_heights,omitempty"`
+	// pending_txs is the number of encrypted txs waiting for their decryption key
+	PendingTxs uint64 `protobuf:"varint,4,opt,name=pending_txs,json=pendingTxs,proto3" json:"pending_txs,omitempty"`
+}
+
+func (m *QueryEncryptedTxBacklogResponse) Reset()         { *m = QueryEncryptedTxBacklogResponse{} }
+func (m *QueryEncryptedTxBacklogResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEncryptedTxBacklogResponse) ProtoMessage()    {}
+func (*QueryEncryptedTxBacklogResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd36cf23112e8be0, []int{11}
+}
+func (m *QueryEncryptedTxBacklogResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEncryptedTxBacklogResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEncryptedTxBacklogResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEncryptedTxBacklogResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEncryptedTxBacklogResponse.Merge(m, src)
+}
+func (m *QueryEncryptedTxBacklogResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEncryptedTxBacklogResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEncryptedTxBacklogResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEncryptedTxBacklogResponse proto.InternalMessageInfo
+
+func (m *QueryEncryptedTxBacklogResponse) GetQueuedHeights() uint64 {
+	if m != nil {
+		return m.QueuedHeights
+	}
+	return 0
+}
+
+func (m *QueryEncryptedTxBacklogResponse) GetQueuedTxs() uint64 {
+	if m != nil {
+		return m.QueuedTxs
+	}
+	return 0
+}
+
+func (m *QueryEncryptedTxBacklogResponse) GetPendingHeights() uint64 {
+	if m != nil {
+		return m.PendingHeights
+	}
+	return 0
+}
+
+func (m *QueryEncryptedTxBacklogResponse) GetPendingTxs() uint64 {
+	if m != nil {
+		return m.PendingTxs
+	}
+	return 0
+}
+
 type QueryGetPepNonceRequest struct {
 	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
@@ -481,7 +589,7 @@ func (m *QueryGetPepNonceRequest) Reset()         { *m = QueryGetPepNonceRequest
 func (m *QueryGetPepNonceRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetPepNonceRequest) ProtoMessage()    {}
 func (*QueryGetPepNonceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd36cf23112e8be0, []int{10}
+	return fileDescriptor_dd36cf23112e8be0, []int{12}
 }
 func (m *QueryGetPepNonceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -525,7 +633,7 @@ func (m *QueryGetPepNonceResponse) Reset()         { *m = QueryGetPepNonceRespon
 func (m *QueryGetPepNonceResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetPepNonceResponse) ProtoMessage()    {}
 func (*QueryGetPepNonceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd36cf23112e8be0, []int{11}
+	return fileDescriptor_dd36cf23112e8be0, []int{13}
 }
 func (m *QueryGetPepNonceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -569,7 +677,7 @@ func (m *QueryAllPepNonceRequest) Reset()         { *m = QueryAllPepNonceRequest
 func (m *QueryAllPepNonceRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllPepNonceRequest) ProtoMessage()    {}
 func (*QueryAllPepNonceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd36cf23112e8be0, []int{12}
+	return fileDescriptor_dd36cf23112e8be0, []int{14}
 }
 func (m *QueryAllPepNonceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -614,7 +722,7 @@ func (m *QueryAllPepNonceResponse) Reset()         { *m = QueryAllPepNonceRespon
 func (m *QueryAllPepNonceResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAllPepNonceResponse) ProtoMessage()    {}
 func (*QueryAllPepNonceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd36cf23112e8be0, []int{13}
+	return fileDescriptor_dd36cf23112e8be0, []int{15}
 }
 func (m *QueryAllPepNonceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -664,7 +772,7 @@ func (m *QueryPubKeyRequest) Reset()         { *m = QueryPubKeyRequest{} }
 func (m *QueryPubKeyRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryPubKeyRequest) ProtoMessage()    {}
 func (*QueryPubKeyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd36cf23112e8be0, []int{14}
+	return fileDescriptor_dd36cf23112e8be0, []int{16}
 }
 func (m *QueryPubKeyRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -702,7 +810,7 @@ func (m *QueryPubKeyResponse) Reset()         { *m = QueryPubKeyResponse{} }
 func (m *QueryPubKeyResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryPubKeyResponse) ProtoMessage()    {}
 func (*QueryPubKeyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd36cf23112e8be0, []int{15}
+	return fileDescriptor_dd36cf23112e8be0, []int{17}
 }
 func (m *QueryPubKeyResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -756,6 +864,8 @@ func init() {
 	proto.RegisterType((*QueryAllEncryptedTxFromHeightResponse)(nil), "fairyring.pep.QueryAllEncryptedTxFromHeightResponse")
 	proto.RegisterType((*QueryLatestHeightRequest)(nil), "fairyring.pep.QueryLatestHeightRequest")
 	proto.RegisterType((*QueryLatestHeightResponse)(nil), "fairyring.pep.QueryLatestHeightResponse")
+	proto.RegisterType((*QueryEncryptedTxBacklogRequest)(nil), "fairyring.pep.QueryEncryptedTxBacklogRequest")
+	proto.RegisterType((*QueryEncryptedTxBacklogResponse)(nil), "fairyring.pep.QueryEncryptedTxBacklogResponse")
 	proto.RegisterType((*QueryGetPepNonceRequest)(nil), "fairyring.pep.QueryGetPepNonceRequest")
 	proto.RegisterType((*QueryGetPepNonceResponse)(nil), "fairyring.pep.QueryGetPepNonceResponse")
 	proto.RegisterType((*QueryAllPepNonceRequest)(nil), "fairyring.pep.QueryAllPepNonceRequest")
@@ -767,64 +877,71 @@ func init() {
 func init() { proto.RegisterFile("fairyring/pep/query.proto", fileDescriptor_dd36cf23112e8be0) }
 
 var fileDescriptor_dd36cf23112e8be0 = []byte{
-	// 898 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0x4f, 0x4f, 0xfc, 0x44,
-	0x18, 0xc7, 0xb7, 0xfb, 0x83, 0x15, 0x67, 0xd1, 0x98, 0x91, 0x3f, 0xa5, 0x0b, 0x65, 0x1d, 0x11,
-	0xd6, 0x8d, 0xe9, 0x84, 0x5d, 0x2e, 0x7a, 0x5b, 0x12, 0xc0, 0xa0, 0x31, 0xb0, 0x51, 0x0f, 0x5e,
-	0x36, 0xb3, 0xbb, 0x63, 0x69, 0x2c, 0x6d, 0x69, 0xbb, 0x64, 0x57, 0x42, 0x62, 0xbc, 0x78, 0xf1,
-	0x60, 0xe2, 0xdd, 0x98, 0xf8, 0x2a, 0x78, 0x07, 0x1c, 0x49, 0xbc, 0x78, 0x32, 0x06, 0xbc, 0xfa,
-	0x1e, 0x4c, 0xa7, 0x4f, 0xd9, 0xb6, 0xcc, 0xb2, 0x90, 0x1f, 0xb7, 0x76, 0x9e, 0x7f, 0x9f, 0x67,
-	0xe6, 0x99, 0x6f, 0x8b, 0x56, 0xbe, 0x65, 0x96, 0x3f, 0xf2, 0x2d, 0xc7, 0xa4, 0x1e, 0xf7, 0xe8,
-	0xd9, 0x80, 0xfb, 0x23, 0xc3, 0xf3, 0xdd, 0xd0, 0xc5, 0x6f, 0xdd, 0x9b, 0x0c, 0x8f, 0x7b, 0xda,
-	0x82, 0xe9, 0x9a, 0xae, 0xb0, 0xd0, 0xe8, 0x29, 0x76, 0xd2, 0x56, 0x4d, 0xd7, 0x35, 0x6d, 0x4e,
-	0x99, 0x67, 0x51, 0xe6, 0x38, 0x6e, 0xc8, 0x42, 0xcb, 0x75, 0x02, 0xb0, 0xd6, 0x7b, 0x6e, 0x70,
-	0xea, 0x06, 0xb4, 0xcb, 0x02, 0x1e, 0xe7, 0xa6, 0xe7, 0xdb, 0x5d, 0x1e, 0xb2, 0x6d, 0xea, 0x31,
-	0xd3, 0x72, 0x84, 0x33, 0xf8, 0x6a, 0x59, 0x12, 0x8f, 0xf9, 0xec, 0x34, 0xc9, 0x53, 0xcd, 0xda,
-	0xb8, 0xd3, 0xf3, 0x47, 0x5e, 0xc8, 0xfb, 0x9d, 0x70, 0x08, 0x1e, 0x6b, 0xb9, 0x68, 0xee, 0x75,
-	0x1c, 0xd7, 0xe9, 0x71, 0x30, 0xd7, 0xb2, 0x66, 0x66, 0x9a, 0x3e, 0x37, 0x59, 0x94, 0xe1, 0x3b,
-	0x3e, 0xea, 0x04, 0x27, 0xcc, 0x4f, 0x3c, 0x2b, 0xb9, 0x44, 0x83, 0x6e, 0xe4, 0x12, 0x1b, 0xc9,
-	0x02, 0xc2, 0xc7, 0x51, 0x17, 0x47, 0x02, 0xae, 0xcd, 0xcf, 0x06, 0x3c, 0x08, 0xc9, 0x21, 0x7a,
-	0x37, 0xb3, 0x1a, 0x78, 0xae, 0x13, 0x70, 0xdc, 0x44, 0xa5, 0xb8, 0x09, 0x55, 0xa9, 0x2a, 0xb5,
-	0x72, 0x63, 0xd1, 0xc8, 0x6c, 0xa8, 0x11, 0xbb, 0xef, 0xce, 0x5c, 0xff, 0xbd, 0x5e, 0x68, 0x83,
-	0x2b, 0xf9, 0x1a, 0x69, 0x22, 0xd7, 0x01, 0x0f, 0xf7, 0x92, 0x2e, 0xbf, 0x1c, 0x42, 0x25, 0x4c,
-	0xd0, 0x7c, 0xc8, 0x7c, 0x93, 0x87, 0x9f, 0x72, 0xcb, 0x3c, 0x09, 0x45, 0xe2, 0x99, 0x76, 0x66,
-	0x0d, 0x2f, 0xa0, 0x59, 0xcb, 0xe9, 0xf3, 0xa1, 0x5a, 0x14, 0xc6, 0xf8, 0x85, 0x30, 0x54, 0x91,
-	0xe6, 0x05, 0xd6, 0x5d, 0x54, 0xe6, 0xe3, 0x65, 0x00, 0xd6, 0x72, 0xc0, 0xa9, 0x40, 0xa0, 0x4e,
-	0x07, 0x91, 0x3e, 0xa0, 0xb7, 0x6c, 0x5b, 0x82, 0xbe, 0x8f, 0xd0, 0xf8, 0xc8, 0xa1, 0xc0, 0xa6,
-	0x11, 0xcf, 0x87, 0x11, 0xcd, 0x87, 0x11, 0xcf, 0x1e, 0xcc, 0x87, 0x71, 0xc4, 0x4c, 0x0e, 0xb1,
-	0xed, 0x54, 0x24, 0xb9, 0x52, 0xa0, 0x93, 0x7c, 0x19, 0xe8, 0xe4, 0x18, 0xbd, 0x93, 0x82, 0x6a,
-	0xf9, 0x3e, 0x1b, 0xa9, 0x4a, 0xf5, 0x55, 0xad, 0xdc, 0x58, 0x9f, 0xdc, 0x8e, 0x70, 0x83, 0x9e,
-	0x1e, 0x84, 0xe3, 0x83, 0x0c, 0x7a, 0x51, 0xa0, 0x6f, 0x4d, 0x45, 0x8f, 0x79, 0x32, 0xec, 0x87,
-	0x68, 0x43, 0x82, 0xbe, 0xef, 0xbb, 0xa7, 0xf1, 0xd9, 0x3d, 0xe3, 0x98, 0xc9, 0xf7, 0xe8, 0x83,
-	0x29, 0xb9, 0x1e, 0xdd, 0x10, 0xe5, 0x35, 0x36, 0x84, 0x68, 0x48, 0x15, 0xb5, 0x3f, 0x67, 0x21,
-	0x0f, 0xc2, 0x0c, 0x3b, 0x69, 0xa2, 0x15, 0x89, 0x0d, 0x58, 0x96, 0x50, 0xe9, 0x24, 0xdd, 0x12,
-	0xbc, 0x91, 0x26, 0x5a, 0x4e, 0xa6, 0xf3, 0x88, 0x7b, 0x5f, 0x44, 0x17, 0x37, 0xd9, 0x0b, 0x15,
-	0xbd, 0xc1, 0xfa, 0x7d, 0x9f, 0x07, 0xf1, 0x35, 0x7a, 0xb3, 0x9d, 0xbc, 0x92, 0xaf, 0x80, 0x22,
-	0x13, 0x04, 0x85, 0x3e, 0x46, 0x73, 0x1e, 0xac, 0x41, 0xb3, 0xcb, 0xf9, 0xdb, 0x07, 0x66, 0x68,
-	0xf2, 0xde, 0x9d, 0x30, 0x60, 0x69, 0xd9, 0x76, 0x9e, 0xe5, 0xa5, 0x66, 0xf8, 0x37, 0x05, 0xd0,
-	0x33, 0x35, 0xa4, 0xe8, 0xaf, 0x9e, 0x81, 0xfe, 0x72, 0x83, 0x7a, 0xaf, 0x73, 0x83, 0xee, 0x67,
-	0x7c, 0x94, 0x1c, 0xed, 0x1f, 0x4a, 0x22, 0x74, 0xb0, 0x0c, 0xc4, 0x7b, 0x68, 0x9e, 0xf5, 0x42,
-	0xeb, 0x9c, 0xc7, 0xeb, 0xb0, 0x31, 0x95, 0x1c, 0x75, 0x2b, 0xe5, 0x02, 0xe4, 0x99, 0xb0, 0x28,
-	0xcd, 0xd9, 0x80, 0x0f, 0x78, 0x1f, 0xd2, 0x14, 0xa5, 0x69, 0x8e, 0x53, 0x2e, 0x49, 0x9a, 0x74,
-	0x58, 0xe3, 0xbf, 0x39, 0x34, 0x2b, 0x28, 0xb1, 0x83, 0x4a, 0xb1, 0xc6, 0xe2, 0xf7, 0x1e, 0x26,
-	0xc9, 0x89, 0xb8, 0x46, 0x1e, 0x73, 0x89, 0x1b, 0x25, 0x6b, 0x3f, 0xfe, 0xf9, 0xef, 0xaf, 0xc5,
-	0x65, 0xbc, 0x48, 0x65, 0xdf, 0x2a, 0xfc, 0xbb, 0x82, 0xca, 0xa9, 0x3b, 0x84, 0x3f, 0x94, 0xa5,
-	0x94, 0x0a, 0xbb, 0x56, 0x7f, 0x8a, 0x2b, 0x50, 0x7c, 0x22, 0x28, 0x76, 0x70, 0x83, 0x4e, 0xfe,
-	0x2a, 0xd2, 0x8b, 0xb4, 0x58, 0x5c, 0xd2, 0x0b, 0xf1, 0x15, 0xb8, 0xc4, 0x3f, 0x2b, 0xe8, 0xed,
-	0xf4, 0x35, 0xb7, 0x6d, 0x39, 0xa5, 0x54, 0xc3, 0xe5, 0x94, 0x72, 0x1d, 0x26, 0xef, 0x0b, 0xca,
-	0x35, 0x5c, 0x79, 0x84, 0x12, 0x5f, 0x29, 0x48, 0xcd, 0xe2, 0x8c, 0x05, 0x0c, 0x37, 0xa7, 0x57,
-	0x7b, 0x20, 0x9d, 0xda, 0xce, 0xf3, 0x82, 0x00, 0xb6, 0x21, 0x60, 0x3f, 0xc2, 0xf5, 0xa7, 0x6f,
-	0x29, 0xfe, 0x49, 0x41, 0xf3, 0x69, 0x91, 0xc3, 0x5b, 0xb2, 0xd2, 0x12, 0x89, 0xd4, 0x6a, 0xd3,
-	0x1d, 0x81, 0x6b, 0x43, 0x70, 0xe9, 0x78, 0x35, 0xc7, 0x65, 0x0b, 0xe7, 0x4e, 0xac, 0x9e, 0x11,
-	0xc9, 0x5c, 0xa2, 0x09, 0x78, 0x73, 0xc2, 0x24, 0xe5, 0xb4, 0x4c, 0xdb, 0x9a, 0xea, 0x07, 0x0c,
-	0x75, 0xc1, 0xb0, 0x81, 0x09, 0x9d, 0xf0, 0x8b, 0x45, 0x2f, 0x40, 0x91, 0x2f, 0xf1, 0x0f, 0x0a,
-	0x2a, 0x27, 0x09, 0xa2, 0xd9, 0xda, 0x9c, 0x70, 0x1a, 0x4f, 0x82, 0x91, 0x88, 0x23, 0xa9, 0x0a,
-	0x18, 0x0d, 0xab, 0x93, 0x60, 0xb0, 0x8b, 0x4a, 0xa0, 0x27, 0xf2, 0x4b, 0x9f, 0x56, 0xb4, 0x09,
-	0x97, 0x3e, 0xa3, 0x6e, 0x44, 0x17, 0x25, 0x55, 0xbc, 0x44, 0xa5, 0x7f, 0x86, 0xbb, 0xf4, 0xfa,
-	0x56, 0x57, 0x6e, 0x6e, 0x75, 0xe5, 0x9f, 0x5b, 0x5d, 0xf9, 0xe5, 0x4e, 0x2f, 0xdc, 0xdc, 0xe9,
-	0x85, 0xbf, 0xee, 0xf4, 0xc2, 0x37, 0x8b, 0xe3, 0x80, 0xa1, 0x08, 0x09, 0x47, 0x1e, 0x0f, 0xba,
-	0x25, 0xf1, 0x2f, 0xd9, 0xfc, 0x3f, 0x00, 0x00, 0xff, 0xff, 0xff, 0x1a, 0x1e, 0xa7, 0x7b, 0x0b,
-	0x00, 0x00,
+	// 1009 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0xcf, 0x6f, 0xdc, 0x44,
+	0x14, 0xc7, 0xe3, 0x24, 0x0d, 0xed, 0xdb, 0x34, 0xa0, 0x69, 0xd2, 0xb8, 0xde, 0x66, 0xb3, 0x4c,
+	0xf3, 0x8b, 0x05, 0x6c, 0x75, 0xb7, 0x17, 0xb8, 0x25, 0x52, 0x5b, 0x54, 0x10, 0x4a, 0x56, 0x81,
+	0x03, 0x97, 0xd5, 0xec, 0xee, 0xe0, 0x58, 0x75, 0x6c, 0xc7, 0xf6, 0x56, 0xbb, 0x44, 0x91, 0x10,
+	0x17, 0x2e, 0x1c, 0x2a, 0x71, 0x47, 0x48, 0xfc, 0x05, 0x1c, 0xfb, 0x1f, 0xf4, 0x58, 0x89, 0x0b,
+	0x27, 0x84, 0x12, 0xfe, 0x10, 0xe4, 0x99, 0xe7, 0xc4, 0xf6, 0xce, 0xfe, 0x88, 0xda, 0xdb, 0xee,
+	0xcc, 0xf7, 0x7d, 0xe7, 0x33, 0x33, 0x6f, 0xde, 0x33, 0xdc, 0xfb, 0x9e, 0x39, 0xe1, 0x20, 0x74,
+	0x3c, 0xdb, 0x0a, 0x78, 0x60, 0x9d, 0xf4, 0x78, 0x38, 0x30, 0x83, 0xd0, 0x8f, 0x7d, 0x72, 0xfb,
+	0x72, 0xca, 0x0c, 0x78, 0x60, 0x2c, 0xdb, 0xbe, 0xed, 0x8b, 0x19, 0x2b, 0xf9, 0x25, 0x45, 0xc6,
+	0x7d, 0xdb, 0xf7, 0x6d, 0x97, 0x5b, 0x2c, 0x70, 0x2c, 0xe6, 0x79, 0x7e, 0xcc, 0x62, 0xc7, 0xf7,
+	0x22, 0x9c, 0xad, 0x75, 0xfc, 0xe8, 0xd8, 0x8f, 0xac, 0x36, 0x8b, 0xb8, 0xf4, 0xb6, 0x5e, 0x3c,
+	0x6c, 0xf3, 0x98, 0x3d, 0xb4, 0x02, 0x66, 0x3b, 0x9e, 0x10, 0xa3, 0xd6, 0xc8, 0x93, 0x04, 0x2c,
+	0x64, 0xc7, 0xa9, 0x4f, 0x35, 0x3f, 0xc7, 0xbd, 0x4e, 0x38, 0x08, 0x62, 0xde, 0x6d, 0xc5, 0x7d,
+	0x54, 0xac, 0x15, 0xa2, 0x79, 0xd0, 0xf2, 0x7c, 0xaf, 0xc3, 0x71, 0x7a, 0x27, 0x3f, 0xcd, 0x6c,
+	0x3b, 0xe4, 0x36, 0x4b, 0x1c, 0x9e, 0xf3, 0x41, 0x2b, 0x3a, 0x62, 0x61, 0xaa, 0x2c, 0x17, 0x8c,
+	0x7a, 0xed, 0x44, 0x22, 0x27, 0xe9, 0x32, 0x90, 0x83, 0x64, 0x17, 0xfb, 0x02, 0xae, 0xc9, 0x4f,
+	0x7a, 0x3c, 0x8a, 0xe9, 0x33, 0xb8, 0x93, 0x1b, 0x8d, 0x02, 0xdf, 0x8b, 0x38, 0x69, 0xc0, 0x82,
+	0xdc, 0x84, 0xae, 0x55, 0xb5, 0x9d, 0x52, 0x7d, 0xc5, 0xcc, 0x1d, 0xa8, 0x29, 0xe5, 0x7b, 0xf3,
+	0xaf, 0xff, 0x59, 0x9f, 0x69, 0xa2, 0x94, 0x7e, 0x0b, 0x86, 0xf0, 0x7a, 0xca, 0xe3, 0xc7, 0xe9,
+	0x2e, 0x0f, 0xfb, 0xb8, 0x12, 0xa1, 0xb0, 0x18, 0xb3, 0xd0, 0xe6, 0xf1, 0x17, 0xdc, 0xb1, 0x8f,
+	0x62, 0x61, 0x3c, 0xdf, 0xcc, 0x8d, 0x91, 0x65, 0xb8, 0xe1, 0x78, 0x5d, 0xde, 0xd7, 0x67, 0xc5,
+	0xa4, 0xfc, 0x43, 0x19, 0x94, 0x95, 0xbe, 0xc8, 0xba, 0x07, 0x25, 0x7e, 0x35, 0x8c, 0xc0, 0x46,
+	0x01, 0x38, 0x13, 0x88, 0xd4, 0xd9, 0x20, 0xda, 0x45, 0xf4, 0x5d, 0xd7, 0x55, 0xa0, 0x3f, 0x01,
+	0xb8, 0xba, 0x72, 0x5c, 0x60, 0xcb, 0x94, 0xf9, 0x61, 0x26, 0xf9, 0x61, 0xca, 0xdc, 0xc3, 0xfc,
+	0x30, 0xf7, 0x99, 0xcd, 0x31, 0xb6, 0x99, 0x89, 0xa4, 0xaf, 0x34, 0x28, 0x2b, 0x97, 0xc1, 0x9d,
+	0x1c, 0xc0, 0x07, 0x19, 0xa8, 0xdd, 0x30, 0x64, 0x03, 0x5d, 0xab, 0xce, 0xed, 0x94, 0xea, 0xeb,
+	0xa3, 0xb7, 0x23, 0x64, 0xb8, 0xa7, 0xa1, 0x70, 0xf2, 0x34, 0x87, 0x3e, 0x2b, 0xd0, 0xb7, 0x27,
+	0xa2, 0x4b, 0x9e, 0x1c, 0xfb, 0x33, 0xd8, 0x50, 0xa0, 0x3f, 0x09, 0xfd, 0x63, 0x79, 0x77, 0xd7,
+	0xb8, 0x66, 0xfa, 0x03, 0x6c, 0x4e, 0xf0, 0x1a, 0x7b, 0x20, 0xda, 0x5b, 0x1c, 0x08, 0x35, 0x40,
+	0x17, 0x6b, 0x7f, 0xc5, 0x62, 0x1e, 0xc5, 0x39, 0x76, 0xda, 0x80, 0x7b, 0x8a, 0x39, 0x64, 0xb9,
+	0x0b, 0x0b, 0x47, 0xd9, 0x2d, 0xe1, 0x3f, 0x5a, 0x85, 0x8a, 0x08, 0xca, 0x66, 0x18, 0xeb, 0x3c,
+	0x77, 0x7d, 0x3b, 0xb5, 0xfd, 0x53, 0x83, 0xf5, 0x91, 0x12, 0x74, 0xdf, 0x84, 0xa5, 0x93, 0x1e,
+	0xef, 0xf1, 0x6e, 0x4b, 0xda, 0x46, 0xb8, 0xca, 0x6d, 0x39, 0x2a, 0x59, 0x22, 0xb2, 0x06, 0x80,
+	0xb2, 0xb8, 0x1f, 0xe1, 0x2b, 0xb9, 0x25, 0x47, 0x0e, 0xfb, 0x11, 0xd9, 0x86, 0xf7, 0x03, 0xee,
+	0x75, 0x1d, 0xcf, 0xbe, 0xb4, 0x99, 0x13, 0x9a, 0x25, 0x1c, 0x4e, 0x7d, 0xd6, 0xa1, 0x94, 0x0a,
+	0x13, 0xa3, 0x79, 0x21, 0x02, 0x1c, 0x3a, 0xec, 0x47, 0xb4, 0x01, 0xab, 0xe9, 0x9b, 0xdb, 0xe7,
+	0xc1, 0xd7, 0x49, 0x39, 0x4a, 0x6f, 0x58, 0x87, 0xf7, 0x58, 0xb7, 0x1b, 0xf2, 0x48, 0x32, 0xde,
+	0x6a, 0xa6, 0x7f, 0xe9, 0x37, 0xa0, 0x0f, 0x07, 0xe1, 0x06, 0x3f, 0x83, 0x9b, 0x01, 0x8e, 0xe1,
+	0x15, 0xae, 0x16, 0x6b, 0x0a, 0x4e, 0xe3, 0xd5, 0x5d, 0xca, 0x29, 0x43, 0x96, 0x5d, 0xd7, 0x2d,
+	0xb2, 0xbc, 0xab, 0x97, 0xf9, 0x9b, 0x06, 0xfa, 0xf0, 0x1a, 0x4a, 0xf4, 0xb9, 0x6b, 0xa0, 0xbf,
+	0xbb, 0xe7, 0x77, 0x59, 0xbd, 0x7b, 0xed, 0x2f, 0xf9, 0x20, 0xcd, 0xac, 0x3f, 0x34, 0xb8, 0x93,
+	0x1b, 0x46, 0xe2, 0xc7, 0xb0, 0xc8, 0x3a, 0xb1, 0xf3, 0x82, 0xcb, 0x71, 0x3c, 0x98, 0x72, 0x81,
+	0x7a, 0x37, 0x23, 0x41, 0xf2, 0x5c, 0x58, 0x62, 0x23, 0x73, 0x0b, 0x6d, 0x66, 0x95, 0x36, 0x07,
+	0x19, 0x49, 0x6a, 0x93, 0x0d, 0xab, 0xbf, 0x04, 0xb8, 0x21, 0x28, 0x89, 0x07, 0x0b, 0xb2, 0x73,
+	0x90, 0x0f, 0x87, 0x4d, 0x0a, 0xad, 0xc9, 0xa0, 0xe3, 0x24, 0x72, 0xa3, 0x74, 0xed, 0xa7, 0xbf,
+	0xfe, 0xfb, 0x75, 0x76, 0x95, 0xac, 0x58, 0xaa, 0x0e, 0x4c, 0x7e, 0xd7, 0xa0, 0x94, 0x79, 0x74,
+	0xe4, 0x23, 0x95, 0xa5, 0xb2, 0x5d, 0x19, 0xb5, 0x69, 0xa4, 0x48, 0xf1, 0xb9, 0xa0, 0x78, 0x44,
+	0xea, 0xd6, 0xe8, 0x5e, 0x6f, 0x9d, 0x66, 0x4b, 0xe0, 0x99, 0x75, 0x2a, 0x7a, 0xdb, 0x19, 0xf9,
+	0x45, 0x83, 0xa5, 0x6c, 0xf1, 0x72, 0x5d, 0x35, 0xa5, 0xb2, 0x33, 0x19, 0xb5, 0x69, 0xa4, 0x48,
+	0xf9, 0x40, 0x50, 0xae, 0x91, 0xf2, 0x18, 0x4a, 0xf2, 0x4a, 0x03, 0x3d, 0x8f, 0x73, 0x55, 0x96,
+	0x49, 0x63, 0xf2, 0x6a, 0x43, 0x0d, 0xc1, 0x78, 0x74, 0xbd, 0x20, 0x84, 0xad, 0x0b, 0xd8, 0x4f,
+	0x48, 0x6d, 0xfa, 0x23, 0x25, 0x3f, 0x6b, 0xb0, 0x98, 0x2d, 0xdd, 0x64, 0x5b, 0xb5, 0xb4, 0xa2,
+	0xf0, 0x1b, 0x3b, 0x93, 0x85, 0xc8, 0xb5, 0x21, 0xb8, 0x2a, 0xe4, 0x7e, 0x81, 0xcb, 0x15, 0x62,
+	0xac, 0xba, 0x09, 0xc9, 0xcd, 0xb4, 0x26, 0x90, 0xad, 0x11, 0x99, 0x54, 0xa8, 0x65, 0xc6, 0xf6,
+	0x44, 0x1d, 0x32, 0xd4, 0x04, 0xc3, 0x06, 0xa1, 0xd6, 0x88, 0x0f, 0x47, 0xeb, 0x14, 0x2b, 0xf2,
+	0x19, 0xf9, 0x51, 0x83, 0x52, 0x6a, 0x90, 0xe4, 0xd6, 0xd6, 0x88, 0xdb, 0x98, 0x0a, 0x46, 0x51,
+	0x1c, 0x69, 0x55, 0xc0, 0x18, 0x44, 0x1f, 0x05, 0x43, 0x7c, 0x58, 0xc0, 0x7a, 0xa2, 0x7e, 0xf4,
+	0xd9, 0x8a, 0x66, 0xd0, 0x71, 0x12, 0x5c, 0xb2, 0x22, 0x96, 0xd4, 0xc9, 0x5d, 0x4b, 0xf9, 0xbd,
+	0x9b, 0xbc, 0x7a, 0x32, 0xdc, 0x6a, 0xc9, 0xa7, 0x2a, 0xeb, 0x91, 0x5d, 0xdb, 0x30, 0xa7, 0x95,
+	0x23, 0xd5, 0xc7, 0x82, 0x6a, 0x93, 0x3c, 0x18, 0x93, 0xb1, 0xad, 0xb6, 0x0c, 0xda, 0xb3, 0x5e,
+	0x9f, 0x57, 0xb4, 0x37, 0xe7, 0x15, 0xed, 0xdf, 0xf3, 0x8a, 0xf6, 0xf2, 0xa2, 0x32, 0xf3, 0xe6,
+	0xa2, 0x32, 0xf3, 0xf7, 0x45, 0x65, 0xe6, 0xbb, 0x95, 0xab, 0xe8, 0xbe, 0x88, 0x8f, 0x07, 0x01,
+	0x8f, 0xda, 0x0b, 0xe2, 0x23, 0xbe, 0xf1, 0xff, 0x00, 0x60, 0x41, 0x63, 0x82, 0xf4, 0x0c, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -855,6 +972,8 @@ type QueryClient interface {
 	PepNonceAll(ctx context.Context, in *QueryAllPepNonceRequest, opts ...grpc.CallOption) (*QueryAllPepNonceResponse, error)
 	// Queries the public keys
 	PubKey(ctx context.Context, in *QueryPubKeyRequest, opts ...grpc.CallOption) (*QueryPubKeyResponse, error)
+	// Queries the number of encrypted txs waiting to be executed
+	EncryptedTxBacklog(ctx context.Context, in *QueryEncryptedTxBacklogRequest, opts ...grpc.CallOption) (*QueryEncryptedTxBacklogResponse, error)
 }
 
 type queryClient struct {
@@ -937,6 +1056,15 @@ func (c *queryClient) PubKey(ctx context.Context, in *QueryPubKeyRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) EncryptedTxBacklog(ctx context.Context, in *QueryEncryptedTxBacklogRequest, opts ...grpc.CallOption) (*QueryEncryptedTxBacklogResponse, error) {
+	out := new(QueryEncryptedTxBacklogResponse)
+	err := c.cc.Invoke(ctx, "/fairyring.pep.Query/EncryptedTxBacklog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
@@ -955,6 +1083,8 @@ type QueryServer interface {
 	PepNonceAll(context.Context, *QueryAllPepNonceRequest) (*QueryAllPepNonceResponse, error)
 	// Queries the public keys
 	PubKey(context.Context, *QueryPubKeyRequest) (*QueryPubKeyResponse, error)
+	// Queries the number of encrypted txs waiting to be executed
+	EncryptedTxBacklog(context.Context, *QueryEncryptedTxBacklogRequest) (*QueryEncryptedTxBacklogResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -985,6 +1115,9 @@ func (*UnimplementedQueryServer) PepNonceAll(ctx context.Context, req *QueryAllP
 func (*UnimplementedQueryServer) PubKey(ctx context.Context, req *QueryPubKeyRequest) (*QueryPubKeyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PubKey not implemented")
 }
+func (*UnimplementedQueryServer) EncryptedTxBacklog(ctx context.Context, req *QueryEncryptedTxBacklogRequest) (*QueryEncryptedTxBacklogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EncryptedTxBacklog not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1134,6 +1267,24 @@ func _Query_PubKey_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_EncryptedTxBacklog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEncryptedTxBacklogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EncryptedTxBacklog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fairyring.pep.Query/EncryptedTxBacklog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EncryptedTxBacklog(ctx, req.(*QueryEncryptedTxBacklogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "fairyring.pep.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1170,6 +1321,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "PubKey",
 			Handler:    _Query_PubKey_Handler,
 		},
+		{
+			MethodName: "EncryptedTxBacklog",
+			Handler:    _Query_EncryptedTxBacklog_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "fairyring/pep/query.proto",
@@ -1493,6 +1648,72 @@ func (m *QueryLatestHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryEncryptedTxBacklogRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEncryptedTxBacklogRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEncryptedTxBacklogRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEncryptedTxBacklogResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEncryptedTxBacklogResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEncryptedTxBacklogResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PendingTxs != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PendingTxs))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PendingHeights != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PendingHeights))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.QueuedTxs != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.QueuedTxs))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.QueuedHeights != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.QueuedHeights))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryGetPepNonceRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1839,6 +2060,36 @@ func (m *QueryLatestHeightResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryEncryptedTxBacklogRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryEncryptedTxBacklogResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.QueuedHeights != 0 {
+		n += 1 + sovQuery(uint64(m.QueuedHeights))
+	}
+	if m.QueuedTxs != 0 {
+		n += 1 + sovQuery(uint64(m.QueuedTxs))
+	}
+	if m.PendingHeights != 0 {
+		n += 1 + sovQuery(uint64(m.PendingHeights))
+	}
+	if m.PendingTxs != 0 {
+		n += 1 + sovQuery(uint64(m.PendingTxs))
+	}
+	return n
+}
+
 func (m *QueryGetPepNonceRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2704,6 +2955,182 @@ func (m *QueryLatestHeightResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryEncryptedTxBacklogRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEncryptedTxBacklogRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEncryptedTxBacklogRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEncryptedTxBacklogResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEncryptedTxBacklogResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEncryptedTxBacklogResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedHeights", wireType)
+			}
+			m.QueuedHeights = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueuedHeights |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedTxs", wireType)
+			}
+			m.QueuedTxs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueuedTxs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingHeights", wireType)
+			}
+			m.PendingHeights = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PendingHeights |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingTxs", wireType)
+			}
+			m.PendingTxs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PendingTxs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryGetPepNonceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0